@@ -163,6 +163,14 @@ pachyderm-enterprise
 {{- end }}
 {{- end }}
 
+{{- define "pachyderm.s3GatewayPresignKeySecretName" -}}
+{{- if .Values.pachd.s3GatewayPresignKeySecretName }}
+{{ .Values.pachd.s3GatewayPresignKeySecretName }}
+{{- else if .Values.pachd.s3GatewayPresignKey }}
+pachyderm-s3gateway-presign-key
+{{- end }}
+{{- end }}
+
 {{- define "pachyderm.upstreamIDPsSecretName" -}}
 {{- if .Values.oidc.upstreamIDPsSecretName }}
 {{ .Values.oidc.upstreamIDPsSecretName }}
@@ -11,6 +11,12 @@ set -xeuo pipefail
 # LOCAL_PACH_CONTEXT=kind-kind LOCAL_PACH_APISERVER_HOST=127.0.0.1 LOCAL_PACH_APISERVER_PORT=46407 \
 # ./etc/testing/local.sh ./pachd
 #
+# To run pipeline workers as local processes instead of in kubernetes, build the worker binary and
+# point pachd at it:
+#
+# CGO_ENABLED=0 go build ./src/server/cmd/worker
+# PPS_INFRA_DRIVER=local PPS_LOCAL_WORKER_BINARY=./worker ./etc/testing/local.sh ./pachd
+#
 # The truly motiviated engineer would teach pachd to read ~/.kube/config (testutil already does this
 # if KUBERNETES_SERVICE_HOST is unset), or at least `jq` the host and port out of `kubectl config
 # view -o json`.
@@ -5,10 +5,12 @@ package admin
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -83,30 +85,533 @@ func (m *ClusterInfo) GetDeploymentID() string {
 	return ""
 }
 
+type InspectStorageRequest struct {
+	// repos restricts the per-repo report to these repos, if set. Cluster-wide
+	// totals always cover every repo.
+	Repos []*pfs.Repo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	// details includes a per-commit and per-commit-set breakdown.
+	Details              bool     `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectStorageRequest) Reset()         { *m = InspectStorageRequest{} }
+func (m *InspectStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectStorageRequest) ProtoMessage()    {}
+func (*InspectStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{1}
+}
+func (m *InspectStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectStorageRequest.Merge(m, src)
+}
+func (m *InspectStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectStorageRequest proto.InternalMessageInfo
+
+func (m *InspectStorageRequest) GetRepos() []*pfs.Repo {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *InspectStorageRequest) GetDetails() bool {
+	if m != nil {
+		return m.Details
+	}
+	return false
+}
+
+// StorageStats describes how much data a set of commits holds, and how much
+// it costs to store once content-defined deduplication is taken into account.
+type StorageStats struct {
+	// logical_bytes is the sum of the sizes of the commits' contents, which is
+	// what would be stored without deduplication.
+	LogicalBytes int64 `protobuf:"varint,1,opt,name=logical_bytes,json=logicalBytes,proto3" json:"logical_bytes,omitempty"`
+	// physical_bytes is the size of the distinct chunks the commits reference,
+	// before compression.
+	PhysicalBytes int64 `protobuf:"varint,2,opt,name=physical_bytes,json=physicalBytes,proto3" json:"physical_bytes,omitempty"`
+	// chunks is the number of distinct chunks the commits reference.
+	Chunks int64 `protobuf:"varint,3,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// freeable_bytes is the size of the chunks that no other commits
+	// reference, i.e. what deleting the commits would free.
+	FreeableBytes int64 `protobuf:"varint,4,opt,name=freeable_bytes,json=freeableBytes,proto3" json:"freeable_bytes,omitempty"`
+	// dedup_ratio is logical_bytes / physical_bytes.
+	DedupRatio           float64  `protobuf:"fixed64,5,opt,name=dedup_ratio,json=dedupRatio,proto3" json:"dedup_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageStats) Reset()         { *m = StorageStats{} }
+func (m *StorageStats) String() string { return proto.CompactTextString(m) }
+func (*StorageStats) ProtoMessage()    {}
+func (*StorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{2}
+}
+func (m *StorageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageStats.Merge(m, src)
+}
+func (m *StorageStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageStats proto.InternalMessageInfo
+
+func (m *StorageStats) GetLogicalBytes() int64 {
+	if m != nil {
+		return m.LogicalBytes
+	}
+	return 0
+}
+
+func (m *StorageStats) GetPhysicalBytes() int64 {
+	if m != nil {
+		return m.PhysicalBytes
+	}
+	return 0
+}
+
+func (m *StorageStats) GetChunks() int64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *StorageStats) GetFreeableBytes() int64 {
+	if m != nil {
+		return m.FreeableBytes
+	}
+	return 0
+}
+
+func (m *StorageStats) GetDedupRatio() float64 {
+	if m != nil {
+		return m.DedupRatio
+	}
+	return 0
+}
+
+type CommitStorageInfo struct {
+	Commit               *pfs.Commit   `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Stats                *StorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CommitStorageInfo) Reset()         { *m = CommitStorageInfo{} }
+func (m *CommitStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*CommitStorageInfo) ProtoMessage()    {}
+func (*CommitStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{3}
+}
+func (m *CommitStorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitStorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitStorageInfo.Merge(m, src)
+}
+func (m *CommitStorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitStorageInfo proto.InternalMessageInfo
+
+func (m *CommitStorageInfo) GetCommit() *pfs.Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *CommitStorageInfo) GetStats() *StorageStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type BranchStorageInfo struct {
+	Branch *pfs.Branch `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// stats describes the branch's head commit.
+	Stats                *StorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BranchStorageInfo) Reset()         { *m = BranchStorageInfo{} }
+func (m *BranchStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*BranchStorageInfo) ProtoMessage()    {}
+func (*BranchStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{4}
+}
+func (m *BranchStorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchStorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BranchStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchStorageInfo.Merge(m, src)
+}
+func (m *BranchStorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchStorageInfo proto.InternalMessageInfo
+
+func (m *BranchStorageInfo) GetBranch() *pfs.Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *BranchStorageInfo) GetStats() *StorageStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type RepoStorageInfo struct {
+	Repo *pfs.Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// stats describes every commit in the repo. Its freeable_bytes is what
+	// DeleteRepo would free.
+	Stats                *StorageStats        `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	Branches             []*BranchStorageInfo `protobuf:"bytes,3,rep,name=branches,proto3" json:"branches,omitempty"`
+	Commits              []*CommitStorageInfo `protobuf:"bytes,4,rep,name=commits,proto3" json:"commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RepoStorageInfo) Reset()         { *m = RepoStorageInfo{} }
+func (m *RepoStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*RepoStorageInfo) ProtoMessage()    {}
+func (*RepoStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{5}
+}
+func (m *RepoStorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoStorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoStorageInfo.Merge(m, src)
+}
+func (m *RepoStorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoStorageInfo proto.InternalMessageInfo
+
+func (m *RepoStorageInfo) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoStorageInfo) GetStats() *StorageStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *RepoStorageInfo) GetBranches() []*BranchStorageInfo {
+	if m != nil {
+		return m.Branches
+	}
+	return nil
+}
+
+func (m *RepoStorageInfo) GetCommits() []*CommitStorageInfo {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+type CommitSetStorageInfo struct {
+	CommitSet *pfs.CommitSet `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
+	// stats describes every commit in the commit set. Its freeable_bytes is
+	// what DropCommitSet would free.
+	Stats                *StorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CommitSetStorageInfo) Reset()         { *m = CommitSetStorageInfo{} }
+func (m *CommitSetStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*CommitSetStorageInfo) ProtoMessage()    {}
+func (*CommitSetStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{6}
+}
+func (m *CommitSetStorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSetStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSetStorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitSetStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSetStorageInfo.Merge(m, src)
+}
+func (m *CommitSetStorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSetStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSetStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitSetStorageInfo proto.InternalMessageInfo
+
+func (m *CommitSetStorageInfo) GetCommitSet() *pfs.CommitSet {
+	if m != nil {
+		return m.CommitSet
+	}
+	return nil
+}
+
+func (m *CommitSetStorageInfo) GetStats() *StorageStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type StorageInfo struct {
+	// total describes every commit in the cluster.
+	Total *StorageStats `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// shared_chunks is the number of chunks referenced by more than one repo.
+	SharedChunks int64 `protobuf:"varint,2,opt,name=shared_chunks,json=sharedChunks,proto3" json:"shared_chunks,omitempty"`
+	// shared_bytes is the size of the chunks referenced by more than one repo.
+	SharedBytes          int64                   `protobuf:"varint,3,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+	Repos                []*RepoStorageInfo      `protobuf:"bytes,4,rep,name=repos,proto3" json:"repos,omitempty"`
+	CommitSets           []*CommitSetStorageInfo `protobuf:"bytes,5,rep,name=commit_sets,json=commitSets,proto3" json:"commit_sets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *StorageInfo) Reset()         { *m = StorageInfo{} }
+func (m *StorageInfo) String() string { return proto.CompactTextString(m) }
+func (*StorageInfo) ProtoMessage()    {}
+func (*StorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8595c8dce2486799, []int{7}
+}
+func (m *StorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageInfo.Merge(m, src)
+}
+func (m *StorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageInfo proto.InternalMessageInfo
+
+func (m *StorageInfo) GetTotal() *StorageStats {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *StorageInfo) GetSharedChunks() int64 {
+	if m != nil {
+		return m.SharedChunks
+	}
+	return 0
+}
+
+func (m *StorageInfo) GetSharedBytes() int64 {
+	if m != nil {
+		return m.SharedBytes
+	}
+	return 0
+}
+
+func (m *StorageInfo) GetRepos() []*RepoStorageInfo {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *StorageInfo) GetCommitSets() []*CommitSetStorageInfo {
+	if m != nil {
+		return m.CommitSets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterInfo)(nil), "admin_v2.ClusterInfo")
+	proto.RegisterType((*InspectStorageRequest)(nil), "admin_v2.InspectStorageRequest")
+	proto.RegisterType((*StorageStats)(nil), "admin_v2.StorageStats")
+	proto.RegisterType((*CommitStorageInfo)(nil), "admin_v2.CommitStorageInfo")
+	proto.RegisterType((*BranchStorageInfo)(nil), "admin_v2.BranchStorageInfo")
+	proto.RegisterType((*RepoStorageInfo)(nil), "admin_v2.RepoStorageInfo")
+	proto.RegisterType((*CommitSetStorageInfo)(nil), "admin_v2.CommitSetStorageInfo")
+	proto.RegisterType((*StorageInfo)(nil), "admin_v2.StorageInfo")
 }
 
 func init() { proto.RegisterFile("admin/admin.proto", fileDescriptor_8595c8dce2486799) }
 
 var fileDescriptor_8595c8dce2486799 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x4c, 0xc9, 0xcd,
-	0xcc, 0xd3, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x1c, 0x60, 0x4e, 0x7c, 0x99,
-	0x91, 0x94, 0x74, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x58, 0x3c, 0xa9, 0x34, 0x4d, 0x3f,
-	0x35, 0xb7, 0xa0, 0xa4, 0x12, 0xa2, 0x4c, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07,
-	0xb1, 0x20, 0xa2, 0x4a, 0x31, 0x5c, 0xdc, 0xce, 0x39, 0xa5, 0xc5, 0x25, 0xa9, 0x45, 0x9e, 0x79,
-	0x69, 0xf9, 0x42, 0x62, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x6c,
-	0x8f, 0xee, 0xc9, 0x33, 0x79, 0xba, 0x04, 0x31, 0x65, 0xa6, 0x08, 0x99, 0x72, 0xf1, 0xa6, 0xa4,
-	0x16, 0xe4, 0xe4, 0x57, 0xe6, 0xa6, 0xe6, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x81, 0x95, 0x08,
-	0x3c, 0xba, 0x27, 0xcf, 0xe3, 0x02, 0x97, 0xf0, 0x74, 0x09, 0xe2, 0x41, 0x28, 0xf3, 0x4c, 0x31,
-	0xf2, 0xe0, 0x62, 0x76, 0x0c, 0xf0, 0x14, 0x72, 0xe4, 0xe2, 0xf3, 0xcc, 0x2b, 0x2e, 0x48, 0x4d,
-	0x2e, 0x81, 0xda, 0x25, 0x24, 0xa6, 0x07, 0x71, 0xaa, 0x1e, 0xcc, 0xa9, 0x7a, 0xae, 0x20, 0xa7,
-	0x4a, 0x89, 0xea, 0xc1, 0x3c, 0xa3, 0x87, 0xe4, 0x2c, 0x25, 0x06, 0x27, 0xcb, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x31, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x20, 0x31, 0x39, 0xa3, 0x32, 0x25, 0xb5, 0x08, 0x99,
-	0x55, 0x66, 0xa4, 0x5f, 0x5c, 0x94, 0x0c, 0x09, 0xa5, 0x24, 0x36, 0xb0, 0x1d, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x83, 0x02, 0xab, 0x67, 0x3b, 0x01, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6a, 0xdb, 0x4c,
+	0x14, 0xcd, 0xd8, 0xb1, 0x93, 0x5c, 0xd9, 0xf9, 0xbe, 0x0c, 0x89, 0x51, 0x13, 0xb0, 0x5d, 0x95,
+	0x96, 0x40, 0x83, 0x54, 0x5c, 0x42, 0xe9, 0xaa, 0xc4, 0x49, 0x17, 0xee, 0xaa, 0x4c, 0xe8, 0xa6,
+	0x14, 0x84, 0x2c, 0x8d, 0x65, 0x51, 0xd9, 0xa3, 0x6a, 0xc6, 0x06, 0xbf, 0x47, 0x9f, 0xa6, 0x4f,
+	0xd0, 0x65, 0xfb, 0x02, 0xa1, 0xf8, 0x1d, 0xba, 0x2f, 0xf3, 0x23, 0x5b, 0x76, 0x4a, 0x21, 0x1b,
+	0x31, 0x73, 0xee, 0xb9, 0x73, 0x46, 0xe7, 0xde, 0xb9, 0x70, 0x14, 0x44, 0x93, 0x64, 0xea, 0xa9,
+	0xaf, 0x9b, 0xe5, 0x4c, 0x30, 0xbc, 0xaf, 0x36, 0xfe, 0xbc, 0x77, 0x7a, 0x16, 0x33, 0x16, 0xa7,
+	0xd4, 0x53, 0xf8, 0x70, 0x36, 0xf2, 0xe8, 0x24, 0x13, 0x0b, 0x4d, 0x3b, 0x3d, 0x8e, 0x59, 0xcc,
+	0xd4, 0xd2, 0x93, 0x2b, 0x83, 0x36, 0xb3, 0x11, 0xf7, 0xb2, 0x11, 0xd7, 0x5b, 0xe7, 0x13, 0x58,
+	0xd7, 0xe9, 0x8c, 0x0b, 0x9a, 0x0f, 0xa6, 0x23, 0x86, 0x5b, 0x50, 0x49, 0x22, 0x1b, 0x75, 0xd1,
+	0xf9, 0x41, 0xbf, 0xbe, 0xbc, 0xeb, 0x54, 0x06, 0x37, 0xa4, 0x92, 0x44, 0xf8, 0x12, 0x9a, 0x11,
+	0xcd, 0x52, 0xb6, 0x98, 0xd0, 0xa9, 0xf0, 0x93, 0xc8, 0xae, 0x28, 0xca, 0xff, 0xcb, 0xbb, 0x4e,
+	0xe3, 0x66, 0x15, 0x18, 0xdc, 0x90, 0xc6, 0x9a, 0x36, 0x88, 0x9c, 0x0f, 0x70, 0x32, 0x98, 0xf2,
+	0x8c, 0x86, 0xe2, 0x56, 0xb0, 0x3c, 0x88, 0x29, 0xa1, 0x5f, 0x66, 0x94, 0x0b, 0xec, 0x40, 0x2d,
+	0xa7, 0x19, 0xe3, 0x36, 0xea, 0x56, 0xcf, 0xad, 0x5e, 0xc3, 0xcd, 0x46, 0xdc, 0x9f, 0xf7, 0x5c,
+	0x42, 0x33, 0x46, 0x74, 0x08, 0xdb, 0xb0, 0x17, 0x51, 0x11, 0x24, 0x29, 0x57, 0x6a, 0xfb, 0xa4,
+	0xd8, 0x3a, 0xdf, 0x10, 0x34, 0xcc, 0x81, 0xb7, 0x22, 0x10, 0x1c, 0x3f, 0x81, 0x66, 0xca, 0xe2,
+	0x24, 0x0c, 0x52, 0x7f, 0xb8, 0x10, 0x94, 0xab, 0x3f, 0xa8, 0x92, 0x86, 0x01, 0xfb, 0x12, 0xc3,
+	0x4f, 0xe1, 0x30, 0x1b, 0x2f, 0x78, 0x89, 0x55, 0x51, 0xac, 0x66, 0x81, 0x6a, 0x5a, 0x0b, 0xea,
+	0xe1, 0x78, 0x36, 0xfd, 0xcc, 0xed, 0xaa, 0x0a, 0x9b, 0x9d, 0x4c, 0x1f, 0xe5, 0x94, 0x06, 0xc3,
+	0x94, 0x9a, 0xf4, 0x5d, 0x9d, 0x5e, 0xa0, 0x3a, 0xbd, 0x03, 0x56, 0x44, 0xa3, 0x59, 0xe6, 0xe7,
+	0x81, 0x48, 0x98, 0x5d, 0xeb, 0xa2, 0x73, 0x44, 0x40, 0x41, 0x44, 0x22, 0x4e, 0x02, 0x47, 0xd7,
+	0x6c, 0x32, 0x49, 0x0a, 0x4b, 0x94, 0xef, 0xcf, 0xa0, 0x1e, 0x2a, 0x50, 0xdd, 0xdc, 0xea, 0x1d,
+	0x16, 0x86, 0x68, 0x2a, 0x31, 0x51, 0x7c, 0x01, 0x35, 0x2e, 0xff, 0x58, 0x5d, 0xdd, 0xea, 0xb5,
+	0xdc, 0xa2, 0x15, 0xdc, 0xb2, 0x1f, 0x44, 0x93, 0xa4, 0x54, 0x3f, 0x0f, 0xa6, 0xe1, 0x78, 0x4b,
+	0x6a, 0xa8, 0xc0, 0x6d, 0x29, 0x4d, 0x25, 0x26, 0xfa, 0x40, 0xa9, 0x9f, 0x08, 0xfe, 0x93, 0xc5,
+	0x2b, 0x2b, 0x75, 0x61, 0x57, 0x56, 0xd2, 0xe8, 0x6c, 0xd6, 0x58, 0x45, 0x1e, 0xa6, 0x81, 0x5f,
+	0xc1, 0xbe, 0xbe, 0x1b, 0x95, 0xb5, 0x91, 0x7d, 0x73, 0xb6, 0x4e, 0xb8, 0xf7, 0xa3, 0x64, 0x45,
+	0xc6, 0x97, 0xb0, 0xa7, 0xfd, 0x93, 0x35, 0xdb, 0xca, 0xbb, 0x57, 0x0b, 0x52, 0x70, 0x9d, 0x39,
+	0x1c, 0x9b, 0x28, 0xdd, 0x28, 0xd6, 0x0b, 0x00, 0x4d, 0xf1, 0x39, 0x2d, 0x0a, 0x76, 0xb4, 0x59,
+	0xb0, 0x5b, 0x2a, 0xc8, 0x41, 0x58, 0x2c, 0x1f, 0xe8, 0xe5, 0x6f, 0x04, 0x56, 0x59, 0xef, 0x02,
+	0x6a, 0x82, 0x89, 0x20, 0xb5, 0xd1, 0xbf, 0xb3, 0x15, 0x49, 0xbe, 0x05, 0x3e, 0x0e, 0x72, 0x1a,
+	0xf9, 0xa6, 0x8d, 0x75, 0x97, 0x37, 0x34, 0x78, 0xad, 0x9b, 0xf9, 0x31, 0x98, 0xbd, 0x69, 0x65,
+	0xdd, 0xea, 0x96, 0xc6, 0x74, 0x23, 0x7b, 0xc5, 0x13, 0xd5, 0x96, 0x3d, 0x5a, 0xab, 0x6e, 0xd5,
+	0xb9, 0x78, 0xaf, 0x6f, 0xc0, 0x5a, 0xdb, 0xc2, 0xed, 0x9a, 0x4a, 0x6b, 0xdf, 0x73, 0x7a, 0xc3,
+	0x4b, 0x02, 0x2b, 0x93, 0x78, 0xef, 0x2b, 0x82, 0xea, 0xd5, 0xfb, 0x01, 0xbe, 0x82, 0x43, 0x33,
+	0x35, 0xcc, 0x68, 0xc2, 0x2d, 0x57, 0x0f, 0x3a, 0xb7, 0x18, 0x74, 0xee, 0x5b, 0x39, 0xe8, 0x4e,
+	0x4f, 0x4a, 0xa7, 0xaf, 0xa7, 0x98, 0xb3, 0x83, 0xdf, 0xad, 0x8e, 0x30, 0x62, 0xb8, 0xb3, 0xa6,
+	0xfe, 0x75, 0x24, 0x95, 0xcf, 0x2a, 0x5d, 0xd0, 0xd9, 0xe9, 0xbf, 0xfe, 0xbe, 0x6c, 0xa3, 0x1f,
+	0xcb, 0x36, 0xfa, 0xb5, 0x6c, 0xa3, 0x8f, 0xcf, 0xe3, 0x44, 0x8c, 0x67, 0x43, 0x37, 0x64, 0x13,
+	0x2f, 0x0b, 0xc2, 0xf1, 0x22, 0xa2, 0x79, 0x79, 0x35, 0xef, 0x79, 0x3c, 0x0f, 0xf5, 0xbc, 0x1e,
+	0xd6, 0xd5, 0x7d, 0x5f, 0xfe, 0x19, 0x00, 0xd4, 0x45, 0x83, 0x51, 0xc5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +627,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type APIClient interface {
 	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
+	// InspectStorage reports logical and physical (deduplicated) storage usage.
+	InspectStorage(ctx context.Context, in *InspectStorageRequest, opts ...grpc.CallOption) (*StorageInfo, error)
 }
 
 type aPIClient struct {
@@ -141,9 +648,20 @@ func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ..
 	return out, nil
 }
 
-// APIServer is the server API for API service.
+func (c *aPIClient) InspectStorage(ctx context.Context, in *InspectStorageRequest, opts ...grpc.CallOption) (*StorageInfo, error) {
+	out := new(StorageInfo)
+	err := c.cc.Invoke(ctx, "/admin_v2.API/InspectStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServer is the server API for API service.
 type APIServer interface {
 	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
+	// InspectStorage reports logical and physical (deduplicated) storage usage.
+	InspectStorage(context.Context, *InspectStorageRequest) (*StorageInfo, error)
 }
 
 // UnimplementedAPIServer can be embedded to have forward compatible implementations.
@@ -153,6 +671,9 @@ type UnimplementedAPIServer struct {
 func (*UnimplementedAPIServer) InspectCluster(ctx context.Context, req *types.Empty) (*ClusterInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectCluster not implemented")
 }
+func (*UnimplementedAPIServer) InspectStorage(ctx context.Context, req *InspectStorageRequest) (*StorageInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectStorage not implemented")
+}
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
 	s.RegisterService(&_API_serviceDesc, srv)
@@ -176,6 +697,24 @@ func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_InspectStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_v2.API/InspectStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectStorage(ctx, req.(*InspectStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "admin_v2.API",
 	HandlerType: (*APIServer)(nil),
@@ -184,6 +723,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InspectCluster",
 			Handler:    _API_InspectCluster_Handler,
 		},
+		{
+			MethodName: "InspectStorage",
+			Handler:    _API_InspectStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
@@ -227,47 +770,1552 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DedupRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DedupRatio))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.FreeableBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FreeableBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PhysicalBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PhysicalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LogicalBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogicalBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitStorageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitStorageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitStorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BranchStorageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchStorageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BranchStorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoStorageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoStorageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoStorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitSetStorageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSetStorageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitSetStorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommitSet != nil {
+		{
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CommitSets) > 0 {
+		for iNdEx := len(m.CommitSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SharedBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SharedBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SharedChunks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SharedChunks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != nil {
+		{
+			size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeploymentID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Details {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicalBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.LogicalBytes))
+	}
+	if m.PhysicalBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.PhysicalBytes))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovAdmin(uint64(m.Chunks))
+	}
+	if m.FreeableBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.FreeableBytes))
+	}
+	if m.DedupRatio != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitStorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchStorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoStorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSetStorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != nil {
+		l = m.Total.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.SharedChunks != 0 {
+		n += 1 + sovAdmin(uint64(m.SharedChunks))
+	}
+	if m.SharedBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.SharedBytes))
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.CommitSets) > 0 {
+		for _, e := range m.CommitSets {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &pfs.Repo{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicalBytes", wireType)
+			}
+			m.LogicalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicalBytes", wireType)
+			}
+			m.PhysicalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhysicalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeableBytes", wireType)
+			}
+			m.FreeableBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeableBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DedupRatio = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitStorageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitStorageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitStorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StorageStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchStorageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchStorageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchStorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs.Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StorageStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoStorageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoStorageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoStorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StorageStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &BranchStorageInfo{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &CommitStorageInfo{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ClusterInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.DeploymentID)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CommitSetStorageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitSetStorageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitSetStorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &pfs.CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &StorageStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *StorageInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -290,17 +2338,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -310,29 +2358,71 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Total == nil {
+				m.Total = &StorageStats{}
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedChunks", wireType)
+			}
+			m.SharedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedBytes", wireType)
+			}
+			m.SharedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -342,23 +2432,59 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeploymentID = string(dAtA[iNdEx:postIndex])
+			m.Repos = append(m.Repos, &RepoStorageInfo{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSets = append(m.CommitSets, &CommitSetStorageInfo{})
+			if err := m.CommitSets[len(m.CommitSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
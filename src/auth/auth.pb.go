@@ -98,6 +98,9 @@ const (
 	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
 	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
 	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
+	Permission_REPO_CREATE_TAG             Permission = 215
+	Permission_REPO_LIST_TAG               Permission = 216
+	Permission_REPO_DELETE_TAG             Permission = 217
 	Permission_PIPELINE_LIST_JOB           Permission = 301
 )
 
@@ -164,6 +167,9 @@ var Permission_name = map[int32]string{
 	212: "REPO_ADD_PIPELINE_READER",
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
+	215: "REPO_CREATE_TAG",
+	216: "REPO_LIST_TAG",
+	217: "REPO_DELETE_TAG",
 	301: "PIPELINE_LIST_JOB",
 }
 
@@ -230,6 +236,9 @@ var Permission_value = map[string]int32{
 	"REPO_ADD_PIPELINE_READER":                   212,
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
+	"REPO_CREATE_TAG":                            215,
+	"REPO_LIST_TAG":                              216,
+	"REPO_DELETE_TAG":                            217,
 	"PIPELINE_LIST_JOB":                          301,
 }
 
@@ -3203,184 +3212,184 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2821 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x5b, 0x22, 0xaf, 0x36, 0x78, 0x44, 0x49, 0x14, 0x64, 0x89, 0x12, 0x1c, 0xc7,
-	0xcb, 0xff, 0x1f, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xdc, 0x07, 0x2e, 0x10, 0x8d, 0x84, 0x22, 0x79,
-	0x00, 0xd0, 0x8e, 0x7b, 0x7a, 0x8a, 0x52, 0xe4, 0x58, 0x42, 0x2d, 0x11, 0x0c, 0x00, 0xaa, 0x56,
-	0xda, 0xb4, 0x4d, 0xf7, 0x3d, 0x69, 0xd3, 0xf6, 0x5b, 0xf4, 0xa5, 0xfd, 0x12, 0xe9, 0x9e, 0xae,
-	0x8f, 0x6e, 0x8e, 0x3e, 0x42, 0x1f, 0xfa, 0xdc, 0x33, 0x83, 0x01, 0x30, 0x00, 0x41, 0xd9, 0x49,
-	0x4e, 0x5e, 0x24, 0xcc, 0xbd, 0xbf, 0xbb, 0xcc, 0x9d, 0x3b, 0x83, 0x3b, 0x17, 0x84, 0xb9, 0xf6,
-	0xc0, 0x3b, 0xd8, 0x22, 0x7f, 0x36, 0xfb, 0x8e, 0xed, 0xd9, 0x68, 0x92, 0x3c, 0x9b, 0xc7, 0xdb,
-	0x52, 0x6e, 0xdf, 0xde, 0xb7, 0x29, 0x6d, 0x8b, 0x3c, 0xf9, 0x6c, 0xa9, 0xb0, 0x6f, 0xdb, 0xfb,
-	0x87, 0x78, 0x8b, 0x8e, 0xf6, 0x06, 0xf7, 0xb7, 0x3c, 0xeb, 0x08, 0xbb, 0x5e, 0xfb, 0xa8, 0xef,
-	0x03, 0xe4, 0x67, 0x61, 0xae, 0xd8, 0xf1, 0xac, 0xe3, 0xb6, 0x87, 0x35, 0xfc, 0xfa, 0x00, 0xbb,
-	0x1e, 0x5a, 0x05, 0x70, 0x6c, 0xdb, 0x33, 0x3d, 0xfb, 0x01, 0xee, 0xe5, 0x85, 0x75, 0xe1, 0x5a,
-	0x56, 0xcb, 0x12, 0x8a, 0x41, 0x08, 0xf2, 0x73, 0x20, 0x46, 0x12, 0x6e, 0xdf, 0xee, 0xb9, 0x98,
-	0x88, 0xf4, 0xdb, 0x9d, 0x83, 0xb8, 0x08, 0xa1, 0xf8, 0x22, 0xf3, 0x70, 0xb1, 0x82, 0xdb, 0x71,
-	0x33, 0x72, 0x0e, 0x10, 0x4f, 0xf4, 0x35, 0xc9, 0x9f, 0x81, 0x45, 0xcd, 0xf6, 0x08, 0x25, 0x30,
-	0xf8, 0x84, 0x6e, 0xdd, 0x84, 0xa5, 0x21, 0xc1, 0xc8, 0xbb, 0xb3, 0x24, 0x3f, 0x18, 0x03, 0x68,
-	0xa8, 0x95, 0x72, 0xd9, 0xee, 0xdd, 0xb7, 0xf6, 0xd1, 0x22, 0x4c, 0x58, 0xae, 0x3b, 0xc0, 0x0e,
-	0x43, 0xb2, 0x11, 0xba, 0x0e, 0xd9, 0xce, 0xa1, 0x85, 0x7b, 0x9e, 0x69, 0x75, 0xf3, 0x63, 0x84,
-	0x55, 0x9a, 0x3e, 0x7d, 0x54, 0xc8, 0x94, 0x29, 0x51, 0xad, 0x68, 0x19, 0x9f, 0xad, 0x76, 0xd1,
-	0x65, 0x98, 0x61, 0x50, 0x17, 0x77, 0x1c, 0xec, 0xe5, 0xc7, 0xa9, 0xa6, 0x69, 0x9f, 0xa8, 0x53,
-	0x1a, 0xda, 0x86, 0x69, 0x07, 0x77, 0x2d, 0x07, 0x77, 0x3c, 0x73, 0xe0, 0x58, 0xf9, 0xf3, 0x54,
-	0xe5, 0xdc, 0xe9, 0xa3, 0xc2, 0x94, 0xc6, 0xe8, 0x2d, 0x4d, 0xd5, 0xa6, 0x02, 0x50, 0xcb, 0xb1,
-	0x88, 0x6f, 0x6e, 0xc7, 0xee, 0x63, 0x37, 0x7f, 0x61, 0x7d, 0x9c, 0xf8, 0xe6, 0x8f, 0xd0, 0xa7,
-	0x60, 0xd1, 0xc1, 0xaf, 0x0f, 0x2c, 0x07, 0x9b, 0xf8, 0xa8, 0x6d, 0x1d, 0x9a, 0xc7, 0xd8, 0xb1,
-	0xee, 0x5b, 0xb8, 0x9b, 0x9f, 0x58, 0x17, 0xae, 0x65, 0xb4, 0x1c, 0xe3, 0x2a, 0x84, 0x79, 0x87,
-	0xf1, 0xd0, 0x75, 0x10, 0x0f, 0xed, 0x4e, 0xfb, 0xf0, 0xc0, 0x76, 0x3d, 0x93, 0xcd, 0x79, 0x92,
-	0xe2, 0xe7, 0x42, 0xba, 0xea, 0x4f, 0xfe, 0xb3, 0xb0, 0x32, 0x70, 0xb1, 0x63, 0xb6, 0x3b, 0x1d,
-	0xec, 0xba, 0xd6, 0xde, 0x21, 0x66, 0x02, 0x26, 0x01, 0xe5, 0x33, 0x74, 0x7e, 0x79, 0x02, 0x29,
-	0x86, 0x08, 0x5f, 0xf4, 0xb6, 0xed, 0x7a, 0xf2, 0x32, 0x2c, 0x55, 0xb1, 0xe7, 0x07, 0x78, 0xe0,
-	0xb4, 0x3d, 0xcb, 0x0e, 0x96, 0x55, 0x6e, 0x41, 0x7e, 0x98, 0xc5, 0x16, 0xee, 0x45, 0x98, 0xe9,
-	0xf0, 0x0c, 0xba, 0x22, 0x53, 0xdb, 0xf3, 0x9b, 0x2c, 0xe9, 0x37, 0xa3, 0x65, 0xd3, 0xe2, 0x48,
-	0xd9, 0x80, 0x25, 0x3d, 0xdd, 0xe2, 0xc7, 0xd1, 0x2a, 0x41, 0x5e, 0x1f, 0xe1, 0xac, 0xfc, 0x1b,
-	0x01, 0xb2, 0x34, 0xa1, 0xd4, 0xde, 0x7d, 0x1b, 0xe5, 0x61, 0xd2, 0x1d, 0xec, 0x7d, 0x09, 0x77,
-	0x3c, 0x96, 0x46, 0xc1, 0x10, 0xe9, 0x00, 0xf8, 0x61, 0xdf, 0x62, 0xb6, 0xc7, 0xa8, 0x6d, 0x69,
-	0xd3, 0xdf, 0xa7, 0x9b, 0xc1, 0x3e, 0xdd, 0x34, 0x82, 0x7d, 0x5a, 0x5a, 0xfa, 0xcf, 0xa3, 0xc2,
-	0x5c, 0x77, 0xef, 0x25, 0x39, 0x92, 0x92, 0xdf, 0xf9, 0x77, 0x41, 0xd0, 0x38, 0x35, 0xe8, 0xd3,
-	0x30, 0x7d, 0xd0, 0x76, 0x0f, 0x70, 0x97, 0x25, 0x39, 0x4d, 0xb8, 0xd2, 0x7c, 0x20, 0x4a, 0x89,
-	0x26, 0x41, 0xc8, 0xda, 0x94, 0x0f, 0xf4, 0x73, 0xff, 0x0b, 0x30, 0x5f, 0x1c, 0x78, 0x07, 0xb8,
-	0xe7, 0x59, 0x1d, 0xee, 0x08, 0xf8, 0x7f, 0x00, 0xdb, 0xea, 0x76, 0x4c, 0x97, 0x6c, 0x28, 0x7f,
-	0x02, 0xa5, 0x99, 0xd3, 0x47, 0x85, 0x2c, 0x09, 0x8d, 0x4e, 0x77, 0x59, 0x96, 0x00, 0xe8, 0x23,
-	0x5a, 0x86, 0x8c, 0x15, 0x18, 0x1e, 0xf3, 0x27, 0x6b, 0x31, 0xfd, 0x2f, 0x40, 0x2e, 0xae, 0xff,
-	0xc9, 0x0e, 0x8c, 0x39, 0x98, 0xb9, 0x7b, 0x60, 0x17, 0x8f, 0xd4, 0x20, 0x4b, 0xde, 0x12, 0x60,
-	0x36, 0xa0, 0x30, 0x15, 0x12, 0x64, 0x48, 0xbe, 0xf5, 0xda, 0x47, 0xcc, 0x43, 0x2d, 0x1c, 0x7f,
-	0x22, 0x31, 0x96, 0x75, 0xb8, 0x54, 0xc5, 0x9e, 0x66, 0x1f, 0x62, 0x77, 0xc7, 0x76, 0x9a, 0xd8,
-	0x39, 0xb2, 0x5c, 0x97, 0xcb, 0xab, 0xe7, 0x01, 0xfa, 0x21, 0x91, 0xba, 0x34, 0xcb, 0x25, 0x15,
-	0x87, 0xe7, 0x60, 0x72, 0x05, 0x56, 0x47, 0x28, 0x65, 0xd3, 0xbc, 0x0c, 0x17, 0x1c, 0xc2, 0xcd,
-	0x0b, 0xeb, 0xe3, 0xd7, 0xa6, 0xb6, 0x67, 0x42, 0x85, 0x44, 0x46, 0xf3, 0x79, 0xb2, 0x03, 0x17,
-	0xa8, 0x0a, 0xb4, 0x15, 0x47, 0x2f, 0xc7, 0xd0, 0xae, 0xff, 0x57, 0xe9, 0x79, 0xce, 0x09, 0x93,
-	0x94, 0x6e, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xf8, 0x03, 0x7c, 0xc2, 0xc2, 0x49, 0x1e, 0x51, 0x0e,
-	0x2e, 0x1c, 0xb7, 0x0f, 0x07, 0x98, 0x06, 0x31, 0xa3, 0xf9, 0x83, 0x97, 0xc6, 0x6e, 0x0a, 0xf2,
-	0xaf, 0x04, 0x98, 0x22, 0xa2, 0x25, 0xab, 0xd7, 0xb5, 0x7a, 0xfb, 0xe8, 0x65, 0x98, 0xc4, 0x3d,
-	0xcf, 0xb1, 0x42, 0xe3, 0x1b, 0x31, 0xe3, 0x0c, 0xb6, 0xa9, 0xf8, 0x18, 0xdf, 0x89, 0x40, 0x42,
-	0x7a, 0x05, 0xa6, 0x79, 0x46, 0x8a, 0x23, 0x4f, 0xf1, 0x8e, 0x4c, 0x6d, 0xcf, 0xc6, 0x67, 0xc6,
-	0x3b, 0xa6, 0x42, 0x46, 0xc3, 0xae, 0x3d, 0x70, 0x3a, 0x18, 0x5d, 0x87, 0xf3, 0xde, 0x49, 0x1f,
-	0xb3, 0xd5, 0x58, 0x88, 0x84, 0x18, 0xc0, 0x38, 0xe9, 0x63, 0x8d, 0x42, 0x10, 0x82, 0xf3, 0x34,
-	0x97, 0xfc, 0x0c, 0xa6, 0xcf, 0xf2, 0x37, 0x05, 0xb8, 0xd0, 0x72, 0xb1, 0xe3, 0xa2, 0x97, 0x21,
-	0x1b, 0x64, 0x57, 0x30, 0xbf, 0xd5, 0x50, 0x1b, 0x85, 0xd0, 0xbf, 0x94, 0xef, 0xcf, 0x2d, 0xc2,
-	0x4b, 0xb7, 0x60, 0x36, 0xce, 0xfc, 0x50, 0x81, 0x7e, 0x08, 0x13, 0x55, 0xc7, 0x1e, 0xf4, 0x5d,
-	0xf4, 0x3c, 0x4c, 0xec, 0xd3, 0x27, 0xe6, 0xc1, 0x4a, 0xe8, 0x81, 0x0f, 0x60, 0xff, 0x7c, 0xfb,
-	0x0c, 0x2a, 0xbd, 0x08, 0x53, 0x1c, 0xf9, 0x43, 0x59, 0x7e, 0x5b, 0x80, 0xf3, 0x24, 0xbc, 0x61,
-	0x6c, 0x84, 0x28, 0x36, 0xe8, 0x05, 0x98, 0x8a, 0xf2, 0xd8, 0xcd, 0x8f, 0xad, 0x8f, 0x8f, 0xca,
-	0x77, 0x1e, 0x87, 0x6e, 0xc1, 0xac, 0xc3, 0x82, 0x6f, 0x92, 0xb8, 0xbb, 0xf9, 0x71, 0x2a, 0x39,
-	0x62, 0x6d, 0x66, 0x1c, 0x6e, 0xe4, 0xca, 0x0f, 0x41, 0x24, 0xe7, 0x89, 0xed, 0x58, 0x6f, 0x84,
-	0x87, 0xd5, 0x33, 0x90, 0x09, 0x40, 0xec, 0x28, 0xbf, 0x38, 0xa4, 0x4b, 0x0b, 0x21, 0x1f, 0xd1,
-	0x6f, 0xf9, 0xb7, 0x02, 0x5c, 0xe4, 0x4c, 0xb3, 0xdd, 0xb9, 0x06, 0xd0, 0x0e, 0x88, 0x5d, 0x6a,
-	0x3d, 0xa3, 0x71, 0x14, 0xf4, 0x1c, 0x64, 0xdd, 0xb6, 0x67, 0xb9, 0xf4, 0x5d, 0x7c, 0x86, 0xa9,
-	0x08, 0x85, 0x9e, 0x81, 0x49, 0x4a, 0xed, 0xed, 0xb3, 0xc8, 0xa4, 0x0a, 0x04, 0x18, 0x74, 0x09,
-	0xb2, 0x7d, 0xc7, 0xea, 0x75, 0xac, 0x7e, 0xfb, 0xd0, 0xaf, 0x21, 0xb4, 0x88, 0x20, 0xef, 0xc0,
-	0x42, 0x15, 0x7b, 0x91, 0x9c, 0xfb, 0xd1, 0x82, 0x26, 0xf7, 0x61, 0x23, 0xae, 0x87, 0x1c, 0x56,
-	0x81, 0x95, 0x8f, 0xb8, 0x10, 0x31, 0xcf, 0xc7, 0x92, 0x9e, 0x63, 0x58, 0x4c, 0x7a, 0xce, 0x62,
-	0x9e, 0x58, 0x40, 0xe1, 0x09, 0x13, 0x2f, 0x17, 0x1c, 0x8d, 0x63, 0xb4, 0x74, 0x62, 0x27, 0xe7,
-	0x9b, 0x90, 0xdf, 0xb5, 0xbb, 0xd6, 0xfd, 0x13, 0xee, 0x8c, 0xfa, 0x24, 0xe6, 0x13, 0x99, 0x1f,
-	0xe7, 0xcd, 0xaf, 0xc0, 0x72, 0x8a, 0x79, 0x56, 0x51, 0xf8, 0x8b, 0xf7, 0xb1, 0x1d, 0x93, 0x6f,
-	0xd3, 0x50, 0xa6, 0x58, 0x40, 0x9b, 0x30, 0xb9, 0xe7, 0x93, 0x98, 0x9e, 0x5c, 0xda, 0x99, 0xad,
-	0x05, 0x20, 0xf9, 0x8b, 0x30, 0xa5, 0x63, 0x1a, 0x4f, 0x5a, 0xe4, 0xe4, 0xe0, 0x42, 0xcf, 0xee,
-	0x75, 0x82, 0x73, 0xc1, 0x1f, 0x10, 0x2a, 0x2d, 0x42, 0x59, 0x0c, 0xfc, 0x01, 0xba, 0x02, 0xb3,
-	0x1d, 0xbb, 0x77, 0x8c, 0x1d, 0x22, 0x6d, 0x62, 0xc7, 0xa1, 0x35, 0x4a, 0x86, 0x56, 0x58, 0x8c,
-	0xaa, 0x38, 0x8e, 0xbc, 0x00, 0xf3, 0x55, 0xec, 0x91, 0x32, 0xa3, 0x66, 0xef, 0x5b, 0x61, 0x95,
-	0x78, 0x17, 0x72, 0x71, 0x32, 0x9b, 0xc0, 0x75, 0xc8, 0x1e, 0x12, 0x82, 0x39, 0x70, 0x0e, 0x59,
-	0x9d, 0x42, 0x8b, 0x72, 0x8a, 0x6a, 0x69, 0x35, 0x2d, 0x43, 0xd9, 0x2d, 0x87, 0x2e, 0x80, 0x5f,
-	0xce, 0x30, 0xb7, 0xe8, 0x40, 0xae, 0x52, 0xc5, 0x9a, 0xbd, 0x97, 0xb8, 0x6d, 0xd0, 0xe5, 0xda,
-	0xb3, 0x83, 0xea, 0xcd, 0x1f, 0xa0, 0x65, 0x18, 0xf7, 0x3c, 0x7f, 0x62, 0xe3, 0xa5, 0xc9, 0xd3,
-	0x47, 0x85, 0x71, 0xc3, 0xa8, 0x69, 0x84, 0x26, 0x3f, 0xc3, 0x16, 0x6b, 0x2f, 0x79, 0xfb, 0xc8,
-	0xc1, 0x05, 0xbe, 0xca, 0xf1, 0x07, 0xf2, 0x26, 0x2c, 0x6a, 0xf8, 0xd8, 0x7e, 0x80, 0xc9, 0x99,
-	0x92, 0xb4, 0x9c, 0x82, 0x5f, 0x86, 0xa5, 0x21, 0x3c, 0x4b, 0x93, 0x5d, 0x5a, 0xea, 0xfa, 0x67,
-	0xfc, 0x8e, 0xed, 0x90, 0x37, 0x4d, 0xa0, 0xeb, 0xac, 0x1a, 0x69, 0x31, 0x7c, 0x99, 0xf8, 0x1b,
-	0x82, 0x8d, 0x58, 0x8d, 0x9b, 0x50, 0xc7, 0x4c, 0xdd, 0x81, 0x9c, 0x9f, 0xae, 0xbb, 0xf8, 0x68,
-	0x0f, 0x3b, 0x2e, 0xe7, 0x33, 0x95, 0x0e, 0x7c, 0xa6, 0x03, 0xf2, 0xaa, 0x69, 0x77, 0xbb, 0x4c,
-	0x3d, 0x79, 0x24, 0x36, 0x1d, 0x7c, 0x64, 0x1f, 0x63, 0xb6, 0x0b, 0xd8, 0x48, 0x5e, 0x82, 0x85,
-	0x84, 0x5e, 0x66, 0x10, 0x81, 0x58, 0x0d, 0x9c, 0x09, 0x72, 0xe1, 0x16, 0xad, 0xc3, 0x42, 0x07,
-	0x87, 0x8e, 0xa1, 0xd8, 0x3e, 0x14, 0x92, 0xe7, 0xca, 0xff, 0xc1, 0x45, 0x4e, 0x23, 0x5b, 0xa3,
-	0xc5, 0xd8, 0x8b, 0x35, 0x8a, 0xc5, 0x55, 0x98, 0xab, 0x62, 0x8f, 0xbe, 0xde, 0xcf, 0x9c, 0xaa,
-	0xfc, 0x2c, 0xf5, 0x93, 0x01, 0x99, 0xd2, 0x4b, 0xc9, 0x92, 0x21, 0xcb, 0xd5, 0x04, 0x24, 0xcc,
-	0xca, 0x43, 0xcf, 0x69, 0x77, 0xbc, 0x70, 0x45, 0xc3, 0x19, 0x56, 0x61, 0x39, 0x85, 0xc7, 0xd4,
-	0xde, 0x80, 0x09, 0x9a, 0x12, 0x41, 0x11, 0x80, 0xc2, 0x2d, 0x1b, 0xde, 0x3e, 0x34, 0x86, 0x90,
-	0xcb, 0x24, 0x6b, 0x5c, 0xcf, 0x76, 0x86, 0xd3, 0xec, 0x1a, 0x9f, 0x66, 0xe9, 0x5a, 0x58, 0xea,
-	0x49, 0x90, 0x1f, 0x56, 0xc2, 0xd6, 0xe7, 0x16, 0xac, 0x25, 0xd2, 0xf2, 0x43, 0xa4, 0xa0, 0xbc,
-	0x01, 0x85, 0x91, 0xd2, 0xcc, 0xc0, 0x3a, 0xac, 0x55, 0xf0, 0x21, 0xf6, 0xb0, 0x42, 0x0a, 0x71,
-	0xdc, 0x1d, 0x0e, 0xd6, 0x06, 0x14, 0x46, 0x22, 0x7c, 0x25, 0x37, 0xde, 0x9d, 0x03, 0x88, 0x5e,
-	0x0b, 0x68, 0x11, 0x50, 0x53, 0xd1, 0x76, 0x55, 0x5d, 0x57, 0x1b, 0x75, 0xb3, 0x55, 0x7f, 0xb5,
-	0xde, 0xb8, 0x5b, 0x17, 0xcf, 0xa1, 0x15, 0x58, 0x2a, 0xd7, 0x5a, 0xba, 0xa1, 0x68, 0xe6, 0x6e,
-	0xa3, 0xa2, 0xee, 0xdc, 0x33, 0x4b, 0x6a, 0xbd, 0xa2, 0xd6, 0xab, 0xba, 0xd8, 0x45, 0x79, 0xc8,
-	0x05, 0xcc, 0xaa, 0x62, 0x44, 0x1c, 0x8c, 0x56, 0x60, 0x91, 0xe7, 0x34, 0x8b, 0xe5, 0xdb, 0x15,
-	0xb3, 0xd6, 0xa8, 0xea, 0xe2, 0x2f, 0x04, 0xb4, 0x0c, 0x0b, 0x01, 0xb3, 0xd8, 0x32, 0x6e, 0x9b,
-	0xc5, 0xb2, 0xa1, 0xde, 0x29, 0x1a, 0x8a, 0x78, 0x9f, 0x37, 0x47, 0x59, 0x15, 0x25, 0x64, 0xee,
-	0x0f, 0x31, 0x89, 0xe6, 0x72, 0xa3, 0xbe, 0xa3, 0x56, 0xc5, 0x83, 0x21, 0xa6, 0x1e, 0x31, 0x2d,
-	0xb4, 0x01, 0x97, 0x86, 0x24, 0xb5, 0x46, 0xa9, 0x61, 0x98, 0x46, 0xe3, 0x55, 0xa5, 0x2e, 0xfe,
-	0x48, 0x40, 0x57, 0x60, 0x23, 0x06, 0x61, 0xb3, 0xad, 0x6a, 0x8d, 0x56, 0xd3, 0xdc, 0x55, 0x76,
-	0x4b, 0x8a, 0xa6, 0x8b, 0x47, 0xa9, 0x3e, 0x50, 0x8c, 0x2e, 0xf6, 0xd0, 0x7a, 0x8a, 0x19, 0x5f,
-	0x41, 0x4b, 0x27, 0xe2, 0x36, 0x2a, 0xc0, 0x4a, 0x0c, 0xa1, 0xbc, 0x66, 0x68, 0xc5, 0x32, 0x73,
-	0x43, 0x17, 0xfb, 0x68, 0x0d, 0xa4, 0x18, 0x40, 0x53, 0x74, 0xa3, 0xa1, 0x29, 0xcc, 0xcf, 0xd7,
-	0xd1, 0x16, 0xdc, 0x18, 0x32, 0x11, 0x2d, 0x9c, 0x6e, 0xee, 0x34, 0x34, 0xb3, 0xa9, 0xa9, 0xf5,
-	0xb2, 0xda, 0x2c, 0xd6, 0xc4, 0x9f, 0x08, 0xe8, 0x2a, 0xc8, 0x89, 0x88, 0xd6, 0x14, 0x43, 0x31,
-	0x95, 0xd7, 0x9a, 0xaa, 0xa6, 0x54, 0x02, 0xc3, 0x3f, 0x16, 0xd0, 0x53, 0x50, 0x48, 0x58, 0xbe,
-	0xd3, 0x78, 0x55, 0xa1, 0x9e, 0x07, 0xa8, 0x9f, 0x0a, 0xe8, 0x32, 0xac, 0xc5, 0x51, 0x0d, 0xa3,
-	0x68, 0x28, 0xa6, 0xd6, 0x08, 0x63, 0xf9, 0xae, 0xc0, 0xcf, 0x52, 0xa9, 0x1b, 0x8a, 0xd6, 0xd4,
-	0x54, 0x5d, 0x89, 0x96, 0xd9, 0xe1, 0x03, 0xc5, 0x01, 0x6e, 0x2b, 0x45, 0xcd, 0x28, 0x29, 0x45,
-	0x43, 0x74, 0x47, 0xa8, 0xf0, 0x57, 0xbc, 0xa2, 0x88, 0x1e, 0xda, 0x80, 0xd5, 0x14, 0x00, 0x97,
-	0x2f, 0x03, 0xb4, 0x0a, 0xf9, 0x14, 0x48, 0xb3, 0xd8, 0xd2, 0x15, 0xf1, 0x97, 0x31, 0x2f, 0xd5,
-	0x8a, 0x52, 0x37, 0x54, 0xe3, 0x1e, 0x9f, 0x35, 0xc7, 0xa9, 0x00, 0x2e, 0xe7, 0xbe, 0x9c, 0x0a,
-	0x28, 0x6b, 0x0a, 0x09, 0x88, 0x5a, 0x69, 0x8a, 0x0f, 0x53, 0x01, 0xad, 0x66, 0x25, 0x00, 0x9c,
-	0xf0, 0xcb, 0x1d, 0x02, 0x6a, 0xaa, 0x6e, 0x10, 0xb6, 0x2e, 0xbe, 0x81, 0x2e, 0x45, 0x53, 0x88,
-	0xb9, 0x40, 0xa4, 0xbf, 0x92, 0xaa, 0x9e, 0xad, 0x2f, 0x01, 0x7c, 0x15, 0x5d, 0x85, 0xcb, 0xa3,
-	0x1c, 0x24, 0x75, 0x83, 0x59, 0xae, 0xa9, 0x4a, 0xdd, 0x10, 0xdf, 0x4c, 0x05, 0x32, 0x47, 0x79,
-	0xe0, 0xd7, 0xd0, 0xd3, 0x51, 0x3a, 0xc5, 0x1d, 0xe6, 0x60, 0xba, 0xf8, 0x75, 0x74, 0x05, 0xd6,
-	0x53, 0x1d, 0xe7, 0xb5, 0x7d, 0x43, 0x40, 0xd7, 0x52, 0xec, 0xb2, 0x19, 0xf0, 0xc8, 0xb7, 0x04,
-	0xb4, 0x04, 0x28, 0x40, 0x56, 0x94, 0x52, 0xab, 0x6a, 0x56, 0x5a, 0xbb, 0x4d, 0xf1, 0x5b, 0x02,
-	0xbf, 0xca, 0x35, 0xb5, 0xac, 0xd4, 0xf9, 0x4c, 0xfb, 0x76, 0x2a, 0x3b, 0xcc, 0xa2, 0xef, 0x08,
-	0x68, 0x3d, 0x0a, 0x61, 0x28, 0x5d, 0xa9, 0x98, 0x8c, 0x26, 0x7e, 0x37, 0x96, 0xf1, 0x01, 0x82,
-	0x45, 0x26, 0x00, 0x7d, 0x2f, 0x15, 0xc4, 0xa6, 0x11, 0x80, 0xbe, 0x2f, 0x20, 0x39, 0x4a, 0xd9,
-	0x00, 0x44, 0x43, 0xc7, 0x88, 0xba, 0xf8, 0x03, 0x01, 0x49, 0xd1, 0xd9, 0xc8, 0x16, 0x4a, 0x57,
-	0xca, 0x9a, 0x62, 0x88, 0x6f, 0x93, 0x73, 0x33, 0x17, 0xc9, 0xeb, 0x06, 0xe3, 0xe8, 0xe2, 0x3b,
-	0x02, 0x42, 0x30, 0xe3, 0x8f, 0x98, 0x59, 0xf1, 0x67, 0x02, 0x9a, 0x87, 0x59, 0x46, 0x53, 0xeb,
-	0x7a, 0x53, 0x29, 0x1b, 0xe2, 0xcf, 0x13, 0x61, 0xa4, 0x0e, 0x16, 0x6b, 0x35, 0xf1, 0x87, 0x02,
-	0x9a, 0x85, 0xac, 0xa6, 0x34, 0x1b, 0xa6, 0xa6, 0x14, 0x2b, 0xe2, 0x7b, 0x02, 0x9a, 0x03, 0xa0,
-	0xe3, 0xbb, 0x9a, 0x6a, 0x28, 0xe2, 0xef, 0xa8, 0x75, 0x4a, 0x48, 0xbe, 0x06, 0x7e, 0x2f, 0x20,
-	0x11, 0xa6, 0x28, 0x8b, 0xd9, 0xfe, 0x83, 0x80, 0xf2, 0x30, 0x4f, 0x29, 0xcc, 0xb2, 0x59, 0x6e,
-	0xec, 0xee, 0xaa, 0x86, 0xf8, 0x47, 0x01, 0x2d, 0x80, 0x48, 0x39, 0xfe, 0xcc, 0x7d, 0xf2, 0x9f,
-	0xa8, 0x5f, 0x9c, 0x8a, 0x80, 0xf1, 0xe7, 0x88, 0xc1, 0xa2, 0x51, 0xd2, 0x8a, 0xf5, 0xf2, 0x6d,
-	0xf1, 0x2f, 0x09, 0x45, 0x8c, 0xfc, 0xfe, 0x90, 0x22, 0xc6, 0xf8, 0xab, 0x80, 0x16, 0xe1, 0x62,
-	0xcc, 0xa5, 0x1d, 0xb5, 0xa6, 0x88, 0x7f, 0xa3, 0x61, 0x8a, 0xf4, 0x50, 0xe2, 0xdf, 0x69, 0xd6,
-	0x50, 0x22, 0xc9, 0x85, 0xa6, 0xda, 0x54, 0x6a, 0x6a, 0x5d, 0xa1, 0xa1, 0x51, 0x34, 0xf1, 0x1f,
-	0x34, 0x6b, 0x58, 0xb0, 0x76, 0x1b, 0x77, 0x94, 0x21, 0xc4, 0x3f, 0x47, 0x28, 0xa0, 0xb1, 0xd4,
-	0xc4, 0x7f, 0x51, 0x67, 0x42, 0x2a, 0x35, 0xfc, 0x4a, 0xa3, 0x24, 0xfe, 0x7a, 0xec, 0x46, 0x03,
-	0xa6, 0xf9, 0xab, 0x3e, 0x79, 0x55, 0x6a, 0x8a, 0xde, 0x68, 0x69, 0x65, 0xc5, 0x34, 0xee, 0x35,
-	0x15, 0xee, 0xcd, 0x3c, 0x05, 0x93, 0x41, 0x6e, 0x09, 0x28, 0x03, 0xe7, 0x89, 0x39, 0x71, 0x0c,
-	0xcd, 0x40, 0x96, 0xcc, 0xcf, 0xa4, 0xc3, 0xf1, 0xed, 0xff, 0x8a, 0x30, 0x5e, 0x6c, 0xaa, 0xa8,
-	0x08, 0x99, 0xe0, 0x0b, 0x05, 0xca, 0x87, 0x75, 0x4d, 0xe2, 0x33, 0x87, 0xb4, 0x9c, 0xc2, 0x61,
-	0x45, 0xc7, 0x39, 0x54, 0x05, 0x88, 0x3e, 0x4e, 0x20, 0x29, 0x84, 0x0e, 0x7d, 0xc6, 0x90, 0x56,
-	0x52, 0x79, 0xa1, 0xa2, 0x7b, 0xb4, 0x30, 0x8c, 0x75, 0x8c, 0xd1, 0x7a, 0xd4, 0xb6, 0x49, 0x6f,
-	0x51, 0x4b, 0x1b, 0x67, 0x20, 0x78, 0xd5, 0xfa, 0x68, 0xd5, 0xfa, 0x63, 0x55, 0xeb, 0xa3, 0x55,
-	0xef, 0xc2, 0x34, 0xdf, 0xb6, 0x45, 0x97, 0xa2, 0x58, 0x0d, 0x77, 0x8b, 0xa5, 0xd5, 0x11, 0xdc,
-	0x50, 0x5d, 0x05, 0xb2, 0x61, 0xeb, 0x04, 0x2d, 0xc7, 0xd0, 0x7c, 0x27, 0x47, 0x92, 0xd2, 0x58,
-	0xa1, 0x16, 0x1d, 0x66, 0xe3, 0x1d, 0x01, 0xb4, 0xc6, 0x87, 0x69, 0xb8, 0xc9, 0x21, 0x15, 0x46,
-	0xf2, 0x43, 0xa5, 0x0f, 0x40, 0x1a, 0xdd, 0xd8, 0x40, 0x37, 0x46, 0x28, 0x48, 0xb9, 0x76, 0x3c,
-	0x89, 0xb1, 0x97, 0x61, 0xc2, 0x6f, 0x62, 0xa3, 0xc5, 0x10, 0x1c, 0xeb, 0x73, 0x4b, 0x4b, 0x43,
-	0xf4, 0x50, 0xf8, 0x20, 0xec, 0x06, 0xc4, 0x3b, 0xc5, 0xe8, 0x0a, 0x6f, 0x78, 0x64, 0x7b, 0x5a,
-	0x7a, 0xfa, 0x71, 0xb0, 0xd0, 0xd2, 0xe7, 0xe1, 0xe2, 0x50, 0x53, 0x02, 0x45, 0x79, 0x33, 0xaa,
-	0x5f, 0x22, 0xc9, 0x67, 0x41, 0x12, 0xcb, 0xc8, 0xab, 0x5e, 0x4b, 0x7a, 0x96, 0xd0, 0x5b, 0x18,
-	0xc9, 0xe7, 0x13, 0x96, 0xef, 0x0f, 0x70, 0x09, 0x9b, 0xd2, 0x4d, 0xe0, 0x12, 0x36, 0xad, 0xa9,
-	0x20, 0x9f, 0x43, 0x4d, 0x98, 0x89, 0x5d, 0xe6, 0xd1, 0x6a, 0xdc, 0x85, 0x44, 0xb7, 0x40, 0x5a,
-	0x1b, 0xc5, 0x0e, 0x35, 0xde, 0x81, 0xb9, 0xc4, 0x55, 0x07, 0x15, 0xb8, 0x9e, 0x4d, 0x5a, 0x27,
-	0x40, 0x5a, 0x1f, 0x0d, 0x08, 0xf5, 0xf6, 0x86, 0xfa, 0x02, 0xc1, 0x15, 0x0a, 0x5d, 0x1d, 0x25,
-	0x9e, 0xb8, 0xa2, 0x49, 0xd7, 0x1e, 0x0f, 0x4c, 0x1c, 0x3a, 0xb1, 0xee, 0x40, 0xfc, 0xd0, 0x49,
-	0xeb, 0x43, 0xc4, 0x0f, 0x9d, 0xf4, 0xd6, 0x02, 0x0d, 0x7a, 0xac, 0x09, 0xc0, 0x05, 0x3d, 0xad,
-	0xe9, 0xc0, 0x05, 0x3d, 0xbd, 0x77, 0x40, 0xcf, 0x9d, 0xf0, 0xae, 0xcf, 0x9d, 0x3b, 0xc9, 0x8e,
-	0x02, 0x77, 0xee, 0x0c, 0xb5, 0x06, 0xe8, 0x76, 0x58, 0x48, 0xed, 0x37, 0xc4, 0x37, 0xde, 0xc8,
-	0x7e, 0xc4, 0x63, 0xb4, 0x17, 0x21, 0x13, 0x74, 0x0e, 0xb8, 0x97, 0x55, 0xa2, 0xeb, 0x20, 0x2d,
-	0xa7, 0x70, 0xf8, 0xfd, 0x3a, 0xd4, 0x2e, 0xe0, 0xf6, 0xeb, 0xa8, 0x36, 0x03, 0xb7, 0x5f, 0x47,
-	0x76, 0x1b, 0xfc, 0x15, 0x4f, 0x5e, 0xff, 0x11, 0x9f, 0x99, 0xa9, 0xed, 0x05, 0x6e, 0xc5, 0x47,
-	0xf6, 0x0e, 0x68, 0xf2, 0x8e, 0xb8, 0xba, 0x73, 0xc9, 0x7b, 0xf6, 0xf5, 0x9f, 0x4b, 0xde, 0xc7,
-	0x74, 0x01, 0xd8, 0x26, 0x8c, 0xff, 0x46, 0x80, 0xdf, 0x84, 0xa9, 0x3f, 0x3b, 0xe0, 0x37, 0x61,
-	0xfa, 0xcf, 0x0b, 0xe4, 0x73, 0xa5, 0x9b, 0xef, 0x9d, 0xae, 0x09, 0xef, 0x9f, 0xae, 0x09, 0x1f,
-	0x9c, 0xae, 0x09, 0x9f, 0xbb, 0xb1, 0x6f, 0x79, 0x07, 0x83, 0xbd, 0xcd, 0x8e, 0x7d, 0xb4, 0xd5,
-	0x6f, 0x77, 0x0e, 0x4e, 0xba, 0xd8, 0xe1, 0x9f, 0x8e, 0xb7, 0xb7, 0x5c, 0xa7, 0x43, 0x7f, 0xc4,
-	0xb1, 0x37, 0x41, 0x3f, 0x46, 0x3e, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x2a, 0x5a,
-	0x32, 0xd8, 0x21, 0x00, 0x00,
+	// 2828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdb, 0x77, 0xdb, 0xc6,
+	0xd1, 0x0f, 0x44, 0xdb, 0x22, 0x47, 0x96, 0x04, 0xaf, 0x29, 0x89, 0x82, 0x2e, 0x94, 0xe0, 0x38,
+	0xbe, 0x7c, 0x5f, 0xa4, 0xc4, 0xf9, 0xf2, 0xd5, 0x49, 0xdc, 0x07, 0x5e, 0x60, 0x1a, 0x09, 0x45,
+	0xf2, 0x00, 0xa0, 0x1d, 0xf7, 0xf4, 0x14, 0xa5, 0xc8, 0xb5, 0x84, 0x5a, 0x22, 0x18, 0x00, 0x54,
+	0xed, 0xb4, 0x69, 0x9b, 0xde, 0xef, 0x49, 0xaf, 0xff, 0x45, 0x5f, 0xda, 0x7f, 0x22, 0xbd, 0xa7,
+	0x4d, 0xaf, 0x2f, 0x6e, 0x8e, 0xff, 0x84, 0x3e, 0xf4, 0xb9, 0x67, 0x17, 0x0b, 0x60, 0x01, 0x02,
+	0xb2, 0x93, 0x9c, 0xbc, 0xd8, 0xd8, 0x99, 0xdf, 0xfc, 0x66, 0x76, 0x76, 0x76, 0xb1, 0x18, 0x0a,
+	0xe6, 0x7b, 0x63, 0x6f, 0x7f, 0x9b, 0xfc, 0xb3, 0x35, 0x72, 0x6c, 0xcf, 0x46, 0xd3, 0xe4, 0xd9,
+	0x3c, 0xba, 0x22, 0x15, 0xf7, 0xec, 0x3d, 0x9b, 0xca, 0xb6, 0xc9, 0x93, 0xaf, 0x96, 0xca, 0x7b,
+	0xb6, 0xbd, 0x77, 0x80, 0xb7, 0xe9, 0x68, 0x77, 0x7c, 0x67, 0xdb, 0xb3, 0x0e, 0xb1, 0xeb, 0xf5,
+	0x0e, 0x47, 0x3e, 0x40, 0x7e, 0x06, 0xe6, 0x2b, 0x7d, 0xcf, 0x3a, 0xea, 0x79, 0x58, 0xc3, 0xaf,
+	0x8d, 0xb1, 0xeb, 0xa1, 0x35, 0x00, 0xc7, 0xb6, 0x3d, 0xd3, 0xb3, 0xef, 0xe2, 0x61, 0x49, 0xd8,
+	0x10, 0x2e, 0x16, 0xb4, 0x02, 0x91, 0x18, 0x44, 0x20, 0x3f, 0x0b, 0x62, 0x64, 0xe1, 0x8e, 0xec,
+	0xa1, 0x8b, 0x89, 0xc9, 0xa8, 0xd7, 0xdf, 0x8f, 0x9b, 0x10, 0x89, 0x6f, 0x72, 0x16, 0xce, 0xd4,
+	0x71, 0x2f, 0xee, 0x46, 0x2e, 0x02, 0xe2, 0x85, 0x3e, 0x93, 0xfc, 0x09, 0x58, 0xd4, 0x6c, 0x8f,
+	0x48, 0x02, 0x87, 0x8f, 0x19, 0xd6, 0x55, 0x58, 0x9a, 0x30, 0x8c, 0xa2, 0x3b, 0xce, 0xf2, 0xfd,
+	0x29, 0x80, 0xb6, 0x5a, 0xaf, 0xd5, 0xec, 0xe1, 0x1d, 0x6b, 0x0f, 0x2d, 0xc2, 0x29, 0xcb, 0x75,
+	0xc7, 0xd8, 0x61, 0x48, 0x36, 0x42, 0x97, 0xa0, 0xd0, 0x3f, 0xb0, 0xf0, 0xd0, 0x33, 0xad, 0x41,
+	0x69, 0x8a, 0xa8, 0xaa, 0xa7, 0x1f, 0x3e, 0x28, 0xe7, 0x6b, 0x54, 0xa8, 0xd6, 0xb5, 0xbc, 0xaf,
+	0x56, 0x07, 0xe8, 0x1c, 0xcc, 0x32, 0xa8, 0x8b, 0xfb, 0x0e, 0xf6, 0x4a, 0x39, 0xca, 0x74, 0xda,
+	0x17, 0xea, 0x54, 0x86, 0xae, 0xc0, 0x69, 0x07, 0x0f, 0x2c, 0x07, 0xf7, 0x3d, 0x73, 0xec, 0x58,
+	0xa5, 0x13, 0x94, 0x72, 0xfe, 0xe1, 0x83, 0xf2, 0x8c, 0xc6, 0xe4, 0x5d, 0x4d, 0xd5, 0x66, 0x02,
+	0x50, 0xd7, 0xb1, 0x48, 0x6c, 0x6e, 0xdf, 0x1e, 0x61, 0xb7, 0x74, 0x72, 0x23, 0x47, 0x62, 0xf3,
+	0x47, 0xe8, 0xff, 0x60, 0xd1, 0xc1, 0xaf, 0x8d, 0x2d, 0x07, 0x9b, 0xf8, 0xb0, 0x67, 0x1d, 0x98,
+	0x47, 0xd8, 0xb1, 0xee, 0x58, 0x78, 0x50, 0x3a, 0xb5, 0x21, 0x5c, 0xcc, 0x6b, 0x45, 0xa6, 0x55,
+	0x88, 0xf2, 0x26, 0xd3, 0xa1, 0x4b, 0x20, 0x1e, 0xd8, 0xfd, 0xde, 0xc1, 0xbe, 0xed, 0x7a, 0x26,
+	0x9b, 0xf3, 0x34, 0xc5, 0xcf, 0x87, 0x72, 0xd5, 0x9f, 0xfc, 0x27, 0x61, 0x65, 0xec, 0x62, 0xc7,
+	0xec, 0xf5, 0xfb, 0xd8, 0x75, 0xad, 0xdd, 0x03, 0xcc, 0x0c, 0x4c, 0x02, 0x2a, 0xe5, 0xe9, 0xfc,
+	0x4a, 0x04, 0x52, 0x09, 0x11, 0xbe, 0xe9, 0x0d, 0xdb, 0xf5, 0xe4, 0x65, 0x58, 0x6a, 0x60, 0xcf,
+	0x4f, 0xf0, 0xd8, 0xe9, 0x79, 0x96, 0x1d, 0x2c, 0xab, 0xdc, 0x85, 0xd2, 0xa4, 0x8a, 0x2d, 0xdc,
+	0x0b, 0x30, 0xdb, 0xe7, 0x15, 0x74, 0x45, 0x66, 0xae, 0x9c, 0xdd, 0x62, 0x45, 0xbf, 0x15, 0x2d,
+	0x9b, 0x16, 0x47, 0xca, 0x06, 0x2c, 0xe9, 0xe9, 0x1e, 0x3f, 0x0a, 0xab, 0x04, 0x25, 0x3d, 0x23,
+	0x58, 0xf9, 0x97, 0x02, 0x14, 0x68, 0x41, 0xa9, 0xc3, 0x3b, 0x36, 0x2a, 0xc1, 0xb4, 0x3b, 0xde,
+	0xfd, 0x1c, 0xee, 0x7b, 0xac, 0x8c, 0x82, 0x21, 0xd2, 0x01, 0xf0, 0xbd, 0x91, 0xc5, 0x7c, 0x4f,
+	0x51, 0xdf, 0xd2, 0x96, 0xbf, 0x4f, 0xb7, 0x82, 0x7d, 0xba, 0x65, 0x04, 0xfb, 0xb4, 0xba, 0xf4,
+	0xef, 0x07, 0xe5, 0xf9, 0xc1, 0xee, 0x8b, 0x72, 0x64, 0x25, 0xbf, 0xfd, 0xaf, 0xb2, 0xa0, 0x71,
+	0x34, 0xe8, 0xff, 0xe1, 0xf4, 0x7e, 0xcf, 0xdd, 0xc7, 0x03, 0x56, 0xe4, 0xb4, 0xe0, 0xaa, 0x67,
+	0x03, 0x53, 0x2a, 0x34, 0x09, 0x42, 0xd6, 0x66, 0x7c, 0xa0, 0x5f, 0xfb, 0x9f, 0x81, 0xb3, 0x95,
+	0xb1, 0xb7, 0x8f, 0x87, 0x9e, 0xd5, 0xe7, 0x8e, 0x80, 0xff, 0x05, 0xb0, 0xad, 0x41, 0xdf, 0x74,
+	0xc9, 0x86, 0xf2, 0x27, 0x50, 0x9d, 0x7d, 0xf8, 0xa0, 0x5c, 0x20, 0xa9, 0xd1, 0x89, 0x50, 0x2b,
+	0x10, 0x00, 0x7d, 0x44, 0xcb, 0x90, 0xb7, 0x02, 0xc7, 0x53, 0xfe, 0x64, 0x2d, 0xc6, 0xff, 0x3c,
+	0x14, 0xe3, 0xfc, 0x8f, 0x77, 0x60, 0xcc, 0xc3, 0xec, 0xad, 0x7d, 0xbb, 0x72, 0xa8, 0x06, 0x55,
+	0xf2, 0xa6, 0x00, 0x73, 0x81, 0x84, 0x51, 0x48, 0x90, 0x27, 0xf5, 0x36, 0xec, 0x1d, 0xb2, 0x08,
+	0xb5, 0x70, 0xfc, 0xb1, 0xe4, 0x58, 0xd6, 0x61, 0xb5, 0x81, 0x3d, 0xcd, 0x3e, 0xc0, 0xee, 0x75,
+	0xdb, 0xe9, 0x60, 0xe7, 0xd0, 0x72, 0x5d, 0xae, 0xae, 0x9e, 0x03, 0x18, 0x85, 0x42, 0x1a, 0xd2,
+	0x1c, 0x57, 0x54, 0x1c, 0x9e, 0x83, 0xc9, 0x75, 0x58, 0xcb, 0x20, 0x65, 0xd3, 0x3c, 0x07, 0x27,
+	0x1d, 0xa2, 0x2d, 0x09, 0x1b, 0xb9, 0x8b, 0x33, 0x57, 0x66, 0x43, 0x42, 0x62, 0xa3, 0xf9, 0x3a,
+	0xd9, 0x81, 0x93, 0x94, 0x02, 0x6d, 0xc7, 0xd1, 0xcb, 0x31, 0xb4, 0xeb, 0xff, 0xab, 0x0c, 0x3d,
+	0xe7, 0x3e, 0xb3, 0x94, 0xae, 0x02, 0x44, 0x42, 0x24, 0x42, 0xee, 0x2e, 0xbe, 0xcf, 0xd2, 0x49,
+	0x1e, 0x51, 0x11, 0x4e, 0x1e, 0xf5, 0x0e, 0xc6, 0x98, 0x26, 0x31, 0xaf, 0xf9, 0x83, 0x17, 0xa7,
+	0xae, 0x0a, 0xf2, 0xcf, 0x05, 0x98, 0x21, 0xa6, 0x55, 0x6b, 0x38, 0xb0, 0x86, 0x7b, 0xe8, 0x25,
+	0x98, 0xc6, 0x43, 0xcf, 0xb1, 0x42, 0xe7, 0x9b, 0x31, 0xe7, 0x0c, 0xb6, 0xa5, 0xf8, 0x18, 0x3f,
+	0x88, 0xc0, 0x42, 0x7a, 0x19, 0x4e, 0xf3, 0x8a, 0x94, 0x40, 0x9e, 0xe4, 0x03, 0x99, 0xb9, 0x32,
+	0x17, 0x9f, 0x19, 0x1f, 0x98, 0x0a, 0x79, 0x0d, 0xbb, 0xf6, 0xd8, 0xe9, 0x63, 0x74, 0x09, 0x4e,
+	0x78, 0xf7, 0x47, 0x98, 0xad, 0xc6, 0x42, 0x64, 0xc4, 0x00, 0xc6, 0xfd, 0x11, 0xd6, 0x28, 0x04,
+	0x21, 0x38, 0x41, 0x6b, 0xc9, 0xaf, 0x60, 0xfa, 0x2c, 0x7f, 0x55, 0x80, 0x93, 0x5d, 0x17, 0x3b,
+	0x2e, 0x7a, 0x09, 0x0a, 0x41, 0x75, 0x05, 0xf3, 0x5b, 0x0b, 0xd9, 0x28, 0x64, 0xab, 0x1b, 0xe8,
+	0xfd, 0xb9, 0x45, 0x78, 0xe9, 0x1a, 0xcc, 0xc5, 0x95, 0x1f, 0x28, 0xd1, 0xf7, 0xe0, 0x54, 0xc3,
+	0xb1, 0xc7, 0x23, 0x17, 0x3d, 0x07, 0xa7, 0xf6, 0xe8, 0x13, 0x8b, 0x60, 0x25, 0x8c, 0xc0, 0x07,
+	0xb0, 0xff, 0x7c, 0xff, 0x0c, 0x2a, 0xbd, 0x00, 0x33, 0x9c, 0xf8, 0x03, 0x79, 0x7e, 0x4b, 0x80,
+	0x13, 0x24, 0xbd, 0x61, 0x6e, 0x84, 0x28, 0x37, 0xe8, 0x79, 0x98, 0x89, 0xea, 0xd8, 0x2d, 0x4d,
+	0x6d, 0xe4, 0xb2, 0xea, 0x9d, 0xc7, 0xa1, 0x6b, 0x30, 0xe7, 0xb0, 0xe4, 0x9b, 0x24, 0xef, 0x6e,
+	0x29, 0xb7, 0x91, 0xcb, 0x5e, 0x9b, 0x59, 0x87, 0x1b, 0xb9, 0xf2, 0x3d, 0x10, 0xc9, 0x79, 0x62,
+	0x3b, 0xd6, 0xeb, 0xe1, 0x61, 0xf5, 0x34, 0xe4, 0x03, 0x10, 0x3b, 0xca, 0xcf, 0x4c, 0x70, 0x69,
+	0x21, 0xe4, 0x43, 0xc6, 0x2d, 0xff, 0x4a, 0x80, 0x33, 0x9c, 0x6b, 0xb6, 0x3b, 0xd7, 0x01, 0x7a,
+	0x81, 0x70, 0x40, 0xbd, 0xe7, 0x35, 0x4e, 0x82, 0x9e, 0x85, 0x82, 0xdb, 0xf3, 0x2c, 0x97, 0xbe,
+	0x8b, 0x8f, 0x71, 0x15, 0xa1, 0xd0, 0xd3, 0x30, 0x4d, 0xa5, 0xc3, 0xbd, 0x52, 0x2e, 0xdb, 0x20,
+	0xc0, 0xa0, 0x55, 0x28, 0x8c, 0x1c, 0x6b, 0xd8, 0xb7, 0x46, 0xbd, 0x03, 0xff, 0x0e, 0xa1, 0x45,
+	0x02, 0xf9, 0x3a, 0x2c, 0x34, 0xb0, 0x17, 0xd9, 0xb9, 0x1f, 0x2e, 0x69, 0xf2, 0x08, 0x36, 0xe3,
+	0x3c, 0xe4, 0xb0, 0x0a, 0xbc, 0x7c, 0xc8, 0x85, 0x88, 0x45, 0x3e, 0x95, 0x8c, 0x1c, 0xc3, 0x62,
+	0x32, 0x72, 0x96, 0xf3, 0xc4, 0x02, 0x0a, 0x8f, 0x59, 0x78, 0xc5, 0xe0, 0x68, 0x9c, 0xa2, 0x57,
+	0x27, 0x7f, 0x20, 0xbf, 0x01, 0xa5, 0x1d, 0x7b, 0x60, 0xdd, 0xb9, 0xcf, 0x9d, 0x51, 0x1f, 0xc7,
+	0x7c, 0x22, 0xf7, 0x39, 0xde, 0xfd, 0x0a, 0x2c, 0xa7, 0xb8, 0x67, 0x37, 0x0a, 0x7f, 0xf1, 0x3e,
+	0x72, 0x60, 0xf2, 0x0d, 0x58, 0x4c, 0xf2, 0xb0, 0x54, 0x6e, 0xc1, 0xf4, 0xae, 0x2f, 0x62, 0x3c,
+	0xc5, 0xb4, 0x33, 0x5b, 0x0b, 0x40, 0xf2, 0x67, 0x61, 0x46, 0xc7, 0x34, 0x9f, 0xf4, 0x92, 0x53,
+	0x84, 0x93, 0x43, 0x7b, 0xd8, 0x0f, 0xce, 0x05, 0x7f, 0x40, 0xa4, 0xf4, 0x12, 0xca, 0x72, 0xe0,
+	0x0f, 0xd0, 0x79, 0x98, 0xeb, 0xdb, 0xc3, 0x23, 0xec, 0x10, 0x6b, 0x13, 0x3b, 0x0e, 0xbd, 0xa3,
+	0xe4, 0xb5, 0xd9, 0x48, 0xaa, 0x38, 0x8e, 0xbc, 0x00, 0x67, 0x1b, 0xd8, 0x23, 0xd7, 0x8c, 0xa6,
+	0xbd, 0x67, 0x85, 0xb7, 0xc4, 0x5b, 0x50, 0x8c, 0x8b, 0xd9, 0x04, 0x2e, 0x41, 0xe1, 0x80, 0x08,
+	0xcc, 0xb1, 0x73, 0x50, 0x12, 0xa2, 0x4b, 0x39, 0x45, 0x75, 0xb5, 0xa6, 0x96, 0xa7, 0xea, 0xae,
+	0x43, 0x17, 0xc0, 0xbf, 0xce, 0xb0, 0xb0, 0xe8, 0x40, 0x6e, 0x50, 0x62, 0xcd, 0xde, 0x4d, 0x7c,
+	0x6d, 0xd0, 0xe5, 0xda, 0xb5, 0x83, 0xdb, 0x9b, 0x3f, 0x40, 0xcb, 0x90, 0xf3, 0x3c, 0x7f, 0x62,
+	0xb9, 0xea, 0xf4, 0xc3, 0x07, 0xe5, 0x9c, 0x61, 0x34, 0x35, 0x22, 0x93, 0x9f, 0x86, 0x85, 0x04,
+	0x11, 0x0b, 0xb1, 0x08, 0x27, 0xf9, 0x5b, 0x8e, 0x3f, 0x90, 0xb7, 0x60, 0x51, 0xc3, 0x47, 0xf6,
+	0x5d, 0x4c, 0xce, 0x94, 0xa4, 0xe7, 0x14, 0xfc, 0x32, 0x2c, 0x4d, 0xe0, 0x59, 0x99, 0xec, 0xd0,
+	0xab, 0xae, 0x7f, 0xc6, 0x5f, 0xb7, 0x1d, 0xf2, 0xa6, 0x09, 0xb8, 0x8e, 0xbb, 0x23, 0x2d, 0x86,
+	0x2f, 0x13, 0x7f, 0x43, 0xb0, 0x11, 0xbb, 0xe3, 0x26, 0xe8, 0x98, 0xab, 0x9b, 0x50, 0xf4, 0xcb,
+	0x75, 0x07, 0x1f, 0xee, 0x62, 0xc7, 0xe5, 0x62, 0xa6, 0xd6, 0x41, 0xcc, 0x74, 0x40, 0x5e, 0x35,
+	0xbd, 0xc1, 0x80, 0xd1, 0x93, 0x47, 0xe2, 0xd3, 0xc1, 0x87, 0xf6, 0x11, 0x66, 0xbb, 0x80, 0x8d,
+	0xe4, 0x25, 0x58, 0x48, 0xf0, 0x32, 0x87, 0x08, 0xc4, 0x46, 0x10, 0x4c, 0x50, 0x0b, 0xd7, 0x60,
+	0x35, 0x94, 0xa5, 0x1d, 0x43, 0xb1, 0x7d, 0x28, 0x24, 0xcf, 0x95, 0xff, 0x81, 0x33, 0x1c, 0x23,
+	0x5b, 0xa3, 0xc5, 0xd8, 0x8b, 0x35, 0xca, 0xc5, 0x05, 0x98, 0x6f, 0x60, 0x8f, 0xbe, 0xde, 0x8f,
+	0x9d, 0xaa, 0xfc, 0x0c, 0x88, 0x11, 0x90, 0x91, 0xae, 0x26, 0xaf, 0x0c, 0x05, 0xee, 0x4e, 0x40,
+	0xd2, 0xac, 0xdc, 0xf3, 0x9c, 0x5e, 0xdf, 0x0b, 0x57, 0x34, 0x9c, 0x61, 0x03, 0x96, 0x53, 0x74,
+	0x8c, 0xf6, 0x32, 0x9c, 0xa2, 0x25, 0x11, 0x5c, 0x02, 0x50, 0xb8, 0x65, 0xc3, 0xaf, 0x0f, 0x8d,
+	0x21, 0xe4, 0x1a, 0xa9, 0x1a, 0xd7, 0xb3, 0x9d, 0xc9, 0x32, 0xbb, 0xc8, 0x97, 0x59, 0x3a, 0x0b,
+	0x2b, 0x3d, 0x09, 0x4a, 0x93, 0x24, 0x6c, 0x7d, 0xae, 0xc1, 0x7a, 0xa2, 0x2c, 0x3f, 0x40, 0x09,
+	0xca, 0x9b, 0x50, 0xce, 0xb4, 0x66, 0x0e, 0x36, 0x60, 0xbd, 0x8e, 0x0f, 0xb0, 0x87, 0x15, 0x72,
+	0x11, 0xc7, 0x83, 0xc9, 0x64, 0x6d, 0x42, 0x39, 0x13, 0xe1, 0x93, 0x5c, 0x7e, 0x6f, 0x1e, 0x20,
+	0x7a, 0x2d, 0xa0, 0x45, 0x40, 0x1d, 0x45, 0xdb, 0x51, 0x75, 0x5d, 0x6d, 0xb7, 0xcc, 0x6e, 0xeb,
+	0x95, 0x56, 0xfb, 0x56, 0x4b, 0x7c, 0x02, 0xad, 0xc0, 0x52, 0xad, 0xd9, 0xd5, 0x0d, 0x45, 0x33,
+	0x77, 0xda, 0x75, 0xf5, 0xfa, 0x6d, 0xb3, 0xaa, 0xb6, 0xea, 0x6a, 0xab, 0xa1, 0x8b, 0x03, 0x54,
+	0x82, 0x62, 0xa0, 0x6c, 0x28, 0x46, 0xa4, 0xc1, 0x68, 0x05, 0x16, 0x79, 0x4d, 0xa7, 0x52, 0xbb,
+	0x51, 0x37, 0x9b, 0xed, 0x86, 0x2e, 0xfe, 0x54, 0x40, 0xcb, 0xb0, 0x10, 0x28, 0x2b, 0x5d, 0xe3,
+	0x86, 0x59, 0xa9, 0x19, 0xea, 0xcd, 0x8a, 0xa1, 0x88, 0x77, 0x78, 0x77, 0x54, 0x55, 0x57, 0x42,
+	0xe5, 0xde, 0x84, 0x92, 0x30, 0xd7, 0xda, 0xad, 0xeb, 0x6a, 0x43, 0xdc, 0x9f, 0x50, 0xea, 0x91,
+	0xd2, 0x42, 0x9b, 0xb0, 0x3a, 0x61, 0xa9, 0xb5, 0xab, 0x6d, 0xc3, 0x34, 0xda, 0xaf, 0x28, 0x2d,
+	0xf1, 0x7b, 0x02, 0x3a, 0x0f, 0x9b, 0x31, 0x08, 0x9b, 0x6d, 0x43, 0x6b, 0x77, 0x3b, 0xe6, 0x8e,
+	0xb2, 0x53, 0x55, 0x34, 0x5d, 0x3c, 0x4c, 0x8d, 0x81, 0x62, 0x74, 0x71, 0x88, 0x36, 0x60, 0x35,
+	0x5d, 0x69, 0x76, 0x75, 0x62, 0x6e, 0xa3, 0x32, 0xac, 0xc4, 0x10, 0xca, 0xab, 0x86, 0x56, 0xa9,
+	0xb1, 0x30, 0x74, 0x71, 0x84, 0xd6, 0x41, 0x8a, 0x01, 0x34, 0x45, 0x37, 0xda, 0x9a, 0xc2, 0xe2,
+	0x7c, 0x0d, 0x6d, 0xc3, 0xe5, 0x09, 0x17, 0xd1, 0xc2, 0xe9, 0xe6, 0xf5, 0xb6, 0x66, 0x76, 0x34,
+	0xb5, 0x55, 0x53, 0x3b, 0x95, 0xa6, 0xf8, 0x03, 0x01, 0x5d, 0x00, 0x39, 0x91, 0xd1, 0xa6, 0x62,
+	0x28, 0xa6, 0xf2, 0x6a, 0x47, 0xd5, 0x94, 0x7a, 0xe0, 0xf8, 0xfb, 0x02, 0x7a, 0x12, 0xca, 0x09,
+	0xcf, 0x37, 0xdb, 0xaf, 0x28, 0x34, 0xf2, 0x00, 0xf5, 0x43, 0x01, 0x9d, 0x83, 0xf5, 0x38, 0xaa,
+	0x6d, 0x54, 0x0c, 0xc5, 0xd4, 0xda, 0x61, 0x2e, 0x7f, 0x22, 0xf0, 0xb3, 0x54, 0x5a, 0x86, 0xa2,
+	0x75, 0x34, 0x55, 0x57, 0xa2, 0x65, 0x76, 0xf8, 0x44, 0x71, 0x80, 0x1b, 0x4a, 0x45, 0x33, 0xaa,
+	0x4a, 0xc5, 0x10, 0xdd, 0x0c, 0x0a, 0x7f, 0xc5, 0xeb, 0x8a, 0xe8, 0xa1, 0x4d, 0x58, 0x4b, 0x01,
+	0x70, 0xf5, 0x32, 0x46, 0x6b, 0x50, 0x4a, 0x81, 0x74, 0x2a, 0x5d, 0x5d, 0x11, 0x7f, 0x16, 0x8b,
+	0x52, 0xad, 0x2b, 0x2d, 0x43, 0x35, 0x6e, 0xf3, 0x55, 0x73, 0x94, 0x0a, 0xe0, 0x6a, 0xee, 0xf3,
+	0xa9, 0x80, 0x9a, 0xa6, 0x90, 0x84, 0xa8, 0xf5, 0x8e, 0x78, 0x2f, 0x15, 0xd0, 0xed, 0xd4, 0x03,
+	0xc0, 0x7d, 0x7e, 0xb9, 0x43, 0x40, 0x53, 0xd5, 0x0d, 0xa2, 0xd6, 0xc5, 0xd7, 0xd1, 0x2a, 0x94,
+	0x26, 0xf4, 0x24, 0x04, 0x62, 0xfd, 0x85, 0x54, 0x7a, 0xb6, 0xbe, 0x04, 0xf0, 0x45, 0x74, 0x01,
+	0xce, 0x65, 0x05, 0x48, 0xee, 0x0d, 0x66, 0xad, 0xa9, 0x2a, 0x2d, 0x43, 0x7c, 0x23, 0x15, 0xc8,
+	0x02, 0xe5, 0x81, 0x5f, 0x42, 0x4f, 0x81, 0x3c, 0x01, 0xa4, 0x01, 0x73, 0x30, 0x5d, 0xfc, 0x32,
+	0x3a, 0x0f, 0x1b, 0xa9, 0x81, 0xf3, 0x6c, 0x5f, 0x11, 0xd0, 0x45, 0x38, 0x97, 0x35, 0x03, 0x1e,
+	0xf9, 0xa6, 0x80, 0x96, 0x00, 0x05, 0xc8, 0xba, 0x52, 0xed, 0x36, 0xcc, 0x7a, 0x77, 0xa7, 0x23,
+	0x7e, 0x4d, 0xe0, 0x57, 0xb9, 0xa9, 0xd6, 0x94, 0x16, 0x5f, 0x69, 0x5f, 0x4f, 0x55, 0x87, 0x55,
+	0xf4, 0x0d, 0x01, 0x6d, 0xc0, 0x4a, 0x52, 0x5d, 0xa9, 0xd7, 0x4d, 0x26, 0x13, 0xbf, 0x19, 0xab,
+	0xf8, 0x00, 0xc1, 0x32, 0x13, 0x80, 0xbe, 0x95, 0x0a, 0x62, 0xd3, 0x08, 0x40, 0xdf, 0x16, 0x90,
+	0x0c, 0x6b, 0x49, 0x10, 0x4d, 0x1d, 0x13, 0xea, 0xe2, 0x77, 0x04, 0x24, 0x45, 0x67, 0x23, 0x5b,
+	0x28, 0x5d, 0xa9, 0x69, 0x8a, 0x21, 0xbe, 0x45, 0xce, 0xcd, 0x62, 0x64, 0xaf, 0x1b, 0x4c, 0xa3,
+	0x8b, 0x6f, 0x0b, 0x08, 0xc1, 0xac, 0x3f, 0x62, 0x6e, 0xc5, 0x1f, 0x09, 0xe8, 0x2c, 0xcc, 0x31,
+	0x99, 0xda, 0xd2, 0x3b, 0x4a, 0xcd, 0x10, 0x7f, 0x9c, 0x48, 0x23, 0x0d, 0xb0, 0xd2, 0x6c, 0x8a,
+	0xdf, 0x15, 0xd0, 0x1c, 0x14, 0x34, 0xa5, 0xd3, 0x36, 0x35, 0xa5, 0x52, 0x17, 0xdf, 0x11, 0xd0,
+	0x3c, 0x00, 0x1d, 0xdf, 0xd2, 0x54, 0x43, 0x11, 0x7f, 0x4d, 0xbd, 0x53, 0x41, 0xf2, 0x35, 0xf0,
+	0x1b, 0x01, 0x89, 0x30, 0x43, 0x55, 0xcc, 0xf7, 0x6f, 0x05, 0x54, 0x82, 0xb3, 0x54, 0xc2, 0x3c,
+	0x9b, 0xb5, 0xf6, 0xce, 0x8e, 0x6a, 0x88, 0xbf, 0x13, 0xd0, 0x02, 0x88, 0x54, 0xe3, 0xcf, 0xdc,
+	0x17, 0xff, 0x9e, 0xc6, 0xc5, 0x51, 0x04, 0x8a, 0x3f, 0x44, 0x0a, 0x96, 0x8d, 0xaa, 0x56, 0x69,
+	0xd5, 0x6e, 0x88, 0x7f, 0x4c, 0x10, 0x31, 0xf1, 0xbb, 0x13, 0x44, 0x4c, 0xf1, 0x27, 0x01, 0x2d,
+	0xc2, 0x99, 0x58, 0x48, 0xd7, 0xd5, 0xa6, 0x22, 0xfe, 0x99, 0xa6, 0x29, 0xe2, 0xa1, 0xc2, 0xf7,
+	0x68, 0xd5, 0x50, 0x21, 0xa9, 0x85, 0x8e, 0xda, 0x51, 0x9a, 0x6a, 0x4b, 0xa1, 0xa9, 0x51, 0x34,
+	0xf1, 0x2f, 0xb4, 0x6a, 0x58, 0xb2, 0x76, 0xda, 0x37, 0x95, 0x09, 0xc4, 0x5f, 0x33, 0x08, 0x68,
+	0x2e, 0x35, 0xf1, 0x6f, 0x02, 0x2a, 0xc2, 0x3c, 0x3f, 0x2b, 0xa3, 0xd2, 0x10, 0xff, 0x4e, 0x57,
+	0x31, 0x0a, 0x85, 0xc8, 0xfe, 0x11, 0x21, 0xd9, 0x7c, 0x88, 0xf4, 0x9f, 0x74, 0x32, 0x21, 0x2b,
+	0x45, 0xbf, 0xdc, 0xae, 0x8a, 0xbf, 0x98, 0xba, 0xdc, 0x86, 0xd3, 0x7c, 0xab, 0x80, 0xbc, 0x6a,
+	0x35, 0x45, 0x6f, 0x77, 0xb5, 0x9a, 0x62, 0x1a, 0xb7, 0x3b, 0x0a, 0xf7, 0x66, 0x9f, 0x81, 0xe9,
+	0xa0, 0x36, 0x05, 0x94, 0x87, 0x13, 0xc4, 0x8b, 0x38, 0x85, 0x66, 0xa1, 0x40, 0xf2, 0x63, 0xd2,
+	0x61, 0xee, 0xca, 0x7f, 0x44, 0xc8, 0x55, 0x3a, 0x2a, 0xaa, 0x40, 0x3e, 0xf8, 0x85, 0x03, 0x95,
+	0xc2, 0x7b, 0x51, 0xe2, 0x67, 0x12, 0x69, 0x39, 0x45, 0xc3, 0x2e, 0x2d, 0x4f, 0xa0, 0x06, 0x40,
+	0xf4, 0xe3, 0x06, 0x92, 0x42, 0xe8, 0xc4, 0xcf, 0x20, 0xd2, 0x4a, 0xaa, 0x2e, 0x24, 0xba, 0x4d,
+	0x2f, 0x96, 0xb1, 0x8e, 0x33, 0xda, 0x08, 0x4d, 0x32, 0x9a, 0xea, 0xd2, 0xe6, 0x31, 0x08, 0x9e,
+	0x5a, 0xcf, 0xa6, 0xd6, 0x1f, 0x49, 0xad, 0x67, 0x53, 0xef, 0xc0, 0x69, 0xbe, 0xed, 0x8b, 0x56,
+	0xa3, 0x5c, 0x4d, 0x76, 0x9b, 0xa5, 0xb5, 0x0c, 0x6d, 0x48, 0x57, 0x87, 0x42, 0xd8, 0x7a, 0x41,
+	0xcb, 0x31, 0x34, 0xdf, 0x09, 0x92, 0xa4, 0x34, 0x55, 0xc8, 0xa2, 0xc3, 0x5c, 0xbc, 0xa3, 0x80,
+	0xd6, 0xf9, 0x34, 0x4d, 0x36, 0x49, 0xa4, 0x72, 0xa6, 0x3e, 0x24, 0xbd, 0x0b, 0x52, 0x76, 0x63,
+	0x04, 0x5d, 0xce, 0x20, 0x48, 0xf9, 0x6c, 0x79, 0x1c, 0x67, 0x2f, 0xc1, 0x29, 0xbf, 0x09, 0x8e,
+	0x16, 0x43, 0x70, 0xac, 0x4f, 0x2e, 0x2d, 0x4d, 0xc8, 0x43, 0xe3, 0xfd, 0xb0, 0x9b, 0x10, 0xef,
+	0x34, 0xa3, 0xf3, 0xbc, 0xe3, 0xcc, 0xf6, 0xb6, 0xf4, 0xd4, 0xa3, 0x60, 0xa1, 0xa7, 0x4f, 0xc3,
+	0x99, 0x89, 0xa6, 0x06, 0x8a, 0xea, 0x26, 0xab, 0xdf, 0x22, 0xc9, 0xc7, 0x41, 0x12, 0xcb, 0xc8,
+	0x53, 0xaf, 0x27, 0x23, 0x4b, 0xf0, 0x96, 0x33, 0xf5, 0x7c, 0xc1, 0xf2, 0xfd, 0x05, 0xae, 0x60,
+	0x53, 0xba, 0x11, 0xd2, 0x5a, 0x86, 0x36, 0xa4, 0xeb, 0xc0, 0x6c, 0xac, 0x19, 0x80, 0xd6, 0xe2,
+	0x21, 0x24, 0xba, 0x0d, 0xd2, 0x7a, 0x96, 0x3a, 0x64, 0xbc, 0x09, 0xf3, 0x89, 0x4f, 0x25, 0x54,
+	0xe6, 0x7a, 0x3e, 0x69, 0x9d, 0x04, 0x69, 0x23, 0x1b, 0x10, 0xf2, 0x0e, 0x27, 0xfa, 0x0a, 0xc1,
+	0x27, 0x18, 0xba, 0x90, 0x65, 0x9e, 0xf8, 0xc4, 0x93, 0x2e, 0x3e, 0x1a, 0x98, 0x38, 0x74, 0x62,
+	0xdd, 0x85, 0xf8, 0xa1, 0x93, 0xd6, 0xc7, 0x90, 0x36, 0x8f, 0x41, 0xf0, 0x49, 0x8f, 0x35, 0x11,
+	0xb8, 0xa4, 0xa7, 0x35, 0x2d, 0xa4, 0xf5, 0x2c, 0x35, 0x7f, 0xee, 0x84, 0xbd, 0x02, 0xee, 0xdc,
+	0x49, 0x76, 0x24, 0x24, 0x29, 0x4d, 0xc5, 0x6d, 0x87, 0x85, 0xd4, 0x7e, 0x45, 0x7c, 0xe3, 0x65,
+	0xf6, 0x33, 0x1e, 0xc1, 0x5e, 0x81, 0x7c, 0xd0, 0x79, 0xe0, 0x5e, 0x56, 0x89, 0xae, 0x85, 0xb4,
+	0x9c, 0xa2, 0xe1, 0xf7, 0xeb, 0x44, 0xbb, 0x81, 0xdb, 0xaf, 0x59, 0x6d, 0x0a, 0x49, 0x3e, 0x0e,
+	0xc2, 0xaf, 0x78, 0xb2, 0x7d, 0x80, 0xf8, 0xca, 0x4c, 0x6d, 0x4f, 0x48, 0x9b, 0xc7, 0x20, 0xf8,
+	0xe2, 0xcd, 0xf8, 0xf4, 0xe7, 0x8a, 0xf7, 0xf8, 0xf6, 0x81, 0x74, 0xf1, 0xd1, 0xc0, 0xd8, 0x26,
+	0x8c, 0xff, 0x8d, 0x01, 0xbf, 0x09, 0x53, 0xff, 0x6c, 0x41, 0xda, 0xc8, 0x06, 0x04, 0xbc, 0xd5,
+	0xab, 0xef, 0x3c, 0x5c, 0x17, 0xde, 0x7d, 0xb8, 0x2e, 0xbc, 0xff, 0x70, 0x5d, 0xf8, 0xd4, 0xe5,
+	0x3d, 0xcb, 0xdb, 0x1f, 0xef, 0x6e, 0xf5, 0xed, 0xc3, 0x6d, 0xf2, 0x93, 0xe8, 0xfd, 0x01, 0x76,
+	0xf8, 0xa7, 0xa3, 0x2b, 0xdb, 0xae, 0xd3, 0xa7, 0x7f, 0x04, 0xb2, 0x7b, 0x8a, 0xfe, 0x98, 0xf9,
+	0xdc, 0x7f, 0x07, 0x00, 0x96, 0x3c, 0xae, 0x80, 0x18, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -15,3 +15,18 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 	}
 	return clusterInfo, nil
 }
+
+// InspectStorage reports logical and physical (deduplicated) storage usage for
+// repos, or for every repo if none are specified. If details is true, the
+// report includes a breakdown for each commit and commit set.
+func (c APIClient) InspectStorage(details bool, repos ...string) (*admin.StorageInfo, error) {
+	request := &admin.InspectStorageRequest{Details: details}
+	for _, repo := range repos {
+		request.Repos = append(request.Repos, NewRepo(repo))
+	}
+	storageInfo, err := c.AdminAPIClient.InspectStorage(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return storageInfo, nil
+}
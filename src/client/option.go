@@ -79,3 +79,10 @@ func WithOffset(offset int64) GetFileOption {
 		gf.Offset = offset
 	}
 }
+
+// WithSizeBytes limits the number of bytes returned by the get file request.
+func WithSizeBytes(sizeBytes int64) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.SizeBytes = sizeBytes
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -27,6 +28,14 @@ func NewSystemRepo(repoName string, repoType string) *pfs.Repo {
 	return &pfs.Repo{Name: repoName, Type: repoType}
 }
 
+// NewTag creates a pfs.Tag
+func NewTag(repoName string, tagName string) *pfs.Tag {
+	return &pfs.Tag{
+		Repo: NewRepo(repoName),
+		Name: tagName,
+	}
+}
+
 // NewBranch creates a pfs.Branch
 func NewBranch(repoName string, branchName string) *pfs.Branch {
 	return &pfs.Branch{
@@ -130,6 +139,23 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ShredRepo deletes a repo and destroys its encryption key, which makes its
+// data unreadable even before it is garbage collected. Unlike DeleteRepo,
+// which also destroys the key of a repo that has one, it fails if the repo
+// does not have its own encryption key.
+func (c APIClient) ShredRepo(repoName string, force bool) error {
+	request := &pfs.DeleteRepoRequest{
+		Repo:  NewRepo(repoName),
+		Force: force,
+		Shred: true,
+	}
+	_, err := c.PfsAPIClient.DeleteRepo(
+		c.Ctx(),
+		request,
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -151,6 +177,21 @@ func (c APIClient) StartCommit(repoName string, branchName string) (_ *pfs.Commi
 	)
 }
 
+// StartCommitIfHead is like StartCommit, but fails with a conflict error (see
+// pacherr.IsConflict) unless the branch's head is currently expectedHead.
+func (c APIClient) StartCommitIfHead(repoName string, branchName string, expectedHead string) (_ *pfs.Commit, retErr error) {
+	defer func() {
+		retErr = pacherr.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.StartCommit(
+		c.Ctx(),
+		&pfs.StartCommitRequest{
+			Branch:       NewBranch(repoName, branchName),
+			ExpectedHead: expectedHead,
+		},
+	)
+}
+
 // StartCommitParent begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -193,6 +234,21 @@ func (c APIClient) FinishCommit(repoName string, branchName string, commitID str
 	return err
 }
 
+// FinishCommitIfHead is like FinishCommit, but fails with a conflict error
+// (see pacherr.IsConflict) unless the commit's branch currently points to
+// expectedHead.
+func (c APIClient) FinishCommitIfHead(repoName string, branchName string, commitID string, expectedHead string) (retErr error) {
+	defer func() { retErr = pacherr.ScrubGRPC(retErr) }()
+	_, err := c.PfsAPIClient.FinishCommit(
+		c.Ctx(),
+		&pfs.FinishCommitRequest{
+			Commit:       NewCommit(repoName, branchName, commitID),
+			ExpectedHead: expectedHead,
+		},
+	)
+	return err
+}
+
 // InspectCommit returns info about a specific Commit.
 func (c APIClient) InspectCommit(repoName string, branchName string, commitID string) (_ *pfs.CommitInfo, retErr error) {
 	defer func() { retErr = grpcutil.ScrubGRPC(retErr) }()
@@ -303,6 +359,27 @@ func (c APIClient) CreateBranch(repoName string, branchName string, commitBranch
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateBranchIfHead is like CreateBranch, but fails with a conflict error
+// (see pacherr.IsConflict) unless the branch's head is currently expectedHead.
+// This can be used to move a branch only if no one else has moved it since it
+// was last read.
+func (c APIClient) CreateBranchIfHead(repoName string, branchName string, commitBranch string, commitID string, provenance []*pfs.Branch, expectedHead string) error {
+	var head *pfs.Commit
+	if commitBranch != "" || commitID != "" {
+		head = NewCommit(repoName, commitBranch, commitID)
+	}
+	_, err := c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:       NewBranch(repoName, branchName),
+			Head:         head,
+			Provenance:   provenance,
+			ExpectedHead: expectedHead,
+		},
+	)
+	return pacherr.ScrubGRPC(err)
+}
+
 // CreateBranchTrigger Creates a branch with a trigger. Note: triggers and
 // provenance are mutually exclusive. See the docs on triggers to learn more
 // about why this is.
@@ -367,6 +444,227 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// MergeBranch merges the changes made on the source branch since its common
+// ancestor with the target branch into the target branch, as a new commit.
+// Paths which were changed differently on both branches are resolved with
+// strategy, or cause an error if strategy is pfs.MergeStrategy_FAIL.
+func (c APIClient) MergeBranch(repoName string, sourceBranch string, targetBranch string, strategy pfs.MergeStrategy) (*pfs.MergeBranchResponse, error) {
+	resp, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source:   NewBranch(repoName, sourceBranch),
+			Target:   NewBranch(repoName, targetBranch),
+			Strategy: strategy,
+		},
+	)
+	return resp, pacherr.ScrubGRPC(err)
+}
+
+// RevertCommit creates a new commit on branchName in the repo repoName, which
+// reverses the changes made by the commit commitID on branchName. If restore
+// is set, the new commit instead has the same content as the commit commitID.
+// If commitID is empty, the head of branchName is reverted.
+func (c APIClient) RevertCommit(repoName string, branchName string, commitID string, restore bool) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.RevertCommit(
+		c.Ctx(),
+		&pfs.RevertCommitRequest{
+			Commit:  NewCommit(repoName, branchName, commitID),
+			Restore: restore,
+		},
+	)
+	return commit, grpcutil.ScrubGRPC(err)
+}
+
+// CreateTag creates an immutable tag named tagName, which points to the commit
+// commitID on branchName in the repo repoName. Either branchName or commitID
+// may be empty, in which case the head of branchName or the commit with ID
+// commitID is tagged, respectively.
+func (c APIClient) CreateTag(repoName string, tagName string, branchName string, commitID string) error {
+	_, err := c.PfsAPIClient.CreateTag(
+		c.Ctx(),
+		&pfs.CreateTagRequest{
+			Tag:    NewTag(repoName, tagName),
+			Commit: NewCommit(repoName, branchName, commitID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectTag returns information about a tag.
+func (c APIClient) InspectTag(repoName string, tagName string) (*pfs.TagInfo, error) {
+	tagInfo, err := c.PfsAPIClient.InspectTag(
+		c.Ctx(),
+		&pfs.InspectTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return tagInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListTag lists the tags in a Repo, or in all repos if repoName is empty.
+func (c APIClient) ListTag(repoName string) ([]*pfs.TagInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	var repo *pfs.Repo
+	if repoName != "" {
+		repo = NewRepo(repoName)
+	}
+	client, err := c.PfsAPIClient.ListTag(
+		ctx,
+		&pfs.ListTagRequest{
+			Repo: repo,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	tagInfos, err := clientsdk.ListTagInfo(client)
+	return tagInfos, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteTag deletes a tag. The commit it points to is left intact.
+func (c APIClient) DeleteTag(repoName string, tagName string) error {
+	_, err := c.PfsAPIClient.DeleteTag(
+		c.Ctx(),
+		&pfs.DeleteTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetRetentionPolicy sets the retention policy of a repo, or of one of its
+// branches if branchName is set. A nil policy removes the existing one.
+func (c APIClient) SetRetentionPolicy(repoName string, branchName string, policy *pfs.RetentionPolicy) error {
+	req := &pfs.SetRetentionPolicyRequest{Policy: policy}
+	if branchName != "" {
+		req.Branch = NewBranch(repoName, branchName)
+	} else {
+		req.Repo = NewRepo(repoName)
+	}
+	_, err := c.PfsAPIClient.SetRetentionPolicy(c.Ctx(), req)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// EnforceRetention squashes the commit sets of a repo, or of all repos if
+// repoName is empty, which aren't kept by any retention policy. It returns
+// the commit sets which were squashed, or would be if dryRun is set.
+func (c APIClient) EnforceRetention(repoName string, dryRun bool) ([]*pfs.CommitSet, error) {
+	var repo *pfs.Repo
+	if repoName != "" {
+		repo = NewRepo(repoName)
+	}
+	resp, err := c.PfsAPIClient.EnforceRetention(
+		c.Ctx(),
+		&pfs.EnforceRetentionRequest{
+			Repo:   repo,
+			DryRun: dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.CommitSets, nil
+}
+
+// CreateReplication starts replicating the commits of the repo repoName to
+// the pachd at target, which is given as a URL such as
+// grpc://pachd.example.com:30650. authToken is used to authenticate with the
+// target cluster, and may be empty if auth isn't active there. If update is
+// true, an existing replication of the repo is updated.
+func (c APIClient) CreateReplication(repoName string, target string, authToken string, update bool) error {
+	_, err := c.PfsAPIClient.CreateReplication(
+		c.Ctx(),
+		&pfs.CreateReplicationRequest{
+			Replication: &pfs.Replication{
+				Repo:   NewRepo(repoName),
+				Target: target,
+			},
+			AuthToken: authToken,
+			Update:    update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectReplication returns the progress of the replication of a repo.
+func (c APIClient) InspectReplication(repoName string) (*pfs.ReplicationInfo, error) {
+	replicationInfo, err := c.PfsAPIClient.InspectReplication(
+		c.Ctx(),
+		&pfs.InspectReplicationRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	return replicationInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListReplication returns the progress of every replication.
+func (c APIClient) ListReplication() ([]*pfs.ReplicationInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListReplication(ctx, &pfs.ListReplicationRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	replicationInfos, err := clientsdk.ListReplicationInfo(client)
+	return replicationInfos, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteReplication stops replicating a repo. The commits which were already
+// replicated are kept in the target cluster.
+func (c APIClient) DeleteReplication(repoName string) error {
+	_, err := c.PfsAPIClient.DeleteReplication(
+		c.Ctx(),
+		&pfs.DeleteReplicationRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ExportRepo writes an archive of a repo's finished commits, branches and data
+// to w, which ImportRepo creates a repo from. If referencesOnly is true, the
+// archive refers to the repo's chunks in object storage rather than holding
+// them, so it can only be imported by a cluster which shares the bucket.
+func (c APIClient) ExportRepo(repoName string, referencesOnly bool, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ExportRepo(ctx, &pfs.ExportRepoRequest{
+		Repo:           NewRepo(repoName),
+		ReferencesOnly: referencesOnly,
+	})
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteFromStreamingBytesClient(client, w)
+}
+
+// ImportRepo creates a repo from an archive written by ExportRepo.
+func (c APIClient) ImportRepo(repoName string, r io.Reader) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ImportRepo(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.Send(&pfs.ImportRepoRequest{Repo: NewRepo(repoName)}); err != nil {
+		return err
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+		return client.Send(&pfs.ImportRepoRequest{Data: data})
+	}); err != nil {
+		return err
+	}
+	_, err = client.CloseAndRecv()
+	return err
+}
+
 func (c APIClient) inspectCommitSet(id string, wait bool, cb func(*pfs.CommitInfo) error) error {
 	req := &pfs.InspectCommitSetRequest{
 		CommitSet: NewCommitSet(id),
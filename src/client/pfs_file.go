@@ -74,8 +74,22 @@ type ModifyFile interface {
 }
 
 // WithModifyFileClient creates a new ModifyFileClient that is scoped to the passed in callback.
+// If the client has an active transaction, the modifications are written to a
+// file set which is added to the commit when the transaction is finished. The
+// file set is kept until the transaction is finished or deleted.
 // TODO: Context should be a parameter, not stored in the pach client.
 func (c APIClient) WithModifyFileClient(commit *pfs.Commit, cb func(ModifyFile) error) (retErr error) {
+	if c.inTransaction() {
+		resp, err := c.WithCreateFileSetClientForCommit(commit, cb)
+		if err != nil {
+			return err
+		}
+		_, err = c.PfsAPIClient.AddFileSet(c.Ctx(), &pfs.AddFileSetRequest{
+			Commit:    commit,
+			FileSetId: resp.FileSetId,
+		})
+		return grpcutil.ScrubGRPC(err)
+	}
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
 	mfc, err := c.WithCtx(cancelCtx).NewModifyFileClient(commit)
@@ -331,9 +345,17 @@ func (c APIClient) WithRenewer(cb func(context.Context, *renew.StringSet) error)
 
 // WithCreateFileSetClient provides a scoped fileset client.
 func (c APIClient) WithCreateFileSetClient(cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
+	return c.WithCreateFileSetClientForCommit(nil, cb)
+}
+
+// WithCreateFileSetClientForCommit provides a scoped fileset client for a
+// fileset that will be added to commit. The fileset's data is encrypted with
+// the encryption key of commit's repo, if it has one, so that shredding the
+// repo makes it unreadable.
+func (c APIClient) WithCreateFileSetClientForCommit(commit *pfs.Commit, cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
-	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClient()
+	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClientForCommit(commit)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +375,12 @@ type CreateFileSetClient struct {
 
 // NewCreateFileSetClient returns a CreateFileSetClient instance backed by this client
 func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr error) {
+	return c.NewCreateFileSetClientForCommit(nil)
+}
+
+// NewCreateFileSetClientForCommit returns a CreateFileSetClient instance
+// backed by this client, for a fileset that will be added to commit.
+func (c APIClient) NewCreateFileSetClientForCommit(commit *pfs.Commit) (_ *CreateFileSetClient, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -360,6 +388,13 @@ func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr erro
 	if err != nil {
 		return nil, err
 	}
+	if commit != nil {
+		if err := client.Send(&pfs.ModifyFileRequest{
+			Body: &pfs.ModifyFileRequest_SetCommit{SetCommit: commit},
+		}); err != nil {
+			return nil, err
+		}
+	}
 	return &CreateFileSetClient{
 		client: client,
 		modifyFileCore: modifyFileCore{
@@ -450,8 +485,8 @@ func (c APIClient) ComposeFileSet(IDs []string, ttl time.Duration) (_ string, re
 }
 
 // GetFile returns the contents of a file at a specific Commit.
-// offset specifies a number of bytes that should be skipped in the beginning of the file.
-// size limits the total amount of data returned, note you will get fewer bytes
+// WithOffset specifies a number of bytes that should be skipped in the beginning of the file.
+// WithSizeBytes limits the total amount of data returned, note you will get fewer bytes
 // than size if you pass a value larger than the size of the file.
 // If size is set to 0 then all of the data will be returned.
 func (c APIClient) GetFile(commit *pfs.Commit, path string, w io.Writer, opts ...GetFileOption) (retErr error) {
@@ -521,70 +556,123 @@ func (c APIClient) GetFileReader(commit *pfs.Commit, path string) (io.Reader, er
 }
 
 // GetFileReadSeeker returns a reader for the contents of a file at a specific
-// Commit that permits Seeking to different points in the file.
+// Commit that permits Seeking to different points in the file. Seeking doesn't
+// read any data, the next Read gets the file starting at the new offset, and
+// only as much of it as was asked for.
+// The reader is also an io.Closer, which stops any in-progress request.
 func (c APIClient) GetFileReadSeeker(commit *pfs.Commit, path string) (io.ReadSeeker, error) {
 	fi, err := c.InspectFile(commit, path)
 	if err != nil {
 		return nil, err
 	}
-	r, err := c.GetFileReader(commit, path)
-	if err != nil {
-		return nil, err
-	}
 	return &getFileReadSeeker{
-		Reader: r,
-		c:      c,
-		file:   commit.NewFile(path),
-		offset: 0,
-		size:   int64(fi.SizeBytes),
+		c:    c,
+		file: fi.File,
+		size: int64(fi.SizeBytes),
 	}, nil
 }
 
+// getFileReadAheadBytes caps how much a getFileReadSeeker requests at once
+// when it is read sequentially.
+const getFileReadAheadBytes = 64 * 1024 * 1024
+
 type getFileReadSeeker struct {
-	io.Reader
 	c            APIClient
 	file         *pfs.File
 	offset, size int64
-}
-
-func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
-	getFileReader := func(offset int64) (io.Reader, error) {
-		r, err := gfrs.c.GetFileReader(gfrs.file.Commit, gfrs.file.Path)
-		if err != nil {
-			return nil, err
+	// r reads the file from offset up to end, it is opened by Read
+	r   io.ReadCloser
+	end int64
+	// sizeBytes is the size of the last request, each request reading on
+	// from the previous one gets twice as much, so that sequential reads
+	// don't need a request per Read
+	sizeBytes int64
+}
+
+func (gfrs *getFileReadSeeker) Read(p []byte) (int, error) {
+	if gfrs.offset >= gfrs.size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if gfrs.r == nil {
+		// request the length which was requested from the reader, or more if
+		// the previous request was read up to its end
+		sizeBytes := int64(len(p))
+		if next := 2 * gfrs.sizeBytes; next > sizeBytes {
+			sizeBytes = next
+			if sizeBytes > getFileReadAheadBytes {
+				sizeBytes = getFileReadAheadBytes
+			}
 		}
-		// TODO: Replace with file range request when implemented in PFS.
-		if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil {
-			return nil, err
+		if rest := gfrs.size - gfrs.offset; sizeBytes > rest {
+			sizeBytes = rest
 		}
-		return r, nil
+		ctx, cf := context.WithCancel(gfrs.c.Ctx())
+		client, err := gfrs.c.PfsAPIClient.GetFile(ctx, &pfs.GetFileRequest{
+			File:      gfrs.file,
+			Offset:    gfrs.offset,
+			SizeBytes: sizeBytes,
+		})
+		if err != nil {
+			cf()
+			return 0, grpcutil.ScrubGRPC(err)
+		}
+		gfrs.r = grpcutil.NewStreamingBytesReader(client, cf)
+		gfrs.end = gfrs.offset + sizeBytes
+		gfrs.sizeBytes = sizeBytes
+	}
+	if int64(len(p)) > gfrs.end-gfrs.offset {
+		p = p[:gfrs.end-gfrs.offset]
+	}
+	n, err := gfrs.r.Read(p)
+	gfrs.offset += int64(n)
+	if errors.Is(err, io.EOF) {
+		// the request ended before the file did
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return n, grpcutil.ScrubGRPC(err)
 	}
+	if gfrs.offset == gfrs.end {
+		return n, gfrs.Close()
+	}
+	return n, nil
+}
+
+func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	switch whence {
 	case io.SeekStart:
-		r, err := getFileReader(offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset = offset
-		gfrs.Reader = r
 	case io.SeekCurrent:
-		r, err := getFileReader(gfrs.offset + offset)
-		if err != nil {
-			return gfrs.offset, err
-		}
-		gfrs.offset += offset
-		gfrs.Reader = r
+		offset += gfrs.offset
 	case io.SeekEnd:
-		r, err := getFileReader(gfrs.size - offset)
-		if err != nil {
+		offset += gfrs.size
+	default:
+		return gfrs.offset, errors.Errorf("invalid whence: %d", whence)
+	}
+	if offset < 0 {
+		return gfrs.offset, errors.Errorf("cannot seek to negative offset %d", offset)
+	}
+	if offset != gfrs.offset {
+		if err := gfrs.Close(); err != nil {
 			return gfrs.offset, err
 		}
-		gfrs.offset = gfrs.size - offset
-		gfrs.Reader = r
+		gfrs.offset = offset
+		gfrs.sizeBytes = 0
 	}
 	return gfrs.offset, nil
 }
 
+func (gfrs *getFileReadSeeker) Close() error {
+	if gfrs.r == nil {
+		return nil
+	}
+	err := gfrs.r.Close()
+	gfrs.r = nil
+	return err
+}
+
 // GetFileURL gets the file at the specified URL
 func (c APIClient) GetFileURL(commit *pfs.Commit, path, URL string) (retErr error) {
 	defer func() {
@@ -616,6 +704,25 @@ func (c APIClient) InspectFile(commit *pfs.Commit, path string) (_ *pfs.FileInfo
 	return fi, err
 }
 
+// PresignFile returns the path and query of an s3 gateway URL, which
+// downloads the specified file without a token until expires has passed.
+func (c APIClient) PresignFile(commit *pfs.Commit, path string, expires time.Duration) (_ string, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	resp, err := c.PfsAPIClient.PresignFile(
+		c.Ctx(),
+		&pfs.PresignFileRequest{
+			File:    commit.NewFile(path),
+			Expires: types.DurationProto(expires),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return resp.URL, nil
+}
+
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
 func (c APIClient) ListFile(commit *pfs.Commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
@@ -43,6 +43,10 @@ const (
 	// PPSScratchSpace is where pps workers store data while it's waiting to be
 	// processed.
 	PPSScratchSpace = ".scratch"
+	// PPSCheckpointDir is the name of the directory under PPSInputPrefix where
+	// user code can save the progress of a datum, when the pipeline sets a
+	// datum checkpoint interval.
+	PPSCheckpointDir = "checkpoint"
 	// PPSWorkerPortEnv is environment variable name for the port that workers
 	// use for their gRPC server
 	PPSWorkerPortEnv = "PPS_WORKER_GRPC_PORT"
@@ -69,6 +73,13 @@ const (
 	DatumIDEnv = "PACH_DATUM_ID"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
+	// PPSSecretVersionsEnv is the env var that holds the resource versions
+	// of the pipeline's secrets when its workers were created, which are
+	// part of the key of the datum cache.
+	PPSSecretVersionsEnv = "PPS_SECRET_VERSIONS"
+	// PPSUserImageIDEnv is the env var that holds the ID of the image that
+	// a local worker runs in, since local workers don't run in a pod.
+	PPSUserImageIDEnv = "PPS_USER_IMAGE_ID"
 
 	ReprocessSpecUntilSuccess = "until_success"
 	ReprocessSpecEveryJob     = "every_job"
@@ -187,6 +198,21 @@ func NewCronInputOpts(name string, repo string, spec string, overwrite bool) *pp
 	}
 }
 
+// NewSQLInput returns an input which materializes the result of a query on a
+// timed schedule. The result will be exposed to jobs as `/pfs/<name>/0000.csv`.
+// It only takes required options.
+func NewSQLInput(name string, url string, secretName string, query string, spec string) *pps.Input {
+	return &pps.Input{
+		SQL: &pps.SQLInput{
+			Name:   name,
+			URL:    url,
+			Secret: &pfs.SQLDatabaseEgress_Secret{Name: secretName},
+			Query:  query,
+			Spec:   spec,
+		},
+	}
+}
+
 // NewJobInput creates a pps.JobInput.
 func NewJobInput(repoName string, branchName string, commitID string, glob string) *pps.JobInput {
 	return &pps.JobInput{
@@ -679,6 +705,20 @@ func (c APIClient) CreatePipeline(
 	return grpcutil.ScrubGRPC(err)
 }
 
+// DryRunPipeline previews the datums that a pipeline would process at its
+// current input commits, without creating it. sample is the number of datums
+// to return, 0 returns the server's default.
+func (c APIClient) DryRunPipeline(request *pps.CreatePipelineRequest, sample int64) (*pps.DryRunPipelineResponse, error) {
+	resp, err := c.PpsAPIClient.DryRunPipeline(
+		c.Ctx(),
+		&pps.DryRunPipelineRequest{
+			CreatePipelineRequest: request,
+			Sample:                sample,
+		},
+	)
+	return resp, grpcutil.ScrubGRPC(err)
+}
+
 // InspectPipeline returns info about a specific pipeline.
 func (c APIClient) InspectPipeline(pipelineName string, details bool) (*pps.PipelineInfo, error) {
 	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
@@ -33,6 +33,10 @@ func (c *unsupportedAdminBuilderClient) InspectCluster(_ context.Context, _ *typ
 	return nil, unsupportedError("InspectCluster")
 }
 
+func (c *unsupportedAdminBuilderClient) InspectStorage(_ context.Context, _ *admin_v2.InspectStorageRequest, opts ...grpc.CallOption) (*admin_v2.StorageInfo, error) {
+	return nil, unsupportedError("InspectStorage")
+}
+
 type unsupportedAuthBuilderClient struct{}
 
 func (c *unsupportedAuthBuilderClient) Activate(_ context.Context, _ *auth_v2.ActivateRequest, opts ...grpc.CallOption) (*auth_v2.ActivateResponse, error) {
@@ -309,6 +313,10 @@ func (c *unsupportedPfsBuilderClient) CreateFileSet(_ context.Context, opts ...g
 	return nil, unsupportedError("CreateFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateReplication(_ context.Context, _ *pfs_v2.CreateReplicationRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) CreateRepo(_ context.Context, _ *pfs_v2.CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CreateRepo")
 }
@@ -317,14 +325,26 @@ func (c *unsupportedPfsBuilderClient) DeleteAll(_ context.Context, _ *types.Empt
 	return nil, unsupportedError("DeleteAll")
 }
 
+func (c *unsupportedPfsBuilderClient) CreateTag(_ context.Context, _ *pfs_v2.CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteBranch(_ context.Context, _ *pfs_v2.DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteBranch")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteReplication(_ context.Context, _ *pfs_v2.DeleteReplicationRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) DeleteRepo(_ context.Context, _ *pfs_v2.DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) DeleteTag(_ context.Context, _ *pfs_v2.DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
+
 func (c *unsupportedPfsBuilderClient) DiffFile(_ context.Context, _ *pfs_v2.DiffFileRequest, opts ...grpc.CallOption) (pfs_v2.API_DiffFileClient, error) {
 	return nil, unsupportedError("DiffFile")
 }
@@ -337,6 +357,18 @@ func (c *unsupportedPfsBuilderClient) Egress(_ context.Context, _ *pfs_v2.Egress
 	return nil, unsupportedError("Egress")
 }
 
+func (c *unsupportedPfsBuilderClient) EnforceRetention(_ context.Context, _ *pfs_v2.EnforceRetentionRequest, opts ...grpc.CallOption) (*pfs_v2.EnforceRetentionResponse, error) {
+	return nil, unsupportedError("EnforceRetention")
+}
+
+func (c *unsupportedPfsBuilderClient) ExportRepo(_ context.Context, _ *pfs_v2.ExportRepoRequest, opts ...grpc.CallOption) (pfs_v2.API_ExportRepoClient, error) {
+	return nil, unsupportedError("ExportRepo")
+}
+
+func (c *unsupportedPfsBuilderClient) FindMissingChunks(_ context.Context, _ *pfs_v2.FindMissingChunksRequest, opts ...grpc.CallOption) (*pfs_v2.FindMissingChunksResponse, error) {
+	return nil, unsupportedError("FindMissingChunks")
+}
+
 func (c *unsupportedPfsBuilderClient) FinishCommit(_ context.Context, _ *pfs_v2.FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("FinishCommit")
 }
@@ -365,6 +397,10 @@ func (c *unsupportedPfsBuilderClient) GlobFile(_ context.Context, _ *pfs_v2.Glob
 	return nil, unsupportedError("GlobFile")
 }
 
+func (c *unsupportedPfsBuilderClient) ImportRepo(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ImportRepoClient, error) {
+	return nil, unsupportedError("ImportRepo")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectBranch(_ context.Context, _ *pfs_v2.InspectBranchRequest, opts ...grpc.CallOption) (*pfs_v2.BranchInfo, error) {
 	return nil, unsupportedError("InspectBranch")
 }
@@ -381,10 +417,18 @@ func (c *unsupportedPfsBuilderClient) InspectFile(_ context.Context, _ *pfs_v2.I
 	return nil, unsupportedError("InspectFile")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectReplication(_ context.Context, _ *pfs_v2.InspectReplicationRequest, opts ...grpc.CallOption) (*pfs_v2.ReplicationInfo, error) {
+	return nil, unsupportedError("InspectReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) InspectRepo(_ context.Context, _ *pfs_v2.InspectRepoRequest, opts ...grpc.CallOption) (*pfs_v2.RepoInfo, error) {
 	return nil, unsupportedError("InspectRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) InspectTag(_ context.Context, _ *pfs_v2.InspectTagRequest, opts ...grpc.CallOption) (*pfs_v2.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListBranch(_ context.Context, _ *pfs_v2.ListBranchRequest, opts ...grpc.CallOption) (pfs_v2.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -401,18 +445,34 @@ func (c *unsupportedPfsBuilderClient) ListFile(_ context.Context, _ *pfs_v2.List
 	return nil, unsupportedError("ListFile")
 }
 
+func (c *unsupportedPfsBuilderClient) ListReplication(_ context.Context, _ *pfs_v2.ListReplicationRequest, opts ...grpc.CallOption) (pfs_v2.API_ListReplicationClient, error) {
+	return nil, unsupportedError("ListReplication")
+}
+
 func (c *unsupportedPfsBuilderClient) ListRepo(_ context.Context, _ *pfs_v2.ListRepoRequest, opts ...grpc.CallOption) (pfs_v2.API_ListRepoClient, error) {
 	return nil, unsupportedError("ListRepo")
 }
 
+func (c *unsupportedPfsBuilderClient) ListTag(_ context.Context, _ *pfs_v2.ListTagRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTagClient, error) {
+	return nil, unsupportedError("ListTag")
+}
+
 func (c *unsupportedPfsBuilderClient) ListTask(_ context.Context, _ *taskapi.ListTaskRequest, opts ...grpc.CallOption) (pfs_v2.API_ListTaskClient, error) {
 	return nil, unsupportedError("ListTask")
 }
 
+func (c *unsupportedPfsBuilderClient) MergeBranch(_ context.Context, _ *pfs_v2.MergeBranchRequest, opts ...grpc.CallOption) (*pfs_v2.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
+
 func (c *unsupportedPfsBuilderClient) ModifyFile(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ModifyFileClient, error) {
 	return nil, unsupportedError("ModifyFile")
 }
 
+func (c *unsupportedPfsBuilderClient) PresignFile(_ context.Context, _ *pfs_v2.PresignFileRequest, opts ...grpc.CallOption) (*pfs_v2.PresignFileResponse, error) {
+	return nil, unsupportedError("PresignFile")
+}
+
 func (c *unsupportedPfsBuilderClient) PutCache(_ context.Context, _ *pfs_v2.PutCacheRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("PutCache")
 }
@@ -421,6 +481,14 @@ func (c *unsupportedPfsBuilderClient) RenewFileSet(_ context.Context, _ *pfs_v2.
 	return nil, unsupportedError("RenewFileSet")
 }
 
+func (c *unsupportedPfsBuilderClient) ReplicateCommit(_ context.Context, opts ...grpc.CallOption) (pfs_v2.API_ReplicateCommitClient, error) {
+	return nil, unsupportedError("ReplicateCommit")
+}
+
+func (c *unsupportedPfsBuilderClient) RevertCommit(_ context.Context, _ *pfs_v2.RevertCommitRequest, opts ...grpc.CallOption) (*pfs_v2.Commit, error) {
+	return nil, unsupportedError("RevertCommit")
+}
+
 func (c *unsupportedPfsBuilderClient) RunLoadTest(_ context.Context, _ *pfs_v2.RunLoadTestRequest, opts ...grpc.CallOption) (*pfs_v2.RunLoadTestResponse, error) {
 	return nil, unsupportedError("RunLoadTest")
 }
@@ -429,6 +497,10 @@ func (c *unsupportedPfsBuilderClient) RunLoadTestDefault(_ context.Context, _ *t
 	return nil, unsupportedError("RunLoadTestDefault")
 }
 
+func (c *unsupportedPfsBuilderClient) SetRetentionPolicy(_ context.Context, _ *pfs_v2.SetRetentionPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetRetentionPolicy")
+}
+
 func (c *unsupportedPfsBuilderClient) SquashCommitSet(_ context.Context, _ *pfs_v2.SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("SquashCommitSet")
 }
@@ -475,6 +547,10 @@ func (c *unsupportedPpsBuilderClient) DeleteSecret(_ context.Context, _ *pps_v2.
 	return nil, unsupportedError("DeleteSecret")
 }
 
+func (c *unsupportedPpsBuilderClient) DryRunPipeline(_ context.Context, _ *pps_v2.DryRunPipelineRequest, opts ...grpc.CallOption) (*pps_v2.DryRunPipelineResponse, error) {
+	return nil, unsupportedError("DryRunPipeline")
+}
+
 func (c *unsupportedPpsBuilderClient) GetLogs(_ context.Context, _ *pps_v2.GetLogsRequest, opts ...grpc.CallOption) (pps_v2.API_GetLogsClient, error) {
 	return nil, unsupportedError("GetLogs")
 }
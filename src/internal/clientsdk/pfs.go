@@ -38,6 +38,66 @@ func ListBranchInfo(client pfs.API_ListBranchClient) ([]*pfs.BranchInfo, error)
 	return results, nil
 }
 
+func ForEachTagInfo(client pfs.API_ListTagClient, cb func(*pfs.TagInfo) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := cb(x); err != nil {
+			if errors.Is(err, pacherr.ErrBreak) {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListTagInfo(client pfs.API_ListTagClient) ([]*pfs.TagInfo, error) {
+	var results []*pfs.TagInfo
+	if err := ForEachTagInfo(client, func(x *pfs.TagInfo) error {
+		results = append(results, x)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func ForEachReplicationInfo(client pfs.API_ListReplicationClient, cb func(*pfs.ReplicationInfo) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := cb(x); err != nil {
+			if errors.Is(err, pacherr.ErrBreak) {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListReplicationInfo(client pfs.API_ListReplicationClient) ([]*pfs.ReplicationInfo, error) {
+	var results []*pfs.ReplicationInfo
+	if err := ForEachReplicationInfo(client, func(x *pfs.ReplicationInfo) error {
+		results = append(results, x)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func ForEachRepoInfo(client pfs.API_ListRepoClient, cb func(*pfs.RepoInfo) error) error {
 	for {
 		x, err := client.Recv()
@@ -3,8 +3,12 @@ package clusterstate
 import (
 	"context"
 
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	enterpriseserver "github.com/pachyderm/pachyderm/v2/src/server/enterprise/server"
 )
 
@@ -17,4 +21,28 @@ var state_2_1_0 migrations.State = state_2_0_0.
 	}).
 	Apply("create pfs cache v1", func(ctx context.Context, env migrations.Env) error {
 		return fileset.CreatePostgresCacheV1(ctx, env.Tx)
+	}).
+	Apply("task service v0", func(ctx context.Context, env migrations.Env) error {
+		return task.SetupPostgresTaskV0(ctx, env.Tx)
+	}).
+	Apply("task service v1", func(ctx context.Context, env migrations.Env) error {
+		return task.SetupPostgresTaskV1(ctx, env.Tx)
+	}).
+	Apply("pfs tags v0", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.TagsCollectionsV0()...)
+	}).
+	Apply("storage chunk store v1", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV1(ctx, env.Tx)
+	}).
+	Apply("storage chunk store v2", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV2(ctx, env.Tx)
+	}).
+	Apply("pfs replications v0", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.ReplicationsCollectionsV0()...)
+	}).
+	Apply("storage chunk store v3", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV3(ctx, env.Tx)
+	}).
+	Apply("storage chunk store v4", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupPostgresStoreV4(ctx, env.Tx)
 	})
@@ -148,7 +148,9 @@ func (r *Reporter) reportClusterMetrics() {
 		time.Sleep(reportingInterval)
 		metrics := &Metrics{}
 		r.internalMetrics(metrics)
-		externalMetrics(r.env.GetKubeClient(), metrics)
+		if r.env.Config().PPSInfraDriver != serviceenv.InfraDriverLocal {
+			externalMetrics(r.env.GetKubeClient(), metrics)
+		}
 		metrics.ClusterID = r.clusterID
 		metrics.PodID = uuid.NewWithoutDashes()
 		metrics.Version = version.PrettyPrintVersion(version.Version)
@@ -234,6 +236,9 @@ func inputMetrics(input *pps.Input, metrics *Metrics) {
 	if input.Cron != nil {
 		metrics.InputCron++
 	}
+	if input.SQL != nil {
+		metrics.InputSql++
+	}
 	if input.Pfs != nil {
 		pfsInputMetrics(input.Pfs, metrics)
 	}
@@ -77,6 +77,7 @@ type Metrics struct {
 	MinParallelism       uint64   `protobuf:"varint,52,opt,name=min_parallelism,json=minParallelism,proto3" json:"min_parallelism,omitempty"`
 	NumParallelism       uint64   `protobuf:"varint,53,opt,name=num_parallelism,json=numParallelism,proto3" json:"num_parallelism,omitempty"`
 	EnterpriseFailures   int64    `protobuf:"varint,54,opt,name=enterprise_failures,json=enterpriseFailures,proto3" json:"enterprise_failures,omitempty"`
+	InputSql             int64    `protobuf:"varint,55,opt,name=input_sql,json=inputSql,proto3" json:"input_sql,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -479,6 +480,13 @@ func (m *Metrics) GetEnterpriseFailures() int64 {
 	return 0
 }
 
+func (m *Metrics) GetInputSql() int64 {
+	if m != nil {
+		return m.InputSql
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Metrics)(nil), "metrics.Metrics")
 }
@@ -486,68 +494,69 @@ func init() {
 func init() { proto.RegisterFile("internal/metrics/metrics.proto", fileDescriptor_80696bde8ca4d1c7) }
 
 var fileDescriptor_80696bde8ca4d1c7 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x95, 0xdb, 0x72, 0xdb, 0x36,
-	0x10, 0x86, 0x47, 0x89, 0x4f, 0x82, 0x0f, 0x92, 0x60, 0x37, 0x83, 0x9c, 0x6c, 0xc5, 0x69, 0x6b,
-	0xc5, 0x71, 0xad, 0x36, 0x6a, 0xf3, 0x00, 0x96, 0x93, 0x8c, 0x33, 0xf1, 0xc4, 0x23, 0xa7, 0x37,
-	0xbd, 0xe1, 0x40, 0x20, 0x44, 0xc3, 0x21, 0x01, 0x04, 0x20, 0x5d, 0x2b, 0x77, 0x7d, 0xbb, 0x5e,
-	0xf6, 0x09, 0x32, 0x1d, 0x3f, 0x49, 0x66, 0x17, 0xa2, 0x44, 0xd9, 0x57, 0xd2, 0xfe, 0xfb, 0x61,
-	0xf7, 0xdf, 0x1d, 0x90, 0x24, 0xdb, 0x4a, 0xe7, 0xd2, 0x69, 0x9e, 0x76, 0x33, 0x99, 0x3b, 0x25,
-	0x7c, 0xf9, 0x7b, 0x68, 0x9d, 0xc9, 0x0d, 0x5d, 0x9e, 0x84, 0x8f, 0xb6, 0x12, 0x93, 0x18, 0xd4,
-	0xba, 0xf0, 0x2f, 0xa4, 0x77, 0xff, 0x69, 0x90, 0xe5, 0xd3, 0x40, 0xd0, 0x03, 0x42, 0x44, 0x5a,
-	0xf8, 0x5c, 0xba, 0x48, 0xc5, 0xac, 0xd6, 0xae, 0x75, 0xea, 0x47, 0xeb, 0x37, 0xdf, 0x76, 0xea,
-	0xfd, 0xa0, 0x9e, 0x1c, 0x0f, 0xea, 0x13, 0xe0, 0x24, 0xa6, 0x6d, 0xb2, 0x64, 0x4d, 0x0c, 0xe4,
-	0x3d, 0x24, 0xeb, 0x37, 0xdf, 0x76, 0x16, 0xcf, 0x4c, 0x7c, 0x72, 0x3c, 0x58, 0xb4, 0x26, 0x3e,
-	0x89, 0xe9, 0x16, 0x59, 0xd4, 0x26, 0x96, 0x9e, 0xdd, 0x6f, 0xd7, 0x3a, 0xf7, 0x07, 0x21, 0xa0,
-	0x8c, 0x2c, 0x5f, 0x49, 0xe7, 0x95, 0xd1, 0x6c, 0x01, 0x0e, 0x0e, 0xca, 0x10, 0x78, 0x27, 0xad,
-	0xf1, 0x6c, 0x31, 0xf0, 0x18, 0x00, 0x2f, 0x4c, 0x96, 0xa9, 0xdc, 0xb3, 0x25, 0xd4, 0xcb, 0x10,
-	0xf8, 0x91, 0x4a, 0xa5, 0x67, 0xcb, 0x81, 0xc7, 0x00, 0xd4, 0xe1, 0x38, 0x97, 0x9e, 0xad, 0xb4,
-	0x6b, 0x9d, 0x85, 0x41, 0x08, 0x28, 0x25, 0x0b, 0x97, 0x66, 0xe8, 0x59, 0x1d, 0x51, 0xfc, 0x4f,
-	0x9f, 0x90, 0xba, 0x55, 0x56, 0xa6, 0x4a, 0x4b, 0xcf, 0x08, 0x26, 0x66, 0x02, 0x7d, 0x41, 0x9a,
-	0xdc, 0x89, 0x0b, 0x75, 0x25, 0xe3, 0xa8, 0x34, 0xb0, 0x8a, 0x50, 0xa3, 0xd4, 0xfb, 0x13, 0x23,
-	0x2f, 0x49, 0x4b, 0x70, 0x2d, 0x64, 0x9a, 0x56, 0xd8, 0x35, 0x64, 0x9b, 0xd3, 0x44, 0x09, 0xef,
-	0x91, 0x06, 0x17, 0xb9, 0xba, 0xe2, 0xb9, 0x32, 0x3a, 0x12, 0x26, 0x96, 0x6c, 0x1d, 0xf7, 0xb0,
-	0x31, 0x93, 0xfb, 0x26, 0x96, 0xf4, 0x19, 0x59, 0xcb, 0xf8, 0x75, 0x34, 0x74, 0x5c, 0x8b, 0x0b,
-	0xe9, 0xd9, 0x06, 0xce, 0xb3, 0x9a, 0xf1, 0xeb, 0xa3, 0x89, 0x44, 0x1f, 0x93, 0xba, 0xb5, 0x3e,
-	0xf2, 0xd6, 0x14, 0x39, 0x6b, 0x60, 0xc3, 0x15, 0x6b, 0xfd, 0x39, 0xc4, 0x74, 0x9f, 0xb4, 0xa6,
-	0xc9, 0xc8, 0x4b, 0x77, 0xa5, 0x84, 0x64, 0xcd, 0x30, 0x41, 0x09, 0x9d, 0x07, 0x99, 0x3e, 0x25,
-	0x44, 0x8c, 0x92, 0x48, 0x26, 0x4e, 0x7a, 0xcf, 0x68, 0xd8, 0x85, 0x18, 0x25, 0x6f, 0x50, 0xa0,
-	0x3b, 0x64, 0x15, 0xd2, 0x3e, 0xe7, 0x3a, 0x1e, 0x8e, 0xd9, 0x26, 0xe6, 0xe1, 0xc4, 0x79, 0x50,
-	0xe8, 0x73, 0xb2, 0x8e, 0x40, 0x2f, 0xe1, 0xb9, 0xfc, 0x9b, 0x8f, 0xd9, 0x16, 0x22, 0x6b, 0x80,
-	0x94, 0x1a, 0x0c, 0x84, 0x50, 0xe8, 0xe9, 0xd9, 0x0f, 0xc8, 0x40, 0xe5, 0x89, 0x0d, 0x3f, 0xf5,
-	0xe1, 0x9c, 0xc8, 0x62, 0xf6, 0x60, 0xe6, 0x03, 0x05, 0x98, 0x17, 0xd2, 0xf9, 0xe8, 0xd2, 0x0c,
-	0x19, 0x0b, 0xf3, 0x8a, 0x51, 0xf2, 0x09, 0x62, 0x30, 0xa9, 0xb4, 0x2d, 0xf2, 0x28, 0x71, 0xa6,
-	0xb0, 0xec, 0x61, 0x30, 0x89, 0xd2, 0x3b, 0x50, 0xa0, 0x78, 0x00, 0x2e, 0x8d, 0xd2, 0xec, 0x51,
-	0x28, 0x8e, 0xca, 0x7b, 0xa3, 0xf4, 0xec, 0xbc, 0x70, 0xc6, 0x7b, 0xf6, 0xb8, 0x72, 0xbe, 0x0f,
-	0xca, 0x0c, 0x28, 0x34, 0xdc, 0xde, 0x27, 0x15, 0xe0, 0x4f, 0x50, 0x66, 0x0d, 0x84, 0x33, 0x9a,
-	0x3d, 0xad, 0x34, 0xe8, 0x3b, 0xa3, 0xc1, 0xfd, 0xc4, 0xa0, 0xca, 0xd9, 0x76, 0x70, 0x1f, 0xec,
-	0xa9, 0x7c, 0x96, 0xb4, 0x23, 0xcf, 0x76, 0x2a, 0xc9, 0xb3, 0x91, 0x87, 0xcd, 0x4d, 0x0a, 0xe3,
-	0x25, 0x62, 0xed, 0xb0, 0xb9, 0x50, 0x1a, 0x25, 0xba, 0x4b, 0xd6, 0x67, 0xc3, 0x45, 0x46, 0xb3,
-	0x67, 0x15, 0x06, 0xe6, 0xfb, 0xa8, 0x69, 0x87, 0x34, 0x03, 0x63, 0x0a, 0x78, 0xc8, 0x71, 0x0d,
-	0xbb, 0x88, 0x6d, 0xa0, 0xfe, 0x11, 0x64, 0xdc, 0xc5, 0x74, 0x92, 0x94, 0x7f, 0x1d, 0xb3, 0xe7,
-	0x95, 0x49, 0x3e, 0xf0, 0xaf, 0x63, 0xb8, 0x5a, 0x21, 0x2d, 0x33, 0x9b, 0x8f, 0xa3, 0xf0, 0x14,
-	0xfe, 0x18, 0xae, 0x16, 0x26, 0xde, 0x80, 0xfe, 0x16, 0x9f, 0xc7, 0x87, 0x24, 0xcc, 0x11, 0xf9,
-	0x1e, 0xfb, 0x29, 0x3c, 0xc0, 0x18, 0x9f, 0xf7, 0xe0, 0xd6, 0x84, 0x54, 0xee, 0x54, 0x92, 0x48,
-	0xc7, 0x7e, 0x0e, 0xb7, 0x06, 0xc5, 0x4f, 0x41, 0x03, 0xd3, 0x4e, 0x7a, 0x53, 0x38, 0x21, 0x23,
-	0x61, 0x8b, 0xc8, 0xc9, 0x2f, 0x6c, 0xaf, 0x5d, 0xeb, 0xdc, 0x1b, 0x6c, 0x94, 0x7a, 0xdf, 0x16,
-	0x03, 0xf9, 0x85, 0x76, 0xc9, 0xd6, 0x6d, 0x32, 0xca, 0xf8, 0x35, 0xeb, 0x20, 0xdd, 0x9a, 0xa7,
-	0x4f, 0xf9, 0xf5, 0x5c, 0xe9, 0x4c, 0x66, 0x58, 0xfa, 0x45, 0x78, 0x16, 0x4b, 0xfd, 0x54, 0x66,
-	0x50, 0xba, 0x4a, 0x26, 0x13, 0x13, 0xfb, 0x61, 0x73, 0xa5, 0xfe, 0xee, 0xae, 0x89, 0xa4, 0x62,
-	0xe2, 0x25, 0xd2, 0xad, 0x79, 0x1a, 0x4c, 0xec, 0x93, 0xa9, 0x18, 0xc5, 0xca, 0x7f, 0xc6, 0xda,
-	0x07, 0xe8, 0xa2, 0x51, 0x26, 0x8e, 0x95, 0xff, 0x0c, 0xc5, 0x0f, 0x08, 0x9d, 0x9b, 0x30, 0x55,
-	0x70, 0x1b, 0x7e, 0xc1, 0xf9, 0x9a, 0x95, 0xf9, 0x3e, 0x80, 0x4e, 0x7b, 0xe4, 0xc1, 0x5d, 0x1a,
-	0xcd, 0x1c, 0xe2, 0x89, 0xcd, 0xdb, 0x27, 0xc0, 0x4e, 0xb5, 0x05, 0xec, 0x24, 0xb4, 0xe8, 0xa2,
-	0x9f, 0x66, 0x65, 0x2b, 0xa1, 0x45, 0x95, 0x4e, 0xa6, 0x86, 0x7e, 0x0d, 0xaf, 0xbe, 0xca, 0xac,
-	0x77, 0x0d, 0x25, 0x73, 0x86, 0x7e, 0xc3, 0x13, 0x9b, 0xb7, 0x4f, 0x80, 0xa1, 0x43, 0xb2, 0x39,
-	0xbf, 0x9f, 0xd0, 0xe3, 0x15, 0x3a, 0x6a, 0x55, 0x37, 0x14, 0x9a, 0xec, 0x91, 0x06, 0xbc, 0x36,
-	0x2d, 0x77, 0x3c, 0x4d, 0x65, 0xaa, 0x7c, 0xc6, 0x7a, 0xf8, 0xe6, 0xdc, 0xc8, 0xf8, 0xf5, 0xd9,
-	0x4c, 0x45, 0x50, 0xe9, 0x39, 0xf0, 0xf7, 0x09, 0xa8, 0xf4, 0x2d, 0x50, 0x17, 0xd9, 0x1c, 0xf8,
-	0x47, 0x00, 0x75, 0x91, 0x55, 0xc1, 0x2e, 0xd9, 0x94, 0xf0, 0x35, 0xb6, 0x4e, 0x79, 0x19, 0x8d,
-	0xb8, 0x4a, 0x0b, 0x27, 0x3d, 0x7b, 0x8d, 0xc3, 0xd1, 0x59, 0xea, 0xed, 0x24, 0xf3, 0x7e, 0x61,
-	0xa5, 0xd5, 0xa4, 0x47, 0xc7, 0xff, 0xde, 0x6c, 0xd7, 0xfe, 0xbb, 0xd9, 0xae, 0xfd, 0x7f, 0xb3,
-	0x5d, 0xfb, 0xeb, 0x75, 0xa2, 0xf2, 0x8b, 0x62, 0x78, 0x28, 0x4c, 0xd6, 0xb5, 0x5c, 0x5c, 0x8c,
-	0x63, 0xe9, 0xaa, 0xff, 0xae, 0x5e, 0x75, 0xbd, 0x13, 0xdd, 0xdb, 0x9f, 0xfd, 0xe1, 0x12, 0x7e,
-	0xd0, 0x7b, 0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x76, 0x04, 0x0b, 0x11, 0x08, 0x00, 0x00,
+	// 986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x96, 0xdd, 0x52, 0x1b, 0x37,
+	0x14, 0xc7, 0xc7, 0x09, 0x04, 0x2c, 0x3e, 0x6c, 0x0b, 0x9a, 0x51, 0xbe, 0xc0, 0x21, 0x6d, 0x71,
+	0x08, 0xc5, 0x6d, 0xdc, 0xa6, 0xf7, 0x98, 0x24, 0x43, 0x26, 0x4c, 0x18, 0x93, 0xde, 0xf4, 0x66,
+	0x67, 0xad, 0x95, 0x17, 0x91, 0x5d, 0x49, 0x48, 0xbb, 0x14, 0xe7, 0x61, 0xfa, 0x3c, 0xbd, 0xec,
+	0x13, 0x64, 0x3a, 0x3c, 0x49, 0xe7, 0x1c, 0xed, 0x7a, 0xd7, 0x70, 0xe5, 0x3d, 0xff, 0xf3, 0xd3,
+	0xd1, 0xff, 0x9c, 0x91, 0x76, 0x4d, 0xb6, 0xa4, 0xca, 0x84, 0x55, 0x61, 0xd2, 0x4f, 0x45, 0x66,
+	0x25, 0x77, 0xe5, 0xef, 0x81, 0xb1, 0x3a, 0xd3, 0x74, 0xa9, 0x08, 0x1f, 0x6f, 0xc6, 0x3a, 0xd6,
+	0xa8, 0xf5, 0xe1, 0xc9, 0xa7, 0x77, 0xfe, 0x6e, 0x91, 0xa5, 0x13, 0x4f, 0xd0, 0x7d, 0x42, 0x78,
+	0x92, 0xbb, 0x4c, 0xd8, 0x40, 0x46, 0xac, 0xd1, 0x6d, 0xf4, 0x9a, 0x87, 0x6b, 0x37, 0xdf, 0xb6,
+	0x9b, 0x43, 0xaf, 0x1e, 0x1f, 0x8d, 0x9a, 0x05, 0x70, 0x1c, 0xd1, 0x2e, 0x79, 0x60, 0x74, 0x04,
+	0xe4, 0x3d, 0x24, 0x9b, 0x37, 0xdf, 0xb6, 0x17, 0x4f, 0x75, 0x74, 0x7c, 0x34, 0x5a, 0x34, 0x3a,
+	0x3a, 0x8e, 0xe8, 0x26, 0x59, 0x54, 0x3a, 0x12, 0x8e, 0xdd, 0xef, 0x36, 0x7a, 0xf7, 0x47, 0x3e,
+	0xa0, 0x8c, 0x2c, 0x5d, 0x09, 0xeb, 0xa4, 0x56, 0x6c, 0x01, 0x16, 0x8e, 0xca, 0x10, 0x78, 0x2b,
+	0x8c, 0x76, 0x6c, 0xd1, 0xf3, 0x18, 0x00, 0xcf, 0x75, 0x9a, 0xca, 0xcc, 0xb1, 0x07, 0xa8, 0x97,
+	0x21, 0xf0, 0x13, 0x99, 0x08, 0xc7, 0x96, 0x3c, 0x8f, 0x01, 0xa8, 0xe3, 0x69, 0x26, 0x1c, 0x5b,
+	0xee, 0x36, 0x7a, 0x0b, 0x23, 0x1f, 0x50, 0x4a, 0x16, 0x2e, 0xf4, 0xd8, 0xb1, 0x26, 0xa2, 0xf8,
+	0x4c, 0x9f, 0x92, 0xa6, 0x91, 0x46, 0x24, 0x52, 0x09, 0xc7, 0x08, 0x26, 0x2a, 0x81, 0xbe, 0x24,
+	0xed, 0xd0, 0xf2, 0x73, 0x79, 0x25, 0xa2, 0xa0, 0x34, 0xb0, 0x82, 0x50, 0xab, 0xd4, 0x87, 0x85,
+	0x91, 0x57, 0xa4, 0xc3, 0x43, 0xc5, 0x45, 0x92, 0xd4, 0xd8, 0x55, 0x64, 0xdb, 0xb3, 0x44, 0x09,
+	0xef, 0x92, 0x56, 0xc8, 0x33, 0x79, 0x15, 0x66, 0x52, 0xab, 0x80, 0xeb, 0x48, 0xb0, 0x35, 0x9c,
+	0xc3, 0x7a, 0x25, 0x0f, 0x75, 0x24, 0xe8, 0x73, 0xb2, 0x9a, 0x86, 0xd7, 0xc1, 0xd8, 0x86, 0x8a,
+	0x9f, 0x0b, 0xc7, 0xd6, 0xb1, 0x9f, 0x95, 0x34, 0xbc, 0x3e, 0x2c, 0x24, 0xfa, 0x84, 0x34, 0x8d,
+	0x71, 0x81, 0x33, 0x3a, 0xcf, 0x58, 0x0b, 0x37, 0x5c, 0x36, 0xc6, 0x9d, 0x41, 0x4c, 0xf7, 0x48,
+	0x67, 0x96, 0x0c, 0x9c, 0xb0, 0x57, 0x92, 0x0b, 0xd6, 0xf6, 0x1d, 0x94, 0xd0, 0x99, 0x97, 0xe9,
+	0x33, 0x42, 0xf8, 0x24, 0x0e, 0x44, 0x6c, 0x85, 0x73, 0x8c, 0xfa, 0x59, 0xf0, 0x49, 0xfc, 0x16,
+	0x05, 0xba, 0x4d, 0x56, 0x20, 0xed, 0xb2, 0x50, 0x45, 0xe3, 0x29, 0xdb, 0xc0, 0x3c, 0xac, 0x38,
+	0xf3, 0x0a, 0x7d, 0x41, 0xd6, 0x10, 0x18, 0xc4, 0x61, 0x26, 0xfe, 0x0a, 0xa7, 0x6c, 0x13, 0x91,
+	0x55, 0x40, 0x4a, 0x0d, 0x1a, 0x42, 0xc8, 0xef, 0xe9, 0xd8, 0x77, 0xc8, 0x40, 0xe5, 0xc2, 0x86,
+	0x9b, 0xf9, 0xb0, 0x96, 0xa7, 0x11, 0x7b, 0x58, 0xf9, 0x40, 0x01, 0xfa, 0x85, 0x74, 0x36, 0xb9,
+	0xd0, 0x63, 0xc6, 0x7c, 0xbf, 0x7c, 0x12, 0x7f, 0x86, 0x18, 0x4c, 0x4a, 0x65, 0xf2, 0x2c, 0x88,
+	0xad, 0xce, 0x0d, 0x7b, 0xe4, 0x4d, 0xa2, 0xf4, 0x1e, 0x14, 0x28, 0xee, 0x81, 0x0b, 0x2d, 0x15,
+	0x7b, 0xec, 0x8b, 0xa3, 0xf2, 0x41, 0x4b, 0x55, 0xad, 0xe7, 0x56, 0x3b, 0xc7, 0x9e, 0xd4, 0xd6,
+	0x0f, 0x41, 0xa9, 0x80, 0x5c, 0xc1, 0xe9, 0x7d, 0x5a, 0x03, 0xfe, 0x00, 0xa5, 0xda, 0x80, 0x5b,
+	0xad, 0xd8, 0xb3, 0xda, 0x06, 0x43, 0xab, 0x15, 0xb8, 0x2f, 0x0c, 0xca, 0x8c, 0x6d, 0x79, 0xf7,
+	0xde, 0x9e, 0xcc, 0xaa, 0xa4, 0x99, 0x38, 0xb6, 0x5d, 0x4b, 0x9e, 0x4e, 0x1c, 0x4c, 0xae, 0x28,
+	0x8c, 0x87, 0x88, 0x75, 0xfd, 0xe4, 0x7c, 0x69, 0x94, 0xe8, 0x0e, 0x59, 0xab, 0x9a, 0x0b, 0xb4,
+	0x62, 0xcf, 0x6b, 0x0c, 0xf4, 0xf7, 0x49, 0xd1, 0x1e, 0x69, 0x7b, 0x46, 0xe7, 0x70, 0xc9, 0x71,
+	0x0c, 0x3b, 0x88, 0xad, 0xa3, 0xfe, 0x09, 0x64, 0x9c, 0xc5, 0xac, 0x93, 0x24, 0xfc, 0x3a, 0x65,
+	0x2f, 0x6a, 0x9d, 0x7c, 0x0c, 0xbf, 0x4e, 0xe1, 0x68, 0xf9, 0xb4, 0x48, 0x4d, 0x36, 0x0d, 0xfc,
+	0x2d, 0xfc, 0xde, 0x1f, 0x2d, 0x4c, 0xbc, 0x05, 0xfd, 0x1d, 0xc8, 0xf4, 0x11, 0xf1, 0x7d, 0x04,
+	0x6e, 0xc0, 0x7e, 0xf0, 0x17, 0x18, 0xe3, 0xb3, 0x01, 0x9c, 0x1a, 0x9f, 0xca, 0xac, 0x8c, 0x63,
+	0x61, 0xd9, 0x8f, 0xfe, 0xd4, 0xa0, 0xf8, 0xd9, 0x6b, 0x60, 0xda, 0x0a, 0xa7, 0x73, 0xcb, 0x45,
+	0xc0, 0x4d, 0x1e, 0x58, 0x71, 0xc9, 0x76, 0xbb, 0x8d, 0xde, 0xbd, 0xd1, 0x7a, 0xa9, 0x0f, 0x4d,
+	0x3e, 0x12, 0x97, 0xb4, 0x4f, 0x36, 0x6f, 0x93, 0x41, 0x1a, 0x5e, 0xb3, 0x1e, 0xd2, 0x9d, 0x79,
+	0xfa, 0x24, 0xbc, 0x9e, 0x2b, 0x9d, 0x8a, 0x14, 0x4b, 0xbf, 0xf4, 0x77, 0xb1, 0xd4, 0x4f, 0x44,
+	0x0a, 0xa5, 0xeb, 0x64, 0x5c, 0x98, 0xd8, 0xf3, 0x93, 0x2b, 0xf5, 0xf7, 0x77, 0x4d, 0xc4, 0x35,
+	0x13, 0xaf, 0x90, 0xee, 0xcc, 0xd3, 0x60, 0x62, 0x8f, 0xcc, 0xc4, 0x20, 0x92, 0xee, 0x0b, 0xd6,
+	0xde, 0x47, 0x17, 0xad, 0x32, 0x71, 0x24, 0xdd, 0x17, 0x28, 0xbe, 0x4f, 0xe8, 0x5c, 0x87, 0x89,
+	0x84, 0xd3, 0xf0, 0x13, 0xf6, 0xd7, 0xae, 0xf5, 0xf7, 0x11, 0x74, 0x3a, 0x20, 0x0f, 0xef, 0xd2,
+	0x68, 0xe6, 0x00, 0x57, 0x6c, 0xdc, 0x5e, 0x01, 0x76, 0xea, 0x5b, 0xc0, 0x4c, 0xfc, 0x16, 0x7d,
+	0xf4, 0xd3, 0xae, 0x4d, 0xc5, 0x6f, 0x51, 0xa7, 0xe3, 0x99, 0xa1, 0x9f, 0xfd, 0xab, 0xaf, 0xd6,
+	0xeb, 0x5d, 0x43, 0xf1, 0x9c, 0xa1, 0x5f, 0x70, 0xc5, 0xc6, 0xed, 0x15, 0x60, 0xe8, 0x80, 0x6c,
+	0xcc, 0xcf, 0xc7, 0xef, 0xf1, 0x1a, 0x1d, 0x75, 0xea, 0x13, 0xf2, 0x9b, 0xec, 0x92, 0x16, 0xbc,
+	0x36, 0x4d, 0x68, 0xc3, 0x24, 0x11, 0x89, 0x74, 0x29, 0x1b, 0xe0, 0x9b, 0x73, 0x3d, 0x0d, 0xaf,
+	0x4f, 0x2b, 0x15, 0x41, 0xa9, 0xe6, 0xc0, 0x5f, 0x0b, 0x50, 0xaa, 0x5b, 0xa0, 0xca, 0xd3, 0x39,
+	0xf0, 0x37, 0x0f, 0xaa, 0x3c, 0xad, 0x83, 0x7d, 0xb2, 0x21, 0xe0, 0x6b, 0x6c, 0xac, 0x74, 0x22,
+	0x98, 0x84, 0x32, 0xc9, 0xad, 0x70, 0xec, 0x0d, 0x36, 0x47, 0xab, 0xd4, 0xbb, 0x22, 0x53, 0x5d,
+	0x7a, 0x77, 0x99, 0xb0, 0xdf, 0x6b, 0x97, 0xfe, 0xec, 0x32, 0xf9, 0xb0, 0xb0, 0xdc, 0x69, 0xd3,
+	0xc3, 0xa3, 0x7f, 0x6e, 0xb6, 0x1a, 0xff, 0xde, 0x6c, 0x35, 0xfe, 0xbb, 0xd9, 0x6a, 0xfc, 0xf9,
+	0x26, 0x96, 0xd9, 0x79, 0x3e, 0x3e, 0xe0, 0x3a, 0xed, 0x9b, 0x90, 0x9f, 0x4f, 0x23, 0x61, 0xeb,
+	0x4f, 0x57, 0xaf, 0xfb, 0xce, 0xf2, 0xfe, 0xed, 0xff, 0x04, 0xe3, 0x07, 0xf8, 0xb5, 0x1f, 0xfc,
+	0x3f, 0x00, 0x0c, 0xb1, 0x66, 0xaa, 0x2e, 0x08, 0x00, 0x00,
 }
 
 func (m *Metrics) Marshal() (dAtA []byte, err error) {
@@ -574,6 +583,13 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.InputSql != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.InputSql))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
 	if m.EnterpriseFailures != 0 {
 		i = encodeVarintMetrics(dAtA, i, uint64(m.EnterpriseFailures))
 		i--
@@ -1112,6 +1128,9 @@ func (m *Metrics) Size() (n int) {
 	if m.EnterpriseFailures != 0 {
 		n += 2 + sovMetrics(uint64(m.EnterpriseFailures))
 	}
+	if m.InputSql != 0 {
+		n += 2 + sovMetrics(uint64(m.InputSql))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2213,6 +2232,25 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputSql", wireType)
+			}
+			m.InputSql = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputSql |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -51,6 +51,7 @@ func reportClusterMetricsToSegment(client *analytics.Client, metrics *Metrics) {
 			"InputCross":          metrics.InputCross,
 			"InputUnion":          metrics.InputUnion,
 			"InputCron":           metrics.InputCron,
+			"InputSql":            metrics.InputSql,
 			"InputGit":            metrics.InputGroup,
 			"InputPfs":            metrics.InputPfs,
 			"InputCommit":         metrics.InputCommit,
@@ -25,6 +25,9 @@ var authHandlers = map[string]authHandler{
 	// Allow InspectCluster to succeed before a user logs in
 	"/admin_v2.API/InspectCluster": unauthenticated,
 
+	// InspectStorage reports on every repo, so it's limited to cluster operators
+	"/admin_v2.API/InspectStorage": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DEBUG_DUMP)),
+
 	//
 	// Auth API
 	//
@@ -124,32 +127,49 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs_v2.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs_v2.API/CreateRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectRepo":      authDisabledOr(authenticated),
-	"/pfs_v2.API/ListRepo":         authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteRepo":       authDisabledOr(authenticated),
-	"/pfs_v2.API/StartCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/FinishCommit":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommit":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommit":       authDisabledOr(authenticated),
-	"/pfs_v2.API/SubscribeCommit":  authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommitSet": authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/SquashCommitSet":  authDisabledOr(authenticated),
-	"/pfs_v2.API/DropCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectBranch":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ListBranch":       authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteBranch":     authDisabledOr(authenticated),
-	"/pfs_v2.API/ModifyFile":       authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFile":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ActivateAuth":       clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs_v2.API/CreateRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectRepo":        authDisabledOr(authenticated),
+	"/pfs_v2.API/ListRepo":           authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/StartCommit":        authDisabledOr(authenticated),
+	"/pfs_v2.API/FinishCommit":       authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommit":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommit":         authDisabledOr(authenticated),
+	"/pfs_v2.API/SubscribeCommit":    authDisabledOr(authenticated),
+	"/pfs_v2.API/RevertCommit":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCommit":        authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommitSet":   authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommitSet":      authDisabledOr(authenticated),
+	"/pfs_v2.API/SquashCommitSet":    authDisabledOr(authenticated),
+	"/pfs_v2.API/DropCommitSet":      authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateBranch":       authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectBranch":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ListBranch":         authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteBranch":       authDisabledOr(authenticated),
+	"/pfs_v2.API/MergeBranch":        authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateTag":          authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectTag":         authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTag":            authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteTag":          authDisabledOr(authenticated),
+	"/pfs_v2.API/SetRetentionPolicy": authDisabledOr(authenticated),
+	"/pfs_v2.API/EnforceRetention":   authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateReplication":  authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectReplication": authDisabledOr(authenticated),
+	"/pfs_v2.API/ListReplication":    authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteReplication":  authDisabledOr(authenticated),
+	"/pfs_v2.API/FindMissingChunks":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MODIFY_BINDINGS)),
+	"/pfs_v2.API/ReplicateCommit":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_MODIFY_BINDINGS)),
+	"/pfs_v2.API/ExportRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/ImportRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/ModifyFile":         authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFile":            authDisabledOr(authenticated),
 	// TODO: GetFileTAR is unauthenticated for performance reasons. Normal authentication
 	// will be applied internally when a commit is used. When a file set id is used, we lean
 	// on the capability based authentication of file sets.
 	"/pfs_v2.API/GetFileTAR":         unauthenticated,
 	"/pfs_v2.API/InspectFile":        authDisabledOr(authenticated),
+	"/pfs_v2.API/PresignFile":        authDisabledOr(authenticated),
 	"/pfs_v2.API/ListFile":           authDisabledOr(authenticated),
 	"/pfs_v2.API/WalkFile":           authDisabledOr(authenticated),
 	"/pfs_v2.API/GlobFile":           authDisabledOr(authenticated),
@@ -189,6 +209,7 @@ var authHandlers = map[string]authHandler{
 	"/pps_v2.API/ListDatumStream": authDisabledOr(authenticated),
 	"/pps_v2.API/RestartDatum":    authDisabledOr(authenticated),
 	"/pps_v2.API/CreatePipeline":  authDisabledOr(authenticated),
+	"/pps_v2.API/DryRunPipeline":  authDisabledOr(authenticated),
 	"/pps_v2.API/InspectPipeline": authDisabledOr(authenticated),
 	"/pps_v2.API/DeletePipeline":  authDisabledOr(authenticated),
 	"/pps_v2.API/StartPipeline":   authDisabledOr(authenticated),
@@ -0,0 +1,93 @@
+package obj
+
+import (
+	"context"
+	"io"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+)
+
+var _ Client = &TieredClient{}
+
+// TieredClient is a Client which writes new objects to a fast (hot) tier, and
+// moves objects to a cheaper (cold) tier with Demote.
+// Objects are read from the hot tier if they are there, and from the cold tier
+// otherwise, so moving an object between tiers is transparent to readers.
+type TieredClient struct {
+	hot, cold Client
+}
+
+// NewTieredClient returns a Client composed of a hot and a cold tier.
+func NewTieredClient(hot, cold Client) *TieredClient {
+	return &TieredClient{
+		hot:  hot,
+		cold: cold,
+	}
+}
+
+func (c *TieredClient) Put(ctx context.Context, name string, r io.Reader) error {
+	return errors.EnsureStack(c.hot.Put(ctx, name, r))
+}
+
+func (c *TieredClient) Get(ctx context.Context, name string, w io.Writer) error {
+	if err := c.hot.Get(ctx, name, w); !pacherr.IsNotExist(err) {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(c.cold.Get(ctx, name, w))
+}
+
+// Delete deletes the object from both tiers. It only errors with a not exist
+// error if the object is in neither tier.
+func (c *TieredClient) Delete(ctx context.Context, name string) error {
+	hotErr := c.hot.Delete(ctx, name)
+	if hotErr != nil && !pacherr.IsNotExist(hotErr) {
+		return errors.EnsureStack(hotErr)
+	}
+	coldErr := c.cold.Delete(ctx, name)
+	if coldErr != nil && (!pacherr.IsNotExist(coldErr) || hotErr != nil) {
+		return errors.EnsureStack(coldErr)
+	}
+	return nil
+}
+
+// Walk walks the objects in the hot tier, then the objects in the cold tier
+// which are not in the hot tier. The names of the objects in the hot tier are
+// held in memory, which is expected to be the smaller tier.
+func (c *TieredClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	hotNames := make(map[string]struct{})
+	if err := c.hot.Walk(ctx, prefix, func(name string) error {
+		hotNames[name] = struct{}{}
+		return fn(name)
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(c.cold.Walk(ctx, prefix, func(name string) error {
+		if _, ok := hotNames[name]; ok {
+			return nil
+		}
+		return fn(name)
+	}))
+}
+
+func (c *TieredClient) Exists(ctx context.Context, name string) (bool, error) {
+	exists, err := c.hot.Exists(ctx, name)
+	if err != nil || exists {
+		return exists, errors.EnsureStack(err)
+	}
+	exists, err = c.cold.Exists(ctx, name)
+	return exists, errors.EnsureStack(err)
+}
+
+func (c *TieredClient) BucketURL() ObjectStoreURL {
+	return c.hot.BucketURL()
+}
+
+// Demote moves an object from the hot tier to the cold tier.
+// The object can be read throughout the move.
+func (c *TieredClient) Demote(ctx context.Context, name string) error {
+	if err := Copy(ctx, c.hot, c.cold, name, name); err != nil {
+		return err
+	}
+	return errors.EnsureStack(c.hot.Delete(ctx, name))
+}
@@ -0,0 +1,45 @@
+package obj
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestTieredClient(t *testing.T) {
+	t.Parallel()
+	TestSuite(t, func(t testing.TB) Client {
+		hot := newTestLocalClient(t)
+		cold := newTestLocalClient(t)
+		return NewTieredClient(hot, cold)
+	})
+}
+
+func TestTieredClientDemote(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	hot, cold := newTestLocalClient(t), newTestLocalClient(t)
+	c := NewTieredClient(hot, cold)
+	require.NoError(t, c.Put(ctx, "a", bytes.NewReader([]byte("a"))))
+	requireExists(t, hot, "a", true)
+	requireExists(t, cold, "a", false)
+
+	require.NoError(t, c.Demote(ctx, "a"))
+	requireExists(t, hot, "a", false)
+	requireExists(t, cold, "a", true)
+	// reads fall through to the cold tier
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Get(ctx, "a", buf))
+	require.Equal(t, "a", buf.String())
+	var names []string
+	require.NoError(t, c.Walk(ctx, "", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, []string{"a"}, names)
+
+	require.NoError(t, c.Delete(ctx, "a"))
+	requireExists(t, c, "a", false)
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"os"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 )
 
 type ErrNotExist struct {
@@ -60,6 +62,101 @@ func IsExists(err error) bool {
 	return errors.As(err, &target)
 }
 
+// ErrConflict is returned when a write was conditioned on the current state of
+// an item, but the item has been modified since.
+type ErrConflict struct {
+	Collection string
+	ID         string
+	Expected   string
+	Actual     string
+}
+
+func NewConflict(collection, id, expected, actual string) error {
+	return ErrConflict{
+		Collection: collection,
+		ID:         id,
+		Expected:   expected,
+		Actual:     actual,
+	}
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("%s item (%s) was modified concurrently: expected %q, but found %q", e.Collection, e.ID, e.Expected, e.Actual)
+}
+
+// conflictReason is the reason of the ErrorInfo detail which marks the status
+// of an ErrConflict.
+const conflictReason = "PACHYDERM_CONFLICT"
+
+func (e ErrConflict) GRPCStatus() *status.Status {
+	s := status.New(codes.Aborted, e.Error())
+	detailed, err := s.WithDetails(&errdetails.ErrorInfo{
+		Reason: conflictReason,
+		Metadata: map[string]string{
+			"collection": e.Collection,
+			"id":         e.ID,
+			"expected":   e.Expected,
+			"actual":     e.Actual,
+		},
+	})
+	if err != nil {
+		return s
+	}
+	return detailed
+}
+
+// IsConflict returns true if err is an ErrConflict, including one which has
+// been sent over gRPC. RPCs send the status of an ErrConflict only if it's
+// returned by UnwrapConflict, and clients keep it only if it's scrubbed with
+// ScrubGRPC.
+func IsConflict(err error) bool {
+	_, ok := asConflict(err)
+	return ok
+}
+
+// UnwrapConflict returns the ErrConflict that err wraps or whose status it is,
+// if there is one, and otherwise err.
+func UnwrapConflict(err error) error {
+	if conflict, ok := asConflict(err); ok {
+		return conflict
+	}
+	return err
+}
+
+// ScrubGRPC is like grpcutil.ScrubGRPC, but returns conflicts as ErrConflicts,
+// so they're still matched by IsConflict.
+func ScrubGRPC(err error) error {
+	if conflict, ok := asConflict(err); ok {
+		return conflict
+	}
+	return grpcutil.ScrubGRPC(err)
+}
+
+func asConflict(err error) (ErrConflict, bool) {
+	if err == nil {
+		return ErrConflict{}, false
+	}
+	target := ErrConflict{}
+	if errors.As(err, &target) {
+		return target, true
+	}
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.Aborted {
+		return ErrConflict{}, false
+	}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == conflictReason {
+			return ErrConflict{
+				Collection: info.Metadata["collection"],
+				ID:         info.Metadata["id"],
+				Expected:   info.Metadata["expected"],
+				Actual:     info.Metadata["actual"],
+			}, true
+		}
+	}
+	return ErrConflict{}, false
+}
+
 var (
 	// ErrBreak is an error used to break out of call back based iteration,
 	// should be swallowed by iteration functions and treated as successful
@@ -3,6 +3,11 @@ package pacherr
 import (
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 )
 
@@ -15,3 +20,20 @@ func TestIsExist(t *testing.T) {
 	err := NewExists("collection", "id")
 	require.True(t, IsExists(err))
 }
+
+func TestIsConflict(t *testing.T) {
+	err := NewConflict("collection", "id", "a", "b")
+	require.True(t, IsConflict(err))
+	require.True(t, IsConflict(errors.Wrap(err, "wrapped")))
+	// The status of a conflict has a detail which identifies it, so it
+	// survives being sent over gRPC, but not being scrubbed.
+	sent := status.Convert(UnwrapConflict(errors.EnsureStack(err))).Err()
+	require.True(t, IsConflict(sent))
+	require.True(t, IsConflict(ScrubGRPC(sent)))
+	require.Equal(t, err, ScrubGRPC(sent))
+	require.False(t, IsConflict(grpcutil.ScrubGRPC(sent)))
+	// Other aborted errors aren't conflicts, even if their message looks like one.
+	require.False(t, IsConflict(status.Error(codes.Aborted, err.Error())))
+	require.False(t, IsConflict(errors.New(err.Error())))
+	require.False(t, IsConflict(NewExists("collection", "id")))
+}
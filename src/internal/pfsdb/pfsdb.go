@@ -15,9 +15,11 @@ import (
 )
 
 const (
-	reposCollectionName    = "repos"
-	branchesCollectionName = "branches"
-	commitsCollectionName  = "commits"
+	reposCollectionName        = "repos"
+	branchesCollectionName     = "branches"
+	commitsCollectionName      = "commits"
+	tagsCollectionName         = "tags"
+	replicationsCollectionName = "replications"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -175,6 +177,86 @@ func Branches(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollect
 	)
 }
 
+var TagsRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.TagInfo).Tag.Repo)
+	},
+}
+
+var TagsCommitIndex = &col.Index{
+	Name: "commit",
+	Extract: func(val proto.Message) string {
+		return CommitKey(val.(*pfs.TagInfo).Commit)
+	},
+}
+
+var tagsIndexes = []*col.Index{TagsRepoIndex, TagsCommitIndex}
+
+func TagKey(tag *pfs.Tag) string {
+	return RepoKey(tag.Repo) + "@" + tag.Name
+}
+
+// Tags returns a collection of tags
+func Tags(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		tagsCollectionName,
+		db,
+		listener,
+		&pfs.TagInfo{},
+		tagsIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if tag, ok := key.(*pfs.Tag); !ok {
+				return "", errors.New("key must be a tag")
+			} else {
+				return TagKey(tag), nil
+			}
+		}),
+		col.WithKeyCheck(func(key string) error {
+			keyParts := strings.Split(key, "@")
+			if len(keyParts) != 2 {
+				return errors.Errorf("tag key %s isn't valid, use TagKey to generate it", key)
+			}
+			if uuid.IsUUIDWithoutDashes(keyParts[1]) {
+				return errors.Errorf("tag name cannot be a UUID V4")
+			}
+			return repoKeyCheck(keyParts[0])
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrTagNotFound{Tag: key.(*pfs.Tag)}.Error()
+		}),
+		col.WithExistsMessage(func(key interface{}) string {
+			return pfsserver.ErrTagExists{Tag: key.(*pfs.Tag)}.Error()
+		}),
+	)
+}
+
+// Replications returns a collection of replications, keyed by the repo
+// which is replicated.
+func Replications(db *pachsql.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		replicationsCollectionName,
+		db,
+		listener,
+		&pfs.ReplicationInfo{},
+		nil,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if repo, ok := key.(*pfs.Repo); !ok {
+				return "", errors.New("key must be a repo")
+			} else {
+				return RepoKey(repo), nil
+			}
+		}),
+		col.WithKeyCheck(repoKeyCheck),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrReplicationNotFound{Repo: key.(*pfs.Repo)}.Error()
+		}),
+		col.WithExistsMessage(func(key interface{}) string {
+			return pfsserver.ErrReplicationExists{Repo: key.(*pfs.Repo)}.Error()
+		}),
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -187,3 +269,23 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(branchesCollectionName, nil, nil, nil, branchesIndexes),
 	}
 }
+
+// TagsCollectionsV0 returns the tags collection for postgres-initialization
+// purposes. It is not usable for querying.
+// DO NOT MODIFY THIS FUNCTION
+// IT IS USED IN A MIGRATION
+func TagsCollectionsV0() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(tagsCollectionName, nil, nil, nil, tagsIndexes),
+	}
+}
+
+// ReplicationsCollectionsV0 returns the replications collection for
+// postgres-initialization purposes. It is not usable for querying.
+// DO NOT MODIFY THIS FUNCTION
+// IT IS USED IN A MIGRATION
+func ReplicationsCollectionsV0() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(replicationsCollectionName, nil, nil, nil, nil),
+	}
+}
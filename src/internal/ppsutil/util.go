@@ -14,6 +14,7 @@ package ppsutil
 import (
 	"bytes"
 	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -258,6 +260,9 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
 		}
+		if input.SQL != nil {
+			input.SQL.Commit = commitsetID
+		}
 		return nil
 	})
 	return jobInput
@@ -266,30 +271,33 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 // PipelineReqFromInfo converts a PipelineInfo into a CreatePipelineRequest.
 func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequest {
 	return &pps.CreatePipelineRequest{
-		Pipeline:              pipelineInfo.Pipeline,
-		Transform:             pipelineInfo.Details.Transform,
-		ParallelismSpec:       pipelineInfo.Details.ParallelismSpec,
-		Egress:                pipelineInfo.Details.Egress,
-		OutputBranch:          pipelineInfo.Details.OutputBranch,
-		ResourceRequests:      pipelineInfo.Details.ResourceRequests,
-		ResourceLimits:        pipelineInfo.Details.ResourceLimits,
-		SidecarResourceLimits: pipelineInfo.Details.SidecarResourceLimits,
-		Input:                 pipelineInfo.Details.Input,
-		Description:           pipelineInfo.Details.Description,
-		Service:               pipelineInfo.Details.Service,
-		DatumSetSpec:          pipelineInfo.Details.DatumSetSpec,
-		DatumTimeout:          pipelineInfo.Details.DatumTimeout,
-		JobTimeout:            pipelineInfo.Details.JobTimeout,
-		Salt:                  pipelineInfo.Details.Salt,
-		PodSpec:               pipelineInfo.Details.PodSpec,
-		PodPatch:              pipelineInfo.Details.PodPatch,
-		Spout:                 pipelineInfo.Details.Spout,
-		SchedulingSpec:        pipelineInfo.Details.SchedulingSpec,
-		DatumTries:            pipelineInfo.Details.DatumTries,
-		S3Out:                 pipelineInfo.Details.S3Out,
-		Metadata:              pipelineInfo.Details.Metadata,
-		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
-		Autoscaling:           pipelineInfo.Details.Autoscaling,
+		Pipeline:                pipelineInfo.Pipeline,
+		Transform:               pipelineInfo.Details.Transform,
+		ParallelismSpec:         pipelineInfo.Details.ParallelismSpec,
+		Egress:                  pipelineInfo.Details.Egress,
+		OutputBranch:            pipelineInfo.Details.OutputBranch,
+		ResourceRequests:        pipelineInfo.Details.ResourceRequests,
+		ResourceLimits:          pipelineInfo.Details.ResourceLimits,
+		SidecarResourceLimits:   pipelineInfo.Details.SidecarResourceLimits,
+		Input:                   pipelineInfo.Details.Input,
+		Description:             pipelineInfo.Details.Description,
+		Service:                 pipelineInfo.Details.Service,
+		DatumSetSpec:            pipelineInfo.Details.DatumSetSpec,
+		DatumTimeout:            pipelineInfo.Details.DatumTimeout,
+		JobTimeout:              pipelineInfo.Details.JobTimeout,
+		Salt:                    pipelineInfo.Details.Salt,
+		PodSpec:                 pipelineInfo.Details.PodSpec,
+		PodPatch:                pipelineInfo.Details.PodPatch,
+		Spout:                   pipelineInfo.Details.Spout,
+		SchedulingSpec:          pipelineInfo.Details.SchedulingSpec,
+		DatumTries:              pipelineInfo.Details.DatumTries,
+		S3Out:                   pipelineInfo.Details.S3Out,
+		Metadata:                pipelineInfo.Details.Metadata,
+		ReprocessSpec:           pipelineInfo.Details.ReprocessSpec,
+		Autoscaling:             pipelineInfo.Details.Autoscaling,
+		DatumCache:              pipelineInfo.Details.DatumCache,
+		TaskSchedulingSpec:      pipelineInfo.Details.TaskSchedulingSpec,
+		DatumCheckpointInterval: pipelineInfo.Details.DatumCheckpointInterval,
 	}
 }
 
@@ -367,6 +375,16 @@ func WriteJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
 	return errors.EnsureStack(err)
 }
 
+// TaskDoerOptions returns the options that schedule the tasks created for a
+// pipeline according to its task scheduling spec.
+func TaskDoerOptions(pipelineInfo *pps.PipelineInfo) []task.DoerOption {
+	spec := pipelineInfo.GetDetails().GetTaskSchedulingSpec()
+	if spec == nil {
+		return nil
+	}
+	return []task.DoerOption{task.WithPriority(spec.Priority), task.WithWeight(spec.Weight)}
+}
+
 func MetaCommit(commit *pfs.Commit) *pfs.Commit {
 	return client.NewSystemRepo(commit.Branch.Repo.Name, pfs.MetaRepoType).NewCommit(commit.Branch.Name, commit.ID)
 }
@@ -385,6 +403,16 @@ func ContainsS3Inputs(in *pps.Input) bool {
 	return found
 }
 
+// SQLInputPasswordEnv returns the name of the environment variable which holds
+// the database password of the SQL input 'name' in the pipeline's workers,
+// which run its query. This helper is in ppsutil because both PPS (which sets
+// the variable) and the worker (which reads it) need to know it. The input
+// name is hex encoded, since it may contain characters that environment
+// variable names can't.
+func SQLInputPasswordEnv(name string) string {
+	return "PACHYDERM_SQL_PASSWORD_" + strings.ToUpper(hex.EncodeToString([]byte(name)))
+}
+
 // SidecarS3GatewayService returns the name of the kubernetes service created
 // for the job 'jobID' to hand sidecar s3 gateway requests. This helper
 // is in ppsutil because both PPS (which creates the service, in the s3 gateway
@@ -282,6 +282,10 @@ func asString(dst *string, x interface{}) error {
 		*dst = *x
 	case json.Number:
 		*dst = string(x)
+	case int64:
+		*dst = strconv.FormatInt(x, 10)
+	case float64:
+		*dst = strconv.FormatFloat(x, 'f', -1, 64)
 	default:
 		return ErrCannotConvert{Dest: dst, Value: x}
 	}
@@ -0,0 +1,488 @@
+package sdata
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+const (
+	// parquetParallelism is the number of goroutines used to encode and decode
+	// each column.
+	parquetParallelism = 1
+	// parquetBatchSize is the number of rows read from each column at a time.
+	parquetBatchSize = 1024
+)
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+// parquetSchemaElement returns the Parquet schema element for a column.
+// Integer, floating point, boolean, string, date, time and timestamp columns
+// map to the corresponding Parquet types. Timestamps are stored with
+// microsecond precision. Numeric and decimal columns are stored as strings,
+// because their precision isn't known, and the tuples hold them as strings
+// to avoid losing precision.
+func parquetSchemaElement(ci pachsql.ColumnInfo) (*parquet.SchemaElement, error) {
+	el := &parquet.SchemaElement{
+		Name:           ci.Name,
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+	}
+	if ci.IsNullable {
+		el.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)
+	}
+	setType := func(t parquet.Type, ct *parquet.ConvertedType, lt *parquet.LogicalType) {
+		el.Type = parquet.TypePtr(t)
+		el.ConvertedType = ct
+		el.LogicalType = lt
+	}
+	intType := func(bitWidth int8) *parquet.LogicalType {
+		return &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: bitWidth, IsSigned: true}}
+	}
+	micros := &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()}
+	switch ci.DataType {
+	case "BOOL", "BOOLEAN":
+		setType(parquet.Type_BOOLEAN, nil, nil)
+	case "SMALLINT", "INT2":
+		setType(parquet.Type_INT32, parquet.ConvertedTypePtr(parquet.ConvertedType_INT_16), intType(16))
+	case "INTEGER", "INT", "INT4":
+		setType(parquet.Type_INT32, parquet.ConvertedTypePtr(parquet.ConvertedType_INT_32), intType(32))
+	case "BIGINT", "INT8":
+		setType(parquet.Type_INT64, parquet.ConvertedTypePtr(parquet.ConvertedType_INT_64), intType(64))
+	case "FLOAT4", "REAL":
+		setType(parquet.Type_FLOAT, nil, nil)
+	case "FLOAT", "FLOAT8", "DOUBLE PRECISION":
+		setType(parquet.Type_DOUBLE, nil, nil)
+	case "NUMERIC", "DECIMAL", "NUMBER", "FIXED", "VARCHAR", "TEXT", "CHARACTER VARYING":
+		setType(parquet.Type_BYTE_ARRAY, parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8), &parquet.LogicalType{STRING: parquet.NewStringType()})
+	case "VARIANT":
+		setType(parquet.Type_BYTE_ARRAY, parquet.ConvertedTypePtr(parquet.ConvertedType_JSON), &parquet.LogicalType{JSON: parquet.NewJsonType()})
+	case "DATE":
+		setType(parquet.Type_INT32, parquet.ConvertedTypePtr(parquet.ConvertedType_DATE), &parquet.LogicalType{DATE: parquet.NewDateType()})
+	case "TIME":
+		setType(parquet.Type_INT64, parquet.ConvertedTypePtr(parquet.ConvertedType_TIME_MICROS), &parquet.LogicalType{TIME: &parquet.TimeType{Unit: micros}})
+	case "TIMESTAMP", "TIMESTAMP_NTZ", "TIMESTAMP WITHOUT TIME ZONE":
+		setType(parquet.Type_INT64, parquet.ConvertedTypePtr(parquet.ConvertedType_TIMESTAMP_MICROS), &parquet.LogicalType{TIMESTAMP: &parquet.TimestampType{Unit: micros}})
+	case "TIMESTAMP_LTZ", "TIMESTAMP_TZ", "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE":
+		setType(parquet.Type_INT64, parquet.ConvertedTypePtr(parquet.ConvertedType_TIMESTAMP_MICROS), &parquet.LogicalType{TIMESTAMP: &parquet.TimestampType{IsAdjustedToUTC: true, Unit: micros}})
+	default:
+		return nil, errors.Errorf("unrecognized type: %v", ci.DataType)
+	}
+	return el, nil
+}
+
+// ParquetWriter writes Tuples as a Parquet file.
+// The file is only complete once Flush has been called, and no Tuples can be
+// written after that.
+type ParquetWriter struct {
+	pw      *writer.ParquetWriter
+	columns []*parquet.SchemaElement
+	record  []interface{}
+	flushed bool
+}
+
+// NewParquetWriter returns a ParquetWriter writing to w, with a schema derived
+// from the columns in info.
+func NewParquetWriter(w io.Writer, info *pachsql.TableInfo) (*ParquetWriter, error) {
+	numChildren := int32(len(info.Columns))
+	schema := []*parquet.SchemaElement{{
+		Name:           "schema",
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+		NumChildren:    &numChildren,
+	}}
+	for _, ci := range info.Columns {
+		el, err := parquetSchemaElement(ci)
+		if err != nil {
+			return nil, err
+		}
+		schema = append(schema, el)
+	}
+	pw, err := writer.NewParquetWriterFromWriter(w, schema, parquetParallelism)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	pw.MarshalFunc = marshal.MarshalCSV
+	return &ParquetWriter{
+		pw:      pw,
+		columns: schema[1:],
+	}, nil
+}
+
+func (m *ParquetWriter) WriteTuple(row Tuple) error {
+	if len(row) != len(m.columns) {
+		return ErrTupleFields{Writer: m, Tuple: row}
+	}
+	if m.flushed {
+		return errors.Errorf("cannot write to a parquet file after it has been flushed")
+	}
+	// The record is retained by the writer until the row group is flushed,
+	// so it can't be reused.
+	record := make([]interface{}, len(row))
+	for i := range row {
+		var err error
+		record[i], err = m.format(m.columns[i], row[i])
+		if err != nil {
+			return err
+		}
+		if record[i] == nil && m.columns[i].GetRepetitionType() == parquet.FieldRepetitionType_REQUIRED {
+			return errors.Errorf("null value for non-nullable column %q", m.columns[i].Name)
+		}
+	}
+	return errors.EnsureStack(m.pw.Write(record))
+}
+
+// format converts x to the value the Parquet writer expects for the column.
+func (m *ParquetWriter) format(el *parquet.SchemaElement, x interface{}) (interface{}, error) {
+	v, err := tupleValue(x)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch el.GetType() {
+	case parquet.Type_BOOLEAN:
+		switch v := v.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			return b, errors.EnsureStack(err)
+		}
+	case parquet.Type_INT32:
+		if el.GetConvertedType() == parquet.ConvertedType_DATE {
+			if t, ok := v.(time.Time); ok {
+				date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+				return int32(date.Sub(unixEpoch) / (24 * time.Hour)), nil
+			}
+			break
+		}
+		switch v := v.(type) {
+		case int64:
+			return int32(v), nil
+		case string:
+			i, err := strconv.ParseInt(v, 10, 32)
+			return int32(i), errors.EnsureStack(err)
+		}
+	case parquet.Type_INT64:
+		switch el.GetConvertedType() {
+		case parquet.ConvertedType_TIME_MICROS:
+			if t, ok := v.(time.Time); ok {
+				midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+				return t.Sub(midnight).Microseconds(), nil
+			}
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			if t, ok := v.(time.Time); ok {
+				return t.UnixMicro(), nil
+			}
+		default:
+			switch v := v.(type) {
+			case int64:
+				return v, nil
+			case string:
+				i, err := strconv.ParseInt(v, 10, 64)
+				return i, errors.EnsureStack(err)
+			}
+		}
+	case parquet.Type_FLOAT, parquet.Type_DOUBLE:
+		var f float64
+		switch v := v.(type) {
+		case float64:
+			f = v
+		case int64:
+			f = float64(v)
+		case string:
+			f, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		default:
+			return nil, ErrCannotConvert{Value: x, Dest: el.GetType()}
+		}
+		if el.GetType() == parquet.Type_FLOAT {
+			return float32(f), nil
+		}
+		return f, nil
+	case parquet.Type_BYTE_ARRAY:
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case time.Time:
+			return formatTimestampNTZ(v.Format(time.RFC3339Nano)), nil
+		}
+	}
+	return nil, ErrCannotConvert{Value: x, Dest: el.GetType()}
+}
+
+// tupleValue dereferences a Tuple element, returning nil, a bool, an int64, a
+// float64, a string or a time.Time.
+func tupleValue(x interface{}) (interface{}, error) {
+	switch x := x.(type) {
+	case *bool:
+		return *x, nil
+	case *int16:
+		return int64(*x), nil
+	case *int32:
+		return int64(*x), nil
+	case *int64:
+		return *x, nil
+	case *float32:
+		return float64(*x), nil
+	case *float64:
+		return *x, nil
+	case *string:
+		return *x, nil
+	case *sql.RawBytes:
+		return string(*x), nil
+	case *time.Time:
+		return *x, nil
+	case *sql.NullBool:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Bool, nil
+	case *sql.NullByte:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Byte), nil
+	case *sql.NullInt16:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Int16), nil
+	case *sql.NullInt32:
+		if !x.Valid {
+			return nil, nil
+		}
+		return int64(x.Int32), nil
+	case *sql.NullInt64:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Int64, nil
+	case *sql.NullFloat64:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Float64, nil
+	case *sql.NullString:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.String, nil
+	case *sql.NullTime:
+		if !x.Valid {
+			return nil, nil
+		}
+		return x.Time, nil
+	case *interface{}:
+		switch v := (*x).(type) {
+		case nil:
+			return nil, nil
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return string(data), nil
+		}
+	default:
+		return nil, errors.Errorf("unrecognized value (%v: %T)", x, x)
+	}
+}
+
+// Flush writes the footer of the Parquet file.
+func (m *ParquetWriter) Flush() error {
+	if m.flushed {
+		return nil
+	}
+	m.flushed = true
+	return errors.EnsureStack(m.pw.WriteStop())
+}
+
+// ParquetParser reads rows from a Parquet file into tuples.
+type ParquetParser struct {
+	pr         *reader.ParquetReader
+	columns    []*parquet.SchemaElement
+	fieldNames []string
+	// indices maps each field to its column.
+	indices []int64
+
+	numRows int64
+	read    int64
+	batch   [][]interface{}
+	pos     int
+}
+
+// NewParquetParser returns a new Parquet parser which reads the Parquet file
+// of the given size from r. Parquet files can't be read as a stream, because
+// the schema is at the end of the file. Columns are matched to fieldNames by
+// name. If fieldNames is empty, the columns are read in the order they appear
+// in the file.
+func NewParquetParser(r io.ReaderAt, size int64, fieldNames []string) (*ParquetParser, error) {
+	pr, err := reader.NewParquetColumnReader(newReaderAtFile(r, size), parquetParallelism)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// The reader renames the columns in the footer, so the original names
+	// have to come from the schema handler.
+	columns := pr.Footer.GetSchema()[1:]
+	names := make([]string, len(columns))
+	for i := range columns {
+		names[i] = pr.SchemaHandler.GetExName(i + 1)
+	}
+	if len(fieldNames) == 0 {
+		fieldNames = names
+	}
+	indices := make([]int64, len(fieldNames))
+	for i, name := range fieldNames {
+		indices[i] = -1
+		for j := range names {
+			if names[j] == name {
+				indices[i] = int64(j)
+			}
+		}
+		if indices[i] < 0 {
+			return nil, errors.Errorf("parquet file has no column %q", name)
+		}
+	}
+	return &ParquetParser{
+		pr:         pr,
+		columns:    columns,
+		fieldNames: fieldNames,
+		indices:    indices,
+		numRows:    pr.GetNumRows(),
+	}, nil
+}
+
+func (p *ParquetParser) Next(row Tuple) error {
+	if len(row) != len(p.fieldNames) {
+		return ErrTupleFields{Fields: p.fieldNames, Tuple: row}
+	}
+	if len(p.batch) == 0 || p.pos >= len(p.batch[0]) {
+		if err := p.readBatch(); err != nil {
+			return err
+		}
+	}
+	for i := range row {
+		v, err := p.value(p.columns[p.indices[i]], p.batch[i][p.pos])
+		if err != nil {
+			return err
+		}
+		if dst, ok := row[i].(*interface{}); ok {
+			*dst = v
+			continue
+		}
+		if err := convert(row[i], v); err != nil {
+			return err
+		}
+	}
+	p.pos++
+	return nil
+}
+
+func (p *ParquetParser) readBatch() error {
+	if p.read >= p.numRows {
+		return io.EOF
+	}
+	n := p.numRows - p.read
+	if n > parquetBatchSize {
+		n = parquetBatchSize
+	}
+	p.batch = p.batch[:0]
+	for _, idx := range p.indices {
+		values, _, _, err := p.pr.ReadColumnByIndex(idx, n)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if int64(len(values)) != n {
+			return errors.Errorf("parquet column %q has %d rows, expected %d", p.columns[idx].Name, len(values), n)
+		}
+		p.batch = append(p.batch, values)
+	}
+	p.read += n
+	p.pos = 0
+	return nil
+}
+
+// value converts a value read from the column to a value accepted by convert.
+func (p *ParquetParser) value(el *parquet.SchemaElement, x interface{}) (interface{}, error) {
+	switch x := x.(type) {
+	case nil:
+		return nil, nil
+	case int32:
+		if el.GetConvertedType() == parquet.ConvertedType_DATE {
+			return unixEpoch.AddDate(0, 0, int(x)), nil
+		}
+		return int64(x), nil
+	case int64:
+		switch el.GetConvertedType() {
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			return time.UnixMicro(x).UTC(), nil
+		case parquet.ConvertedType_TIMESTAMP_MILLIS:
+			return time.UnixMilli(x).UTC(), nil
+		case parquet.ConvertedType_TIME_MICROS:
+			return unixEpoch.Add(time.Duration(x) * time.Microsecond), nil
+		}
+		return x, nil
+	case float32:
+		return float64(x), nil
+	case float64, bool, string:
+		return x, nil
+	default:
+		return nil, errors.Errorf("unsupported parquet value %v (%T) in column %q", x, x, el.Name)
+	}
+}
+
+// readerAtFile adapts an io.ReaderAt to the file interface the Parquet reader
+// expects. The reader opens the file once per column, so each open returns an
+// independent reader.
+type readerAtFile struct {
+	*io.SectionReader
+	r    io.ReaderAt
+	size int64
+}
+
+var _ source.ParquetFile = &readerAtFile{}
+
+func newReaderAtFile(r io.ReaderAt, size int64) *readerAtFile {
+	return &readerAtFile{
+		SectionReader: io.NewSectionReader(r, 0, size),
+		r:             r,
+		size:          size,
+	}
+}
+
+func (f *readerAtFile) Open(string) (source.ParquetFile, error) {
+	return newReaderAtFile(f.r, f.size), nil
+}
+
+func (f *readerAtFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.Errorf("cannot create a file from a reader")
+}
+
+func (f *readerAtFile) Write([]byte) (int, error) {
+	return 0, errors.Errorf("cannot write to a reader")
+}
+
+func (f *readerAtFile) Close() error {
+	return nil
+}
@@ -91,6 +91,24 @@ func NewTupleFromColumnTypes(cTypes []*sql.ColumnType) (Tuple, error) {
 	return row, nil
 }
 
+// NewTableInfoFromColumnTypes returns a TableInfo describing the columns of a
+// query result.
+func NewTableInfoFromColumnTypes(cTypes []*sql.ColumnType) *pachsql.TableInfo {
+	info := &pachsql.TableInfo{}
+	for _, cType := range cTypes {
+		nullable, ok := cType.Nullable()
+		if !ok {
+			nullable = true
+		}
+		info.Columns = append(info.Columns, pachsql.ColumnInfo{
+			Name:       cType.Name(),
+			DataType:   cType.DatabaseTypeName(),
+			IsNullable: nullable,
+		})
+	}
+	return info
+}
+
 // Copy copies a tuple from r to w. Row is used to indicate the correct shape of read data.
 func Copy(w TupleWriter, r TupleReader, row Tuple) (n int, _ error) {
 	for {
@@ -8,8 +8,10 @@ import (
 	"io"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	fuzz "github.com/google/gofuzz"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -91,6 +93,69 @@ func TestFormatParse(t *testing.T) {
 	}
 }
 
+// TestParquetFormatParse is a round trip from Tuples created from a TableInfo
+// through a Parquet file and back again.
+func TestParquetFormatParse(t *testing.T) {
+	info := &pachsql.TableInfo{
+		Columns: []pachsql.ColumnInfo{
+			{Name: "c_id", DataType: "INT8"},
+			{Name: "c_smallint", DataType: "SMALLINT", IsNullable: true},
+			{Name: "c_double", DataType: "FLOAT8"},
+			{Name: "c_numeric", DataType: "NUMERIC", IsNullable: true},
+			{Name: "c_bool", DataType: "BOOL", IsNullable: true},
+			{Name: "c_text", DataType: "TEXT", IsNullable: true},
+			{Name: "c_date", DataType: "DATE"},
+			{Name: "c_timestamp", DataType: "TIMESTAMP", IsNullable: true},
+			{Name: "c_timestamptz", DataType: "TIMESTAMPTZ"},
+		},
+	}
+	var fieldNames []string
+	for _, ci := range info.Columns {
+		fieldNames = append(fieldNames, ci.Name)
+	}
+	ts := time.Date(2021, 11, 3, 12, 34, 56, 789000000, time.UTC)
+	set := func(row Tuple, i int, null bool) {
+		*row[0].(*string) = strconv.Itoa(i)
+		*row[2].(*string) = strconv.FormatFloat(float64(i)+0.5, 'f', -1, 64)
+		*row[6].(*time.Time) = ts.Truncate(24*time.Hour).AddDate(0, 0, i)
+		*row[8].(*time.Time) = ts.Add(time.Duration(i) * time.Hour)
+		if null {
+			return
+		}
+		*row[1].(*sql.NullString) = sql.NullString{String: strconv.Itoa(-i), Valid: true}
+		*row[3].(*sql.NullString) = sql.NullString{String: "1234567890.0987654321", Valid: true}
+		*row[4].(*sql.NullBool) = sql.NullBool{Bool: i%3 == 0, Valid: true}
+		*row[5].(*sql.NullString) = sql.NullString{String: fmt.Sprintf("row %d", i), Valid: true}
+		*row[7].(*sql.NullTime) = sql.NullTime{Time: ts.Add(-time.Duration(i) * time.Minute), Valid: true}
+	}
+	const N = 2500
+	buf := &bytes.Buffer{}
+	w, err := NewParquetWriter(buf, info)
+	require.NoError(t, err)
+	var expected []Tuple
+	for i := 0; i < N; i++ {
+		row, err := NewTupleFromTableInfo(info)
+		require.NoError(t, err)
+		set(row, i, i%2 == 0)
+		require.NoError(t, w.WriteTuple(row))
+		expected = append(expected, row)
+	}
+	require.NoError(t, w.Flush())
+
+	r, err := NewParquetParser(bytes.NewReader(buf.Bytes()), int64(buf.Len()), fieldNames)
+	require.NoError(t, err)
+	for i := 0; i < N; i++ {
+		row, err := NewTupleFromTableInfo(info)
+		require.NoError(t, err)
+		require.NoError(t, r.Next(row))
+		require.Equal(t, expected[i], row)
+	}
+	row, err := NewTupleFromTableInfo(info)
+	require.NoError(t, err)
+	require.YesError(t, r.Next(row))
+	require.True(t, errors.Is(r.Next(row), io.EOF))
+}
+
 type setIDer interface {
 	SetID(int16)
 }
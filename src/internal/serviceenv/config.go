@@ -18,6 +18,7 @@ type GlobalConfiguration struct {
 	PrometheusPort                 uint16 `env:"PROMETHEUS_PORT,default=1656"`
 	PeerPort                       uint16 `env:"PEER_PORT,default=1653"`
 	S3GatewayPort                  uint16 `env:"S3GATEWAY_PORT,default=1600"`
+	S3GatewayPresignKey            string `env:"S3GATEWAY_PRESIGN_KEY,default="`
 	PPSEtcdPrefix                  string `env:"PPS_ETCD_PREFIX,default=pachyderm_pps"`
 	Namespace                      string `env:"PACH_NAMESPACE,default=default"`
 	StorageRoot                    string `env:"PACH_ROOT,default=/pach"`
@@ -41,6 +42,7 @@ type GlobalConfiguration struct {
 	PostgresConnMaxIdleSeconds     int    `env:"POSTGRES_CONN_MAX_IDLE_SECONDS,default=0"`
 	PachdServiceHost               string `env:"PACHD_SERVICE_HOST"`
 	PachdServicePort               string `env:"PACHD_SERVICE_PORT"`
+	TaskServiceBackend             string `env:"TASK_SERVICE_BACKEND,default=etcd"`
 
 	EtcdPrefix           string `env:"ETCD_PREFIX,default="`
 	DeploymentID         string `env:"CLUSTER_DEPLOYMENT_ID,default="`
@@ -74,6 +76,11 @@ type GlobalConfiguration struct {
 
 	// The number of concurrent requests that the PPS Master can make against kubernetes
 	PPSMaxConcurrentK8sRequests int `env:"PPS_MAX_CONCURRENT_K8S_REQUESTS,default=10"`
+
+	// PPSInfraDriver is where pipeline workers run, either in kubernetes or as
+	// local processes. It's propagated to the workers, which don't connect to
+	// kubernetes when they run locally.
+	PPSInfraDriver string `env:"PPS_INFRA_DRIVER,default=kubernetes"`
 }
 
 // PachdFullConfiguration contains the full pachd configuration.
@@ -102,6 +109,16 @@ type PachdSpecificConfiguration struct {
 	PachdPodName                 string `env:"PACHD_POD_NAME,required"`
 	EnableWorkerSecurityContexts bool   `env:"ENABLE_WORKER_SECURITY_CONTEXTS,default=true"`
 	TLSCertSecretName            string `env:"TLS_CERT_SECRET_NAME,default="`
+
+	// The following are only used when PPSInfraDriver is local.
+	// PPSLocalWorkerRunner is how local workers are run, either as processes
+	// or as docker containers of the pipeline's image.
+	PPSLocalWorkerRunner string `env:"PPS_LOCAL_WORKER_RUNNER,default=process"`
+	// PPSLocalWorkerBinary is the worker binary, which is looked up in the PATH
+	// if it isn't a path.
+	PPSLocalWorkerBinary string `env:"PPS_LOCAL_WORKER_BINARY,default=worker"`
+	// PPSLocalWorkerDir is where the local workers' scratch directories are created.
+	PPSLocalWorkerDir string `env:"PPS_LOCAL_WORKER_DIR,default=/tmp/pach/workers"`
 }
 
 // EnterpriseServerConfiguration contains the full configuration for an enterprise server
@@ -122,18 +139,29 @@ type EnterpriseSpecificConfiguration struct {
 
 // StorageConfiguration contains the storage configuration.
 type StorageConfiguration struct {
-	StorageMemoryThreshold               int64 `env:"STORAGE_MEMORY_THRESHOLD"`
-	StorageCompactionShardSizeThreshold  int64 `env:"STORAGE_COMPACTION_SHARD_SIZE_THRESHOLD"`
-	StorageCompactionShardCountThreshold int64 `env:"STORAGE_COMPACTION_SHARD_COUNT_THRESHOLD"`
-	StorageLevelFactor                   int64 `env:"STORAGE_LEVEL_FACTOR"`
-	StorageUploadConcurrencyLimit        int   `env:"STORAGE_UPLOAD_CONCURRENCY_LIMIT,default=100"`
-	StoragePutFileConcurrencyLimit       int   `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
-	StorageGCPeriod                      int64 `env:"STORAGE_GC_PERIOD,default=60"`
-	StorageChunkGCPeriod                 int64 `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
-	StorageCompactionMaxFanIn            int   `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
-	StorageFileSetsMaxOpen               int   `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
-	StorageDiskCacheSize                 int   `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
-	StorageMemoryCacheSize               int   `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageMemoryThreshold               int64  `env:"STORAGE_MEMORY_THRESHOLD"`
+	StorageCompactionShardSizeThreshold  int64  `env:"STORAGE_COMPACTION_SHARD_SIZE_THRESHOLD"`
+	StorageCompactionShardCountThreshold int64  `env:"STORAGE_COMPACTION_SHARD_COUNT_THRESHOLD"`
+	StorageLevelFactor                   int64  `env:"STORAGE_LEVEL_FACTOR"`
+	StorageUploadConcurrencyLimit        int    `env:"STORAGE_UPLOAD_CONCURRENCY_LIMIT,default=100"`
+	StoragePutFileConcurrencyLimit       int    `env:"STORAGE_PUT_FILE_CONCURRENCY_LIMIT,default=100"`
+	StorageGCPeriod                      int64  `env:"STORAGE_GC_PERIOD,default=60"`
+	StorageChunkGCPeriod                 int64  `env:"STORAGE_CHUNK_GC_PERIOD,default=60"`
+	StorageRetentionPeriod               int64  `env:"STORAGE_RETENTION_PERIOD,default=600"`
+	StorageCompactionMaxFanIn            int    `env:"STORAGE_COMPACTION_MAX_FANIN,default=10"`
+	StorageFileSetsMaxOpen               int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
+	StorageDiskCacheSize                 int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
+	StorageMemoryCacheSize               int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageCompressionAlgo               string `env:"STORAGE_COMPRESSION_ALGO,default="`
+	StorageCompressionLevel              int    `env:"STORAGE_COMPRESSION_LEVEL,default=0"`
+	StorageCompressionSampleSize         int    `env:"STORAGE_COMPRESSION_SAMPLE_SIZE,default=0"`
+	StorageEncryptionAlgo                string `env:"STORAGE_ENCRYPTION_ALGO,default="`
+	StorageEncryptionKeys                string `env:"STORAGE_ENCRYPTION_KEYS,default="`
+	StorageKeyRewrapPeriod               int64  `env:"STORAGE_KEY_REWRAP_PERIOD,default=600"`
+	StorageRepoEncryptionKeys            bool   `env:"STORAGE_REPO_ENCRYPTION_KEYS,default=false"`
+	StorageColdTierURL                   string `env:"STORAGE_COLD_TIER_URL,default="`
+	StorageColdTierAfterDays             int64  `env:"STORAGE_COLD_TIER_AFTER_DAYS,default=30"`
+	StorageTieringPeriod                 int64  `env:"STORAGE_TIERING_PERIOD,default=3600"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -149,6 +177,10 @@ type WorkerSpecificConfiguration struct {
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// PPSWorkerRoot is the directory which the worker's inputs and outputs are
+	// placed under. Local workers which run as processes share a filesystem,
+	// so each of them gets its own.
+	PPSWorkerRoot string `env:"PPS_WORKER_ROOT,default=/"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -161,6 +193,20 @@ type FeatureFlags struct {
 	IdentityServerEnabled        bool `env:"IDENTITY_SERVER_ENABLED,default=false"`
 }
 
+const (
+	// TaskServiceEtcd is the TASK_SERVICE_BACKEND which stores tasks in etcd.
+	TaskServiceEtcd = "etcd"
+	// TaskServicePostgres is the TASK_SERVICE_BACKEND which stores tasks in
+	// postgres.
+	TaskServicePostgres = "postgres"
+
+	// InfraDriverKubernetes is the PPS_INFRA_DRIVER which runs workers in kubernetes.
+	InfraDriverKubernetes = "kubernetes"
+	// InfraDriverLocal is the PPS_INFRA_DRIVER which runs workers on the same
+	// host as pachd, without kubernetes.
+	InfraDriverLocal = "local"
+)
+
 // NewConfiguration creates a generic configuration from a specific type of configuration.
 func NewConfiguration(config interface{}) *Configuration {
 	configuration := &Configuration{}
@@ -175,9 +175,13 @@ func InitServiceEnv(config *Configuration) *NonblockingServiceEnv {
 
 // InitWithKube is like InitNonblockingServiceEnv, but also assumes that it's run inside
 // a kubernetes cluster and tries to connect to the kubernetes API server.
+// When pipelines run locally (see InfraDriverLocal), there is no kubernetes
+// cluster, so it doesn't connect.
 func InitWithKube(config *Configuration) *NonblockingServiceEnv {
 	env := InitServiceEnv(config)
-	env.kubeEg.Go(env.initKubeClient)
+	if config.PPSInfraDriver != InfraDriverLocal {
+		env.kubeEg.Go(env.initKubeClient)
+	}
 	return env // env is not ready yet
 }
 
@@ -421,7 +425,12 @@ func (env *NonblockingServiceEnv) GetEtcdClient() *etcd.Client {
 	return env.etcdClient
 }
 
+// GetTaskService returns a task service with the backend selected by
+// TASK_SERVICE_BACKEND, which is either etcd (the default) or postgres.
 func (env *NonblockingServiceEnv) GetTaskService(prefix string) task.Service {
+	if env.config.TaskServiceBackend == TaskServicePostgres {
+		return task.NewPostgresService(env.GetDBClient(), env.GetPostgresListener(), prefix)
+	}
 	return task.NewEtcdService(env.GetEtcdClient(), prefix)
 }
 
@@ -437,6 +446,21 @@ func (env *NonblockingServiceEnv) GetKubeClient() *kube.Clientset {
 	return env.kubeClient
 }
 
+// ErrNoKubernetes is returned by the operations which need kubernetes, such as
+// secrets and pod logs, when pipelines run locally and there is no kubernetes
+// cluster.
+var ErrNoKubernetes = errors.Errorf("not supported when pipelines run locally (PPS_INFRA_DRIVER=%s), as there is no kubernetes cluster", InfraDriverLocal)
+
+// KubeClient returns env's kubernetes client, or ErrNoKubernetes if env never
+// connects to kubernetes because pipelines run locally. Unlike GetKubeClient,
+// it doesn't panic in that case.
+func KubeClient(env ServiceEnv) (*kube.Clientset, error) {
+	if env.Config().PPSInfraDriver == InfraDriverLocal {
+		return nil, ErrNoKubernetes
+	}
+	return env.GetKubeClient(), nil
+}
+
 // GetLokiClient returns the loki client, it doesn't require blocking on a
 // connection because the client is just a dumb struct with no init function.
 func (env *NonblockingServiceEnv) GetLokiClient() (*loki.Client, error) {
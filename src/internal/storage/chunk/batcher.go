@@ -21,13 +21,14 @@ type EntryFunc = func(interface{}, *DataRef) error
 // Callbacks will be executed with respect to the order the entries are added (for the ChunkFunc
 // interface, entries are ordered within as well as across calls).
 type Batcher struct {
-	client    Client
-	entries   []*entry
-	buf       []byte
-	threshold int
-	taskChain *TaskChain
-	chunkFunc ChunkFunc
-	entryFunc EntryFunc
+	client     Client
+	createOpts CreateOptions
+	entries    []*entry
+	buf        []byte
+	threshold  int
+	taskChain  *TaskChain
+	chunkFunc  ChunkFunc
+	entryFunc  EntryFunc
 }
 
 type entry struct {
@@ -39,11 +40,12 @@ type entry struct {
 
 // TODO: Add config for number of entries.
 func (s *Storage) NewBatcher(ctx context.Context, name string, threshold int, opts ...BatcherOption) *Batcher {
-	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
+	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.createOpts.Keyring)
 	b := &Batcher{
-		client:    client,
-		threshold: threshold,
-		taskChain: NewTaskChain(ctx, semaphore.NewWeighted(chunkParallelism)),
+		client:     client,
+		createOpts: s.createOptions(ctx),
+		threshold:  threshold,
+		taskChain:  NewTaskChain(ctx, semaphore.NewWeighted(chunkParallelism)),
 	}
 	for _, opt := range opts {
 		opt(b)
@@ -71,7 +73,7 @@ func (b *Batcher) Add(meta interface{}, data []byte, pointsTo []*DataRef) error
 func (b *Batcher) createBatch(entries []*entry, buf []byte) error {
 	return b.taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
 		pointsTo := getPointsTo(entries)
-		dataRef, err := upload(ctx, b.client, buf, pointsTo, false)
+		dataRef, err := upload(ctx, b.client, b.createOpts, buf, pointsTo, false)
 		if err != nil {
 			return nil, err
 		}
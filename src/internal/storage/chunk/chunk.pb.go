@@ -27,16 +27,22 @@ type CompressionAlgo int32
 const (
 	CompressionAlgo_NONE            CompressionAlgo = 0
 	CompressionAlgo_GZIP_BEST_SPEED CompressionAlgo = 1
+	CompressionAlgo_ZSTD            CompressionAlgo = 2
+	CompressionAlgo_LZ4             CompressionAlgo = 3
 )
 
 var CompressionAlgo_name = map[int32]string{
 	0: "NONE",
 	1: "GZIP_BEST_SPEED",
+	2: "ZSTD",
+	3: "LZ4",
 }
 
 var CompressionAlgo_value = map[string]int32{
 	"NONE":            0,
 	"GZIP_BEST_SPEED": 1,
+	"ZSTD":            2,
+	"LZ4":             3,
 }
 
 func (x CompressionAlgo) String() string {
@@ -52,16 +58,19 @@ type EncryptionAlgo int32
 const (
 	EncryptionAlgo_ENCRYPTION_ALGO_UNKNOWN EncryptionAlgo = 0
 	EncryptionAlgo_CHACHA20                EncryptionAlgo = 1
+	EncryptionAlgo_XCHACHA20_POLY1305      EncryptionAlgo = 2
 )
 
 var EncryptionAlgo_name = map[int32]string{
 	0: "ENCRYPTION_ALGO_UNKNOWN",
 	1: "CHACHA20",
+	2: "XCHACHA20_POLY1305",
 }
 
 var EncryptionAlgo_value = map[string]int32{
 	"ENCRYPTION_ALGO_UNKNOWN": 0,
 	"CHACHA20":                1,
+	"XCHACHA20_POLY1305":      2,
 }
 
 func (x EncryptionAlgo) String() string {
@@ -148,15 +157,20 @@ func (m *DataRef) GetSizeBytes() int64 {
 }
 
 type Ref struct {
-	Id                   []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SizeBytes            int64           `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Edge                 bool            `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
-	Dek                  []byte          `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
-	EncryptionAlgo       EncryptionAlgo  `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
-	CompressionAlgo      CompressionAlgo `protobuf:"varint,6,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Edge      bool   `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	// dek is empty if the data encryption key is wrapped by a key encryption
+	// key, and stored with the chunk's metadata.
+	Dek             []byte          `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
+	EncryptionAlgo  EncryptionAlgo  `protobuf:"varint,5,opt,name=encryption_algo,json=encryptionAlgo,proto3,enum=chunk.EncryptionAlgo" json:"encryption_algo,omitempty"`
+	CompressionAlgo CompressionAlgo `protobuf:"varint,6,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
+	// key_id is set if the chunk is encrypted with its own key, such as a
+	// repo's key. dek is then wrapped by that key.
+	KeyId                string   `protobuf:"bytes,7,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Ref) Reset()         { *m = Ref{} }
@@ -234,11 +248,120 @@ func (m *Ref) GetCompressionAlgo() CompressionAlgo {
 	return CompressionAlgo_NONE
 }
 
+func (m *Ref) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+// Export is a chunk exported from one cluster to be imported into another.
+type Export struct {
+	Id        []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	PointsTo  [][]byte `protobuf:"bytes,3,rep,name=points_to,json=pointsTo,proto3" json:"points_to,omitempty"`
+	// dek is the unwrapped data encryption key if the chunk's key is wrapped by
+	// a key encryption key, so it can be rewrapped by another keyring.
+	Dek []byte `protobuf:"bytes,4,opt,name=dek,proto3" json:"dek,omitempty"`
+	// data is the chunk's object. It is empty if only a reference to the
+	// object was exported, in which case gen and tier locate the object in the
+	// bucket shared by both clusters.
+	Data                 []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Gen                  uint64   `protobuf:"varint,6,opt,name=gen,proto3" json:"gen,omitempty"`
+	Tier                 int32    `protobuf:"varint,7,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Export) Reset()         { *m = Export{} }
+func (m *Export) String() string { return proto.CompactTextString(m) }
+func (*Export) ProtoMessage()    {}
+func (*Export) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b743b4a788792d7, []int{2}
+}
+func (m *Export) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Export) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Export.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Export) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Export.Merge(m, src)
+}
+func (m *Export) XXX_Size() int {
+	return m.Size()
+}
+func (m *Export) XXX_DiscardUnknown() {
+	xxx_messageInfo_Export.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Export proto.InternalMessageInfo
+
+func (m *Export) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Export) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *Export) GetPointsTo() [][]byte {
+	if m != nil {
+		return m.PointsTo
+	}
+	return nil
+}
+
+func (m *Export) GetDek() []byte {
+	if m != nil {
+		return m.Dek
+	}
+	return nil
+}
+
+func (m *Export) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Export) GetGen() uint64 {
+	if m != nil {
+		return m.Gen
+	}
+	return 0
+}
+
+func (m *Export) GetTier() int32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("chunk.CompressionAlgo", CompressionAlgo_name, CompressionAlgo_value)
 	proto.RegisterEnum("chunk.EncryptionAlgo", EncryptionAlgo_name, EncryptionAlgo_value)
 	proto.RegisterType((*DataRef)(nil), "chunk.DataRef")
 	proto.RegisterType((*Ref)(nil), "chunk.Ref")
+	proto.RegisterType((*Export)(nil), "chunk.Export")
 }
 
 func init() {
@@ -246,33 +369,40 @@ func init() {
 }
 
 var fileDescriptor_4b743b4a788792d7 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x5d, 0x8b, 0xd3, 0x40,
-	0x14, 0xdd, 0x69, 0xba, 0x6b, 0xbd, 0x1b, 0x9a, 0x30, 0xa2, 0x06, 0xd4, 0x12, 0xfb, 0x14, 0xf6,
-	0xa1, 0x59, 0xe2, 0xa3, 0x22, 0xa4, 0xd9, 0xb0, 0xbb, 0x2a, 0x49, 0x99, 0x5d, 0x11, 0xf7, 0x25,
-	0xa4, 0xc9, 0xe4, 0x83, 0xed, 0x66, 0xc2, 0xcc, 0xac, 0x50, 0xc1, 0xff, 0xe7, 0xa3, 0x7f, 0x40,
-	0x90, 0xfe, 0x12, 0xc9, 0xb4, 0x54, 0x5b, 0xf6, 0x65, 0x38, 0xf7, 0xdc, 0x73, 0xcf, 0xb9, 0x03,
-	0x17, 0xc6, 0x75, 0x23, 0x29, 0x6f, 0xd2, 0x85, 0x2b, 0x24, 0xe3, 0x69, 0x49, 0xdd, 0xac, 0xba,
-	0x6f, 0x6e, 0xd7, 0xef, 0xa4, 0xe5, 0x4c, 0x32, 0x7c, 0xa8, 0x8a, 0xf1, 0x0f, 0x78, 0x74, 0x96,
-	0xca, 0x94, 0xd0, 0x02, 0xbf, 0x04, 0x8d, 0xd3, 0xc2, 0x42, 0x36, 0x72, 0x8e, 0x3d, 0x98, 0xac,
-	0xc5, 0x84, 0x16, 0xa4, 0xa3, 0x31, 0x86, 0x7e, 0x95, 0x8a, 0xca, 0xea, 0xd9, 0xc8, 0xd1, 0x89,
-	0xc2, 0xf8, 0x35, 0xe8, 0xac, 0x28, 0x04, 0x95, 0xc9, 0x7c, 0x29, 0xa9, 0xb0, 0x34, 0x1b, 0x39,
-	0x1a, 0x39, 0x5e, 0x73, 0xd3, 0x8e, 0xc2, 0xaf, 0x00, 0x44, 0xfd, 0x9d, 0x6e, 0x04, 0x7d, 0x25,
-	0x78, 0xdc, 0x31, 0xaa, 0x3d, 0xfe, 0x8d, 0x40, 0xeb, 0xb2, 0x87, 0xd0, 0xab, 0x73, 0x15, 0xad,
-	0x93, 0x5e, 0x9d, 0xef, 0x8d, 0xf5, 0xf6, 0xc6, 0xba, 0x65, 0x68, 0x5e, 0x52, 0x15, 0x38, 0x20,
-	0x0a, 0x63, 0x13, 0xb4, 0x9c, 0xde, 0xaa, 0x08, 0x9d, 0x74, 0x10, 0xbf, 0x07, 0x83, 0x36, 0x19,
-	0x5f, 0xb6, 0xb2, 0x66, 0x4d, 0x92, 0x2e, 0x4a, 0x66, 0x1d, 0xda, 0xc8, 0x19, 0x7a, 0x4f, 0x37,
-	0x9f, 0x0b, 0xb7, 0x5d, 0x7f, 0x51, 0x32, 0x32, 0xa4, 0x3b, 0x35, 0xf6, 0xc1, 0xcc, 0xd8, 0x5d,
-	0xcb, 0xa9, 0x10, 0x5b, 0x83, 0x23, 0x65, 0xf0, 0x6c, 0x63, 0x10, 0xfc, 0x6b, 0x2b, 0x07, 0x23,
-	0xdb, 0x25, 0x4e, 0x4e, 0xc1, 0xd8, 0xd3, 0xe0, 0x01, 0xf4, 0xa3, 0x38, 0x0a, 0xcd, 0x03, 0xfc,
-	0x04, 0x8c, 0xf3, 0x9b, 0xcb, 0x59, 0x32, 0x0d, 0xaf, 0xae, 0x93, 0xab, 0x59, 0x18, 0x9e, 0x99,
-	0xe8, 0xe4, 0x2d, 0x0c, 0x77, 0xd7, 0xc2, 0x2f, 0xe0, 0x79, 0x18, 0x05, 0xe4, 0xeb, 0xec, 0xfa,
-	0x32, 0x8e, 0x12, 0xff, 0xd3, 0x79, 0x9c, 0x7c, 0x8e, 0x3e, 0x46, 0xf1, 0x97, 0xc8, 0x3c, 0xc0,
-	0x3a, 0x0c, 0x82, 0x0b, 0x3f, 0xb8, 0xf0, 0xbd, 0x53, 0x13, 0x4d, 0x3f, 0xfc, 0x5c, 0x8d, 0xd0,
-	0xaf, 0xd5, 0x08, 0xfd, 0x59, 0x8d, 0xd0, 0xcd, 0xbb, 0xb2, 0x96, 0xd5, 0xfd, 0x7c, 0x92, 0xb1,
-	0x3b, 0xb7, 0x4d, 0xb3, 0x6a, 0x99, 0x53, 0xfe, 0x3f, 0xfa, 0xe6, 0xb9, 0x82, 0x67, 0xee, 0xc3,
-	0xc7, 0x32, 0x3f, 0x52, 0x77, 0xf2, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xc8, 0xba,
-	0x42, 0x4d, 0x02, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xed, 0xd8, 0x79, 0xde, 0x5a, 0x89, 0x35, 0xa8, 0xc5, 0x52, 0x21, 0x32, 0x59, 0x59, 0x5d,
+	0x24, 0x25, 0x85, 0x1d, 0x42, 0xca, 0xc3, 0x6a, 0x03, 0x91, 0x13, 0x4d, 0x82, 0xa0, 0xd9, 0x58,
+	0x8e, 0x3d, 0x71, 0xac, 0xa4, 0x1e, 0xcb, 0x9e, 0x22, 0x82, 0xc4, 0xbf, 0xf0, 0x39, 0x2c, 0xf9,
+	0x04, 0x94, 0x6f, 0xe0, 0x03, 0xd0, 0x4c, 0x42, 0x21, 0x51, 0x37, 0xdd, 0x8c, 0xce, 0x3d, 0xf7,
+	0xcc, 0x3d, 0x73, 0x46, 0xba, 0x50, 0x8f, 0x62, 0x4e, 0xd3, 0xd8, 0x5b, 0x35, 0x33, 0xce, 0x52,
+	0x2f, 0xa4, 0x4d, 0x7f, 0x71, 0x17, 0x2f, 0xb7, 0x67, 0x23, 0x49, 0x19, 0x67, 0x38, 0x2f, 0x8b,
+	0xfa, 0x37, 0x28, 0xf6, 0x3c, 0xee, 0x11, 0x3a, 0xc7, 0xcf, 0x40, 0x4d, 0xe9, 0xdc, 0x40, 0x26,
+	0xb2, 0x8e, 0x5b, 0xd0, 0xd8, 0x8a, 0x09, 0x9d, 0x13, 0x41, 0x63, 0x0c, 0xb9, 0x85, 0x97, 0x2d,
+	0x0c, 0xc5, 0x44, 0x96, 0x46, 0x24, 0xc6, 0x2f, 0x40, 0x63, 0xf3, 0x79, 0x46, 0xb9, 0x3b, 0x5b,
+	0x73, 0x9a, 0x19, 0xaa, 0x89, 0x2c, 0x95, 0x1c, 0x6f, 0xb9, 0x8e, 0xa0, 0xf0, 0x73, 0x80, 0x2c,
+	0xfa, 0x4a, 0x77, 0x82, 0x9c, 0x14, 0x94, 0x05, 0x23, 0xdb, 0xf5, 0xdf, 0x08, 0x54, 0xe1, 0x5d,
+	0x01, 0x25, 0x0a, 0xa4, 0xb5, 0x46, 0x94, 0x28, 0x38, 0xb8, 0xa6, 0x1c, 0x5c, 0x13, 0x8f, 0xa1,
+	0x41, 0x48, 0xa5, 0x61, 0x89, 0x48, 0x8c, 0x75, 0x50, 0x03, 0xba, 0x94, 0x16, 0x1a, 0x11, 0x10,
+	0xbf, 0x85, 0x2a, 0x8d, 0xfd, 0x74, 0x9d, 0xf0, 0x88, 0xc5, 0xae, 0xb7, 0x0a, 0x99, 0x91, 0x37,
+	0x91, 0x55, 0x69, 0x9d, 0xec, 0xc2, 0xd9, 0xf7, 0xdd, 0xf6, 0x2a, 0x64, 0xa4, 0x42, 0xf7, 0x6a,
+	0xdc, 0x06, 0xdd, 0x67, 0xb7, 0x49, 0x4a, 0xb3, 0xec, 0x7e, 0x40, 0x41, 0x0e, 0x38, 0xdd, 0x0d,
+	0xe8, 0xfe, 0x6b, 0xcb, 0x09, 0x55, 0x7f, 0x9f, 0xc0, 0x27, 0x50, 0x58, 0xd2, 0xb5, 0x1b, 0x05,
+	0x46, 0xd1, 0x44, 0x56, 0x99, 0xe4, 0x97, 0x74, 0xdd, 0x0f, 0xea, 0xdf, 0x11, 0x14, 0xec, 0x2f,
+	0x09, 0x4b, 0xf9, 0x63, 0x93, 0x9f, 0x41, 0x39, 0x61, 0x51, 0xcc, 0x33, 0x97, 0x33, 0x43, 0x35,
+	0x55, 0x4b, 0x23, 0xa5, 0x2d, 0x31, 0x61, 0x0f, 0x7c, 0x01, 0x86, 0x5c, 0xe0, 0x71, 0x4f, 0xe6,
+	0xd6, 0x88, 0xc4, 0x42, 0x15, 0xd2, 0x58, 0x26, 0xc9, 0x11, 0x01, 0x85, 0x8a, 0x47, 0x34, 0x95,
+	0x6f, 0xcc, 0x13, 0x89, 0xcf, 0xbb, 0x50, 0x3d, 0x48, 0x87, 0x4b, 0x90, 0x73, 0x86, 0x8e, 0xad,
+	0x1f, 0xe1, 0x27, 0x50, 0xbd, 0x9a, 0xf6, 0x47, 0x6e, 0xc7, 0x1e, 0x4f, 0xdc, 0xf1, 0xc8, 0xb6,
+	0x7b, 0x3a, 0x12, 0xed, 0xe9, 0x78, 0xd2, 0xd3, 0x15, 0x5c, 0x04, 0x75, 0x30, 0x7d, 0xa5, 0xab,
+	0xe7, 0x63, 0xa8, 0xec, 0xff, 0x31, 0x3e, 0x83, 0xa7, 0xb6, 0xd3, 0x25, 0x37, 0xa3, 0x49, 0x7f,
+	0xe8, 0xb8, 0xed, 0xc1, 0xd5, 0xd0, 0xfd, 0xe0, 0xbc, 0x77, 0x86, 0x1f, 0x1d, 0xfd, 0x08, 0x6b,
+	0x50, 0xea, 0x5e, 0xb7, 0xbb, 0xd7, 0xed, 0xd6, 0x85, 0x8e, 0xf0, 0x29, 0xe0, 0x4f, 0x7f, 0x4b,
+	0x77, 0x34, 0x1c, 0xdc, 0xbc, 0xbc, 0xbc, 0x78, 0xad, 0x2b, 0x9d, 0x77, 0x3f, 0x36, 0x35, 0xf4,
+	0x73, 0x53, 0x43, 0xbf, 0x36, 0x35, 0x34, 0x7d, 0x13, 0x46, 0x7c, 0x71, 0x37, 0x6b, 0xf8, 0xec,
+	0xb6, 0x99, 0x78, 0xfe, 0x62, 0x1d, 0xd0, 0xf4, 0x7f, 0xf4, 0xb9, 0xd5, 0xcc, 0x52, 0xbf, 0xf9,
+	0xf0, 0x46, 0xcc, 0x0a, 0x72, 0x19, 0x2e, 0xff, 0x0c, 0x00, 0xa3, 0x81, 0x07, 0xea, 0x32, 0x03,
+	0x00, 0x00,
 }
 
 func (m *DataRef) Marshal() (dAtA []byte, err error) {
@@ -355,6 +485,13 @@ func (m *Ref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.CompressionAlgo != 0 {
 		i = encodeVarintChunk(dAtA, i, uint64(m.CompressionAlgo))
 		i--
@@ -397,6 +534,78 @@ func (m *Ref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Export) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Export) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Export) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tier != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Gen != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.Gen))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Dek) > 0 {
+		i -= len(m.Dek)
+		copy(dAtA[i:], m.Dek)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.Dek)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PointsTo) > 0 {
+		for iNdEx := len(m.PointsTo) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PointsTo[iNdEx])
+			copy(dAtA[i:], m.PointsTo[iNdEx])
+			i = encodeVarintChunk(dAtA, i, uint64(len(m.PointsTo[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChunk(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChunk(v)
 	base := offset
@@ -460,6 +669,49 @@ func (m *Ref) Size() (n int) {
 	if m.CompressionAlgo != 0 {
 		n += 1 + sovChunk(uint64(m.CompressionAlgo))
 	}
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Export) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovChunk(uint64(m.SizeBytes))
+	}
+	if len(m.PointsTo) > 0 {
+		for _, b := range m.PointsTo {
+			l = len(b)
+			n += 1 + l + sovChunk(uint64(l))
+		}
+	}
+	l = len(m.Dek)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
+	if m.Gen != 0 {
+		n += 1 + sovChunk(uint64(m.Gen))
+	}
+	if m.Tier != 0 {
+		n += 1 + sovChunk(uint64(m.Tier))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -805,6 +1057,280 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Export) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Export: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Export: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsTo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointsTo = append(m.PointsTo, make([]byte, postIndex-iNdEx))
+			copy(m.PointsTo[len(m.PointsTo)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dek", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dek = append(m.Dek[:0], dAtA[iNdEx:postIndex]...)
+			if m.Dek == nil {
+				m.Dek = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gen", wireType)
+			}
+			m.Gen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChunk(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	fmt "fmt"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
@@ -20,6 +21,8 @@ import (
 type Client interface {
 	Create(ctx context.Context, md Metadata, chunkData []byte) (ID, error)
 	Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) error
+	GetKey(ctx context.Context, chunkID ID) (*WrappedKey, error)
+	GetEncryptionKey(ctx context.Context, keyID string) ([]byte, error)
 	Close() error
 }
 
@@ -30,18 +33,25 @@ type trackedClient struct {
 	db      *pachsql.DB
 	tracker track.Tracker
 	renewer *Renewer
+	keyring *Keyring
 	ttl     time.Duration
+
+	mu   sync.Mutex
+	keys map[string][]byte
 }
 
 // NewClient returns a client which will write to objc, mdstore, and tracker.  Name is used
-// for the set of temporary objects
-func NewClient(store kv.Store, db *pachsql.DB, tr track.Tracker, renewer *Renewer) Client {
+// for the set of temporary objects. The keyring, if set, unwraps the
+// encryption keys which are stored wrapped.
+func NewClient(store kv.Store, db *pachsql.DB, tr track.Tracker, renewer *Renewer, keyring *Keyring) Client {
 	return &trackedClient{
 		store:   store,
 		db:      db,
 		tracker: tr,
 		renewer: renewer,
+		keyring: keyring,
 		ttl:     defaultChunkTTL,
+		keys:    make(map[string][]byte),
 	}
 }
 
@@ -92,9 +102,22 @@ func (c *trackedClient) beforeUpload(ctx context.Context, chunkID ID, md Metadat
 			return errors.EnsureStack(err)
 		}
 		if len(ents) > 0 {
+			// the key of an uploaded chunk is kept, since md.Key may come
+			// from somewhere else, such as an imported archive
 			needUpload = false
 			return nil
 		}
+		if md.Key != nil {
+			// the object is uploaded with this key, replacing the key of any
+			// earlier upload which didn't finish
+			if _, err := tx.Exec(`
+			INSERT INTO storage.chunk_keys (chunk_id, kek_version, data)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (chunk_id) DO UPDATE SET kek_version = $2, data = $3
+			`, chunkID, md.Key.KEKVersion, md.Key.Data); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
 		if err := tx.Get(&gen, `
 		INSERT INTO storage.chunk_objects (chunk_id, size)
 		VALUES ($1, $2)
@@ -135,9 +158,12 @@ func (c *trackedClient) afterUpload(ctx context.Context, chunkID ID, gen uint64)
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) error {
-	var gen uint64
-	err := c.db.Get(&gen, `
-	SELECT gen
+	var ent struct {
+		Gen        uint64    `db:"gen"`
+		LastReadAt time.Time `db:"last_read_at"`
+	}
+	err := c.db.Get(&ent, `
+	SELECT gen, last_read_at
 	FROM storage.chunk_objects
 	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
 	LIMIT 1
@@ -148,10 +174,64 @@ func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback
 		}
 		return err
 	}
-	key := chunkKey(chunkID, gen)
+	// The last read time decides when the chunk is moved to the cold tier.
+	if time.Since(ent.LastReadAt) > readTrackingInterval {
+		if _, err := c.db.ExecContext(ctx, `
+		UPDATE storage.chunk_objects SET last_read_at = CURRENT_TIMESTAMP
+		WHERE chunk_id = $1 AND gen = $2
+		`, chunkID, ent.Gen); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	key := chunkKey(chunkID, ent.Gen)
 	return errors.EnsureStack(c.store.Get(ctx, key, cb))
 }
 
+// GetKey returns the wrapped data encryption key for a chunk with ID chunkID.
+func (c *trackedClient) GetKey(ctx context.Context, chunkID ID) (*WrappedKey, error) {
+	key := &WrappedKey{}
+	if err := c.db.GetContext(ctx, key, `
+	SELECT kek_version, data
+	FROM storage.chunk_keys
+	WHERE chunk_id = $1
+	`, chunkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Errorf("no key for chunk %v", chunkID)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return key, nil
+}
+
+// GetEncryptionKey returns the encryption key with ID keyID.
+func (c *trackedClient) GetEncryptionKey(ctx context.Context, keyID string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key, ok := c.keys[keyID]; ok {
+		return key, nil
+	}
+	stored := &WrappedKey{}
+	if err := c.db.GetContext(ctx, stored, `SELECT kek_version, data FROM storage.keys WHERE name = $1`, keyID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrapf(ErrEncryptionKeyNotExists, "key %v", keyID)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	key := stored.Data
+	// keys stored with a key encryption key version are wrapped
+	if stored.KEKVersion != 0 {
+		if c.keyring == nil {
+			return nil, errors.Errorf("encryption key %v is wrapped, but there is no keyring", keyID)
+		}
+		var err error
+		if key, err = c.keyring.Unwrap(stored); err != nil {
+			return nil, errors.Wrapf(err, "unwrapping encryption key %v", keyID)
+		}
+	}
+	c.keys[keyID] = key
+	return key, nil
+}
+
 // Close closes the client, stopping the background renewal of created objects
 func (c *trackedClient) Close() error {
 	if c.renewer != nil {
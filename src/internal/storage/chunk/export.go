@@ -0,0 +1,274 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// SetupPostgresStoreV3 adds the shared flag to chunk objects. The objects of
+// shared chunks were imported by reference from another cluster, which owns
+// them, so they are never deleted or moved between tiers by this cluster.
+func SetupPostgresStoreV3(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	ALTER TABLE storage.chunk_objects ADD COLUMN shared BOOLEAN NOT NULL DEFAULT FALSE
+	`)
+	return errors.EnsureStack(err)
+}
+
+// Exporter exports chunks along with the chunks they point to. Each chunk is
+// only exported once.
+type Exporter struct {
+	s              *Storage
+	referencesOnly bool
+	exported       map[string]struct{}
+}
+
+// NewExporter creates a new Exporter. If referencesOnly is true, the chunks'
+// objects are not exported, so the exports can only be imported into a
+// cluster which shares this cluster's bucket.
+func (s *Storage) NewExporter(referencesOnly bool) *Exporter {
+	return &Exporter{
+		s:              s,
+		referencesOnly: referencesOnly,
+		exported:       make(map[string]struct{}),
+	}
+}
+
+// Export calls cb with the export of the chunk with ID id, after the exports
+// of the chunks it points to, unless they have already been exported.
+func (e *Exporter) Export(ctx context.Context, id ID, cb func(*Export) error) error {
+	if _, ok := e.exported[string(id)]; ok {
+		return nil
+	}
+	downstream, err := e.s.tracker.GetDownstream(ctx, id.TrackerID())
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	export := &Export{Id: id}
+	for _, trackerID := range downstream {
+		pointsTo, err := ParseTrackerID(trackerID)
+		if err != nil {
+			return err
+		}
+		if err := e.Export(ctx, pointsTo, cb); err != nil {
+			return err
+		}
+		export.PointsTo = append(export.PointsTo, pointsTo)
+	}
+	var ent struct {
+		Gen  uint64 `db:"gen"`
+		Tier int32  `db:"tier"`
+		Size int64  `db:"size"`
+	}
+	if err := e.s.db.GetContext(ctx, &ent, `
+	SELECT gen, tier, size FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+	LIMIT 1
+	`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrapf(ErrChunkNotExists, "chunk %v", id)
+		}
+		return errors.EnsureStack(err)
+	}
+	export.SizeBytes = ent.Size
+	key := &WrappedKey{}
+	if err := e.s.db.GetContext(ctx, key, `
+	SELECT kek_version, data FROM storage.chunk_keys WHERE chunk_id = $1
+	`, id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.EnsureStack(err)
+	} else if err == nil {
+		if e.s.createOpts.Keyring == nil {
+			return errors.Errorf("chunk %v has a wrapped key, but chunk storage has no keyring", id)
+		}
+		export.Dek, err = e.s.createOpts.Keyring.Unwrap(key)
+		if err != nil {
+			return errors.Wrapf(err, "unwrapping key for chunk %v", id)
+		}
+	}
+	if e.referencesOnly {
+		export.Gen, export.Tier = ent.Gen, ent.Tier
+	} else if err := e.s.store.Get(ctx, chunkKey(id, ent.Gen), func(data []byte) error {
+		export.Data = append([]byte{}, data...)
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	e.exported[string(id)] = struct{}{}
+	return cb(export)
+}
+
+// Skip marks the chunk with ID id as exported, so neither it nor the chunks
+// it points to are exported, e.g. because the importer already has them.
+func (e *Exporter) Skip(id ID) {
+	e.exported[string(id)] = struct{}{}
+}
+
+// Closure returns ids along with the IDs of the chunks they point to, directly
+// or indirectly, without duplicates.
+func (s *Storage) Closure(ctx context.Context, ids []ID) ([]ID, error) {
+	var closure []ID
+	seen := make(map[string]struct{})
+	for len(ids) > 0 {
+		id := ids[len(ids)-1]
+		ids = ids[:len(ids)-1]
+		if _, ok := seen[string(id)]; ok {
+			continue
+		}
+		seen[string(id)] = struct{}{}
+		closure = append(closure, id)
+		downstream, err := s.tracker.GetDownstream(ctx, id.TrackerID())
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		for _, trackerID := range downstream {
+			pointsTo, err := ParseTrackerID(trackerID)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, pointsTo)
+		}
+	}
+	return closure, nil
+}
+
+// Missing returns the IDs in ids of the chunks which don't exist, or haven't
+// been uploaded yet.
+func (s *Storage) Missing(ctx context.Context, ids []ID) ([]ID, error) {
+	var query [][]byte
+	for _, id := range ids {
+		query = append(query, id)
+	}
+	var existing [][]byte
+	if err := s.db.SelectContext(ctx, &existing, `
+	SELECT DISTINCT chunk_id FROM storage.chunk_objects
+	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = ANY($1)
+	`, query); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	exists := make(map[string]struct{})
+	for _, id := range existing {
+		exists[string(id)] = struct{}{}
+	}
+	var missing []ID
+	for _, id := range ids {
+		if _, ok := exists[string(id)]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// Importer imports exported chunks. The imported chunks are kept alive until
+// the importer is closed, so the objects pointing to them can be imported.
+type Importer struct {
+	s          *Storage
+	client     Client
+	references bool
+}
+
+// NewImporter creates a new Importer. Chunks exported by reference are only
+// imported if references is true, because they make the importer trust the
+// metadata of objects it didn't write.
+func (s *Storage) NewImporter(ctx context.Context, name string, references bool) *Importer {
+	return &Importer{
+		s:          s,
+		client:     NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.createOpts.Keyring),
+		references: references,
+	}
+}
+
+// Import imports an exported chunk with the same ID, so the references to it
+// stay valid. The chunks it points to must have been imported first.
+func (im *Importer) Import(ctx context.Context, export *Export) error {
+	id := ID(export.Id)
+	md := Metadata{Size: int(export.SizeBytes)}
+	for _, pointsTo := range export.PointsTo {
+		md.PointsTo = append(md.PointsTo, pointsTo)
+	}
+	if len(export.Dek) > 0 {
+		if im.s.createOpts.Keyring == nil {
+			return errors.Errorf("chunk %v has a wrapped key, but chunk storage has no keyring", id)
+		}
+		var err error
+		md.Key, err = im.s.createOpts.Keyring.Wrap(export.Dek)
+		if err != nil {
+			return err
+		}
+	}
+	if len(export.Data) == 0 {
+		if !im.references {
+			return errors.Errorf("chunk %v was exported by reference, which this import doesn't allow", id)
+		}
+		return im.importReference(ctx, id, md, export.Gen, export.Tier)
+	}
+	actual, err := im.client.Create(ctx, md, export.Data)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(actual, id) {
+		return errors.Wrapf(ErrChunkTampered, "bad chunk. HAVE: %v WANT: %v", actual, id)
+	}
+	return nil
+}
+
+// importReference imports a chunk whose object is in the bucket shared with
+// the exporting cluster.
+func (im *Importer) importReference(ctx context.Context, id ID, md Metadata, gen uint64, tier int32) error {
+	exists, err := im.s.store.Exists(ctx, chunkKey(id, gen))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if !exists {
+		return errors.Errorf("no object for chunk %v, chunks exported by reference can only be imported by a cluster sharing the bucket", id)
+	}
+	var pointsTo []string
+	for _, cid := range md.PointsTo {
+		pointsTo = append(pointsTo, cid.TrackerID())
+	}
+	c := im.client.(*trackedClient)
+	if err := dbutil.WithTx(ctx, im.s.db, func(tx *pachsql.Tx) error {
+		if err := im.s.tracker.CreateTx(tx, id.TrackerID(), pointsTo, c.ttl); err != nil {
+			return errors.EnsureStack(err)
+		}
+		// The key of a chunk this cluster already has is never replaced, since
+		// the archive's key can't be checked against the object.
+		if md.Key != nil {
+			if _, err := tx.Exec(`
+			INSERT INTO storage.chunk_keys (chunk_id, kek_version, data)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (chunk_id) DO NOTHING
+			`, id, md.Key.KEKVersion, md.Key.Data); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		var ents []Entry
+		if err := tx.Select(&ents, `
+		SELECT chunk_id, gen
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1`, id); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(ents) > 0 {
+			return nil
+		}
+		_, err := tx.Exec(`
+		INSERT INTO storage.chunk_objects (chunk_id, gen, size, uploaded, tier, shared)
+		VALUES ($1, $2, $3, TRUE, $4, TRUE)
+		`, id, gen, md.Size, tier)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return err
+	}
+	return c.renewer.Add(ctx, id)
+}
+
+// Close closes the importer. The imported chunks are deleted unless other
+// objects point to them.
+func (im *Importer) Close() error {
+	return im.client.Close()
+}
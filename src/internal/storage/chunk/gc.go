@@ -44,7 +44,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 // RunOnce runs 1 cycle of garbage collection.
 func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 	rows, err := gc.s.db.QueryxContext(ctx, `
-	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
+	SELECT chunk_id, gen, uploaded, shared FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
@@ -75,8 +75,11 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
-	if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
-		return err
+	// The objects of shared chunks are owned by the cluster they were imported from.
+	if !ent.Shared {
+		if err := gc.deleteObject(ctx, ent.ChunkID, ent.Gen); err != nil {
+			return err
+		}
 	}
 	return gc.deleteEntry(ctx, ent.ChunkID, ent.Gen)
 }
@@ -90,5 +93,12 @@ func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uin
 	DELETE FROM storage.chunk_objects
 	WHERE chunk_id = $1 AND gen = $2 AND tombstone = TRUE
 	`, chunkID, gen)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = gc.s.db.ExecContext(ctx, `
+	DELETE FROM storage.chunk_keys
+	WHERE chunk_id = $1 AND NOT EXISTS (SELECT 1 FROM storage.chunk_objects WHERE chunk_id = $1)
+	`, chunkID)
 	return errors.EnsureStack(err)
 }
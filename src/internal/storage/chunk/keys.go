@@ -0,0 +1,385 @@
+package chunk
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const rewrapBatchSize = 100
+
+// WrappedKey is a data encryption key encrypted with a version of a key
+// encryption key.
+type WrappedKey struct {
+	KEKVersion uint64 `db:"kek_version"`
+	Data       []byte `db:"data"`
+}
+
+// Keyring holds versioned key encryption keys, which wrap the data encryption
+// keys of chunks. New keys are wrapped with the active version, which is the
+// highest version. Keys wrapped with older versions can be rewrapped with the
+// active version without rewriting the chunks, so the key encryption key can
+// be rotated by adding a new version.
+type Keyring struct {
+	keks   map[uint64]cipher.AEAD
+	active uint64
+}
+
+// NewKeyring creates a new keyring from 32 byte keys indexed by version.
+func NewKeyring(keys map[uint64][]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("keyring must have at least one key")
+	}
+	k := &Keyring{keks: make(map[uint64]cipher.AEAD)}
+	for version, key := range keys {
+		if version == 0 {
+			return nil, errors.New("key encryption key versions must be positive")
+		}
+		kek, err := chacha20poly1305.NewX(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key encryption key version %d", version)
+		}
+		k.keks[version] = kek
+		if version > k.active {
+			k.active = version
+		}
+	}
+	return k, nil
+}
+
+// ParseKeyring parses a keyring from a comma separated list of
+// "<version>:<base64 key>" pairs.
+func ParseKeyring(s string) (*Keyring, error) {
+	keys := make(map[uint64][]byte)
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid key encryption key %q, must be of the form <version>:<base64 key>", pair)
+		}
+		version, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key encryption key version %q", parts[0])
+		}
+		if _, ok := keys[version]; ok {
+			return nil, errors.Errorf("duplicate key encryption key version %d", version)
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key encryption key version %d", version)
+		}
+		keys[version] = key
+	}
+	return NewKeyring(keys)
+}
+
+// ActiveVersion returns the version of the key encryption key used to wrap new keys.
+func (k *Keyring) ActiveVersion() uint64 {
+	return k.active
+}
+
+// Wrap wraps dek with the active key encryption key.
+func (k *Keyring) Wrap(dek []byte) (*WrappedKey, error) {
+	kek := k.keks[k.active]
+	nonce := make([]byte, kek.NonceSize(), kek.NonceSize()+len(dek)+kek.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return &WrappedKey{
+		KEKVersion: k.active,
+		Data:       kek.Seal(nonce, nonce, dek, nil),
+	}, nil
+}
+
+// Unwrap returns the data encryption key wrapped in key.
+func (k *Keyring) Unwrap(key *WrappedKey) ([]byte, error) {
+	kek, ok := k.keks[key.KEKVersion]
+	if !ok {
+		return nil, errors.Errorf("no key encryption key with version %d", key.KEKVersion)
+	}
+	if len(key.Data) < kek.NonceSize() {
+		return nil, errors.Wrapf(ErrChunkTampered, "wrapped key is too short")
+	}
+	nonce, ctext := key.Data[:kek.NonceSize()], key.Data[kek.NonceSize():]
+	dek, err := kek.Open(nil, nonce, ctext, nil)
+	if err != nil {
+		return nil, errors.Wrapf(ErrChunkTampered, "wrapped key failed authentication")
+	}
+	return dek, nil
+}
+
+// SetupPostgresStoreV1 adds the table of wrapped chunk keys.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresStoreV1(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE storage.chunk_keys (
+		chunk_id BYTEA NOT NULL,
+		kek_version INT8 NOT NULL,
+		data BYTEA NOT NULL,
+
+		PRIMARY KEY(chunk_id)
+	);
+
+	CREATE INDEX chunk_keys_kek_version ON storage.chunk_keys (kek_version)
+	`)
+	return errors.EnsureStack(err)
+}
+
+type keyEntry struct {
+	ChunkID ID `db:"chunk_id"`
+	WrappedKey
+}
+
+// RewrapKeys rewraps the chunk keys and the encryption keys which are wrapped
+// with an older version of the key encryption key with the active version, and
+// returns how many were rewrapped. Chunks stay readable while their keys are
+// rewrapped. Keys which can't be unwrapped, because their key encryption key
+// version is missing from the keyring or they were tampered with, are logged
+// and skipped, so they don't block the rotation of the others, and the number
+// skipped is returned.
+func (s *Storage) RewrapKeys(ctx context.Context, log *logrus.Logger) (rewrapped, skipped int, retErr error) {
+	keyring := s.createOpts.Keyring
+	if keyring == nil {
+		return 0, 0, errors.New("chunk storage has no keyring")
+	}
+	rewrapped, skipped, err := s.rewrapEncryptionKeys(ctx, log)
+	if err != nil {
+		return rewrapped, skipped, err
+	}
+	// The chunk keys are paged through by ID, so that the skipped keys aren't
+	// selected again.
+	after := ID{}
+	for {
+		var ents []keyEntry
+		var n, nSkipped int
+		if err := dbutil.WithTx(ctx, s.db, func(tx *pachsql.Tx) error {
+			n, nSkipped = 0, 0
+			ents = nil
+			if err := tx.SelectContext(ctx, &ents, `
+			SELECT chunk_id, kek_version, data FROM storage.chunk_keys
+			WHERE kek_version <> $1 AND chunk_id > $2
+			ORDER BY chunk_id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+			`, keyring.ActiveVersion(), after, rewrapBatchSize); err != nil {
+				return errors.EnsureStack(err)
+			}
+			for _, ent := range ents {
+				dek, err := keyring.Unwrap(&ent.WrappedKey)
+				if err != nil {
+					log.Errorf("skipping rewrap of the key for chunk %v: %v", ent.ChunkID.HexString(), err)
+					nSkipped++
+					continue
+				}
+				key, err := keyring.Wrap(dek)
+				if err != nil {
+					return err
+				}
+				if _, err := tx.ExecContext(ctx, `
+				UPDATE storage.chunk_keys SET kek_version = $1, data = $2
+				WHERE chunk_id = $3
+				`, key.KEKVersion, key.Data, ent.ChunkID); err != nil {
+					return errors.EnsureStack(err)
+				}
+				n++
+			}
+			return nil
+		}); err != nil {
+			return rewrapped, skipped, err
+		}
+		rewrapped += n
+		skipped += nSkipped
+		if len(ents) < rewrapBatchSize {
+			return rewrapped, skipped, nil
+		}
+		after = ents[len(ents)-1].ChunkID
+	}
+}
+
+// rewrapEncryptionKeys rewraps the wrapped encryption keys, such as the keys
+// of repos, which are few enough to be rewrapped in one transaction.
+func (s *Storage) rewrapEncryptionKeys(ctx context.Context, log *logrus.Logger) (rewrapped, skipped int, retErr error) {
+	keyring := s.createOpts.Keyring
+	err := dbutil.WithTx(ctx, s.db, func(tx *pachsql.Tx) error {
+		rewrapped, skipped = 0, 0
+		var ents []struct {
+			Name string `db:"name"`
+			WrappedKey
+		}
+		if err := tx.SelectContext(ctx, &ents, `
+		SELECT name, kek_version, data FROM storage.keys
+		WHERE kek_version <> 0 AND kek_version <> $1
+		FOR UPDATE
+		`, keyring.ActiveVersion()); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, ent := range ents {
+			key, err := keyring.Unwrap(&ent.WrappedKey)
+			if err != nil {
+				log.Errorf("skipping rewrap of encryption key %v: %v", ent.Name, err)
+				skipped++
+				continue
+			}
+			wrapped, err := keyring.Wrap(key)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `
+			UPDATE storage.keys SET kek_version = $1, data = $2
+			WHERE name = $3
+			`, wrapped.KEKVersion, wrapped.Data, ent.Name); err != nil {
+				return errors.EnsureStack(err)
+			}
+			rewrapped++
+		}
+		return nil
+	})
+	return rewrapped, skipped, err
+}
+
+// RewrapKeysForever calls RewrapKeys every period until the context is
+// cancelled, logging any errors.
+func (s *Storage) RewrapKeysForever(ctx context.Context, period time.Duration, log *logrus.Logger) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		n, skipped, err := s.RewrapKeys(ctx, log)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Errorf("during chunk key rewrap: %v", err)
+		}
+		if n > 0 {
+			log.Infof("rewrapped %d chunk keys with key encryption key version %d", n, s.createOpts.Keyring.ActiveVersion())
+		}
+		if skipped > 0 {
+			log.Errorf("skipped %d chunk keys which could not be unwrapped", skipped)
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetEncryptionKey returns the encryption key with ID keyID.
+func (s *Storage) GetEncryptionKey(ctx context.Context, keyID string) ([]byte, error) {
+	return NewClient(s.store, s.db, s.tracker, nil, s.createOpts.Keyring).GetEncryptionKey(ctx, keyID)
+}
+
+type encryptionKeyIDKey struct{}
+
+// WithEncryptionKey returns a context which creates chunks encrypted with the
+// key with ID keyID, rather than with keys derived from the storage secret.
+// Identical data is only deduplicated between chunks encrypted with the same
+// key.
+func WithEncryptionKey(ctx context.Context, keyID string) context.Context {
+	return context.WithValue(ctx, encryptionKeyIDKey{}, keyID)
+}
+
+// EncryptionKeyID returns the ID of the key set with WithEncryptionKey, or the
+// empty string.
+func EncryptionKeyID(ctx context.Context) string {
+	if v := ctx.Value(encryptionKeyIDKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
+
+// SetupPostgresStoreV4 adds the key encryption key version of the encryption
+// keys, which is 0 for the keys which are not wrapped.
+func SetupPostgresStoreV4(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	ALTER TABLE storage.keys ADD COLUMN kek_version INT8 NOT NULL DEFAULT 0
+	`)
+	return errors.EnsureStack(err)
+}
+
+// CreateEncryptionKeyTx creates a random encryption key with ID keyID.
+func (s *Storage) CreateEncryptionKeyTx(tx *pachsql.Tx, keyID string) error {
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return s.putEncryptionKeyTx(tx, keyID, key)
+}
+
+// ImportEncryptionKeyTx creates the encryption key with ID keyID from a key
+// exported by another cluster, so the chunks encrypted with it can be imported.
+func (s *Storage) ImportEncryptionKeyTx(tx *pachsql.Tx, keyID string, key []byte) error {
+	if len(key) != chacha20poly1305.KeySize {
+		return errors.Errorf("encryption key %v must be %d bytes", keyID, chacha20poly1305.KeySize)
+	}
+	return s.putEncryptionKeyTx(tx, keyID, key)
+}
+
+// CreateSecretTx stores secret, such as a credential for another cluster,
+// with ID secretID alongside the encryption keys, so it's wrapped with the
+// keyring and rewrapped with RewrapKeys like them. It's read with
+// GetEncryptionKey and destroyed with DeleteEncryptionKeyTx.
+func (s *Storage) CreateSecretTx(tx *pachsql.Tx, secretID string, secret []byte) error {
+	return s.putEncryptionKeyTx(tx, secretID, secret)
+}
+
+// putEncryptionKeyTx stores key, wrapped with the keyring if there is one.
+func (s *Storage) putEncryptionKeyTx(tx *pachsql.Tx, keyID string, key []byte) error {
+	stored := &WrappedKey{Data: key}
+	if s.createOpts.Keyring != nil {
+		var err error
+		if stored, err = s.createOpts.Keyring.Wrap(key); err != nil {
+			return err
+		}
+	}
+	_, err := tx.Exec(`INSERT INTO storage.keys (name, kek_version, data) VALUES ($1, $2, $3)`, keyID, stored.KEKVersion, stored.Data)
+	return errors.EnsureStack(err)
+}
+
+// DeleteEncryptionKeyTx destroys the encryption key with ID keyID, which makes
+// the chunks encrypted with it unreadable, including copies of them.
+func DeleteEncryptionKeyTx(tx *pachsql.Tx, keyID string) error {
+	_, err := tx.Exec(`DELETE FROM storage.keys WHERE name = $1`, keyID)
+	return errors.EnsureStack(err)
+}
+
+// wrapKey wraps dek with key. The nonce is derived from dek, so wrapping a
+// data encryption key always returns the same chunk reference.
+func wrapKey(key, dek []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	nonce := Hash(dek)[:aead.NonceSize()]
+	wrapped := make([]byte, 0, len(nonce)+len(dek)+aead.Overhead())
+	wrapped = append(wrapped, nonce...)
+	return aead.Seal(wrapped, nonce, dek, nil), nil
+}
+
+// unwrapKey returns the data encryption key wrapped with key by wrapKey.
+func unwrapKey(key, wrapped []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.Wrapf(ErrChunkTampered, "wrapped key is too short")
+	}
+	dek, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrapf(ErrChunkTampered, "wrapped key failed authentication")
+	}
+	return dek, nil
+}
@@ -0,0 +1,140 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRewrapKeys(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	key1, key2 := randomBytes(t, 32), randomBytes(t, 32)
+	key2[0] ^= 1
+	keyring1, err := NewKeyring(map[uint64][]byte{1: key1})
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithKeyring(keyring1))
+
+	data := make([]byte, 1e7)
+	_, err = rand.New(rand.NewSource(10)).Read(data)
+	require.NoError(t, err)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	n, skipped, err := s.RewrapKeys(ctx, logrus.New())
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.Equal(t, 0, skipped)
+
+	// Rotate the key encryption key, then read the data with only the new version.
+	keyring2, err := NewKeyring(map[uint64][]byte{1: key1, 2: key2})
+	require.NoError(t, err)
+	n, skipped, err = NewStorage(oc, kv.NewMemCache(10), db, tracker, WithKeyring(keyring2)).RewrapKeys(ctx, logrus.New())
+	require.NoError(t, err)
+	require.True(t, n > 0)
+	require.Equal(t, 0, skipped)
+	var count int
+	require.NoError(t, db.GetContext(ctx, &count, `SELECT COUNT(*) FROM storage.chunk_keys WHERE kek_version <> 2`))
+	require.Equal(t, 0, count)
+	keyring3, err := NewKeyring(map[uint64][]byte{2: key2})
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	r := NewStorage(oc, kv.NewMemCache(10), db, tracker, WithKeyring(keyring3)).NewReader(ctx, dataRefs)
+	require.NoError(t, r.Get(buf))
+	require.True(t, bytes.Equal(data, buf.Bytes()))
+}
+
+func TestWrappedEncryptionKeys(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	key1, key2 := randomBytes(t, 32), randomBytes(t, 32)
+	keyring1, err := NewKeyring(map[uint64][]byte{1: key1})
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithKeyring(keyring1))
+	imported := randomBytes(t, 32)
+	secret := []byte("token")
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *pachsql.Tx) error {
+		if err := s.CreateEncryptionKeyTx(tx, "created"); err != nil {
+			return err
+		}
+		if err := s.CreateSecretTx(tx, "secret", secret); err != nil {
+			return err
+		}
+		return s.ImportEncryptionKeyTx(tx, "imported", imported)
+	}))
+	// The keys are stored wrapped.
+	var data []byte
+	require.NoError(t, db.GetContext(ctx, &data, `SELECT data FROM storage.keys WHERE name = 'imported'`))
+	require.False(t, bytes.Equal(imported, data))
+	require.NoError(t, db.GetContext(ctx, &data, `SELECT data FROM storage.keys WHERE name = 'secret'`))
+	require.False(t, bytes.Equal(secret, data))
+	created, err := s.GetEncryptionKey(ctx, "created")
+	require.NoError(t, err)
+
+	// Rotate the key encryption key, then read the keys with only the new version.
+	keyring2, err := NewKeyring(map[uint64][]byte{1: key1, 2: key2})
+	require.NoError(t, err)
+	n, skipped, err := NewStorage(oc, kv.NewMemCache(10), db, tracker, WithKeyring(keyring2)).RewrapKeys(ctx, logrus.New())
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, 0, skipped)
+	keyring3, err := NewKeyring(map[uint64][]byte{2: key2})
+	require.NoError(t, err)
+	s = NewStorage(oc, kv.NewMemCache(10), db, tracker, WithKeyring(keyring3))
+	key, err := s.GetEncryptionKey(ctx, "created")
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(created, key))
+	key, err = s.GetEncryptionKey(ctx, "imported")
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(imported, key))
+	key, err = s.GetEncryptionKey(ctx, "secret")
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(secret, key))
+}
+
+func TestRewrapKeysSkipsTamperedKeys(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	key1, key2 := randomBytes(t, 32), randomBytes(t, 32)
+	key2[0] ^= 1
+	keyring1, err := NewKeyring(map[uint64][]byte{1: key1})
+	require.NoError(t, err)
+	oc, s := NewTestStorage(t, db, tracker, WithKeyring(keyring1))
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *pachsql.Tx) error {
+		if err := s.CreateEncryptionKeyTx(tx, "intact"); err != nil {
+			return err
+		}
+		return s.CreateEncryptionKeyTx(tx, "tampered")
+	}))
+	_, err = db.ExecContext(ctx, `UPDATE storage.keys SET data = $1 WHERE name = 'tampered'`, randomBytes(t, 64))
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO storage.chunk_keys (chunk_id, kek_version, data) VALUES ($1, 1, $2)`, randomBytes(t, 32), randomBytes(t, 64))
+	require.NoError(t, err)
+
+	// The tampered keys are skipped, and the others are still rewrapped.
+	keyring2, err := NewKeyring(map[uint64][]byte{1: key1, 2: key2})
+	require.NoError(t, err)
+	n, skipped, err := NewStorage(oc, kv.NewMemCache(10), db, tracker, WithKeyring(keyring2)).RewrapKeys(ctx, logrus.New())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, 2, skipped)
+	var version uint64
+	require.NoError(t, db.GetContext(ctx, &version, `SELECT kek_version FROM storage.keys WHERE name = 'intact'`))
+	require.Equal(t, uint64(2), version)
+}
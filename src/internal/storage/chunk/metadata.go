@@ -51,11 +51,17 @@ func (id ID) TrackerID() string {
 type Metadata struct {
 	Size     int
 	PointsTo []ID
+	// Key is the wrapped data encryption key of the chunk, if it has one.
+	Key *WrappedKey
 }
 
 var (
 	// ErrChunkNotExists chunk does not exist
 	ErrChunkNotExists = errors.Errorf("chunk does not exist")
+	// ErrChunkTampered chunk or its key failed verification or authentication
+	ErrChunkTampered = errors.Errorf("chunk was tampered with")
+	// ErrEncryptionKeyNotExists encryption key does not exist, it may have been destroyed
+	ErrEncryptionKeyNotExists = errors.Errorf("encryption key does not exist")
 )
 
 // Entry is an chunk object mapping
@@ -64,6 +70,7 @@ type Entry struct {
 	Gen       uint64 `db:"gen"`
 	Uploaded  bool   `db:"uploaded"`
 	Tombstone bool   `db:"tombstone"`
+	Shared    bool   `db:"shared"`
 }
 
 // SetupPostgresStoreV0 sets up tables in db
@@ -3,7 +3,9 @@ package chunk
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -27,6 +29,15 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithColdTier stores chunks in the currently configured object client until
+// they are moved to cold, see DemoteChunks.
+func WithColdTier(cold obj.Client) StorageOption {
+	return func(s *Storage) {
+		s.tiers = obj.NewTieredClient(s.objClient, cold)
+		s.objClient = s.tiers
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -34,6 +45,20 @@ func WithSecret(secret []byte) StorageOption {
 	}
 }
 
+// WithEncryption sets the algorithm used to encrypt chunks
+func WithEncryption(algo EncryptionAlgo) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.Encryption = algo
+	}
+}
+
+// WithKeyring sets the keyring used to wrap the data encryption keys of chunks
+func WithKeyring(keyring *Keyring) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.Keyring = keyring
+	}
+}
+
 // WithCompression sets the compression algorithm used to compress chunks
 func WithCompression(algo CompressionAlgo) StorageOption {
 	return func(s *Storage) {
@@ -41,12 +66,44 @@ func WithCompression(algo CompressionAlgo) StorageOption {
 	}
 }
 
+// WithCompressionLevel sets the algorithm specific level used to compress chunks
+func WithCompressionLevel(level int) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.CompressionLevel = level
+	}
+}
+
+// WithCompressionSampling sets the number of bytes sampled from each chunk to decide
+// if the chunk should be compressed
+func WithCompressionSampling(sampleSize int) StorageOption {
+	return func(s *Storage) {
+		s.createOpts.CompressionSampleSize = sampleSize
+	}
+}
+
 // StorageOptions returns the chunk storage options for the config.
 func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, error) {
 	var opts []StorageOption
 	if conf.StorageUploadConcurrencyLimit > 0 {
 		opts = append(opts, WithMaxConcurrentObjects(0, conf.StorageUploadConcurrencyLimit))
 	}
+	if conf.StorageColdTierURL != "" {
+		url, err := obj.ParseURL(conf.StorageColdTierURL)
+		if err != nil {
+			return nil, err
+		}
+		cold, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		// The hot tier is already limited by the option above, and the cold
+		// tier is limited separately, so moving chunks between the tiers is
+		// limited too.
+		if conf.StorageUploadConcurrencyLimit > 0 {
+			cold = obj.NewLimitedClient(cold, 0, conf.StorageUploadConcurrencyLimit)
+		}
+		opts = append(opts, WithColdTier(cold))
+	}
 	if conf.StorageDiskCacheSize > 0 {
 		diskCache, err := obj.NewLocalClient(filepath.Join(os.TempDir(), "pfs-cache", uuid.NewWithoutDashes()))
 		if err != nil {
@@ -55,9 +112,54 @@ func StorageOptions(conf *serviceenv.StorageConfiguration) ([]StorageOption, err
 		diskCache = obj.TracingObjClient("DiskCache", diskCache)
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageCompressionAlgo != "" {
+		algo, err := ParseCompressionAlgo(conf.StorageCompressionAlgo)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithCompression(algo))
+	}
+	if conf.StorageEncryptionAlgo != "" {
+		algo, err := ParseEncryptionAlgo(conf.StorageEncryptionAlgo)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithEncryption(algo))
+	}
+	if conf.StorageEncryptionKeys != "" {
+		keyring, err := ParseKeyring(conf.StorageEncryptionKeys)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithKeyring(keyring))
+	}
+	if conf.StorageCompressionLevel > 0 {
+		opts = append(opts, WithCompressionLevel(conf.StorageCompressionLevel))
+	}
+	if conf.StorageCompressionSampleSize > 0 {
+		opts = append(opts, WithCompressionSampling(conf.StorageCompressionSampleSize))
+	}
 	return opts, nil
 }
 
+// ParseCompressionAlgo parses a compression algorithm name (case insensitive), such as "zstd".
+func ParseCompressionAlgo(name string) (CompressionAlgo, error) {
+	algo, ok := CompressionAlgo_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, errors.Errorf("unrecognized compression: %v", name)
+	}
+	return CompressionAlgo(algo), nil
+}
+
+// ParseEncryptionAlgo parses an encryption algorithm name (case insensitive), such as "xchacha20_poly1305".
+func ParseEncryptionAlgo(name string) (EncryptionAlgo, error) {
+	algo, ok := EncryptionAlgo_value[strings.ToUpper(name)]
+	if !ok || algo == int32(EncryptionAlgo_ENCRYPTION_ALGO_UNKNOWN) {
+		return 0, errors.Errorf("unrecognized encryption: %v", name)
+	}
+	return EncryptionAlgo(algo), nil
+}
+
 type BatcherOption func(b *Batcher)
 
 func WithChunkCallback(cb ChunkFunc) BatcherOption {
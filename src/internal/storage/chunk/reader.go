@@ -18,10 +18,12 @@ import (
 type Reader struct {
 	ctx           context.Context
 	client        Client
+	keyring       *Keyring
 	memCache      kv.GetPut
 	deduper       *miscutil.WorkDeduper
 	dataRefs      []*DataRef
 	offsetBytes   int64
+	sizeBytes     int64
 	prefetchLimit int
 }
 
@@ -33,10 +35,19 @@ func WithOffsetBytes(offsetBytes int64) ReaderOption {
 	}
 }
 
-func newReader(ctx context.Context, client Client, memCache kv.GetPut, deduper *miscutil.WorkDeduper, prefetchLimit int, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
+// WithSizeBytes limits the data read to sizeBytes, starting at the offset.
+// Data references past the limit are not read.
+func WithSizeBytes(sizeBytes int64) ReaderOption {
+	return func(r *Reader) {
+		r.sizeBytes = sizeBytes
+	}
+}
+
+func newReader(ctx context.Context, client Client, keyring *Keyring, memCache kv.GetPut, deduper *miscutil.WorkDeduper, prefetchLimit int, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
 	r := &Reader{
 		ctx:           ctx,
 		client:        client,
+		keyring:       keyring,
 		memCache:      memCache,
 		deduper:       deduper,
 		prefetchLimit: prefetchLimit,
@@ -50,20 +61,28 @@ func newReader(ctx context.Context, client Client, memCache kv.GetPut, deduper *
 
 // Iterate iterates over the data readers for the data references.
 func (r *Reader) Iterate(cb func(*DataReader) error) error {
-	offset := r.offsetBytes
+	offset, remaining := r.offsetBytes, r.sizeBytes
 	for _, dataRef := range r.dataRefs {
 		if dataRef.SizeBytes <= offset {
 			offset -= dataRef.SizeBytes
 			continue
 		}
-		dr := newDataReader(r.ctx, r.client, r.memCache, r.deduper, dataRef, offset)
+		size := dataRef.SizeBytes - offset
+		if r.sizeBytes > 0 && size > remaining {
+			size = remaining
+		}
+		dr := newDataReader(r.ctx, r.client, r.keyring, r.memCache, r.deduper, dataRef, offset, size)
 		offset = 0
+		remaining -= size
 		if err := cb(dr); err != nil {
 			if errors.Is(err, errutil.ErrBreak) {
 				return nil
 			}
 			return err
 		}
+		if r.sizeBytes > 0 && remaining == 0 {
+			return nil
+		}
 	}
 	return nil
 }
@@ -102,20 +121,24 @@ func (r *Reader) Get(w io.Writer) (retErr error) {
 type DataReader struct {
 	ctx      context.Context
 	client   Client
+	keyring  *Keyring
 	memCache kv.GetPut
 	deduper  *miscutil.WorkDeduper
 	dataRef  *DataRef
 	offset   int64
+	size     int64
 }
 
-func newDataReader(ctx context.Context, client Client, memCache kv.GetPut, deduper *miscutil.WorkDeduper, dataRef *DataRef, offset int64) *DataReader {
+func newDataReader(ctx context.Context, client Client, keyring *Keyring, memCache kv.GetPut, deduper *miscutil.WorkDeduper, dataRef *DataRef, offset, size int64) *DataReader {
 	return &DataReader{
 		ctx:      ctx,
 		client:   client,
+		keyring:  keyring,
 		memCache: memCache,
 		deduper:  deduper,
 		dataRef:  dataRef,
 		offset:   offset,
+		size:     size,
 	}
 }
 
@@ -130,15 +153,16 @@ func (dr *DataReader) Prefetch() error {
 
 // Get writes the data referenced by the data reference.
 func (dr *DataReader) Get(w io.Writer) error {
-	if dr.offset > dr.dataRef.SizeBytes {
-		return errors.Errorf("DataReader.offset cannot be greater than the dataRef size. offset size: %v, dataRef size: %v.", dr.offset, dr.dataRef.SizeBytes)
+	if dr.offset+dr.size > dr.dataRef.SizeBytes {
+		return errors.Errorf("DataReader.offset + DataReader.size cannot be greater than the dataRef size. offset size: %v, size: %v, dataRef size: %v.", dr.offset, dr.size, dr.dataRef.SizeBytes)
 	}
 	ref := dr.dataRef.Ref
 	b := backoff.NewExponentialBackOff()
 	b.InitialInterval = 1 * time.Millisecond
 	return backoff.RetryUntilCancel(dr.ctx, func() error {
 		return getFromCache(dr.ctx, dr.memCache, ref, func(chunk []byte) error {
-			data := chunk[dr.dataRef.OffsetBytes+dr.offset : dr.dataRef.OffsetBytes+dr.dataRef.SizeBytes]
+			start := dr.dataRef.OffsetBytes + dr.offset
+			data := chunk[start : start+dr.size]
 			_, err := w.Write(data)
 			return errors.EnsureStack(err)
 		})
@@ -147,7 +171,7 @@ func (dr *DataReader) Get(w io.Writer) error {
 			return err
 		}
 		return dr.deduper.Do(dr.ctx, ref.Key(), func() error {
-			return Get(dr.ctx, dr.client, ref, func(rawData []byte) error {
+			return Get(dr.ctx, dr.client, dr.keyring, ref, func(rawData []byte) error {
 				return putInCache(dr.ctx, dr.memCache, ref, rawData)
 			})
 		})
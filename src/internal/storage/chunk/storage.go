@@ -27,6 +27,7 @@ type Storage struct {
 	db            *pachsql.DB
 	tracker       track.Tracker
 	store         kv.Store
+	tiers         *obj.TieredClient
 	memCache      kv.GetPut
 	deduper       *miscutil.WorkDeduper
 	prefetchLimit int
@@ -43,8 +44,11 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 		memCache:      memCache,
 		deduper:       &miscutil.WorkDeduper{},
 		prefetchLimit: defaultPrefetchLimit,
+		// Chunks are not compressed unless configured, as they weren't before
+		// compression was configurable. The compression is part of a chunk's
+		// ID, so changing it means new chunks don't dedupe with existing ones.
 		createOpts: CreateOptions{
-			Compression: CompressionAlgo_GZIP_BEST_SPEED,
+			Compression: CompressionAlgo_NONE,
 		},
 	}
 	for _, opt := range opts {
@@ -57,8 +61,15 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *pachsql.DB, tracker tra
 
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef, opts ...ReaderOption) *Reader {
-	client := NewClient(s.store, s.db, s.tracker, nil)
-	return newReader(ctx, client, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
+	client := NewClient(s.store, s.db, s.tracker, nil, s.createOpts.Keyring)
+	return newReader(ctx, client, s.createOpts.Keyring, s.memCache, s.deduper, s.prefetchLimit, dataRefs, opts...)
+}
+
+// createOptions returns the options for creating chunks with ctx, see WithEncryptionKey.
+func (s *Storage) createOptions(ctx context.Context) CreateOptions {
+	opts := s.createOpts
+	opts.KeyID = EncryptionKeyID(ctx)
+	return opts
 }
 
 // List lists all of the chunks in object storage.
@@ -68,6 +79,35 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	}))
 }
 
+// ListSizes calls cb with the ID and size of each chunk that has been uploaded.
+// The size is the size of the chunk before compression and encryption.
+func (s *Storage) ListSizes(ctx context.Context, cb func(id ID, size int64) error) (retErr error) {
+	rows, err := s.db.QueryxContext(ctx, `
+	SELECT chunk_id, MAX(size) FROM storage.chunk_objects
+	WHERE uploaded = true AND tombstone = false
+	GROUP BY chunk_id
+	`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	for rows.Next() {
+		var id ID
+		var size int64
+		if err := rows.Scan(&id, &size); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(id, size); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
@@ -77,7 +117,7 @@ func (s *Storage) NewDeleter() track.Deleter {
 // It will check objects for chunks with IDs in the range [first, last)
 // As a special case: if len(end) == 0 then it is ignored.
 func (s *Storage) Check(ctx context.Context, begin, end []byte, readChunks bool) (int, error) {
-	c := NewClient(s.store, s.db, s.tracker, nil).(*trackedClient)
+	c := NewClient(s.store, s.db, s.tracker, nil, s.createOpts.Keyring).(*trackedClient)
 	first := append([]byte{}, begin...)
 	var count int
 	for {
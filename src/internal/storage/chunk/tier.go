@@ -0,0 +1,120 @@
+package chunk
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TierHot is the tier of new chunks.
+	TierHot = 0
+	// TierCold is the tier of chunks which have not been read for a while.
+	TierCold = 1
+
+	demoteBatchSize = 100
+	// readTrackingInterval is how stale the last read time of a chunk can be,
+	// which avoids writing to the database on every read.
+	readTrackingInterval = time.Hour
+)
+
+// SetupPostgresStoreV2 adds the object storage tier and the last read time to
+// chunk objects.
+func SetupPostgresStoreV2(ctx context.Context, tx *pachsql.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+	ALTER TABLE storage.chunk_objects
+		ADD COLUMN tier INT2 NOT NULL DEFAULT 0,
+		ADD COLUMN last_read_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+	CREATE INDEX chunk_objects_tier_last_read_at ON storage.chunk_objects (tier, last_read_at)
+	`)
+	return errors.EnsureStack(err)
+}
+
+// DemoteChunks moves the chunks in the hot tier which have not been read for
+// age to the cold tier, and returns how many were moved.
+func (s *Storage) DemoteChunks(ctx context.Context, age time.Duration) (int, error) {
+	if s.tiers == nil {
+		return 0, errors.New("chunk storage has no cold tier")
+	}
+	var count int
+	for {
+		var ents []Entry
+		if err := s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND shared = FALSE AND tier = $1
+		AND last_read_at < CURRENT_TIMESTAMP - $2 * interval '1 second'
+		LIMIT $3
+		`, TierHot, int64(age.Seconds()), demoteBatchSize); err != nil {
+			return count, errors.EnsureStack(err)
+		}
+		for _, ent := range ents {
+			if err := s.demote(ctx, ent); err != nil {
+				return count, errors.Wrapf(err, "demoting chunk %v", ent.ChunkID)
+			}
+		}
+		count += len(ents)
+		if len(ents) < demoteBatchSize {
+			return count, nil
+		}
+	}
+}
+
+func (s *Storage) demote(ctx context.Context, ent Entry) error {
+	key := string(chunkKey(ent.ChunkID, ent.Gen))
+	// If the object is not in the hot tier, it was already moved to the cold
+	// tier by an earlier run which failed before recording it, or it was
+	// garbage collected, in which case its row is tombstoned.
+	if err := s.tiers.Demote(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, `
+	UPDATE storage.chunk_objects SET tier = $1
+	WHERE chunk_id = $2 AND gen = $3 AND tombstone = FALSE
+	`, TierCold, ent.ChunkID, ent.Gen)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if affected == 0 {
+		// The garbage collector may have deleted the object before or after
+		// it was copied to the cold tier, so delete the copy.
+		if err := s.tiers.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// DemoteChunksForever calls DemoteChunks every period until the context is
+// cancelled, logging any errors.
+func (s *Storage) DemoteChunksForever(ctx context.Context, period, age time.Duration, log *logrus.Logger) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		n, err := s.DemoteChunks(ctx, age)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			log.Errorf("during chunk tiering: %v", err)
+		}
+		if n > 0 {
+			log.Infof("moved %d chunks to the cold tier", n)
+		}
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
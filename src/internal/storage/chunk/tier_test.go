@@ -0,0 +1,63 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+)
+
+func TestDemoteChunks(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	cold, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	hot, s := NewTestStorage(t, db, tracker, WithColdTier(cold))
+
+	data := make([]byte, 1e7)
+	_, err = rand.New(rand.NewSource(10)).Read(data)
+	require.NoError(t, err)
+	var dataRefs []*DataRef
+	u := s.NewUploader(ctx, "test-writer", false, func(_ interface{}, refs []*DataRef) error {
+		dataRefs = append(dataRefs, refs...)
+		return nil
+	})
+	require.NoError(t, u.Upload(nil, bytes.NewReader(data)))
+	require.NoError(t, u.Close())
+	hotCount, err := countObjects(ctx, hot)
+	require.NoError(t, err)
+	require.True(t, hotCount > 0)
+
+	// Recently read chunks stay in the hot tier.
+	n, err := s.DemoteChunks(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	_, err = db.ExecContext(ctx, `UPDATE storage.chunk_objects SET last_read_at = CURRENT_TIMESTAMP - interval '2 hours'`)
+	require.NoError(t, err)
+	n, err = s.DemoteChunks(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, hotCount, n)
+	count, err := countObjects(ctx, hot)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	count, err = countObjects(ctx, cold)
+	require.NoError(t, err)
+	require.Equal(t, hotCount, count)
+	require.NoError(t, db.GetContext(ctx, &count, `SELECT COUNT(*) FROM storage.chunk_objects WHERE tier <> $1`, TierCold))
+	require.Equal(t, 0, count)
+
+	// Reads fall through to the cold tier, and record the read.
+	buf := &bytes.Buffer{}
+	require.NoError(t, s.NewReader(ctx, dataRefs).Get(buf))
+	require.True(t, bytes.Equal(data, buf.Bytes()))
+	require.NoError(t, db.GetContext(ctx, &count, `SELECT COUNT(*) FROM storage.chunk_objects WHERE last_read_at < CURRENT_TIMESTAMP - interval '1 hour'`))
+	require.Equal(t, 0, count)
+}
@@ -7,57 +7,133 @@ import (
 	"crypto/cipher"
 	io "io"
 	"io/ioutil"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pierrec/lz4/v4"
 	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/chacha20poly1305"
 )
 
 // CreateOptions affect how chunks are created.
 type CreateOptions struct {
-	Secret      []byte
+	Secret []byte
+	// Encryption is the algorithm used to encrypt chunks.
+	// Zero selects CHACHA20.
+	Encryption EncryptionAlgo
+	// Keyring wraps the data encryption keys of chunks, if it is set.
+	// Otherwise, the data encryption keys are stored in the chunk references.
+	Keyring *Keyring
+	// KeyID is the ID of Secret if it is a chunk's own key, see WithEncryptionKey.
+	// The data encryption keys are then wrapped with Secret in the chunk references,
+	// instead of with Keyring.
+	KeyID       string
 	Compression CompressionAlgo
+	// CompressionLevel is the algorithm specific compression level.
+	// Zero selects the default level for the algorithm.
+	CompressionLevel int
+	// CompressionSampleSize is the number of bytes at the start of a chunk that are
+	// compressed to decide if the chunk is worth compressing.
+	// Zero disables sampling.
+	CompressionSampleSize int
 }
 
+// minSampleSavings is the fraction of a sample that compression needs to save for
+// the rest of the chunk to be compressed.
+const minSampleSavings = 0.1
+
 // Create calls createFunc to create a new chunk, but first compresses, and encrypts ptext.
+// If opts has a keyring, createFunc is also passed the wrapped data encryption key, which
+// must be stored with the chunk.
 // ptext will not be modified.
-func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc func(ctx context.Context, data []byte) (ID, error)) (*Ref, error) {
-	buf := make([]byte, len(ptext))
-	compressAlgo, n, err := compress(opts.Compression, buf, ptext)
+func Create(ctx context.Context, opts CreateOptions, ptext []byte, createFunc func(ctx context.Context, data []byte, key *WrappedKey) (ID, error)) (*Ref, error) {
+	buf := make([]byte, len(ptext), len(ptext)+chacha20poly1305.Overhead)
+	algo := opts.Compression
+	if !worthCompressing(algo, opts.CompressionLevel, opts.CompressionSampleSize, ptext) {
+		algo = CompressionAlgo_NONE
+	}
+	compressAlgo, n, err := compress(algo, opts.CompressionLevel, buf, ptext)
 	if err != nil {
 		return nil, err
 	}
 	buf = buf[:n]
+	encryptAlgo := opts.Encryption
+	if encryptAlgo == EncryptionAlgo_ENCRYPTION_ALGO_UNKNOWN {
+		encryptAlgo = EncryptionAlgo_CHACHA20
+	}
 	// encrypt in place; buf is created above.
-	dek := encrypt(opts.Secret, buf, buf)
-	id, err := createFunc(ctx, buf)
+	dek, buf, err := encrypt(encryptAlgo, opts.Secret, buf)
 	if err != nil {
 		return nil, err
 	}
-	return &Ref{
+	var key *WrappedKey
+	if opts.Keyring != nil && opts.KeyID == "" {
+		if key, err = opts.Keyring.Wrap(dek); err != nil {
+			return nil, err
+		}
+	}
+	id, err := createFunc(ctx, buf, key)
+	if err != nil {
+		return nil, err
+	}
+	ref := &Ref{
 		Id:              id,
 		SizeBytes:       int64(len(buf)),
 		Dek:             dek,
 		CompressionAlgo: compressAlgo,
-		EncryptionAlgo:  EncryptionAlgo_CHACHA20,
-	}, nil
+		EncryptionAlgo:  encryptAlgo,
+	}
+	if opts.KeyID != "" {
+		if ref.Dek, err = wrapKey(opts.Secret, dek); err != nil {
+			return nil, err
+		}
+		ref.KeyId = opts.KeyID
+	} else if key != nil {
+		ref.Dek = nil
+	}
+	return ref, nil
 }
 
 // Get calls client.Get to retrieve a chunk, then verifies, decrypts, and decompresses the data.
+// If the data encryption key of the chunk is wrapped, it is unwrapped with the chunk's own
+// key, or with keyring.
 // cb is called with the uncompressed plaintext
-func Get(ctx context.Context, client Client, ref *Ref, cb kv.ValueCallback) error {
-	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 {
+func Get(ctx context.Context, client Client, keyring *Keyring, ref *Ref, cb kv.ValueCallback) error {
+	if ref.EncryptionAlgo != EncryptionAlgo_CHACHA20 && ref.EncryptionAlgo != EncryptionAlgo_XCHACHA20_POLY1305 {
 		return errors.Errorf("unknown encryption algorithm %d", ref.EncryptionAlgo)
 	}
+	dek := ref.Dek
+	switch {
+	case ref.KeyId != "":
+		key, err := client.GetEncryptionKey(ctx, ref.KeyId)
+		if err != nil {
+			return err
+		}
+		if dek, err = unwrapKey(key, ref.Dek); err != nil {
+			return errors.Wrapf(err, "chunk %v", ref.Id)
+		}
+	case len(dek) == 0:
+		if keyring == nil {
+			return errors.Errorf("chunk %v has a wrapped key, but there is no keyring", ref.Id)
+		}
+		key, err := client.GetKey(ctx, ref.Id)
+		if err != nil {
+			return err
+		}
+		if dek, err = keyring.Unwrap(key); err != nil {
+			return errors.Wrapf(err, "chunk %v", ref.Id)
+		}
+	}
 	err := client.Get(ctx, ref.Id, func(ctext []byte) error {
 		if err := verifyData(ref.Id, ctext); err != nil {
 			return err
 		}
-		var r io.Reader = bytes.NewReader(ctext)
-		var err error
-		if r, err = decrypt(ref.Dek, r); err != nil {
-			return err
+		r, err := decrypt(ref.EncryptionAlgo, dek, ctext)
+		if err != nil {
+			return errors.Wrapf(err, "chunk %v", ref.Id)
 		}
 		if r, err = decompress(ref.CompressionAlgo, r); err != nil {
 			return err
@@ -71,42 +147,80 @@ func Get(ctx context.Context, client Client, ref *Ref, cb kv.ValueCallback) erro
 	return errors.EnsureStack(err)
 }
 
+// worthCompressing compresses a sample of ptext to determine if compressing all of ptext
+// with algo is likely to save space. Data that is already compressed (images, video, archives)
+// usually doesn't shrink, so the cost of compressing it can be skipped.
+func worthCompressing(algo CompressionAlgo, level, sampleSize int, ptext []byte) bool {
+	if algo == CompressionAlgo_NONE || sampleSize <= 0 || len(ptext) <= sampleSize {
+		return true
+	}
+	sample := ptext[:sampleSize]
+	buf := make([]byte, int(float64(len(sample))*(1-minSampleSavings)))
+	sampleAlgo, _, err := compress(algo, level, buf, sample)
+	return err == nil && sampleAlgo != CompressionAlgo_NONE
+}
+
 // compress attempts to compress src using algo. If the compressed data is bigger
 // then no compression is used.
 // compress returns the compression algorithm used (algo or NONE), the number of bytes written to dst
 // or an error
-func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, error) {
+func compress(algo CompressionAlgo, level int, dst, src []byte) (CompressionAlgo, int, error) {
 	switch algo {
 	case CompressionAlgo_NONE:
 		copy(dst, src)
 		return CompressionAlgo_NONE, len(src), nil
 	case CompressionAlgo_GZIP_BEST_SPEED:
-		lw := newLimitWriter(dst)
-		err := func() (retErr error) {
-			gw, err := gzip.NewWriterLevel(lw, gzip.BestSpeed)
-			if err != nil {
-				return errors.EnsureStack(err)
-			}
-			defer func() {
-				if err := gw.Close(); retErr == nil {
-					retErr = err
-				}
-			}()
-			_, err = gw.Write(src)
-			if err != nil {
-				return errors.EnsureStack(err)
-			}
-			return errors.EnsureStack(gw.Close())
-		}()
-		if errors.Is(err, io.ErrShortWrite) {
-			return compress(CompressionAlgo_NONE, dst, src)
+		return compressStream(algo, dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			gw, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+			return gw, errors.EnsureStack(err)
+		})
+	case CompressionAlgo_ZSTD:
+		enc, err := zstdEncoder(level)
+		if err != nil {
+			return 0, 0, err
+		}
+		// EncodeAll appends to dst, so it only reallocates if the output is bigger than dst.
+		out := enc.EncodeAll(src, dst[:0])
+		if len(out) > len(dst) {
+			return compress(CompressionAlgo_NONE, level, dst, src)
 		}
-		return CompressionAlgo_GZIP_BEST_SPEED, lw.pos, err
+		return CompressionAlgo_ZSTD, len(out), nil
+	case CompressionAlgo_LZ4:
+		return compressStream(algo, dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			lw := lz4.NewWriter(w)
+			if err := lw.Apply(lz4.CompressionLevelOption(lz4Level(level))); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return lw, nil
+		})
 	default:
 		return 0, 0, errors.Errorf("unrecognized compression: %v", algo)
 	}
 }
 
+// compressStream compresses src into dst with the writer returned by newWriter.
+// If the compressed data does not fit in dst, then no compression is used.
+func compressStream(algo CompressionAlgo, dst, src []byte, newWriter func(io.Writer) (io.WriteCloser, error)) (CompressionAlgo, int, error) {
+	lw := newLimitWriter(dst)
+	err := func() (retErr error) {
+		w, err := newWriter(lw)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := w.Close(); retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+		}()
+		_, err = w.Write(src)
+		return errors.EnsureStack(err)
+	}()
+	if errors.Is(err, io.ErrShortWrite) {
+		return compress(CompressionAlgo_NONE, 0, dst, src)
+	}
+	return algo, lw.pos, err
+}
+
 func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 	switch algo {
 	case CompressionAlgo_NONE:
@@ -117,11 +231,90 @@ func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 			return nil, errors.EnsureStack(err)
 		}
 		return gr, nil
+	case CompressionAlgo_ZSTD:
+		dec, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		data, err = dec.DecodeAll(data, nil)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return bytes.NewReader(data), nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewReader(r), nil
 	default:
 		return nil, errors.Errorf("unrecognized compression: %v", algo)
 	}
 }
 
+var (
+	zstdEncoders sync.Map // map[zstd.EncoderLevel]*zstd.Encoder
+
+	zstdDecoderOnce sync.Once
+	zstdDec         *zstd.Decoder
+	zstdDecErr      error
+)
+
+// zstdEncoder returns a shared encoder for the zstd compression level.
+// Encoders are safe for concurrent use with EncodeAll.
+func zstdEncoder(level int) (*zstd.Encoder, error) {
+	encLevel := zstd.SpeedDefault
+	if level > 0 {
+		encLevel = zstd.EncoderLevelFromZstd(level)
+	}
+	if enc, ok := zstdEncoders.Load(encLevel); ok {
+		return enc.(*zstd.Encoder), nil
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encLevel))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	actual, loaded := zstdEncoders.LoadOrStore(encLevel, enc)
+	if loaded {
+		enc.Close()
+	}
+	return actual.(*zstd.Encoder), nil
+}
+
+// zstdDecoder returns a shared decoder.
+// Decoders are safe for concurrent use with DecodeAll.
+func zstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDec, zstdDecErr = zstd.NewReader(nil)
+		zstdDecErr = errors.EnsureStack(zstdDecErr)
+	})
+	return zstdDec, zstdDecErr
+}
+
+var lz4Levels = []lz4.CompressionLevel{
+	lz4.Fast,
+	lz4.Level1,
+	lz4.Level2,
+	lz4.Level3,
+	lz4.Level4,
+	lz4.Level5,
+	lz4.Level6,
+	lz4.Level7,
+	lz4.Level8,
+	lz4.Level9,
+}
+
+// lz4Level converts a numeric compression level (0-9) to an lz4 compression level.
+func lz4Level(level int) lz4.CompressionLevel {
+	if level < 0 {
+		level = 0
+	}
+	if level >= len(lz4Levels) {
+		level = len(lz4Levels) - 1
+	}
+	return lz4Levels[level]
+}
+
 type limitWriter struct {
 	buf []byte
 	pos int
@@ -143,24 +336,56 @@ func (w *limitWriter) Write(p []byte) (int, error) {
 	return n, nil
 }
 
-// encrypt generates a key using secret and src, and encrypts src, writing the output to dst
-func encrypt(secret []byte, dst, src []byte) (dek []byte) {
-	dek = deriveKey(secret, src)
-	cryptoXOR(dek[:32], dst, src)
-	return dek
+// encrypt generates a key using secret and buf, and encrypts buf in place using algo.
+// It returns the key and the encrypted data, which is longer than buf for authenticated
+// encryption, so buf should have the capacity for chacha20poly1305.Overhead more bytes.
+func encrypt(algo EncryptionAlgo, secret []byte, buf []byte) (dek, ctext []byte, _ error) {
+	dek = deriveKey(secret, buf)
+	switch algo {
+	case EncryptionAlgo_CHACHA20:
+		cryptoXOR(dek[:32], buf, buf)
+		return dek, buf, nil
+	case EncryptionAlgo_XCHACHA20_POLY1305:
+		aead, err := chacha20poly1305.NewX(dek)
+		if err != nil {
+			return nil, nil, errors.EnsureStack(err)
+		}
+		// The key is derived from the data, so the nonce can be fixed.
+		nonce := [chacha20poly1305.NonceSizeX]byte{}
+		return dek, aead.Seal(buf[:0], nonce[:], buf, nil), nil
+	default:
+		return nil, nil, errors.Errorf("unrecognized encryption: %v", algo)
+	}
 }
 
-// decrypt returns an io.Reader containing r decrypted using dek
-func decrypt(dek []byte, r io.Reader) (io.Reader, error) {
+// decrypt returns an io.Reader containing ctext decrypted using dek.
+// Authenticated encryption that fails to authenticate returns ErrChunkTampered.
+func decrypt(algo EncryptionAlgo, dek []byte, ctext []byte) (io.Reader, error) {
 	if len(dek) != 32 {
 		return nil, errors.Errorf("data encryption key is wrong length")
 	}
-	nonce := [chacha20.NonceSize]byte{}
-	ciph, err := chacha20.NewUnauthenticatedCipher(dek, nonce[:])
-	if err != nil {
-		return nil, errors.EnsureStack(err)
+	switch algo {
+	case EncryptionAlgo_CHACHA20:
+		nonce := [chacha20.NonceSize]byte{}
+		ciph, err := chacha20.NewUnauthenticatedCipher(dek, nonce[:])
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return cipher.StreamReader{S: ciph, R: bytes.NewReader(ctext)}, nil
+	case EncryptionAlgo_XCHACHA20_POLY1305:
+		aead, err := chacha20poly1305.NewX(dek)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		nonce := [chacha20poly1305.NonceSizeX]byte{}
+		ptext, err := aead.Open(nil, nonce[:], ctext, nil)
+		if err != nil {
+			return nil, errors.Wrapf(ErrChunkTampered, "chunk failed authentication")
+		}
+		return bytes.NewReader(ptext), nil
+	default:
+		return nil, errors.Errorf("unrecognized encryption: %v", algo)
 	}
-	return cipher.StreamReader{S: ciph, R: r}, nil
 }
 
 // deriveKey returns Hash(secret + Hash(ptext))
@@ -184,7 +409,7 @@ func cryptoXOR(key, dst, src []byte) {
 func verifyData(id ID, x []byte) error {
 	actualHash := Hash(x)
 	if !bytes.Equal(actualHash[:], id) {
-		return errors.Errorf("bad chunk. HAVE: %v WANT: %v", actualHash, id)
+		return errors.Wrapf(ErrChunkTampered, "bad chunk. HAVE: %v WANT: %v", actualHash, id)
 	}
 	return nil
 }
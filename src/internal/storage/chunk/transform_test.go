@@ -0,0 +1,260 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+type memClient struct {
+	chunks map[string][]byte
+	keys   map[string]*WrappedKey
+	// encryptionKeys are the keys returned by GetEncryptionKey.
+	encryptionKeys map[string][]byte
+}
+
+func newMemClient() *memClient {
+	return &memClient{
+		chunks: make(map[string][]byte),
+		keys:   make(map[string]*WrappedKey),
+
+		encryptionKeys: make(map[string][]byte),
+	}
+}
+
+func (c *memClient) Create(_ context.Context, md Metadata, chunkData []byte) (ID, error) {
+	id := Hash(chunkData)
+	c.chunks[string(id)] = append([]byte{}, chunkData...)
+	if md.Key != nil {
+		c.keys[string(id)] = md.Key
+	}
+	return id, nil
+}
+
+func (c *memClient) Get(_ context.Context, chunkID ID, cb kv.ValueCallback) error {
+	data, ok := c.chunks[string(chunkID)]
+	if !ok {
+		return errors.Errorf("chunk %v does not exist", chunkID)
+	}
+	return cb(data)
+}
+
+func (c *memClient) GetKey(_ context.Context, chunkID ID) (*WrappedKey, error) {
+	key, ok := c.keys[string(chunkID)]
+	if !ok {
+		return nil, errors.Errorf("no key for chunk %v", chunkID)
+	}
+	return key, nil
+}
+
+func (c *memClient) GetEncryptionKey(_ context.Context, keyID string) ([]byte, error) {
+	key, ok := c.encryptionKeys[keyID]
+	if !ok {
+		return nil, errors.Wrapf(ErrEncryptionKeyNotExists, "key %v", keyID)
+	}
+	return key, nil
+}
+
+func (c *memClient) Close() error {
+	return nil
+}
+
+func create(t *testing.T, client Client, opts CreateOptions, data []byte) *Ref {
+	ref, err := Create(context.Background(), opts, data, func(ctx context.Context, data []byte, key *WrappedKey) (ID, error) {
+		return client.Create(ctx, Metadata{Key: key}, data)
+	})
+	require.NoError(t, err)
+	return ref
+}
+
+func get(client Client, keyring *Keyring, ref *Ref, data []byte) error {
+	return Get(context.Background(), client, keyring, ref, func(actual []byte) error {
+		if !bytes.Equal(data, actual) {
+			return errors.Errorf("chunk data does not match")
+		}
+		return nil
+	})
+}
+
+func createAndGet(t *testing.T, opts CreateOptions, data []byte) *Ref {
+	client := newMemClient()
+	ref := create(t, client, opts, data)
+	require.NoError(t, get(client, opts.Keyring, ref, data))
+	return ref
+}
+
+// randomBytes returns uniformly random bytes, which are not compressible.
+func randomBytes(t *testing.T, n int) []byte {
+	data := make([]byte, n)
+	_, err := rand.New(rand.NewSource(0)).Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func TestCompression(t *testing.T) {
+	compressible := bytes.Repeat([]byte("pachyderm"), units.MB/9)
+	incompressible := randomBytes(t, units.MB)
+	for _, algo := range []CompressionAlgo{
+		CompressionAlgo_NONE,
+		CompressionAlgo_GZIP_BEST_SPEED,
+		CompressionAlgo_ZSTD,
+		CompressionAlgo_LZ4,
+	} {
+		algo := algo
+		t.Run(algo.String(), func(t *testing.T) {
+			for _, level := range []int{0, 1, 9} {
+				ref := createAndGet(t, CreateOptions{Compression: algo, CompressionLevel: level}, compressible)
+				require.Equal(t, algo, ref.CompressionAlgo)
+				if algo != CompressionAlgo_NONE {
+					require.True(t, ref.SizeBytes < int64(len(compressible)))
+				}
+			}
+			// Data that doesn't compress is stored uncompressed.
+			ref := createAndGet(t, CreateOptions{Compression: algo}, incompressible)
+			require.Equal(t, CompressionAlgo_NONE, ref.CompressionAlgo)
+			require.Equal(t, int64(len(incompressible)), ref.SizeBytes)
+		})
+	}
+}
+
+func TestCompressionSampling(t *testing.T) {
+	compressible := bytes.Repeat([]byte("pachyderm"), units.MB/9)
+	incompressible := randomBytes(t, units.MB)
+	opts := CreateOptions{
+		Compression:           CompressionAlgo_ZSTD,
+		CompressionSampleSize: 64 * units.KB,
+	}
+	ref := createAndGet(t, opts, compressible)
+	require.Equal(t, CompressionAlgo_ZSTD, ref.CompressionAlgo)
+	// The sample doesn't compress, so the rest of the chunk is not compressed,
+	// even though it would compress well.
+	mixed := append(append([]byte{}, incompressible[:128*units.KB]...), compressible...)
+	ref = createAndGet(t, opts, mixed)
+	require.Equal(t, CompressionAlgo_NONE, ref.CompressionAlgo)
+}
+
+func TestParseCompressionAlgo(t *testing.T) {
+	for name, expected := range map[string]CompressionAlgo{
+		"none":            CompressionAlgo_NONE,
+		"gzip_best_speed": CompressionAlgo_GZIP_BEST_SPEED,
+		"zstd":            CompressionAlgo_ZSTD,
+		"LZ4":             CompressionAlgo_LZ4,
+	} {
+		algo, err := ParseCompressionAlgo(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, algo)
+	}
+	_, err := ParseCompressionAlgo("brotli")
+	require.YesError(t, err)
+}
+
+func TestEncryption(t *testing.T) {
+	data := randomBytes(t, units.MB)
+	ref := createAndGet(t, CreateOptions{}, data)
+	require.Equal(t, EncryptionAlgo_CHACHA20, ref.EncryptionAlgo)
+	require.Equal(t, int64(len(data)), ref.SizeBytes)
+	ref = createAndGet(t, CreateOptions{Encryption: EncryptionAlgo_XCHACHA20_POLY1305}, data)
+	require.Equal(t, EncryptionAlgo_XCHACHA20_POLY1305, ref.EncryptionAlgo)
+	require.Equal(t, int64(len(data)+chacha20poly1305.Overhead), ref.SizeBytes)
+	// Encryption is deterministic, so identical chunks are deduplicated.
+	require.Equal(t, ref.Id, createAndGet(t, CreateOptions{Encryption: EncryptionAlgo_XCHACHA20_POLY1305}, data).Id)
+}
+
+func TestTampering(t *testing.T) {
+	data := randomBytes(t, units.KB)
+	client := newMemClient()
+	ref := create(t, client, CreateOptions{Encryption: EncryptionAlgo_XCHACHA20_POLY1305}, data)
+	// Changing the stored chunk fails verification.
+	ctext := client.chunks[string(ref.Id)]
+	ctext[0] ^= 1
+	err := get(client, nil, ref, data)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, ErrChunkTampered))
+	// Changing the chunk and its reference fails authentication.
+	tampered := proto.Clone(ref).(*Ref)
+	tampered.Id = Hash(ctext)
+	client.chunks[string(tampered.Id)] = ctext
+	err = get(client, nil, tampered, data)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, ErrChunkTampered))
+}
+
+func TestKeyring(t *testing.T) {
+	key1, key2 := randomBytes(t, 32), randomBytes(t, 32)
+	// randomBytes is deterministic.
+	key2[0] ^= 1
+	keyring1, err := NewKeyring(map[uint64][]byte{1: key1})
+	require.NoError(t, err)
+	data := randomBytes(t, units.KB)
+	client := newMemClient()
+	ref := create(t, client, CreateOptions{Keyring: keyring1}, data)
+	require.Equal(t, 0, len(ref.Dek))
+	require.Equal(t, uint64(1), client.keys[string(ref.Id)].KEKVersion)
+	require.NoError(t, get(client, keyring1, ref, data))
+	require.YesError(t, get(client, nil, ref, data))
+
+	// Rotate the key encryption key.
+	keyring2, err := ParseKeyring(fmt.Sprintf("1:%s,2:%s", base64.StdEncoding.EncodeToString(key1), base64.StdEncoding.EncodeToString(key2)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), keyring2.ActiveVersion())
+	require.NoError(t, get(client, keyring2, ref, data))
+	dek, err := keyring2.Unwrap(client.keys[string(ref.Id)])
+	require.NoError(t, err)
+	client.keys[string(ref.Id)], err = keyring2.Wrap(dek)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), client.keys[string(ref.Id)].KEKVersion)
+	// The old key encryption key is no longer needed.
+	require.YesError(t, get(client, keyring1, ref, data))
+	keyring3, err := NewKeyring(map[uint64][]byte{2: key2})
+	require.NoError(t, err)
+	require.NoError(t, get(client, keyring3, ref, data))
+
+	// A tampered wrapped key fails authentication.
+	client.keys[string(ref.Id)].Data[0] ^= 1
+	err = get(client, keyring3, ref, data)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, ErrChunkTampered))
+
+	_, err = ParseKeyring("1:" + base64.StdEncoding.EncodeToString(key1[:16]))
+	require.YesError(t, err)
+	_, err = ParseKeyring("0:" + base64.StdEncoding.EncodeToString(key1))
+	require.YesError(t, err)
+}
+
+func TestEncryptionKey(t *testing.T) {
+	key1, key2 := randomBytes(t, 32), randomBytes(t, 32)
+	// randomBytes is deterministic.
+	key2[0] ^= 1
+	data := randomBytes(t, units.KB)
+	client := newMemClient()
+	client.encryptionKeys["repo1"], client.encryptionKeys["repo2"] = key1, key2
+	ref1 := create(t, client, CreateOptions{Secret: key1, KeyID: "repo1"}, data)
+	require.Equal(t, "repo1", ref1.KeyId)
+	require.NoError(t, get(client, nil, ref1, data))
+	// Identical data is only deduplicated between chunks with the same key.
+	require.Equal(t, ref1.Id, create(t, client, CreateOptions{Secret: key1, KeyID: "repo1"}, data).Id)
+	ref2 := create(t, client, CreateOptions{Secret: key2, KeyID: "repo2"}, data)
+	require.NotEqual(t, ref1.Id, ref2.Id)
+	// The chunk is unreadable once its key is destroyed.
+	delete(client.encryptionKeys, "repo1")
+	err := get(client, nil, ref1, data)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, ErrEncryptionKeyNotExists))
+	require.NoError(t, get(client, nil, ref2, data))
+	// A tampered wrapped key fails authentication.
+	tampered := proto.Clone(ref2).(*Ref)
+	tampered.Dek[len(tampered.Dek)-1] ^= 1
+	err = get(client, nil, tampered, data)
+	require.YesError(t, err)
+	require.True(t, errors.Is(err, ErrChunkTampered))
+}
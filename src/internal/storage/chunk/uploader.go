@@ -21,23 +21,25 @@ type UploadFunc = func(interface{}, []*DataRef) error
 // Upload tasks are performed asynchronously, which is why the interface is callback based.
 // Callbacks will be executed with respect to the order the upload tasks are created.
 type Uploader struct {
-	ctx       context.Context
-	client    Client
-	taskChain *TaskChain
-	chunkSem  *semaphore.Weighted
-	noUpload  bool
-	cb        UploadFunc
+	ctx        context.Context
+	client     Client
+	createOpts CreateOptions
+	taskChain  *TaskChain
+	chunkSem   *semaphore.Weighted
+	noUpload   bool
+	cb         UploadFunc
 }
 
 func (s *Storage) NewUploader(ctx context.Context, name string, noUpload bool, cb UploadFunc) *Uploader {
-	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL))
+	client := NewClient(s.store, s.db, s.tracker, NewRenewer(ctx, s.tracker, name, defaultChunkTTL), s.createOpts.Keyring)
 	return &Uploader{
-		ctx:       ctx,
-		client:    client,
-		taskChain: NewTaskChain(ctx, semaphore.NewWeighted(taskParallelism)),
-		chunkSem:  semaphore.NewWeighted(chunkParallelism),
-		noUpload:  noUpload,
-		cb:        cb,
+		ctx:        ctx,
+		client:     client,
+		createOpts: s.createOptions(ctx),
+		taskChain:  NewTaskChain(ctx, semaphore.NewWeighted(taskParallelism)),
+		chunkSem:   semaphore.NewWeighted(chunkParallelism),
+		noUpload:   noUpload,
+		cb:         cb,
 	}
 }
 
@@ -47,7 +49,7 @@ func (u *Uploader) Upload(meta interface{}, r io.Reader) error {
 	var dataRefs []*DataRef
 	if err := ComputeChunks(r, func(chunkBytes []byte) error {
 		return taskChain.CreateTask(func(ctx context.Context) (func() error, error) {
-			dataRef, err := upload(ctx, u.client, chunkBytes, nil, u.noUpload)
+			dataRef, err := upload(ctx, u.client, u.createOpts, chunkBytes, nil, u.noUpload)
 			if err != nil {
 				return nil, err
 			}
@@ -81,21 +83,29 @@ func (u *Uploader) Copy(meta interface{}, dataRefs []*DataRef) error {
 	})
 }
 
-func upload(ctx context.Context, client Client, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
+func upload(ctx context.Context, client Client, createOpts CreateOptions, chunkBytes []byte, pointsTo []ID, noUpload bool) (*DataRef, error) {
 	md := Metadata{
 		Size:     len(chunkBytes),
 		PointsTo: pointsTo,
 	}
-	createFunc := func(ctx context.Context, data []byte) (ID, error) {
+	if createOpts.KeyID != "" {
+		key, err := client.GetEncryptionKey(ctx, createOpts.KeyID)
+		if err != nil {
+			return nil, err
+		}
+		createOpts.Secret = key
+	}
+	createFunc := func(ctx context.Context, data []byte, key *WrappedKey) (ID, error) {
+		md.Key = key
 		res, err := client.Create(ctx, md, data)
 		return res, errors.EnsureStack(err)
 	}
 	if noUpload {
-		createFunc = func(ctx context.Context, data []byte) (ID, error) {
+		createFunc = func(ctx context.Context, data []byte, _ *WrappedKey) (ID, error) {
 			return Hash(data), nil
 		}
 	}
-	ref, err := Create(ctx, CreateOptions{}, chunkBytes, createFunc)
+	ref, err := Create(ctx, createOpts, chunkBytes, createFunc)
 	if err != nil {
 		return nil, err
 	}
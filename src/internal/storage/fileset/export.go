@@ -0,0 +1,60 @@
+package fileset
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+)
+
+// Export calls cb with the metadata of the file set with ID id, after the
+// metadata of the file sets it is composed of.
+func (s *Storage) Export(ctx context.Context, id ID, cb func(ID, *Metadata) error) error {
+	md, err := s.store.Get(ctx, id)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if comp := md.GetComposite(); comp != nil {
+		layers, err := comp.PointsTo()
+		if err != nil {
+			return err
+		}
+		for _, layer := range layers {
+			if err := s.Export(ctx, layer, cb); err != nil {
+				return err
+			}
+		}
+	}
+	return cb(id, md)
+}
+
+// Import creates a file set with ID id from exported metadata, so file sets
+// composed of it can be imported with their metadata unchanged. The chunks or
+// file sets it points to must already exist.
+func (s *Storage) Import(ctx context.Context, id ID, md *Metadata, ttl time.Duration) error {
+	var pointsTo []string
+	switch x := md.Value.(type) {
+	case *Metadata_Primitive:
+		for _, chunkID := range x.Primitive.PointsTo() {
+			pointsTo = append(pointsTo, chunkID.TrackerID())
+		}
+	case *Metadata_Composite:
+		layers, err := x.Composite.PointsTo()
+		if err != nil {
+			return err
+		}
+		for _, layer := range layers {
+			pointsTo = append(pointsTo, layer.TrackerID())
+		}
+	default:
+		return errors.Errorf("cannot import type %T", md.Value)
+	}
+	return dbutil.WithTx(ctx, s.store.DB(), func(tx *pachsql.Tx) error {
+		if err := s.store.SetTx(tx, id, md); err != nil && !errors.Is(err, ErrFileSetExists) {
+			return errors.EnsureStack(err)
+		}
+		return errors.EnsureStack(s.tracker.CreateTx(tx, id.TrackerID(), pointsTo, ttl))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
@@ -41,14 +42,42 @@ type Cache struct {
 	db      *pachsql.DB
 	tracker track.Tracker
 	maxSize int
+	// tag, if set, scopes the cache to the entries with the tag.
+	tag string
+	// excludedTags are the tags of the entries of other caches, which
+	// share the table but not the max size.
+	excludedTags []string
 }
 
-func NewCache(db *pachsql.DB, tracker track.Tracker, maxSize int) *Cache {
-	return &Cache{
+// CacheOption configures a cache.
+type CacheOption func(*Cache)
+
+// WithCacheTag scopes a cache to the entries with tag. All of the entries
+// put in the cache get the tag, and only they count towards its max size.
+func WithCacheTag(tag string) CacheOption {
+	return func(c *Cache) {
+		c.tag = tag
+	}
+}
+
+// WithoutCacheTags excludes the entries with the given tags, which belong to
+// other caches, from the max size of a cache.
+func WithoutCacheTags(tags ...string) CacheOption {
+	return func(c *Cache) {
+		c.excludedTags = append(c.excludedTags, tags...)
+	}
+}
+
+func NewCache(db *pachsql.DB, tracker track.Tracker, maxSize int, opts ...CacheOption) *Cache {
+	c := &Cache{
 		db:      db,
 		tracker: tracker,
 		maxSize: maxSize,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *Cache) Put(ctx context.Context, key string, value *types.Any, ids []ID, tag string) error {
@@ -65,6 +94,9 @@ func (c *Cache) Put(ctx context.Context, key string, value *types.Any, ids []ID,
 }
 
 func (c *Cache) put(tx *pachsql.Tx, key string, value []byte, ids []ID, tag string) error {
+	if c.tag != "" {
+		tag = c.tag
+	}
 	if ids == nil {
 		ids = []ID{}
 	}
@@ -83,12 +115,25 @@ func (c *Cache) put(tx *pachsql.Tx, key string, value []byte, ids []ID, tag stri
 	return errors.EnsureStack(c.tracker.CreateTx(tx, cacheTrackerKey(key), pointsTo, track.NoTTL))
 }
 
+// entries returns the condition on the entries of the table which belong to
+// the cache, and its arguments.
+func (c *Cache) entries() (string, []interface{}) {
+	if c.tag != "" {
+		return "tag = $1", []interface{}{c.tag}
+	}
+	if len(c.excludedTags) > 0 {
+		return "(tag IS NULL OR NOT tag = ANY($1))", []interface{}{pq.StringArray(c.excludedTags)}
+	}
+	return "TRUE", nil
+}
+
 func (c *Cache) applyEvictionPolicy(tx *pachsql.Tx) error {
+	cond, args := c.entries()
 	var size int
 	if err := tx.Get(&size, `
 		SELECT COUNT(key)
-		FROM storage.cache 
-	`); err != nil {
+		FROM storage.cache
+		WHERE `+cond, args...); err != nil {
 		return errors.EnsureStack(err)
 	}
 	if size <= c.maxSize {
@@ -100,11 +145,12 @@ func (c *Cache) applyEvictionPolicy(tx *pachsql.Tx) error {
 		WHERE key IN (
 			SELECT key 
 			FROM storage.cache 
+			WHERE `+cond+`
 			ORDER BY accessed_at
 			LIMIT 1
 		)
 		RETURNING key
-	`); err != nil {
+	`, args...); err != nil {
 		return errors.EnsureStack(err)
 	}
 	return errors.EnsureStack(c.tracker.DeleteTx(tx, cacheTrackerKey(key)))
@@ -151,3 +197,23 @@ func (c *Cache) Clear(ctx context.Context, tagPrefix string) error {
 	}
 	return nil
 }
+
+// ClearTx clears the entries whose tags start with tagPrefix in tx. Unlike
+// Clear, the prefix is matched literally, since it may hold names with
+// underscores.
+func (c *Cache) ClearTx(tx *pachsql.Tx, tagPrefix string) error {
+	var keys []string
+	if err := tx.Select(&keys, `
+		DELETE FROM storage.cache
+		WHERE left(tag, length($1)) = $1
+		RETURNING key
+	`, tagPrefix); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, key := range keys {
+		if err := c.tracker.DeleteTx(tx, cacheTrackerKey(key)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
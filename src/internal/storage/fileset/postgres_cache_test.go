@@ -14,13 +14,13 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
 
-func newTestCache(t *testing.T, db *pachsql.DB, tr track.Tracker, maxSize int) *Cache {
+func newTestCache(t *testing.T, db *pachsql.DB, tr track.Tracker, maxSize int, opts ...CacheOption) *Cache {
 	ctx := context.Background()
 	tx := db.MustBegin()
 	tx.MustExec(`CREATE SCHEMA IF NOT EXISTS storage`)
 	require.NoError(t, CreatePostgresCacheV1(ctx, tx))
 	require.NoError(t, tx.Commit())
-	return NewCache(db, tr, maxSize)
+	return NewCache(db, tr, maxSize, opts...)
 }
 
 func TestPostgresCache(t *testing.T) {
@@ -99,3 +99,42 @@ func TestPostgresCache(t *testing.T) {
 		checkExists(i)
 	}
 }
+
+func TestPostgresCacheTags(t *testing.T) {
+	ctx := context.Background()
+	db := dockertestenv.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	maxSize := 2
+	cache := newTestCache(t, db, tr, maxSize, WithoutCacheTags("tagged"))
+	taggedCache := NewCache(db, tr, maxSize, WithCacheTag("tagged"))
+	put := func(c *Cache, key string) {
+		require.NoError(t, c.Put(ctx, key, &types.Any{}, nil, ""))
+	}
+	checkExists := func(keys ...string) {
+		for _, key := range keys {
+			_, err := cache.Get(ctx, key)
+			require.NoError(t, err)
+		}
+	}
+	checkNotExists := func(keys ...string) {
+		for _, key := range keys {
+			_, err := cache.Get(ctx, key)
+			require.YesError(t, err)
+		}
+	}
+	// Each cache only evicts its own entries.
+	put(cache, "a")
+	put(cache, "b")
+	put(taggedCache, "c")
+	put(taggedCache, "d")
+	put(taggedCache, "e")
+	checkNotExists("c")
+	checkExists("a", "b", "d", "e")
+	put(cache, "f")
+	checkNotExists("a")
+	checkExists("b", "d", "e", "f")
+	// The entries of the tagged cache get its tag.
+	require.NoError(t, cache.Clear(ctx, "tagged"))
+	checkNotExists("d", "e")
+	checkExists("b", "f")
+}
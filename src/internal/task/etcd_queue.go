@@ -2,6 +2,7 @@ package task
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,11 +21,25 @@ type taskFunc func()
 type groupEntry struct {
 	cancel       context.CancelFunc
 	taskFuncChan chan taskFunc
+	priority     int64
+	weight       float64
+	// pass is the virtual time of the group, which advances by the inverse of
+	// the group's weight each time one of its tasks is processed.
+	pass float64
 }
 
+// taskQueue schedules the tasks of a set of groups with stride scheduling.
+// The next task is taken from the group with the highest priority which has
+// a task ready, and among those, the group with the lowest pass (groups with
+// the same pass are taken in the order they were created). This processes
+// the tasks of groups with the same priority in proportion to their weights.
 type taskQueue struct {
 	groups *ordered_map.OrderedMap
-	mu     sync.Mutex
+	// pass is the pass of the last group that a task was processed for.
+	// Groups which are created or were idle start from it, so they don't
+	// get credit for the time they didn't have tasks ready.
+	pass float64
+	mu   sync.Mutex
 }
 
 func newTaskQueue(ctx context.Context) *taskQueue {
@@ -32,46 +47,69 @@ func newTaskQueue(ctx context.Context) *taskQueue {
 		groups: ordered_map.NewOrderedMap(),
 	}
 	go func() {
-		var cycleKey string
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			default:
 			}
-			// Get the next group.
-			tq.mu.Lock()
-			iter := tq.groups.IterFunc()
-			kv, ok := iter()
-			tq.mu.Unlock()
-			var key string
-			if ok {
-				key = kv.Key.(string)
+			// Check the groups in scheduling order for a task that is ready and process it.
+			entries := tq.schedule()
+			var processed bool
+			for i, ge := range entries {
+				select {
+				case cb := <-ge.taskFuncChan:
+					cb()
+					tq.charge(entries[:i], ge)
+					processed = true
+				default:
+				}
+				if processed {
+					break
+				}
 			}
-			// Wait if there are no group entries or a cycle has been completed without finding a task.
-			if !ok || key == cycleKey {
+			// Wait if there are no group entries or none of them had a task ready.
+			if !processed {
 				time.Sleep(waitTime)
-				cycleKey = ""
-				continue
 			}
-			if cycleKey == "" {
-				cycleKey = key
-			}
-			// Check if the next task is ready for the group and process it.
-			ge := kv.Value.(*groupEntry)
-			select {
-			case cb := <-ge.taskFuncChan:
-				cb()
-				cycleKey = ""
-			default:
-			}
-			tq.requeueGroup(key)
 		}
 	}()
 	return tq
 }
 
-func (tq *taskQueue) group(ctx context.Context, groupID string, cb func(context.Context, chan taskFunc)) error {
+// schedule returns the group entries in the order they should be checked for a ready task.
+func (tq *taskQueue) schedule() []*groupEntry {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	var entries []*groupEntry
+	iter := tq.groups.IterFunc()
+	for kv, ok := iter(); ok; kv, ok = iter() {
+		entries = append(entries, kv.Value.(*groupEntry))
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].priority != entries[j].priority {
+			return entries[i].priority > entries[j].priority
+		}
+		return entries[i].pass < entries[j].pass
+	})
+	return entries
+}
+
+// charge advances the pass of a group that a task was processed for. The groups that
+// were skipped because they didn't have a task ready are brought up to the same pass.
+func (tq *taskQueue) charge(idle []*groupEntry, ge *groupEntry) {
+	tq.mu.Lock()
+	defer tq.mu.Unlock()
+	tq.pass = ge.pass
+	for _, idleGE := range idle {
+		if idleGE.pass < tq.pass {
+			idleGE.pass = tq.pass
+		}
+	}
+	ge.pass += 1 / ge.weight
+}
+
+func (tq *taskQueue) group(ctx context.Context, groupID string, g *Group, cb func(context.Context, chan taskFunc)) error {
 	tq.mu.Lock()
 	defer tq.mu.Unlock()
 	if _, ok := tq.groups.Get(groupID); ok {
@@ -82,7 +120,9 @@ func (tq *taskQueue) group(ctx context.Context, groupID string, cb func(context.
 	ge := &groupEntry{
 		cancel:       cancel,
 		taskFuncChan: taskFuncChan,
+		pass:         tq.pass,
 	}
+	setScheduling(ge, g)
 	tq.groups.Set(groupID, ge)
 	go func() {
 		cb(ctx, taskFuncChan)
@@ -90,15 +130,23 @@ func (tq *taskQueue) group(ctx context.Context, groupID string, cb func(context.
 	return nil
 }
 
-func (tq *taskQueue) requeueGroup(groupID string) {
+// updateGroup updates the scheduling settings of a group.
+func (tq *taskQueue) updateGroup(groupID string, g *Group) {
 	tq.mu.Lock()
 	defer tq.mu.Unlock()
 	ge, ok := tq.groups.Get(groupID)
 	if !ok {
 		return
 	}
-	tq.groups.Delete(groupID)
-	tq.groups.Set(groupID, ge)
+	setScheduling(ge.(*groupEntry), g)
+}
+
+func setScheduling(ge *groupEntry, g *Group) {
+	ge.priority = g.Priority
+	ge.weight = g.Weight
+	if ge.weight <= 0 {
+		ge.weight = 1
+	}
 }
 
 func (tq *taskQueue) deleteGroup(groupID string) {
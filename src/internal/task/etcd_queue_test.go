@@ -3,6 +3,7 @@ package task
 import (
 	"context"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,7 +27,7 @@ func TestTaskQueue(t *testing.T) {
 	ready := make(chan struct{})
 	for i := 0; i < numGroups; i++ {
 		i := i
-		require.NoError(t, tq.group(context.Background(), strconv.Itoa(i), func(_ context.Context, taskFuncChan chan taskFunc) {
+		require.NoError(t, tq.group(context.Background(), strconv.Itoa(i), &Group{}, func(_ context.Context, taskFuncChan chan taskFunc) {
 			// The first group will create a task that sleeps a bit to allow the tasks
 			// from the subsequent groups to queue up.
 			if i == 0 {
@@ -56,3 +57,57 @@ func TestTaskQueue(t *testing.T) {
 		}
 	}
 }
+
+// runTaskQueue queues bufSize tasks for each of the groups, and returns the
+// groups in the order that their tasks were processed.
+func runTaskQueue(t *testing.T, groups []*Group) []int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tq := newTaskQueue(ctx)
+	// The tasks wait until every group has queued its tasks, so the order
+	// doesn't depend on when the groups were set up.
+	var queued sync.WaitGroup
+	queued.Add(len(groups))
+	order := make(chan int, len(groups)*bufSize)
+	for i, g := range groups {
+		i := i
+		require.NoError(t, tq.group(ctx, strconv.Itoa(i), g, func(_ context.Context, taskFuncChan chan taskFunc) {
+			for j := 0; j < bufSize; j++ {
+				taskFuncChan <- func() {
+					queued.Wait()
+					order <- i
+				}
+			}
+			queued.Done()
+		}))
+	}
+	var result []int
+	for i := 0; i < len(groups)*bufSize; i++ {
+		result = append(result, <-order)
+	}
+	return result
+}
+
+func TestTaskQueueWeights(t *testing.T) {
+	order := runTaskQueue(t, []*Group{{Weight: 3}, {Weight: 1}})
+	counts := make([]int, 2)
+	for _, i := range order[:8] {
+		counts[i]++
+	}
+	require.Equal(t, []int{6, 2}, counts)
+}
+
+func TestTaskQueuePriorities(t *testing.T) {
+	order := runTaskQueue(t, []*Group{{Priority: 0}, {Priority: 1}, {Priority: -1}})
+	// The first task may be from any group, but after that the groups should
+	// be processed strictly in priority order.
+	var expected []int
+	counts := []int{bufSize, bufSize, bufSize}
+	counts[order[0]]--
+	for _, i := range []int{1, 0, 2} {
+		for j := 0; j < counts[i]; j++ {
+			expected = append(expected, i)
+		}
+	}
+	require.Equal(t, expected, order[1:])
+}
@@ -39,12 +39,12 @@ func NewEtcdService(etcdClient *etcd.Client, etcdPrefix string) Service {
 	}
 }
 
-func (es *etcdService) NewDoer(namespace, group string, cache Cache) Doer {
+func (es *etcdService) NewDoer(namespace, group string, cache Cache, opts ...DoerOption) Doer {
 	if group == "" {
 		group = uuid.NewWithoutDashes()
 	}
 	namespaceEtcd := newNamespaceEtcd(es.etcdClient, es.etcdPrefix, namespace)
-	return newEtcdDoer(namespaceEtcd, group, cache)
+	return newEtcdDoer(namespaceEtcd, group, cache, newGroup(opts))
 }
 
 func (es *etcdService) NewSource(namespace string) Source {
@@ -104,15 +104,17 @@ func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Me
 
 type etcdDoer struct {
 	*namespaceEtcd
-	group string
-	cache Cache
+	group      string
+	cache      Cache
+	scheduling *Group
 }
 
-func newEtcdDoer(namespaceEtcd *namespaceEtcd, group string, cache Cache) Doer {
+func newEtcdDoer(namespaceEtcd *namespaceEtcd, group string, cache Cache, scheduling *Group) Doer {
 	return &etcdDoer{
 		namespaceEtcd: namespaceEtcd,
 		group:         group,
 		cache:         cache,
+		scheduling:    scheduling,
 	}
 }
 
@@ -202,10 +204,11 @@ func (ed *etcdDoer) Do(ctx context.Context, inputChan chan *types.Any, cb Collec
 				}
 				taskKey := path.Join(prefix, taskID)
 				task := &Task{
-					ID:    taskID,
-					Input: input,
-					State: State_RUNNING,
-					Index: index,
+					ID:      taskID,
+					Input:   input,
+					State:   State_RUNNING,
+					Index:   index,
+					Created: types.TimestampNow(),
 				}
 				index++
 				if err := renewer.Put(ctx, taskKey, task); err != nil {
@@ -229,7 +232,7 @@ func (ed *etcdDoer) withGroup(ctx context.Context, cb func(ctx context.Context,
 				fmt.Printf("errored deleting group key %v: %v\n", key, err)
 			}
 		}()
-		if err := renewer.Put(ctx, key, &Group{}); err != nil {
+		if err := renewer.Put(ctx, key, ed.scheduling); err != nil {
 			return err
 		}
 		err := ed.taskCol.WithRenewer(ctx, func(ctx context.Context, renewer *col.Renewer) error {
@@ -261,7 +264,7 @@ func newEtcdSource(namespaceEtcd *namespaceEtcd) Source {
 }
 
 func (es *etcdSource) Iterate(ctx context.Context, cb ProcessFunc) error {
-	groups := make(map[string]map[string]struct{})
+	groups := make(map[string]map[string]*Group)
 	tq := newTaskQueue(ctx)
 	err := es.groupCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
 		group, uuid := path.Split(string(e.Key))
@@ -275,17 +278,25 @@ func (es *etcdSource) Iterate(ctx context.Context, cb ProcessFunc) error {
 			if len(groupMap) == 0 {
 				tq.deleteGroup(group)
 				delete(groups, group)
+				return nil
 			}
+			tq.updateGroup(group, mergeGroups(groupMap))
 			return nil
 		}
+		var key string
+		scheduling := &Group{}
+		if err := e.Unmarshal(&key, scheduling); err != nil {
+			return errors.EnsureStack(err)
+		}
 		if ok {
-			groupMap[uuid] = struct{}{}
+			groupMap[uuid] = scheduling
+			tq.updateGroup(group, mergeGroups(groupMap))
 			return nil
 		}
-		groupMap = make(map[string]struct{})
+		groupMap = make(map[string]*Group)
 		groups[group] = groupMap
-		groupMap[uuid] = struct{}{}
-		return tq.group(ctx, group, func(ctx context.Context, taskFuncChan chan taskFunc) {
+		groupMap[uuid] = scheduling
+		return tq.group(ctx, group, scheduling, func(ctx context.Context, taskFuncChan chan taskFunc) {
 			if err := es.forEachTask(ctx, group, func(taskKey string) error {
 				select {
 				case taskFuncChan <- es.createTaskFunc(ctx, taskKey, cb):
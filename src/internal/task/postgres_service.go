@@ -0,0 +1,722 @@
+package task
+
+import (
+	"context"
+	"crypto/md5"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// postgresTTL is how long a doer's tasks and a source's claim live without
+	// being renewed, which matches the TTL of the etcd leases.
+	postgresTTL = 30 * time.Second
+	// pollInterval is how often doers and sources check for changes which
+	// they may not have been notified of, such as expired claims.
+	pollInterval = 5 * time.Second
+
+	groupEvent = "group"
+	taskEvent  = "task"
+)
+
+// SetupPostgresTaskV0 creates the tables for the Postgres task service.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresTaskV0(ctx context.Context, tx *pachsql.Tx) error {
+	const schema = `
+	CREATE SCHEMA task;
+
+	CREATE TABLE task.groups (
+		doer_id text NOT NULL PRIMARY KEY,
+		prefix text NOT NULL,
+		namespace text NOT NULL,
+		group_id text NOT NULL,
+		expires_at timestamptz NOT NULL
+	);
+	CREATE INDEX ON task.groups (prefix, namespace, group_id);
+	CREATE INDEX ON task.groups (expires_at);
+
+	CREATE TABLE task.tasks (
+		doer_id text NOT NULL REFERENCES task.groups (doer_id) ON DELETE CASCADE,
+		task_id text NOT NULL,
+		seq bigserial NOT NULL,
+		state int NOT NULL,
+		task_pb bytea NOT NULL,
+		claim_id text,
+		claim_expires_at timestamptz,
+		collected boolean NOT NULL DEFAULT false,
+		PRIMARY KEY (doer_id, task_id)
+	);
+	CREATE INDEX ON task.tasks (doer_id, seq);
+`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+// SetupPostgresTaskV1 adds the scheduling settings of groups.
+// DO NOT MODIFY THIS FUNCTION
+// IT HAS BEEN USED IN A RELEASED MIGRATION
+func SetupPostgresTaskV1(ctx context.Context, tx *pachsql.Tx) error {
+	const schema = `
+	ALTER TABLE task.groups
+		ADD COLUMN priority bigint NOT NULL DEFAULT 0,
+		ADD COLUMN weight float8 NOT NULL DEFAULT 1;
+`
+	_, err := tx.ExecContext(ctx, schema)
+	return errors.EnsureStack(err)
+}
+
+// postgresService is a Service which stores tasks in Postgres. A Do call
+// registers a group row which owns its tasks, and which it renews until it
+// returns; a source claims a task by setting a claim on its row, which it
+// renews until the task is processed. Rows which aren't renewed expire, as
+// the leased keys of the etcd service do.
+type postgresService struct {
+	db       *pachsql.DB
+	listener col.PostgresListener
+	prefix   string
+}
+
+// NewPostgresService creates a Service which stores tasks in Postgres, and
+// notifies doers and sources through listener.
+func NewPostgresService(db *pachsql.DB, listener col.PostgresListener, prefix string) Service {
+	return &postgresService{
+		db:       db,
+		listener: listener,
+		prefix:   path.Join(prefix, version.PrettyVersion()),
+	}
+}
+
+func (ps *postgresService) NewDoer(namespace, group string, cache Cache, opts ...DoerOption) Doer {
+	if group == "" {
+		group = uuid.NewWithoutDashes()
+	}
+	return &postgresDoer{
+		postgresService: ps,
+		namespace:       namespace,
+		group:           group,
+		cache:           cache,
+		scheduling:      newGroup(opts),
+	}
+}
+
+func (ps *postgresService) NewSource(namespace string) Source {
+	return &postgresSource{
+		postgresService: ps,
+		namespace:       namespace,
+	}
+}
+
+func (ps *postgresService) List(ctx context.Context, namespace, group string, cb func(string, string, *Task, bool) error) error {
+	if namespace == "" && group != "" {
+		return errors.New("must provide a task namespace to list a group")
+	}
+	rows, err := ps.db.QueryContext(ctx, `
+		SELECT g.namespace, g.group_id, t.task_pb, COALESCE(t.claim_expires_at > now(), false)
+		FROM task.tasks t JOIN task.groups g ON t.doer_id = g.doer_id
+		WHERE g.prefix = $1 AND ($2 = '' OR g.namespace = $2) AND ($3 = '' OR g.group_id = $3)
+		ORDER BY t.seq DESC`, ps.prefix, namespace, group)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var namespace, group string
+		var taskPB []byte
+		var claimed bool
+		if err := rows.Scan(&namespace, &group, &taskPB, &claimed); err != nil {
+			return errors.EnsureStack(err)
+		}
+		task := &Task{}
+		if err := proto.Unmarshal(taskPB, task); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := cb(namespace, group, task, claimed && task.State == State_RUNNING); err != nil {
+			return err
+		}
+	}
+	return errors.EnsureStack(rows.Err())
+}
+
+// notify sends an event about a group to the sources of its namespace. Sources
+// of the empty namespace process the tasks of every namespace, as they do with
+// the etcd service, so they are notified too.
+func (ps *postgresService) notify(ctx context.Context, event, namespace, group string) error {
+	payload := strings.Join([]string{
+		event,
+		base64.StdEncoding.EncodeToString([]byte(namespace)),
+		base64.StdEncoding.EncodeToString([]byte(group)),
+	}, " ")
+	channels := []string{ps.namespaceChannel(namespace)}
+	if namespace != "" {
+		channels = append(channels, ps.namespaceChannel(""))
+	}
+	for _, channel := range channels {
+		if _, err := ps.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, channel, payload); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+func (ps *postgresService) namespaceChannel(namespace string) string {
+	return fmt.Sprintf("task_ns_%x", md5.Sum([]byte(path.Join(ps.prefix, namespace))))
+}
+
+func doerChannel(doerID string) string {
+	return "task_doer_" + doerID
+}
+
+func parseEvent(payload string) (event, namespace, group string, _ error) {
+	parts := strings.Split(payload, " ")
+	if len(parts) != 3 {
+		return "", "", "", errors.Errorf("malformed task notification %q", payload)
+	}
+	ns, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", "", "", errors.EnsureStack(err)
+	}
+	g, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", "", "", errors.EnsureStack(err)
+	}
+	return parts[0], string(ns), string(g), nil
+}
+
+// renew calls f every third of the TTL until the context is canceled. If f
+// fails, then the context is canceled with cancel.
+func renew(ctx context.Context, cancel context.CancelFunc, f func(context.Context) (bool, error)) {
+	ticker := time.NewTicker(postgresTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ok, err := f(ctx)
+			if err != nil || !ok {
+				if ctx.Err() == nil {
+					fmt.Printf("errored renewing task row: %v\n", err)
+				}
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func rowsAffected(res sql.Result, err error) (bool, error) {
+	if err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return n > 0, nil
+}
+
+type postgresDoer struct {
+	*postgresService
+	namespace, group string
+	cache            Cache
+	scheduling       *Group
+}
+
+func (pd *postgresDoer) Do(ctx context.Context, inputChan chan *types.Any, cb CollectFunc) error {
+	doerID := uuid.NewWithoutDashes()
+	n := newNotifier(doerChannel(doerID))
+	if err := pd.listener.Register(n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer pd.listener.Unregister(n)
+	return pd.withGroup(ctx, doerID, func(ctx context.Context) error {
+		var eg errgroup.Group
+		done := make(chan struct{})
+		var mu sync.Mutex
+		var count int64
+		ctx, cancel := context.WithCancel(ctx)
+		defer func() {
+			cancel()
+			eg.Wait()
+		}()
+		eg.Go(func() error {
+			ticker := time.NewTicker(pollInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-n.signal:
+					if _, err := n.drain(); err != nil {
+						return err
+					}
+				case <-ticker.C:
+				case <-ctx.Done():
+					return errors.EnsureStack(ctx.Err())
+				}
+				tasks, err := pd.collect(ctx, doerID)
+				if err != nil {
+					return err
+				}
+				for _, task := range tasks {
+					var err error
+					if task.State == State_FAILURE {
+						err = errors.New(task.Reason)
+					}
+					if pd.cache != nil && err == nil {
+						if err := pd.cache.Put(ctx, task.ID, task.Output); err != nil {
+							fmt.Printf("errored putting task %v in cache: %v\n", task.ID, err)
+						}
+					}
+					if err := cb(task.Index, task.Output, err); err != nil {
+						return err
+					}
+					mu.Lock()
+					count--
+					mu.Unlock()
+				}
+				select {
+				case <-done:
+					mu.Lock()
+					finished := count == 0
+					mu.Unlock()
+					if finished {
+						return nil
+					}
+				default:
+				}
+			}
+		})
+		var index int64
+		for {
+			select {
+			case input, more := <-inputChan:
+				if !more {
+					close(done)
+					mu.Lock()
+					finished := count == 0
+					mu.Unlock()
+					// If the tasks have already been collected (or there were none), then just return.
+					if finished {
+						return nil
+					}
+					// Wake the collector, in case the last task was collected
+					// before the input channel was closed.
+					n.wake()
+					return errors.EnsureStack(eg.Wait())
+				}
+				taskID, err := computeTaskID(input)
+				if err != nil {
+					return err
+				}
+				if pd.cache != nil {
+					output, err := pd.cache.Get(ctx, taskID)
+					if err == nil {
+						if err := cb(index, output, nil); err != nil {
+							return err
+						}
+						index++
+						continue
+					}
+				}
+				task := &Task{
+					ID:      taskID,
+					Input:   input,
+					State:   State_RUNNING,
+					Index:   index,
+					Created: types.TimestampNow(),
+				}
+				index++
+				mu.Lock()
+				count++
+				mu.Unlock()
+				if err := pd.createTask(ctx, doerID, task); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return errors.EnsureStack(ctx.Err())
+			}
+		}
+	})
+}
+
+// withGroup registers the doer's group row, and renews it until cb returns.
+// The group row owns the doer's tasks, so they are deleted with it.
+func (pd *postgresDoer) withGroup(ctx context.Context, doerID string, cb func(context.Context) error) error {
+	if _, err := pd.db.ExecContext(ctx, `
+		INSERT INTO task.groups (doer_id, prefix, namespace, group_id, expires_at, priority, weight)
+		VALUES ($1, $2, $3, $4, now() + $5::float8 * interval '1 second', $6, $7)`,
+		doerID, pd.prefix, pd.namespace, pd.group, postgresTTL.Seconds(), pd.scheduling.Priority, pd.scheduling.Weight); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if _, err := pd.db.ExecContext(context.Background(), `DELETE FROM task.groups WHERE doer_id = $1`, doerID); err != nil {
+			fmt.Printf("errored deleting group row %v: %v\n", doerID, err)
+		}
+		if err := pd.notify(context.Background(), groupEvent, pd.namespace, pd.group); err != nil {
+			fmt.Printf("errored notifying sources of group %v: %v\n", pd.group, err)
+		}
+	}()
+	if err := pd.notify(ctx, groupEvent, pd.namespace, pd.group); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go renew(ctx, cancel, func(ctx context.Context) (bool, error) {
+		return rowsAffected(pd.db.ExecContext(ctx, `
+			UPDATE task.groups SET expires_at = now() + $2::float8 * interval '1 second' WHERE doer_id = $1`,
+			doerID, postgresTTL.Seconds()))
+	})
+	return cb(ctx)
+}
+
+func (pd *postgresDoer) createTask(ctx context.Context, doerID string, task *Task) error {
+	taskPB, err := proto.Marshal(task)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := pd.db.ExecContext(ctx, `
+		INSERT INTO task.tasks (doer_id, task_id, state, task_pb) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (doer_id, task_id) DO UPDATE SET
+			state = EXCLUDED.state, task_pb = EXCLUDED.task_pb, claim_id = NULL, claim_expires_at = NULL, collected = false`,
+		doerID, task.ID, task.State, taskPB); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return pd.notify(ctx, taskEvent, pd.namespace, pd.group)
+}
+
+// collect returns the tasks of a doer which have finished since the last call.
+func (pd *postgresDoer) collect(ctx context.Context, doerID string) ([]*Task, error) {
+	rows, err := pd.db.QueryContext(ctx, `
+		UPDATE task.tasks SET collected = true
+		WHERE doer_id = $1 AND state != $2 AND NOT collected
+		RETURNING task_pb`, doerID, State_RUNNING)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	var tasks []*Task
+	for rows.Next() {
+		var taskPB []byte
+		if err := rows.Scan(&taskPB); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		task := &Task{}
+		if err := proto.Unmarshal(taskPB, task); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, errors.EnsureStack(rows.Err())
+}
+
+type postgresSource struct {
+	*postgresService
+	namespace string
+}
+
+type groupKey struct {
+	namespace, group string
+}
+
+func (gk groupKey) String() string {
+	return path.Join(gk.namespace, gk.group)
+}
+
+func (ps *postgresSource) Iterate(ctx context.Context, cb ProcessFunc) error {
+	n := newNotifier(ps.namespaceChannel(ps.namespace))
+	if err := ps.listener.Register(n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer ps.listener.Unregister(n)
+	groups := make(map[groupKey]chan struct{})
+	tq := newTaskQueue(ctx)
+	updateGroups := func() error {
+		active, err := ps.activeGroups(ctx)
+		if err != nil {
+			return err
+		}
+		for gk := range groups {
+			if _, ok := active[gk]; !ok {
+				tq.deleteGroup(gk.String())
+				delete(groups, gk)
+			}
+		}
+		for gk, scheduling := range active {
+			if _, ok := groups[gk]; ok {
+				tq.updateGroup(gk.String(), scheduling)
+				continue
+			}
+			gk := gk
+			wake := make(chan struct{}, 1)
+			groups[gk] = wake
+			if err := tq.group(ctx, gk.String(), scheduling, func(ctx context.Context, taskFuncChan chan taskFunc) {
+				ps.forEachTask(ctx, gk, wake, taskFuncChan, cb)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := updateGroups(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.signal:
+			payloads, err := n.drain()
+			if err != nil {
+				return err
+			}
+			var groupChanged bool
+			for _, payload := range payloads {
+				event, namespace, group, err := parseEvent(payload)
+				if err != nil {
+					return err
+				}
+				if event == groupEvent {
+					groupChanged = true
+					continue
+				}
+				if wake, ok := groups[groupKey{namespace, group}]; ok {
+					signal(wake)
+				}
+			}
+			if groupChanged {
+				if err := updateGroups(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := ps.deleteExpiredGroups(ctx); err != nil {
+				return err
+			}
+			if err := updateGroups(); err != nil {
+				return err
+			}
+			for _, wake := range groups {
+				signal(wake)
+			}
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}
+}
+
+// activeGroups returns the groups with live doers, along with their
+// scheduling settings, which are merged as they are by mergeGroups.
+func (ps *postgresSource) activeGroups(ctx context.Context) (map[groupKey]*Group, error) {
+	rows, err := ps.db.QueryContext(ctx, `
+		SELECT namespace, group_id, MAX(priority), MAX(weight) FROM task.groups
+		WHERE prefix = $1 AND ($2 = '' OR namespace = $2) AND expires_at > now()
+		GROUP BY namespace, group_id`,
+		ps.prefix, ps.namespace)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	active := make(map[groupKey]*Group)
+	for rows.Next() {
+		var gk groupKey
+		scheduling := &Group{}
+		if err := rows.Scan(&gk.namespace, &gk.group, &scheduling.Priority, &scheduling.Weight); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		active[gk] = scheduling
+	}
+	return active, errors.EnsureStack(rows.Err())
+}
+
+// deleteExpiredGroups deletes the groups of doers which stopped renewing them
+// (along with their tasks), which the etcd service gets from lease expiry.
+func (ps *postgresSource) deleteExpiredGroups(ctx context.Context) error {
+	_, err := ps.db.ExecContext(ctx, `DELETE FROM task.groups WHERE prefix = $1 AND expires_at < now()`, ps.prefix)
+	return errors.EnsureStack(err)
+}
+
+// forEachTask offers the task queue one task of a group at a time. When the
+// group has no claimable tasks, it waits until it is woken by a notification
+// or the poll interval.
+func (ps *postgresSource) forEachTask(ctx context.Context, gk groupKey, wake chan struct{}, taskFuncChan chan taskFunc, cb ProcessFunc) {
+	for {
+		found := make(chan bool, 1)
+		select {
+		case taskFuncChan <- func() { found <- ps.processTask(ctx, gk, cb) }:
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case ok := <-found:
+			if ok {
+				continue
+			}
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// processTask claims a task of a group and processes it. It returns whether a
+// task was claimed.
+func (ps *postgresSource) processTask(ctx context.Context, gk groupKey, cb ProcessFunc) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	claimID := uuid.NewWithoutDashes()
+	var doerID, taskID string
+	var taskPB []byte
+	if err := ps.db.QueryRowContext(ctx, `
+		UPDATE task.tasks SET claim_id = $4, claim_expires_at = now() + $5::float8 * interval '1 second'
+		WHERE (doer_id, task_id) = (
+			SELECT t.doer_id, t.task_id FROM task.tasks t JOIN task.groups g ON t.doer_id = g.doer_id
+			WHERE g.prefix = $1 AND g.namespace = $2 AND g.group_id = $3 AND g.expires_at > now()
+				AND t.state = $6 AND (t.claim_expires_at IS NULL OR t.claim_expires_at < now())
+			ORDER BY t.seq LIMIT 1
+			FOR UPDATE OF t SKIP LOCKED
+		)
+		RETURNING doer_id, task_id, task_pb`,
+		ps.prefix, gk.namespace, gk.group, claimID, postgresTTL.Seconds(), State_RUNNING,
+	).Scan(&doerID, &taskID, &taskPB); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) && !errors.Is(ctx.Err(), context.Canceled) {
+			fmt.Printf("errored claiming task: %v\n", err)
+		}
+		return false
+	}
+	if err := func() error {
+		task := &Task{}
+		if err := proto.Unmarshal(taskPB, task); err != nil {
+			return errors.EnsureStack(err)
+		}
+		claimCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go renew(claimCtx, cancel, func(ctx context.Context) (bool, error) {
+			return rowsAffected(ps.db.ExecContext(ctx, `
+				UPDATE task.tasks SET claim_expires_at = now() + $4::float8 * interval '1 second'
+				WHERE doer_id = $1 AND task_id = $2 AND claim_id = $3`,
+				doerID, taskID, claimID, postgresTTL.Seconds()))
+		})
+		taskOutput, taskErr := cb(claimCtx, task.Input)
+		// If the task context was canceled or the claim was lost, release the
+		// claim so that another source can process the task.
+		if errors.Is(claimCtx.Err(), context.Canceled) {
+			if _, err := ps.db.ExecContext(context.Background(), `
+				UPDATE task.tasks SET claim_id = NULL, claim_expires_at = NULL
+				WHERE doer_id = $1 AND task_id = $2 AND claim_id = $3`,
+				doerID, taskID, claimID); err != nil {
+				return errors.EnsureStack(err)
+			}
+			return ps.notify(context.Background(), taskEvent, gk.namespace, gk.group)
+		}
+		task.State = State_SUCCESS
+		task.Output = taskOutput
+		if taskErr != nil {
+			task.State = State_FAILURE
+			task.Reason = taskErr.Error()
+		}
+		taskPB, err := proto.Marshal(task)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		updated, err := rowsAffected(ps.db.ExecContext(ctx, `
+			UPDATE task.tasks SET state = $4, task_pb = $5, claim_id = NULL, claim_expires_at = NULL
+			WHERE doer_id = $1 AND task_id = $2 AND claim_id = $3 AND state = $6`,
+			doerID, taskID, claimID, task.State, taskPB, State_RUNNING))
+		if err != nil || !updated {
+			return err
+		}
+		_, err = ps.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, doerChannel(doerID), taskID)
+		return errors.EnsureStack(err)
+	}(); err != nil && !errors.Is(ctx.Err(), context.Canceled) {
+		fmt.Printf("errored in task callback: %v\n", err)
+	}
+	return true
+}
+
+// notifier collects the notifications of a channel. Notifications are
+// buffered in a set, because the listener can't block on a slow consumer.
+type notifier struct {
+	id, channel string
+	signal      chan struct{}
+
+	mu       sync.Mutex
+	payloads map[string]struct{}
+	err      error
+}
+
+func newNotifier(channel string) *notifier {
+	return &notifier{
+		id:       uuid.NewWithoutDashes(),
+		channel:  channel,
+		signal:   make(chan struct{}, 1),
+		payloads: make(map[string]struct{}),
+	}
+}
+
+func (n *notifier) ID() string {
+	return n.id
+}
+
+func (n *notifier) Channel() string {
+	return n.channel
+}
+
+func (n *notifier) Notify(notification *col.Notification) {
+	n.mu.Lock()
+	n.payloads[notification.Extra] = struct{}{}
+	n.mu.Unlock()
+	n.wake()
+}
+
+func (n *notifier) Error(err error) {
+	n.mu.Lock()
+	n.err = err
+	n.mu.Unlock()
+	n.wake()
+}
+
+func (n *notifier) wake() {
+	signal(n.signal)
+}
+
+// drain returns the payloads received since the last call.
+func (n *notifier) drain() ([]string, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err != nil {
+		return nil, n.err
+	}
+	var payloads []string
+	for payload := range n.payloads {
+		payloads = append(payloads, payload)
+	}
+	n.payloads = make(map[string]struct{})
+	return payloads, nil
+}
+
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
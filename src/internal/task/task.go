@@ -3,6 +3,7 @@ package task
 import (
 	"context"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 )
 
@@ -30,11 +31,14 @@ import (
 
 // Scheduling:
 // A task managed by a Service has a group.
-// The group is used for scheduling purposes.
-// Scheduling is based on maximizing fairness for the groups, and the schedulable unit is a task.
+// The group is used for scheduling purposes, and the schedulable unit is a task.
+// A group has a priority and a weight, which are set by its Doers (see WithPriority and WithWeight).
+// Groups with a higher priority are strictly preferred: a Source only processes tasks of a lower
+// priority group when no higher priority group has a task ready.
+// Groups with the same priority share a Source fairly in proportion to their weights.
 type Service interface {
 	// NewDoer creates a Doer with the provided namespace and group.
-	NewDoer(namespace, group string, cache Cache) Doer
+	NewDoer(namespace, group string, cache Cache, opts ...DoerOption) Doer
 	// NewSource creates a Source with the provided namespace.
 	NewSource(namespace string) Source
 	// List calls a function on every task under a namespace and group
@@ -68,6 +72,57 @@ type CollectFunc = func(index int64, output *types.Any, _ error) error
 // This error will be propagated back to the Doer that created the task.
 type ProcessFunc = func(ctx context.Context, input *types.Any) (output *types.Any, _ error)
 
+// DoerOption configures the scheduling of the tasks created by a Doer.
+type DoerOption func(*Group)
+
+// WithPriority sets the priority class of a Doer's group.
+// The default priority is 0, and negative priorities are allowed.
+func WithPriority(priority int64) DoerOption {
+	return func(g *Group) {
+		g.Priority = priority
+	}
+}
+
+// WithWeight sets the weight of a Doer's group, relative to the other groups
+// in its priority class. A group with a weight of 2 is scheduled twice as
+// often as a group with a weight of 1. Weights which aren't positive are
+// treated as the default weight of 1.
+func WithWeight(weight float64) DoerOption {
+	return func(g *Group) {
+		g.Weight = weight
+	}
+}
+
+func newGroup(opts []DoerOption) *Group {
+	g := &Group{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.Weight <= 0 {
+		g.Weight = 1
+	}
+	return g
+}
+
+// mergeGroups returns the scheduling settings of a group which has been
+// registered by multiple doers, which are the highest of each of their settings.
+func mergeGroups(groups map[string]*Group) *Group {
+	var merged *Group
+	for _, g := range groups {
+		if merged == nil {
+			merged = proto.Clone(g).(*Group)
+			continue
+		}
+		if g.Priority > merged.Priority {
+			merged.Priority = g.Priority
+		}
+		if g.Weight > merged.Weight {
+			merged.Weight = g.Weight
+		}
+	}
+	return merged
+}
+
 type Cache interface {
 	Get(ctx context.Context, key string) (output *types.Any, _ error)
 	Put(ctx context.Context, key string, output *types.Any) error
@@ -4,6 +4,7 @@
 package task
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -55,7 +56,11 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6d80e170482be60c, []int{0}
 }
 
+// Group is registered by a doer for each Do call, and carries the scheduling
+// settings of the doer's group.
 type Group struct {
+	Priority             int64    `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight               float64  `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -94,17 +99,32 @@ func (m *Group) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Group proto.InternalMessageInfo
 
+func (m *Group) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Group) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 // TODO: Consider splitting this up into separate structures for each state in a oneof.
 type Task struct {
-	ID                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State                State      `protobuf:"varint,2,opt,name=state,proto3,enum=task.State" json:"state,omitempty"`
-	Input                *types.Any `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
-	Output               *types.Any `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
-	Reason               string     `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Index                int64      `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	ID                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State                State            `protobuf:"varint,2,opt,name=state,proto3,enum=task.State" json:"state,omitempty"`
+	Input                *types.Any       `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Output               *types.Any       `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	Reason               string           `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Index                int64            `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Created              *types.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -182,6 +202,13 @@ func (m *Task) GetIndex() int64 {
 	return 0
 }
 
+func (m *Task) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
 type Claim struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -279,29 +306,33 @@ func init() {
 func init() { proto.RegisterFile("internal/task/task.proto", fileDescriptor_6d80e170482be60c) }
 
 var fileDescriptor_6d80e170482be60c = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x51, 0x4b, 0x83, 0x50,
-	0x1c, 0xc5, 0xbb, 0x6e, 0xba, 0xba, 0xa3, 0xb0, 0xcb, 0x18, 0xb6, 0x87, 0x65, 0x3e, 0xc9, 0x08,
-	0x85, 0xed, 0x13, 0xb8, 0xb5, 0xc6, 0x28, 0x0c, 0xae, 0x4a, 0xd0, 0x4b, 0xdc, 0xcd, 0x9b, 0x93,
-	0x6d, 0x5e, 0xd1, 0x6b, 0xb4, 0x6f, 0x58, 0x6f, 0x7d, 0x82, 0x08, 0x3f, 0x49, 0x5c, 0x5d, 0x50,
-	0x2f, 0x7b, 0x91, 0xf3, 0xfb, 0x9f, 0x03, 0xc7, 0xc3, 0x85, 0x5a, 0x9c, 0x70, 0x9a, 0x25, 0x64,
-	0x63, 0x73, 0x92, 0xaf, 0xab, 0x8f, 0x95, 0x66, 0x8c, 0x33, 0xd4, 0x14, 0xba, 0xd7, 0x89, 0x58,
-	0xc4, 0xaa, 0x83, 0x2d, 0x54, 0xed, 0xf5, 0x2e, 0x22, 0xc6, 0xa2, 0x0d, 0xb5, 0x2b, 0x5a, 0x14,
-	0x2f, 0x36, 0x49, 0x76, 0xb5, 0x65, 0xb4, 0xa0, 0x3c, 0xcb, 0x58, 0x91, 0x1a, 0x1f, 0x00, 0x36,
-	0x7d, 0x92, 0xaf, 0x51, 0x17, 0x4a, 0x71, 0xa8, 0x01, 0x1d, 0x98, 0x27, 0x63, 0xa5, 0xfc, 0xba,
-	0x94, 0xe6, 0x37, 0x58, 0x8a, 0x43, 0x74, 0x05, 0xe5, 0x9c, 0x13, 0x4e, 0x35, 0x49, 0x07, 0xe6,
-	0xd9, 0xb0, 0x6d, 0x55, 0xe5, 0x9e, 0x38, 0xe1, 0xda, 0x41, 0x03, 0x28, 0xc7, 0x49, 0x5a, 0x70,
-	0xad, 0xa1, 0x03, 0xb3, 0x3d, 0xec, 0x58, 0x75, 0xaf, 0xf5, 0xdb, 0x6b, 0x39, 0xc9, 0x0e, 0xd7,
-	0x11, 0x74, 0x0d, 0x15, 0x56, 0x70, 0x11, 0x6e, 0x1e, 0x08, 0xef, 0x33, 0xa8, 0x0b, 0x95, 0x8c,
-	0x92, 0x9c, 0x25, 0x9a, 0x2c, 0x7e, 0x0c, 0xef, 0x09, 0x75, 0x44, 0x63, 0x48, 0xdf, 0x34, 0x45,
-	0x07, 0x66, 0x03, 0xd7, 0x20, 0x46, 0x4d, 0x36, 0x24, 0xde, 0x1a, 0x06, 0x3c, 0xf6, 0x69, 0xce,
-	0x0f, 0xed, 0x1a, 0x38, 0x50, 0xae, 0x46, 0xa0, 0x73, 0x78, 0xea, 0xf9, 0x8e, 0x3f, 0x7d, 0x0e,
-	0xdc, 0x3b, 0xf7, 0xe1, 0xd1, 0x55, 0x8f, 0x50, 0x1b, 0xb6, 0x70, 0xe0, 0xba, 0x73, 0x77, 0xa6,
-	0x02, 0x01, 0x5e, 0x30, 0x99, 0x4c, 0x3d, 0x4f, 0x95, 0x04, 0xdc, 0x3a, 0xf3, 0xfb, 0x00, 0x4f,
-	0xd5, 0xc6, 0xd8, 0x79, 0x2f, 0xfb, 0xe0, 0xb3, 0xec, 0x83, 0xef, 0xb2, 0x0f, 0x9e, 0x46, 0x51,
-	0xcc, 0x57, 0xc5, 0xc2, 0x5a, 0xb2, 0xad, 0x9d, 0x92, 0xe5, 0x6a, 0x17, 0xd2, 0xec, 0xaf, 0x7a,
-	0x1d, 0xda, 0x79, 0xb6, 0xb4, 0xff, 0xbd, 0xe4, 0x42, 0xa9, 0x66, 0x8f, 0x7e, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x44, 0x39, 0x6f, 0xd2, 0xe1, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x75, 0xd2, 0x26, 0x5d, 0xa7, 0x28, 0x75, 0x28, 0x4b, 0xcc, 0x43, 0xb7, 0xe6, 0xa9, 0x2c,
+	0x92, 0x40, 0xd7, 0x37, 0x9f, 0xb2, 0xb5, 0x2e, 0x45, 0x89, 0x30, 0x49, 0x10, 0x7c, 0x91, 0x69,
+	0x32, 0xa6, 0xc3, 0x36, 0x99, 0x30, 0x99, 0xa8, 0xf9, 0x43, 0x1f, 0xfd, 0x02, 0x91, 0xfc, 0x88,
+	0x32, 0x93, 0x54, 0xd4, 0x85, 0x7d, 0x09, 0xf7, 0xdc, 0x73, 0xee, 0xcd, 0x39, 0x97, 0x81, 0x36,
+	0x2b, 0x25, 0x15, 0x25, 0x39, 0xfa, 0x92, 0xd4, 0xb7, 0xfa, 0xe3, 0x55, 0x82, 0x4b, 0x8e, 0xc6,
+	0xaa, 0x76, 0xe6, 0x39, 0xcf, 0xb9, 0x6e, 0xf8, 0xaa, 0xea, 0x39, 0xe7, 0x69, 0xce, 0x79, 0x7e,
+	0xa4, 0xbe, 0x46, 0xfb, 0xe6, 0x93, 0x4f, 0xca, 0x76, 0xa0, 0x2e, 0xfe, 0xa7, 0x24, 0x2b, 0x68,
+	0x2d, 0x49, 0x51, 0xf5, 0x02, 0xf7, 0x25, 0x34, 0x6f, 0x04, 0x6f, 0x2a, 0xe4, 0xc0, 0xb3, 0x4a,
+	0x30, 0x2e, 0x98, 0x6c, 0x6d, 0xb0, 0x04, 0xab, 0x11, 0xfe, 0x83, 0xd1, 0x39, 0xb4, 0xbe, 0x50,
+	0x96, 0x1f, 0xa4, 0x6d, 0x2c, 0xc1, 0x0a, 0xe0, 0x01, 0xb9, 0xbf, 0x00, 0x1c, 0xc7, 0xa4, 0xbe,
+	0x45, 0xe7, 0xd0, 0x60, 0x99, 0x1e, 0x7b, 0x78, 0x6d, 0x75, 0x3f, 0x2e, 0x8c, 0xdd, 0x2b, 0x6c,
+	0xb0, 0x0c, 0x3d, 0x83, 0x66, 0x2d, 0x89, 0xa4, 0x7a, 0xee, 0xf1, 0x7a, 0xea, 0xe9, 0x44, 0x91,
+	0x6a, 0xe1, 0x9e, 0x41, 0x97, 0xd0, 0x64, 0x65, 0xd5, 0x48, 0x7b, 0xb4, 0x04, 0xab, 0xe9, 0x7a,
+	0xee, 0xf5, 0x8e, 0xbd, 0x93, 0x63, 0x2f, 0x28, 0x5b, 0xdc, 0x4b, 0xd0, 0x73, 0x68, 0xf1, 0x46,
+	0x2a, 0xf1, 0xf8, 0x1e, 0xf1, 0xa0, 0x51, 0xae, 0x05, 0x25, 0x35, 0x2f, 0x6d, 0x53, 0x19, 0xc3,
+	0x03, 0x42, 0x73, 0xf5, 0xc7, 0x8c, 0x7e, 0xb5, 0x2d, 0x1d, 0xb3, 0x07, 0xe8, 0x05, 0x9c, 0xa4,
+	0x82, 0x12, 0x49, 0x33, 0x7b, 0xa2, 0x97, 0x3b, 0x77, 0x96, 0xc7, 0xa7, 0xdb, 0xe1, 0x93, 0xd4,
+	0x9d, 0x40, 0x73, 0x73, 0x24, 0xac, 0x70, 0x5d, 0x78, 0x16, 0xd3, 0x5a, 0xde, 0x77, 0x8d, 0xcb,
+	0x00, 0x9a, 0x3a, 0x3a, 0x7a, 0x02, 0x1f, 0x45, 0x71, 0x10, 0x6f, 0x3f, 0x26, 0xe1, 0x9b, 0xf0,
+	0xdd, 0xfb, 0x70, 0xf6, 0x00, 0x4d, 0xe1, 0x04, 0x27, 0x61, 0xb8, 0x0b, 0x6f, 0x66, 0x40, 0x81,
+	0x28, 0xd9, 0x6c, 0xb6, 0x51, 0x34, 0x33, 0x14, 0x78, 0x1d, 0xec, 0xde, 0x26, 0x78, 0x3b, 0x1b,
+	0x5d, 0x07, 0xdf, 0xba, 0x05, 0xf8, 0xde, 0x2d, 0xc0, 0xcf, 0x6e, 0x01, 0x3e, 0x5c, 0xe5, 0x4c,
+	0x1e, 0x9a, 0xbd, 0x97, 0xf2, 0xc2, 0xaf, 0x48, 0x7a, 0x68, 0x33, 0x2a, 0xfe, 0xae, 0x3e, 0xaf,
+	0xfd, 0x5a, 0xa4, 0xfe, 0x3f, 0x8f, 0x6a, 0x6f, 0xe9, 0x3c, 0x57, 0xbf, 0x07, 0x00, 0xe1, 0xee,
+	0xb3, 0xad, 0x6c, 0x02, 0x00, 0x00,
 }
 
 func (m *Group) Marshal() (dAtA []byte, err error) {
@@ -328,6 +359,17 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Weight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -355,6 +397,18 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Index != 0 {
 		i = encodeVarintTask(dAtA, i, uint64(m.Index))
 		i--
@@ -484,6 +538,12 @@ func (m *Group) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovTask(uint64(m.Priority))
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -518,6 +578,10 @@ func (m *Task) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovTask(uint64(m.Index))
 	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -587,6 +651,36 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Weight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -812,6 +906,42 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
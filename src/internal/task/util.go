@@ -2,6 +2,7 @@ package task
 
 import (
 	"context"
+	"time"
 
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 
@@ -62,9 +63,50 @@ func translateTaskState(state State) taskapi.State {
 	return taskapi.State_UNKNOWN
 }
 
+type groupStats struct {
+	queueDepth int64
+	oldest     time.Time
+}
+
+// listGroupStats returns the number of tasks waiting to be claimed in each group,
+// and the creation time of the oldest of them.
+func listGroupStats(ctx context.Context, svc Service, namespace, group string) (map[groupKey]*groupStats, error) {
+	stats := make(map[groupKey]*groupStats)
+	if err := svc.List(ctx, namespace, group, func(namespace, group string, data *Task, claimed bool) error {
+		if data.State != State_RUNNING || claimed {
+			return nil
+		}
+		key := groupKey{namespace, group}
+		gs, ok := stats[key]
+		if !ok {
+			gs = &groupStats{}
+			stats[key] = gs
+		}
+		gs.queueDepth++
+		if data.Created != nil {
+			created, err := types.TimestampFromProto(data.Created)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			if gs.oldest.IsZero() || created.Before(gs.oldest) {
+				gs.oldest = created
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return stats, nil
+}
+
 // List implements the functionality for an arbitrary service's ListTask gRPC
 func List(ctx context.Context, svc Service, req *taskapi.ListTaskRequest, send func(info *taskapi.TaskInfo) error) error {
 	var marshaler jsonpb.Marshaler
+	stats, err := listGroupStats(ctx, svc, req.Group.Namespace, req.Group.Group)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
 	return errors.EnsureStack(svc.List(ctx, req.Group.Namespace, req.Group.Group, func(namespace, group string, data *Task, claimed bool) error {
 		state := translateTaskState(data.State)
 		if claimed {
@@ -93,6 +135,12 @@ func List(ctx context.Context, svc Service, req *taskapi.ListTaskRequest, send f
 			InputType: data.Input.TypeUrl,
 			InputData: inputJSON,
 		}
+		if gs, ok := stats[groupKey{namespace, group}]; ok {
+			info.GroupQueueDepth = gs.queueDepth
+			if !gs.oldest.IsZero() {
+				info.GroupWaitTime = types.DurationProto(now.Sub(gs.oldest))
+			}
+		}
 		return errors.EnsureStack(send(info))
 	}))
 }
@@ -56,6 +56,12 @@ type mockInspectCluster struct{ handler inspectClusterFunc }
 
 func (mock *mockInspectCluster) Use(cb inspectClusterFunc) { mock.handler = cb }
 
+type inspectStorageFunc func(context.Context, *admin.InspectStorageRequest) (*admin.StorageInfo, error)
+
+type mockInspectStorage struct{ handler inspectStorageFunc }
+
+func (mock *mockInspectStorage) Use(cb inspectStorageFunc) { mock.handler = cb }
+
 type adminServerAPI struct {
 	mock *mockAdminServer
 }
@@ -63,6 +69,7 @@ type adminServerAPI struct {
 type mockAdminServer struct {
 	api            adminServerAPI
 	InspectCluster mockInspectCluster
+	InspectStorage mockInspectStorage
 }
 
 func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty) (*admin.ClusterInfo, error) {
@@ -72,6 +79,13 @@ func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty)
 	return nil, errors.Errorf("unhandled pachd mock admin.InspectCluster")
 }
 
+func (api *adminServerAPI) InspectStorage(ctx context.Context, req *admin.InspectStorageRequest) (*admin.StorageInfo, error) {
+	if api.mock.InspectStorage.handler != nil {
+		return api.mock.InspectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock admin.InspectStorage")
+}
+
 /* Auth Server Mocks */
 
 type activateAuthFunc func(context.Context, *auth.ActivateRequest) (*auth.ActivateResponse, error)
@@ -435,14 +449,31 @@ type inspectCommitSetFunc func(*pfs.InspectCommitSetRequest, pfs.API_InspectComm
 type listCommitSetFunc func(*pfs.ListCommitSetRequest, pfs.API_ListCommitSetServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
 type clearCommitFunc func(context.Context, *pfs.ClearCommitRequest) (*types.Empty, error)
+type revertCommitFunc func(context.Context, *pfs.RevertCommitRequest) (*pfs.Commit, error)
 type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Empty, error)
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(*pfs.ListBranchRequest, pfs.API_ListBranchServer) error
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*types.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(*pfs.ListTagRequest, pfs.API_ListTagServer) error
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*types.Empty, error)
+type setRetentionPolicyFunc func(context.Context, *pfs.SetRetentionPolicyRequest) (*types.Empty, error)
+type enforceRetentionFunc func(context.Context, *pfs.EnforceRetentionRequest) (*pfs.EnforceRetentionResponse, error)
+type createReplicationFunc func(context.Context, *pfs.CreateReplicationRequest) (*types.Empty, error)
+type inspectReplicationFunc func(context.Context, *pfs.InspectReplicationRequest) (*pfs.ReplicationInfo, error)
+type listReplicationFunc func(*pfs.ListReplicationRequest, pfs.API_ListReplicationServer) error
+type deleteReplicationFunc func(context.Context, *pfs.DeleteReplicationRequest) (*types.Empty, error)
+type findMissingChunksFunc func(context.Context, *pfs.FindMissingChunksRequest) (*pfs.FindMissingChunksResponse, error)
+type replicateCommitFunc func(pfs.API_ReplicateCommitServer) error
+type exportRepoFunc func(*pfs.ExportRepoRequest, pfs.API_ExportRepoServer) error
+type importRepoFunc func(pfs.API_ImportRepoServer) error
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileTARFunc func(*pfs.GetFileRequest, pfs.API_GetFileTARServer) error
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
+type presignFileFunc func(context.Context, *pfs.PresignFileRequest) (*pfs.PresignFileResponse, error)
 type listFileFunc func(*pfs.ListFileRequest, pfs.API_ListFileServer) error
 type walkFileFunc func(*pfs.WalkFileRequest, pfs.API_WalkFileServer) error
 type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
@@ -478,14 +509,31 @@ type mockInspectCommitSet struct{ handler inspectCommitSetFunc }
 type mockListCommitSet struct{ handler listCommitSetFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
 type mockClearCommit struct{ handler clearCommitFunc }
+type mockRevertCommit struct{ handler revertCommitFunc }
 type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
+type mockSetRetentionPolicy struct{ handler setRetentionPolicyFunc }
+type mockEnforceRetention struct{ handler enforceRetentionFunc }
+type mockCreateReplication struct{ handler createReplicationFunc }
+type mockInspectReplication struct{ handler inspectReplicationFunc }
+type mockListReplication struct{ handler listReplicationFunc }
+type mockDeleteReplication struct{ handler deleteReplicationFunc }
+type mockFindMissingChunks struct{ handler findMissingChunksFunc }
+type mockReplicateCommit struct{ handler replicateCommitFunc }
+type mockExportRepo struct{ handler exportRepoFunc }
+type mockImportRepo struct{ handler importRepoFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockGetFileTAR struct{ handler getFileTARFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
+type mockPresignFile struct{ handler presignFileFunc }
 type mockListFile struct{ handler listFileFunc }
 type mockWalkFile struct{ handler walkFileFunc }
 type mockGlobFile struct{ handler globFileFunc }
@@ -517,6 +565,7 @@ func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handle
 func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
 func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
 func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
+func (mock *mockRevertCommit) Use(cb revertCommitFunc)             { mock.handler = cb }
 func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)       { mock.handler = cb }
 func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)           { mock.handler = cb }
 func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)     { mock.handler = cb }
@@ -525,10 +574,26 @@ func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handle
 func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
 func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
 func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)               { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)                   { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)                 { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                       { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)                   { mock.handler = cb }
+func (mock *mockSetRetentionPolicy) Use(cb setRetentionPolicyFunc) { mock.handler = cb }
+func (mock *mockEnforceRetention) Use(cb enforceRetentionFunc)     { mock.handler = cb }
+func (mock *mockCreateReplication) Use(cb createReplicationFunc)   { mock.handler = cb }
+func (mock *mockInspectReplication) Use(cb inspectReplicationFunc) { mock.handler = cb }
+func (mock *mockListReplication) Use(cb listReplicationFunc)       { mock.handler = cb }
+func (mock *mockDeleteReplication) Use(cb deleteReplicationFunc)   { mock.handler = cb }
+func (mock *mockFindMissingChunks) Use(cb findMissingChunksFunc)   { mock.handler = cb }
+func (mock *mockReplicateCommit) Use(cb replicateCommitFunc)       { mock.handler = cb }
+func (mock *mockExportRepo) Use(cb exportRepoFunc)                 { mock.handler = cb }
+func (mock *mockImportRepo) Use(cb importRepoFunc)                 { mock.handler = cb }
 func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
 func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
 func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                 { mock.handler = cb }
 func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
+func (mock *mockPresignFile) Use(cb presignFileFunc)               { mock.handler = cb }
 func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
 func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
 func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
@@ -566,6 +631,7 @@ type mockPFSServer struct {
 	ListCommit         mockListCommit
 	SubscribeCommit    mockSubscribeCommit
 	ClearCommit        mockClearCommit
+	RevertCommit       mockRevertCommit
 	SquashCommitSet    mockSquashCommitSet
 	DropCommitSet      mockDropCommitSet
 	InspectCommitSet   mockInspectCommitSet
@@ -574,10 +640,26 @@ type mockPFSServer struct {
 	InspectBranch      mockInspectBranch
 	ListBranch         mockListBranch
 	DeleteBranch       mockDeleteBranch
+	MergeBranch        mockMergeBranch
+	CreateTag          mockCreateTag
+	InspectTag         mockInspectTag
+	ListTag            mockListTag
+	DeleteTag          mockDeleteTag
+	SetRetentionPolicy mockSetRetentionPolicy
+	EnforceRetention   mockEnforceRetention
+	CreateReplication  mockCreateReplication
+	InspectReplication mockInspectReplication
+	ListReplication    mockListReplication
+	DeleteReplication  mockDeleteReplication
+	FindMissingChunks  mockFindMissingChunks
+	ReplicateCommit    mockReplicateCommit
+	ExportRepo         mockExportRepo
+	ImportRepo         mockImportRepo
 	ModifyFile         mockModifyFile
 	GetFile            mockGetFile
 	GetFileTAR         mockGetFileTAR
 	InspectFile        mockInspectFile
+	PresignFile        mockPresignFile
 	ListFile           mockListFile
 	WalkFile           mockWalkFile
 	GlobFile           mockGlobFile
@@ -689,6 +771,12 @@ func (api *pfsServerAPI) ClearCommit(ctx context.Context, req *pfs.ClearCommitRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.ClearCommit")
 }
+func (api *pfsServerAPI) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest) (*pfs.Commit, error) {
+	if api.mock.RevertCommit.handler != nil {
+		return api.mock.RevertCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RevertCommit")
+}
 func (api *pfsServerAPI) CreateBranch(ctx context.Context, req *pfs.CreateBranchRequest) (*types.Empty, error) {
 	if api.mock.CreateBranch.handler != nil {
 		return api.mock.CreateBranch.handler(ctx, req)
@@ -713,6 +801,96 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*types.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(req *pfs.ListTagRequest, serv pfs.API_ListTagServer) error {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*types.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
+func (api *pfsServerAPI) SetRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest) (*types.Empty, error) {
+	if api.mock.SetRetentionPolicy.handler != nil {
+		return api.mock.SetRetentionPolicy.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetRetentionPolicy")
+}
+func (api *pfsServerAPI) EnforceRetention(ctx context.Context, req *pfs.EnforceRetentionRequest) (*pfs.EnforceRetentionResponse, error) {
+	if api.mock.EnforceRetention.handler != nil {
+		return api.mock.EnforceRetention.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.EnforceRetention")
+}
+func (api *pfsServerAPI) CreateReplication(ctx context.Context, req *pfs.CreateReplicationRequest) (*types.Empty, error) {
+	if api.mock.CreateReplication.handler != nil {
+		return api.mock.CreateReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateReplication")
+}
+func (api *pfsServerAPI) InspectReplication(ctx context.Context, req *pfs.InspectReplicationRequest) (*pfs.ReplicationInfo, error) {
+	if api.mock.InspectReplication.handler != nil {
+		return api.mock.InspectReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectReplication")
+}
+func (api *pfsServerAPI) ListReplication(req *pfs.ListReplicationRequest, serv pfs.API_ListReplicationServer) error {
+	if api.mock.ListReplication.handler != nil {
+		return api.mock.ListReplication.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListReplication")
+}
+func (api *pfsServerAPI) DeleteReplication(ctx context.Context, req *pfs.DeleteReplicationRequest) (*types.Empty, error) {
+	if api.mock.DeleteReplication.handler != nil {
+		return api.mock.DeleteReplication.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteReplication")
+}
+func (api *pfsServerAPI) FindMissingChunks(ctx context.Context, req *pfs.FindMissingChunksRequest) (*pfs.FindMissingChunksResponse, error) {
+	if api.mock.FindMissingChunks.handler != nil {
+		return api.mock.FindMissingChunks.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.FindMissingChunks")
+}
+func (api *pfsServerAPI) ReplicateCommit(serv pfs.API_ReplicateCommitServer) error {
+	if api.mock.ReplicateCommit.handler != nil {
+		return api.mock.ReplicateCommit.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ReplicateCommit")
+}
+func (api *pfsServerAPI) ExportRepo(req *pfs.ExportRepoRequest, serv pfs.API_ExportRepoServer) error {
+	if api.mock.ExportRepo.handler != nil {
+		return api.mock.ExportRepo.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ExportRepo")
+}
+func (api *pfsServerAPI) ImportRepo(serv pfs.API_ImportRepoServer) error {
+	if api.mock.ImportRepo.handler != nil {
+		return api.mock.ImportRepo.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ImportRepo")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -737,6 +915,12 @@ func (api *pfsServerAPI) InspectFile(ctx context.Context, req *pfs.InspectFileRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.InspectFile")
 }
+func (api *pfsServerAPI) PresignFile(ctx context.Context, req *pfs.PresignFileRequest) (*pfs.PresignFileResponse, error) {
+	if api.mock.PresignFile.handler != nil {
+		return api.mock.PresignFile.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.PresignFile")
+}
 func (api *pfsServerAPI) ListFile(req *pfs.ListFileRequest, serv pfs.API_ListFileServer) error {
 	if api.mock.ListFile.handler != nil {
 		return api.mock.ListFile.handler(req, serv)
@@ -866,6 +1050,7 @@ type inspectDatumFunc func(context.Context, *pps.InspectDatumRequest) (*pps.Datu
 type listDatumFunc func(*pps.ListDatumRequest, pps.API_ListDatumServer) error
 type restartDatumFunc func(context.Context, *pps.RestartDatumRequest) (*types.Empty, error)
 type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*types.Empty, error)
+type dryRunPipelineFunc func(context.Context, *pps.DryRunPipelineRequest) (*pps.DryRunPipelineResponse, error)
 type inspectPipelineFunc func(context.Context, *pps.InspectPipelineRequest) (*pps.PipelineInfo, error)
 type listPipelineFunc func(*pps.ListPipelineRequest, pps.API_ListPipelineServer) error
 type deletePipelineFunc func(context.Context, *pps.DeletePipelineRequest) (*types.Empty, error)
@@ -897,6 +1082,7 @@ type mockInspectDatum struct{ handler inspectDatumFunc }
 type mockListDatum struct{ handler listDatumFunc }
 type mockRestartDatum struct{ handler restartDatumFunc }
 type mockCreatePipeline struct{ handler createPipelineFunc }
+type mockDryRunPipeline struct{ handler dryRunPipelineFunc }
 type mockInspectPipeline struct{ handler inspectPipelineFunc }
 type mockListPipeline struct{ handler listPipelineFunc }
 type mockDeletePipeline struct{ handler deletePipelineFunc }
@@ -928,6 +1114,7 @@ func (mock *mockInspectDatum) Use(cb inspectDatumFunc)                   { mock.
 func (mock *mockListDatum) Use(cb listDatumFunc)                         { mock.handler = cb }
 func (mock *mockRestartDatum) Use(cb restartDatumFunc)                   { mock.handler = cb }
 func (mock *mockCreatePipeline) Use(cb createPipelineFunc)               { mock.handler = cb }
+func (mock *mockDryRunPipeline) Use(cb dryRunPipelineFunc)               { mock.handler = cb }
 func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)             { mock.handler = cb }
 func (mock *mockListPipeline) Use(cb listPipelineFunc)                   { mock.handler = cb }
 func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)               { mock.handler = cb }
@@ -965,6 +1152,7 @@ type mockPPSServer struct {
 	ListDatum          mockListDatum
 	RestartDatum       mockRestartDatum
 	CreatePipeline     mockCreatePipeline
+	DryRunPipeline     mockDryRunPipeline
 	InspectPipeline    mockInspectPipeline
 	ListPipeline       mockListPipeline
 	DeletePipeline     mockDeletePipeline
@@ -1057,6 +1245,12 @@ func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.CreatePipeline")
 }
+func (api *ppsServerAPI) DryRunPipeline(ctx context.Context, req *pps.DryRunPipelineRequest) (*pps.DryRunPipelineResponse, error) {
+	if api.mock.DryRunPipeline.handler != nil {
+		return api.mock.DryRunPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DryRunPipeline")
+}
 func (api *ppsServerAPI) InspectPipeline(ctx context.Context, req *pps.InspectPipelineRequest) (*pps.PipelineInfo, error) {
 	if api.mock.InspectPipeline.handler != nil {
 		return api.mock.InspectPipeline.handler(ctx, req)
@@ -1,6 +1,8 @@
 package testpachd
 
 import (
+	"context"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -37,6 +39,26 @@ func (mock *mockNewJobFinisher) Use(cb newJobFinisherFunc) {
 	mock.handler = cb
 }
 
+type newSecretCreatorFunc func(context.Context, *txncontext.TransactionContext) txncontext.PpsSecretCreator
+
+type mockNewSecretCreator struct {
+	handler newSecretCreatorFunc
+}
+
+func (mock *mockNewSecretCreator) Use(cb newSecretCreatorFunc) {
+	mock.handler = cb
+}
+
+type newJobCacheClearerFunc func(context.Context, *txncontext.TransactionContext) txncontext.PpsJobCacheClearer
+
+type mockNewJobCacheClearer struct {
+	handler newJobCacheClearerFunc
+}
+
+func (mock *mockNewJobCacheClearer) Use(cb newJobCacheClearerFunc) {
+	mock.handler = cb
+}
+
 type stopJobInTransactionFunc func(*txncontext.TransactionContext, *pps.StopJobRequest) error
 
 type mockStopJobInTransaction struct {
@@ -67,6 +89,46 @@ func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionF
 	mock.handler = cb
 }
 
+type deletePipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.DeletePipelineRequest) error
+
+type mockDeletePipelineInTransaction struct {
+	handler deletePipelineInTransactionFunc
+}
+
+func (mock *mockDeletePipelineInTransaction) Use(cb deletePipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type startPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StartPipelineRequest) error
+
+type mockStartPipelineInTransaction struct {
+	handler startPipelineInTransactionFunc
+}
+
+func (mock *mockStartPipelineInTransaction) Use(cb startPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type stopPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StopPipelineRequest) error
+
+type mockStopPipelineInTransaction struct {
+	handler stopPipelineInTransactionFunc
+}
+
+func (mock *mockStopPipelineInTransaction) Use(cb stopPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type createSecretInTransactionFunc func(*txncontext.TransactionContext, *pps.CreateSecretRequest) error
+
+type mockCreateSecretInTransaction struct {
+	handler createSecretInTransactionFunc
+}
+
+func (mock *mockCreateSecretInTransaction) Use(cb createSecretInTransactionFunc) {
+	mock.handler = cb
+}
+
 type inspectPipelineInTransactionFunc func(*txncontext.TransactionContext, string) (*pps.PipelineInfo, error)
 
 type mockInspectPipelineInTransaction struct {
@@ -89,9 +151,15 @@ type MockPPSTransactionServer struct {
 	NewPropagater                mockNewPropagater
 	NewJobStopper                mockNewJobStopper
 	NewJobFinisher               mockNewJobFinisher
+	NewSecretCreator             mockNewSecretCreator
+	NewJobCacheClearer           mockNewJobCacheClearer
 	StopJobInTransaction         mockStopJobInTransaction
 	UpdateJobStateInTransaction  mockUpdateJobStateInTransaction
 	CreatePipelineInTransaction  mockCreatePipelineInTransaction
+	DeletePipelineInTransaction  mockDeletePipelineInTransaction
+	StartPipelineInTransaction   mockStartPipelineInTransaction
+	StopPipelineInTransaction    mockStopPipelineInTransaction
+	CreateSecretInTransaction    mockCreateSecretInTransaction
 	InspectPipelineInTransaction mockInspectPipelineInTransaction
 }
 
@@ -131,6 +199,31 @@ func (api *ppsTransactionAPI) NewJobFinisher(txnCtx *txncontext.TransactionConte
 	return &MockPPSJobFinisher{}
 }
 
+type MockPPSSecretCreator struct{}
+
+func (mpp *MockPPSSecretCreator) CreateSecret(*pps.CreateSecretRequest) {}
+func (mpp *MockPPSSecretCreator) Run() error                            { return nil }
+func (mpp *MockPPSSecretCreator) Rollback()                             {}
+
+func (api *ppsTransactionAPI) NewSecretCreator(ctx context.Context, txnCtx *txncontext.TransactionContext) txncontext.PpsSecretCreator {
+	if api.mock.NewSecretCreator.handler != nil {
+		return api.mock.NewSecretCreator.handler(ctx, txnCtx)
+	}
+	return &MockPPSSecretCreator{}
+}
+
+type MockPPSJobCacheClearer struct{}
+
+func (mpp *MockPPSJobCacheClearer) ClearJobCache(string) {}
+func (mpp *MockPPSJobCacheClearer) Run()                 {}
+
+func (api *ppsTransactionAPI) NewJobCacheClearer(ctx context.Context, txnCtx *txncontext.TransactionContext) txncontext.PpsJobCacheClearer {
+	if api.mock.NewJobCacheClearer.handler != nil {
+		return api.mock.NewJobCacheClearer.handler(ctx, txnCtx)
+	}
+	return &MockPPSJobCacheClearer{}
+}
+
 func (api *ppsTransactionAPI) StopJobInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopJobRequest) error {
 	if api.mock.StopJobInTransaction.handler != nil {
 		return api.mock.StopJobInTransaction.handler(txnCtx, req)
@@ -152,6 +245,34 @@ func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txncontext.Tra
 	return errors.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
 }
 
+func (api *ppsTransactionAPI) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.DeletePipelineRequest) error {
+	if api.mock.DeletePipelineInTransaction.handler != nil {
+		return api.mock.DeletePipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.DeletePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StartPipelineRequest) error {
+	if api.mock.StartPipelineInTransaction.handler != nil {
+		return api.mock.StartPipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.StartPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopPipelineRequest) error {
+	if api.mock.StopPipelineInTransaction.handler != nil {
+		return api.mock.StopPipelineInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.StopPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) CreateSecretInTransaction(txnCtx *txncontext.TransactionContext, req *pps.CreateSecretRequest) error {
+	if api.mock.CreateSecretInTransaction.handler != nil {
+		return api.mock.CreateSecretInTransaction.handler(txnCtx, req)
+	}
+	return errors.Errorf("unhandled pachd mock: pps.CreateSecretInTransaction")
+}
+
 func (api *ppsTransactionAPI) InspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, pipeline string) (*pps.PipelineInfo, error) {
 	if api.mock.InspectPipelineInTransaction.handler != nil {
 		return api.mock.InspectPipelineInTransaction.handler(txnCtx, pipeline)
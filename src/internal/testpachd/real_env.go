@@ -19,10 +19,12 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/proxy"
+	adminserver "github.com/pachyderm/pachyderm/v2/src/server/admin/server"
 	authapi "github.com/pachyderm/pachyderm/v2/src/server/auth"
 	authtesting "github.com/pachyderm/pachyderm/v2/src/server/auth/testing"
 	pfsapi "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	ppsapi "github.com/pachyderm/pachyderm/v2/src/server/pps"
 	proxyserver "github.com/pachyderm/pachyderm/v2/src/server/proxy/server"
 	txnserver "github.com/pachyderm/pachyderm/v2/src/server/transaction/server"
 )
@@ -35,8 +37,10 @@ type RealEnv struct {
 	MockEnv
 
 	ServiceEnv               serviceenv.ServiceEnv
+	AdminServer              adminserver.APIServer
 	AuthServer               authapi.APIServer
 	PFSServer                pfsapi.APIServer
+	PPSServer                ppsapi.APIServer
 	TransactionServer        txnserver.APIServer
 	ProxyServer              proxy.APIServer
 	MockPPSTransactionServer *MockPPSTransactionServer
@@ -44,9 +48,31 @@ type RealEnv struct {
 
 // NewRealEnv constructs a MockEnv, then forwards all API calls to go to API
 // server instances for supported operations. PPS requires a kubernetes
-// environment in order to spin up pipelines, which is not yet supported by this
-// package, but the other API servers work.
+// environment in order to spin up pipelines, so it is mocked, but the other API
+// servers work. See NewRealEnvWithPPS for running pipelines.
 func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	return newRealEnv(t, nil, customOpts...)
+}
+
+// NewPPSServerFunc constructs the PPS API server of a RealEnv. The PPS server
+// package imports testpachd in its tests, so it is passed in by the caller.
+type NewPPSServerFunc func(serviceenv.ServiceEnv, *txnenv.TransactionEnv) (ppsapi.APIServer, error)
+
+// NewRealEnvWithPPS is like NewRealEnv, but also runs the PPS API server
+// returned by newPPS, whose pipeline workers run as local processes of
+// workerBinary, a build of src/server/cmd/worker.
+func NewRealEnvWithPPS(t testing.TB, newPPS NewPPSServerFunc, workerBinary string, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	opts := []serviceenv.ConfigOption{
+		func(config *serviceenv.Configuration) {
+			config.PPSInfraDriver = serviceenv.InfraDriverLocal
+			config.PPSLocalWorkerBinary = workerBinary
+			config.PPSLocalWorkerDir = path.Join(t.TempDir(), "workers")
+		},
+	}
+	return newRealEnv(t, newPPS, append(opts, customOpts...)...)
+}
+
+func newRealEnv(t testing.TB, newPPS NewPPSServerFunc, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	mockEnv := NewMockEnv(t)
 
 	realEnv := &RealEnv{MockEnv: *mockEnv}
@@ -102,26 +128,37 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPfsServer(realEnv.PFSServer)
 
 	// PPS
-	realEnv.MockPPSTransactionServer = NewMockPPSTransactionServer()
-	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
-	realEnv.MockPPSTransactionServer.InspectPipelineInTransaction.
-		Use(func(txnctx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
-			return nil, col.ErrNotFound{
-				Type: "pipelines",
-				Key:  name,
-			}
-		})
+	if newPPS != nil {
+		realEnv.PPSServer, err = newPPS(realEnv.ServiceEnv, txnEnv)
+		require.NoError(t, err)
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(realEnv.PPSServer)
+	} else {
+		realEnv.MockPPSTransactionServer = NewMockPPSTransactionServer()
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
+		realEnv.MockPPSTransactionServer.InspectPipelineInTransaction.
+			Use(func(txnctx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
+				return nil, col.ErrNotFound{
+					Type: "pipelines",
+					Key:  name,
+				}
+			})
+	}
 
 	realEnv.TransactionServer, err = txnserver.NewAPIServer(realEnv.ServiceEnv, txnEnv)
 	require.NoError(t, err)
 	realEnv.ProxyServer = proxyserver.NewAPIServer(proxyserver.Env{Listener: realEnv.ServiceEnv.GetPostgresListener()})
+	realEnv.AdminServer = adminserver.NewAPIServer(adminserver.EnvFromServiceEnv(realEnv.ServiceEnv))
 
 	txnEnv.Initialize(realEnv.ServiceEnv, realEnv.TransactionServer)
 
+	linkServers(&realEnv.MockPachd.Admin, realEnv.AdminServer)
 	linkServers(&realEnv.MockPachd.PFS, realEnv.PFSServer)
 	linkServers(&realEnv.MockPachd.Auth, realEnv.AuthServer)
 	linkServers(&realEnv.MockPachd.Transaction, realEnv.TransactionServer)
 	linkServers(&realEnv.MockPachd.Proxy, realEnv.ProxyServer)
+	if newPPS != nil {
+		linkServers(&realEnv.MockPachd.PPS, realEnv.PPSServer)
+	}
 
 	return realEnv
 }
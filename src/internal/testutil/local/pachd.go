@@ -382,7 +382,7 @@ func RunLocal() (retErr error) {
 		return internalServer.Wait()
 	})
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient)
+		router := s3.Router(s3.NewMasterDriver([]byte(env.Config().S3GatewayPresignKey)), env.GetPachClient)
 		server := s3.Server(env.Config().S3GatewayPort, router)
 
 		if err != nil {
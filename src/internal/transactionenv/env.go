@@ -33,6 +33,8 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	AddFileSet(*pfs.AddFileSetRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -43,6 +45,10 @@ type PpsWrites interface {
 	StopJob(*pps.StopJobRequest) error
 	UpdateJobState(*pps.UpdateJobStateRequest) error
 	CreatePipeline(*pps.CreatePipelineRequest) error
+	DeletePipeline(*pps.DeletePipelineRequest) error
+	StartPipeline(*pps.StartPipelineRequest) error
+	StopPipeline(*pps.StopPipelineRequest) error
+	CreateSecret(*pps.CreateSecretRequest) error
 }
 
 // AuthWrites is an interface providing a wrapper for each operation that
@@ -159,6 +165,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req))
 }
 
+func (t *directTransaction) AddFileSet(original *pfs.AddFileSetRequest) error {
+	req := proto.Clone(original).(*pfs.AddFileSetRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PfsServer().AddFileSetInTransaction(t.txnCtx, req))
+}
+
 func (t *directTransaction) StopJob(original *pps.StopJobRequest) error {
 	req := proto.Clone(original).(*pps.StopJobRequest)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StopJobInTransaction(t.txnCtx, req))
@@ -180,6 +191,26 @@ func (t *directTransaction) CreatePipeline(original *pps.CreatePipelineRequest)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().CreatePipelineInTransaction(t.txnCtx, req))
 }
 
+func (t *directTransaction) DeletePipeline(original *pps.DeletePipelineRequest) error {
+	req := proto.Clone(original).(*pps.DeletePipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().DeletePipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) StartPipeline(original *pps.StartPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StartPipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StartPipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) StopPipeline(original *pps.StopPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StopPipelineRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().StopPipelineInTransaction(t.txnCtx, req))
+}
+
+func (t *directTransaction) CreateSecret(original *pps.CreateSecretRequest) error {
+	req := proto.Clone(original).(*pps.CreateSecretRequest)
+	return errors.EnsureStack(t.txnEnv.serviceEnv.PpsServer().CreateSecretInTransaction(t.txnCtx, req))
+}
+
 func (t *directTransaction) DeleteRoleBinding(original *auth.Resource) error {
 	req := proto.Clone(original).(*auth.Resource)
 	return errors.EnsureStack(t.txnEnv.serviceEnv.AuthServer().DeleteRoleBindingInTransaction(t.txnCtx, req))
@@ -237,6 +268,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return errors.EnsureStack(err)
 }
 
+func (t *appendTransaction) AddFileSet(req *pfs.AddFileSetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{AddFileSet: req})
+	return errors.EnsureStack(err)
+}
+
 func (t *appendTransaction) StopJob(req *pps.StopJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopJob: req})
 	return errors.EnsureStack(err)
@@ -252,6 +288,26 @@ func (t *appendTransaction) CreatePipeline(req *pps.CreatePipelineRequest) error
 	return errors.EnsureStack(err)
 }
 
+func (t *appendTransaction) DeletePipeline(req *pps.DeletePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeletePipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) StartPipeline(req *pps.StartPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartPipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) StopPipeline(req *pps.StopPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipeline: req})
+	return errors.EnsureStack(err)
+}
+
+func (t *appendTransaction) CreateSecret(req *pps.CreateSecretRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{CreateSecret: req})
+	return errors.EnsureStack(err)
+}
+
 func (t *appendTransaction) ModifyRoleBinding(original *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
 	panic("ModifyRoleBinding not yet implemented in transactions")
 }
@@ -303,10 +359,10 @@ func (env *TransactionEnv) WithTransaction(ctx context.Context, cb func(Transact
 	}
 }
 
-func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, cb func(*txncontext.TransactionContext) error) error {
+func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, write bool, cb func(*txncontext.TransactionContext) error) (*txncontext.TransactionContext, error) {
 	txnCtx, err := txncontext.New(ctx, sqlTx, env.serviceEnv.AuthServer())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if env.serviceEnv.PfsServer() != nil {
 		txnCtx.PfsPropagater = env.serviceEnv.PfsServer().NewPropagater(txnCtx)
@@ -315,13 +371,17 @@ func (env *TransactionEnv) attemptTx(ctx context.Context, sqlTx *pachsql.Tx, cb
 		txnCtx.PpsPropagater = env.serviceEnv.PpsServer().NewPropagater(txnCtx)
 		txnCtx.PpsJobStopper = env.serviceEnv.PpsServer().NewJobStopper(txnCtx)
 		txnCtx.PpsJobFinisher = env.serviceEnv.PpsServer().NewJobFinisher(txnCtx)
+		if write {
+			txnCtx.PpsSecretCreator = env.serviceEnv.PpsServer().NewSecretCreator(ctx, txnCtx)
+			txnCtx.PpsJobCacheClearer = env.serviceEnv.PpsServer().NewJobCacheClearer(ctx, txnCtx)
+		}
 	}
 
 	err = cb(txnCtx)
 	if err != nil {
-		return err
+		return txnCtx, err
 	}
-	return txnCtx.Finish()
+	return txnCtx, txnCtx.Finish()
 }
 
 func (env *TransactionEnv) waitReady(ctx context.Context) error {
@@ -339,9 +399,24 @@ func (env *TransactionEnv) WithWriteContext(ctx context.Context, cb func(*txncon
 	if err := env.waitReady(ctx); err != nil {
 		return err
 	}
-	return dbutil.WithTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
-		return env.attemptTx(ctx, sqlTx, cb)
-	})
+	// Each retry of the database transaction undoes the external changes of
+	// the previous attempt, as does a failure to commit the last one.
+	var txnCtx *txncontext.TransactionContext
+	if err := dbutil.WithTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
+		if txnCtx != nil {
+			txnCtx.Rollback()
+		}
+		var err error
+		txnCtx, err = env.attemptTx(ctx, sqlTx, true, cb)
+		return err
+	}); err != nil {
+		if txnCtx != nil {
+			txnCtx.Rollback()
+		}
+		return err
+	}
+	txnCtx.Committed()
+	return nil
 }
 
 // WithReadContext will call the given callback with a txncontext.TransactionContext
@@ -352,6 +427,7 @@ func (env *TransactionEnv) WithReadContext(ctx context.Context, cb func(*txncont
 		return err
 	}
 	return col.NewDryrunSQLTx(ctx, env.serviceEnv.GetDBClient(), func(sqlTx *pachsql.Tx) error {
-		return env.attemptTx(ctx, sqlTx, cb)
+		_, err := env.attemptTx(ctx, sqlTx, false, cb)
+		return err
 	})
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 // TransactionContext is a helper type to encapsulate the state for a given
@@ -32,6 +33,13 @@ type TransactionContext struct {
 	// PpsJobStopper stops Jobs in any pipelines that are associated with a removed commitset
 	PpsJobStopper  PpsJobStopper
 	PpsJobFinisher PpsJobFinisher
+	// PpsSecretCreator creates Kubernetes secrets at the end of the transaction.
+	// It is only set for transactions which may write.
+	PpsSecretCreator PpsSecretCreator
+	// PpsJobCacheClearer clears the cached results of jobs once the
+	// transaction is committed. It is only set for transactions which may
+	// write.
+	PpsJobCacheClearer PpsJobCacheClearer
 }
 
 type identifier interface {
@@ -86,6 +94,23 @@ func (t *TransactionContext) FinishJob(commitInfo *pfs.CommitInfo) {
 	t.PpsJobFinisher.FinishJob(commitInfo)
 }
 
+// CreateSecret saves a secret to be created at the end of the transaction (if
+// all operations complete successfully). The request must already have been
+// validated. Secrets are not created by transactions which can't write.
+func (t *TransactionContext) CreateSecret(request *pps.CreateSecretRequest) {
+	if t.PpsSecretCreator != nil {
+		t.PpsSecretCreator.CreateSecret(request)
+	}
+}
+
+// ClearJobCache saves a tag prefix of cached job results to be cleared once
+// the transaction is committed.
+func (t *TransactionContext) ClearJobCache(tagPrefix string) {
+	if t.PpsJobCacheClearer != nil {
+		t.PpsJobCacheClearer.ClearJobCache(tagPrefix)
+	}
+}
+
 // PropagateBranch saves a branch to be propagated at the end of the transaction
 // (if all operations complete successfully).  This is used to batch together
 // propagations and dedupe downstream commits in PFS.
@@ -122,9 +147,32 @@ func (t *TransactionContext) Finish() error {
 			return errors.EnsureStack(err)
 		}
 	}
+	// Secrets live outside of the database, so they are created last and
+	// removed again by Rollback if the database transaction fails.
+	if t.PpsSecretCreator != nil {
+		if err := t.PpsSecretCreator.Run(); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
 	return nil
 }
 
+// Committed applies the deferred logic which must only run once the database
+// transaction has been committed, since it can't be undone.
+func (t *TransactionContext) Committed() {
+	if t.PpsJobCacheClearer != nil {
+		t.PpsJobCacheClearer.Run()
+	}
+}
+
+// Rollback undoes the changes Finish made outside of the database, for when the
+// database transaction could not be committed.
+func (t *TransactionContext) Rollback() {
+	if t.PpsSecretCreator != nil {
+		t.PpsSecretCreator.Rollback()
+	}
+}
+
 // PfsPropagater is the interface that PFS implements to propagate commits at
 // the end of a transaction.  It is defined here to avoid a circular dependency.
 type PfsPropagater interface {
@@ -152,3 +200,20 @@ type PpsJobFinisher interface {
 	FinishJob(commitInfo *pfs.CommitInfo)
 	Run() error
 }
+
+// PpsSecretCreator is the interface that PPS implements to create Kubernetes
+// secrets at the end of a transaction.  It is defined here to avoid a circular
+// dependency.
+type PpsSecretCreator interface {
+	CreateSecret(request *pps.CreateSecretRequest)
+	Run() error
+	Rollback()
+}
+
+// PpsJobCacheClearer is the interface that PPS implements to clear the cached
+// results of jobs once a transaction is committed.  It is defined here to
+// avoid a circular dependency.
+type PpsJobCacheClearer interface {
+	ClearJobCache(tagPrefix string)
+	Run()
+}
@@ -28,13 +28,31 @@ type SQLIngestParams struct {
 	Password  secrets.Secret
 	Format    string
 	HasHeader bool
+
+	// WatermarkColumn makes the ingest incremental if set. It must be a
+	// monotonic column in the result of each query, such as an updated_at
+	// timestamp or an id, named by a plain identifier. Only rows with a value
+	// greater than Watermark are read, and the largest value read is written
+	// under WatermarksDir.
+	WatermarkColumn string
+	// Watermark is the watermark recorded by the previous run.
+	// If it is empty, all rows are read.
+	Watermark string
+	// TombstoneColumn is an optional boolean column which marks rows as
+	// deleted in an incremental ingest. Those rows are written under
+	// DeletesDir instead, they are not removed from the output of previous
+	// runs.
+	TombstoneColumn string
 }
 
 // SQLIngest connects to a SQL database at params.URL and runs queries
 // read from files in the input.
 // The resulting rows are written to files in params.OutputDir.
 // The format of the output file is controlled by params.Format.
-// Valid options are "json" and "csv"
+// Valid options are "json", "csv" and "parquet"
+//
+// If params.WatermarkColumn is set, only the rows beyond params.Watermark are
+// read, see SQLIngestParams.
 //
 // It makes outgoing connections using pachsql.OpenURL
 // It accesses the filesystem only within params.InputDir, and params.OutputDir
@@ -58,6 +76,13 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 	if err != nil {
 		return err
 	}
+	if params.WatermarkColumn != "" {
+		if err := sqlIngestIncremental(ctx, db, writerFactory, params); err != nil {
+			return err
+		}
+		log.Infof("DONE")
+		return nil
+	}
 	if err := bijectiveMap(params.InputDir, params.OutputDir, IdentityPM, func(r io.Reader, w io.Writer) error {
 		queryBytes, err := io.ReadAll(r)
 		if err != nil {
@@ -71,12 +96,16 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 			return errors.EnsureStack(err)
 		}
 		log.Infof("Query complete, begin reading rows...")
-		colNames, err := rows.Columns()
+		cTypes, err := rows.ColumnTypes()
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
-		log.Infof("Column names: %v", colNames)
-		tw := writerFactory(w, colNames)
+		info := sdata.NewTableInfoFromColumnTypes(cTypes)
+		log.Infof("Columns: %v", info.Columns)
+		tw, err := writerFactory(w, info)
+		if err != nil {
+			return err
+		}
 		res, err := sdata.MaterializeSQL(tw, rows)
 		if err != nil {
 			return err
@@ -90,33 +119,48 @@ func SQLIngest(ctx context.Context, params SQLIngestParams) error {
 	return nil
 }
 
-type writerFactory = func(w io.Writer, fieldNames []string) sdata.TupleWriter
+type writerFactory = func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error)
 
 func makeWriterFactory(formatName string, hasHeader bool) (writerFactory, error) {
 	switch formatName {
 	case "json", "jsonlines":
-		return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewJSONWriter(w, fieldNames)
+		return func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewJSONWriter(w, columnNames(info)), nil
 		}, nil
 	case "csv":
 		if hasHeader {
-			return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-				return sdata.NewCSVWriter(w, fieldNames)
+			return func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+				return sdata.NewCSVWriter(w, columnNames(info)), nil
 			}, nil
 		}
-		return func(w io.Writer, fieldNames []string) sdata.TupleWriter {
-			return sdata.NewCSVWriter(w, nil)
+		return func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewCSVWriter(w, nil), nil
+		}, nil
+	case "parquet":
+		return func(w io.Writer, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+			return sdata.NewParquetWriter(w, info)
 		}, nil
 	default:
 		return nil, errors.Errorf("unrecognized format %v", formatName)
 	}
 }
 
+func columnNames(info *pachsql.TableInfo) []string {
+	var names []string
+	for _, ci := range info.Columns {
+		names = append(names, ci.Name)
+	}
+	return names
+}
+
 type SQLQueryGenerationParams struct {
 	Logger              *logrus.Logger
 	InputDir, OutputDir string
 
 	Query string
+	// Incremental names the output after the cron timestamp instead of
+	// 0000, so that the output of each tick downstream is named after it.
+	Incremental bool
 }
 
 // SQLQueryGeneration generates queries with a timestamp in the comments
@@ -127,7 +171,11 @@ func SQLQueryGeneration(ctx context.Context, params SQLQueryGenerationParams) er
 	}
 	timestampComment := fmt.Sprintf("-- %d\n", timestamp)
 	contents := timestampComment + params.Query + "\n"
-	outputPath := filepath.Join(params.OutputDir, "0000")
+	outputName := "0000"
+	if params.Incremental {
+		outputName = fmt.Sprintf("%010d", timestamp)
+	}
+	outputPath := filepath.Join(params.OutputDir, outputName)
 	return errors.EnsureStack(ioutil.WriteFile(outputPath, []byte(contents), 0755))
 }
 
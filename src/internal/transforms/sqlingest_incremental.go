@@ -0,0 +1,289 @@
+package transforms
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+)
+
+const (
+	// WatermarksDir is the directory in the output of an incremental ingest
+	// which holds the watermark recorded for each query.
+	WatermarksDir = "watermarks"
+	// DeletesDir is the directory in the output of an incremental ingest
+	// which holds the rows marked as deleted by the tombstone column.
+	DeletesDir = "deletes"
+)
+
+// identifierRegex matches the column names which can be used as a watermark
+// or tombstone column. They are put in the query as they are, so anything that
+// is not a plain identifier is rejected.
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func validateIdentifier(name string) error {
+	if !identifierRegex.MatchString(name) {
+		return errors.Errorf("invalid column name %q, must match %v", name, identifierRegex)
+	}
+	return nil
+}
+
+// sqlIngestIncremental runs each query in params.InputDir, only reading rows
+// with a value in params.WatermarkColumn greater than params.Watermark.
+// For each query file, the rows are written to the same path in
+// params.OutputDir, rows marked as deleted are written under DeletesDir, and
+// the new watermark is written under WatermarksDir.
+// Rows marked as deleted are not removed from the output of previous runs,
+// it is up to the consumers of the output to apply the deletes.
+func sqlIngestIncremental(ctx context.Context, db *pachsql.DB, wf writerFactory, params SQLIngestParams) error {
+	log := params.Logger
+	if err := validateIdentifier(params.WatermarkColumn); err != nil {
+		return errors.Wrap(err, "watermark column")
+	}
+	if params.TombstoneColumn != "" {
+		if err := validateIdentifier(params.TombstoneColumn); err != nil {
+			return errors.Wrap(err, "tombstone column")
+		}
+	}
+	log.Infof("Incremental ingest on column %q, watermark: %q", params.WatermarkColumn, params.Watermark)
+	err := filepath.WalkDir(params.InputDir, func(inputPath string, dirEnt fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEnt.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(params.InputDir, inputPath)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		queryBytes, err := ioutil.ReadFile(inputPath)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		query, args, err := incrementalQuery(db, string(queryBytes), params.WatermarkColumn, params.Watermark)
+		if err != nil {
+			return err
+		}
+		log.Infof("Query: %q, args: %v", query, args)
+		watermark, count, err := ingestIncremental(ctx, db, wf, params, name, query, args)
+		if err != nil {
+			return err
+		}
+		log.Infof("Successfully materialized %d rows, new watermark: %q", count, watermark)
+		return writeOutputFile(params.OutputDir, filepath.Join(WatermarksDir, name), []byte(watermark))
+	})
+	return errors.EnsureStack(err)
+}
+
+// incrementalQuery wraps query so that it only returns rows beyond watermark,
+// ordered by column. column must have been validated with validateIdentifier.
+func incrementalQuery(db *pachsql.DB, query, column, watermark string) (string, []interface{}, error) {
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	// The query is followed by a newline in case it ends with a comment.
+	if watermark == "" {
+		return "SELECT * FROM (" + query + "\n) AS q ORDER BY " + column, nil, nil
+	}
+	arg, err := decodeWatermark(watermark)
+	if err != nil {
+		return "", nil, err
+	}
+	return db.Rebind("SELECT * FROM (" + query + "\n) AS q WHERE " + column + " > ? ORDER BY " + column), []interface{}{arg}, nil
+}
+
+func ingestIncremental(ctx context.Context, db *pachsql.DB, wf writerFactory, params SQLIngestParams, name, query string, args []interface{}) (_ string, _ uint64, retErr error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	cTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	info := sdata.NewTableInfoFromColumnTypes(cTypes)
+	watermarkIdx, err := columnIndex(info, params.WatermarkColumn)
+	if err != nil {
+		return "", 0, err
+	}
+	tombstoneIdx := -1
+	if params.TombstoneColumn != "" {
+		if tombstoneIdx, err = columnIndex(info, params.TombstoneColumn); err != nil {
+			return "", 0, err
+		}
+	}
+	row, err := sdata.NewTupleFromColumnTypes(cTypes)
+	if err != nil {
+		return "", 0, err
+	}
+	dataFile, err := createOutputFile(params.OutputDir, name)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() {
+		if err := dataFile.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	tw, err := wf(dataFile, info)
+	if err != nil {
+		return "", 0, err
+	}
+	var dw sdata.TupleWriter
+	if tombstoneIdx >= 0 {
+		deletesFile, err := createOutputFile(params.OutputDir, filepath.Join(DeletesDir, name))
+		if err != nil {
+			return "", 0, err
+		}
+		defer func() {
+			if err := deletesFile.Close(); retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+		}()
+		if dw, err = wf(deletesFile, info); err != nil {
+			return "", 0, err
+		}
+	}
+	watermark := params.Watermark
+	var count uint64
+	for rows.Next() {
+		if err := rows.Scan(row...); err != nil {
+			return "", 0, errors.EnsureStack(err)
+		}
+		w := tw
+		if tombstoneIdx >= 0 {
+			deleted, err := isTombstone(row[tombstoneIdx])
+			if err != nil {
+				return "", 0, err
+			}
+			if deleted {
+				w = dw
+			}
+		}
+		if err := w.WriteTuple(row); err != nil {
+			return "", 0, errors.EnsureStack(err)
+		}
+		// Rows are ordered by the watermark column, so the last value is the
+		// largest.
+		if v, ok, err := watermarkValue(row[watermarkIdx]); err != nil {
+			return "", 0, err
+		} else if ok {
+			watermark = v
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	if err := tw.Flush(); err != nil {
+		return "", 0, errors.EnsureStack(err)
+	}
+	if dw != nil {
+		if err := dw.Flush(); err != nil {
+			return "", 0, errors.EnsureStack(err)
+		}
+	}
+	return watermark, count, nil
+}
+
+func columnIndex(info *pachsql.TableInfo, name string) (int, error) {
+	for i, ci := range info.Columns {
+		if strings.EqualFold(ci.Name, name) {
+			return i, nil
+		}
+	}
+	return -1, errors.Errorf("query result has no column %q", name)
+}
+
+// The prefixes of the encoded watermarks, which record the type of the
+// watermark column, so that the watermark is passed back to the database as a
+// value of that type.
+const (
+	watermarkString    = "string:"
+	watermarkTimestamp = "timestamp:"
+)
+
+// watermarkValue returns the encoded value of a watermark column, see
+// decodeWatermark. It returns false if the value is null. Numbers are read as
+// strings, so that they don't lose precision.
+func watermarkValue(x interface{}) (string, bool, error) {
+	switch x := x.(type) {
+	case *string:
+		return watermarkString + *x, true, nil
+	case *sql.NullString:
+		return watermarkString + x.String, x.Valid, nil
+	case *time.Time:
+		return watermarkTimestamp + x.Format(time.RFC3339Nano), true, nil
+	case *sql.NullTime:
+		return watermarkTimestamp + x.Time.Format(time.RFC3339Nano), x.Valid, nil
+	default:
+		return "", false, errors.Errorf("unsupported type for watermark column: %T", x)
+	}
+}
+
+// decodeWatermark returns the query parameter for a watermark encoded by
+// watermarkValue. Timestamps are passed as time.Time, so that the driver
+// sends them in the database's native format.
+func decodeWatermark(watermark string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(watermark, watermarkString):
+		return strings.TrimPrefix(watermark, watermarkString), nil
+	case strings.HasPrefix(watermark, watermarkTimestamp):
+		t, err := time.Parse(time.RFC3339Nano, strings.TrimPrefix(watermark, watermarkTimestamp))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timestamp watermark %q", watermark)
+		}
+		return t, nil
+	default:
+		return nil, errors.Errorf("invalid watermark %q, must start with %q or %q", watermark, watermarkString, watermarkTimestamp)
+	}
+}
+
+// isTombstone returns true if the value of a tombstone column marks a row as
+// deleted.
+func isTombstone(x interface{}) (bool, error) {
+	switch x := x.(type) {
+	case *bool:
+		return *x, nil
+	case *sql.NullBool:
+		return x.Valid && x.Bool, nil
+	case *string:
+		b, err := strconv.ParseBool(*x)
+		return b, errors.EnsureStack(err)
+	case *sql.NullString:
+		if !x.Valid {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(x.String)
+		return b, errors.EnsureStack(err)
+	default:
+		return false, errors.Errorf("unsupported type for tombstone column: %T", x)
+	}
+}
+
+func createOutputFile(outputDir, name string) (*os.File, error) {
+	p := filepath.Join(outputDir, name)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	return f, errors.EnsureStack(err)
+}
+
+func writeOutputFile(outputDir, name string, data []byte) error {
+	p := filepath.Join(outputDir, name)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(ioutil.WriteFile(p, data, 0755))
+}
@@ -2,7 +2,9 @@ package transforms
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,8 +14,10 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
@@ -136,6 +140,135 @@ func TestCSVHeaderSQLIngest(t *testing.T) {
 	require.Equal(t, N+1, lineCount)
 }
 
+func TestParquetSQLIngest(t *testing.T) {
+	ctx := context.Background()
+	inputDir, outputDir := t.TempDir(), t.TempDir()
+	u := dockertestenv.NewMySQLURL(t)
+	const N = 100
+	loadDB(t, u, N)
+
+	// write queries
+	const Shards = 2
+	for i := 0; i < Shards; i++ {
+		name := fmt.Sprintf("%04d", i)
+		// query would normally be different per shard
+		query := "select * from test_data"
+		err := ioutil.WriteFile(filepath.Join(inputDir, name), []byte(query), 0755)
+		require.NoError(t, err)
+	}
+
+	err := SQLIngest(ctx, SQLIngestParams{
+		Logger: logrus.StandardLogger(),
+
+		InputDir:  inputDir,
+		OutputDir: outputDir,
+
+		URL:      u,
+		Password: dockertestenv.MySQLPassword,
+		Format:   "parquet",
+	})
+	require.NoError(t, err)
+
+	// check the file exists and can be read back
+	dirEnts, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, dirEnts, Shards)
+	require.Equal(t, outputName, dirEnts[0].Name())
+	data, err := ioutil.ReadFile(filepath.Join(outputDir, outputName))
+	require.NoError(t, err)
+	r, err := sdata.NewParquetParser(bytes.NewReader(data), int64(len(data)), []string{"id", "col_a"})
+	require.NoError(t, err)
+	var rowCount int
+	for {
+		var id int64
+		var colA string
+		err := r.Next(sdata.Tuple{&id, &colA})
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.NotEqual(t, "", colA)
+		rowCount++
+	}
+	require.Equal(t, N, rowCount)
+}
+
+func TestIncrementalSQLIngest(t *testing.T) {
+	ctx := context.Background()
+	u := dockertestenv.NewMySQLURL(t)
+	const N = 100
+	loadDB(t, u, N)
+	const name = "0000000001"
+
+	ingest := func(watermark string) (string, int) {
+		inputDir, outputDir := t.TempDir(), t.TempDir()
+		err := ioutil.WriteFile(filepath.Join(inputDir, name), []byte("-- 1\nselect * from test_data;"), 0755)
+		require.NoError(t, err)
+		err = SQLIngest(ctx, SQLIngestParams{
+			Logger: logrus.StandardLogger(),
+
+			InputDir:  inputDir,
+			OutputDir: outputDir,
+
+			URL:      u,
+			Password: dockertestenv.MySQLPassword,
+			Format:   "csv",
+
+			WatermarkColumn: "id",
+			Watermark:       watermark,
+		})
+		require.NoError(t, err)
+		data, err := ioutil.ReadFile(filepath.Join(outputDir, WatermarksDir, name))
+		require.NoError(t, err)
+		return string(data), countLinesInFile(t, filepath.Join(outputDir, name))
+	}
+	watermark, lineCount := ingest("")
+	require.Equal(t, fmt.Sprint("string:", N), watermark)
+	require.Equal(t, N, lineCount)
+
+	// only the new rows are read
+	db := testutil.OpenDBURL(t, u, dockertestenv.MySQLPassword)
+	for i := 0; i < 10; i++ {
+		_, err := db.Exec(`INSERT INTO test_data (col_a) VALUES (?)`, randutil.UniqueString(""))
+		require.NoError(t, err)
+	}
+	watermark, lineCount = ingest(watermark)
+	require.Equal(t, fmt.Sprint("string:", N+10), watermark)
+	require.Equal(t, 10, lineCount)
+
+	// the watermark is kept if there are no new rows
+	watermark, lineCount = ingest(watermark)
+	require.Equal(t, fmt.Sprint("string:", N+10), watermark)
+	require.Equal(t, 0, lineCount)
+}
+
+func TestWatermarkEncoding(t *testing.T) {
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 6, time.UTC)
+	for _, x := range []interface{}{
+		&ts,
+		&sql.NullTime{Time: ts, Valid: true},
+	} {
+		watermark, ok, err := watermarkValue(x)
+		require.NoError(t, err)
+		require.True(t, ok)
+		arg, err := decodeWatermark(watermark)
+		require.NoError(t, err)
+		require.Equal(t, ts, arg)
+	}
+	id := "100"
+	watermark, ok, err := watermarkValue(&id)
+	require.NoError(t, err)
+	require.True(t, ok)
+	arg, err := decodeWatermark(watermark)
+	require.NoError(t, err)
+	require.Equal(t, id, arg)
+	_, ok, err = watermarkValue(&sql.NullString{})
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = decodeWatermark("100")
+	require.Error(t, err)
+}
+
 func countLinesInFile(t testing.TB, p string) int {
 	f, err := os.Open(p)
 	require.NoError(t, err)
@@ -246,3 +379,12 @@ func runChain(t testing.TB, inDir, outDir string, stages []func(inDir, outDir st
 		inDir = outDir2
 	}
 }
+
+func TestValidateIdentifier(t *testing.T) {
+	for _, name := range []string{"id", "updated_at", "_col1"} {
+		require.NoError(t, validateIdentifier(name))
+	}
+	for _, name := range []string{"", "1col", "id; DROP TABLE test_data", "id > 0 OR 1", `"id"`, "q.id"} {
+		require.Error(t, validateIdentifier(name), name)
+	}
+}
@@ -18,6 +18,14 @@ const (
 	UserRepoType = "user"
 	MetaRepoType = "meta"
 	SpecRepoType = "spec"
+	// SQLRepoType is the type of the repos which hold the results of the
+	// queries of a pipeline's SQL inputs, one branch per input.
+	SQLRepoType = "sql"
+
+	// DatumCacheTag is the tag of the cache entries that hold the output of
+	// datums, which are shared by all pipelines. They are kept apart from the
+	// other cache entries, so they don't evict each other.
+	DatumCacheTag = "datum-cache"
 )
 
 // NewHash returns a hash that PFS uses internally to compute checksums.
@@ -50,6 +58,13 @@ func (r *Repo) NewBranch(name string) *Branch {
 	}
 }
 
+func (r *Repo) NewTag(name string) *Tag {
+	return &Tag{
+		Repo: proto.Clone(r).(*Repo),
+		Name: name,
+	}
+}
+
 func (r *Repo) NewCommit(branch, id string) *Commit {
 	return &Commit{
 		ID:     id,
@@ -78,3 +93,7 @@ func (b *Branch) NewCommit(id string) *Commit {
 func (b *Branch) String() string {
 	return b.Repo.String() + "@" + b.Name
 }
+
+func (t *Tag) String() string {
+	return t.Repo.String() + "@" + t.Name
+}
@@ -237,7 +237,7 @@ func (x PipelineInfo_PipelineType) String() string {
 }
 
 func (PipelineInfo_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{28, 0}
 }
 
 type SecretMount struct {
@@ -1071,6 +1071,146 @@ func (m *CronInput) GetStart() *types.Timestamp {
 	return nil
 }
 
+// SQLInput materializes the result of a query into a repo, which is exposed to
+// the pipeline like a PFS input. Each run of the query is a separate commit, so
+// jobs have provenance on the exact result they processed.
+type SQLInput struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Repo is the name of the pipeline's sql repo, which holds the results of
+	// the query in the branch named after the input. It is set by pachd.
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// URL is the pachsql URL of the database, e.g.
+	// postgres://user@host:5432/database
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret is the Kubernetes secret which holds the database password.
+	Secret *pfs.SQLDatabaseEgress_Secret `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	Query  string                        `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// Spec is a cron spec for how often the query is run. If it is empty, the
+	// query is only run by RunCron.
+	Spec string `protobuf:"bytes,7,opt,name=spec,proto3" json:"spec,omitempty"`
+	// FileFormat is the format of the materialized files, CSV by default.
+	FileFormat *pfs.SQLDatabaseEgress_FileFormat `protobuf:"bytes,8,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
+	// KeyColumn, if set, shards the result into Shards files by the hash of the
+	// column's value. Each file is a separate datum.
+	KeyColumn            string           `protobuf:"bytes,9,opt,name=key_column,json=keyColumn,proto3" json:"key_column,omitempty"`
+	Shards               int64            `protobuf:"varint,10,opt,name=shards,proto3" json:"shards,omitempty"`
+	Start                *types.Timestamp `protobuf:"bytes,11,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SQLInput) Reset()         { *m = SQLInput{} }
+func (m *SQLInput) String() string { return proto.CompactTextString(m) }
+func (*SQLInput) ProtoMessage()    {}
+func (*SQLInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{10}
+}
+func (m *SQLInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLInput.Merge(m, src)
+}
+func (m *SQLInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLInput proto.InternalMessageInfo
+
+func (m *SQLInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SQLInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *SQLInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *SQLInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *SQLInput) GetSecret() *pfs.SQLDatabaseEgress_Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *SQLInput) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SQLInput) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *SQLInput) GetFileFormat() *pfs.SQLDatabaseEgress_FileFormat {
+	if m != nil {
+		return m.FileFormat
+	}
+	return nil
+}
+
+func (m *SQLInput) GetKeyColumn() string {
+	if m != nil {
+		return m.KeyColumn
+	}
+	return ""
+}
+
+func (m *SQLInput) GetShards() int64 {
+	if m != nil {
+		return m.Shards
+	}
+	return 0
+}
+
+func (m *SQLInput) GetStart() *types.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
 type Input struct {
 	Pfs                  *PFSInput  `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
 	Join                 []*Input   `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
@@ -1078,6 +1218,7 @@ type Input struct {
 	Cross                []*Input   `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
 	Union                []*Input   `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
 	Cron                 *CronInput `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	SQL                  *SQLInput  `protobuf:"bytes,7,opt,name=sql,proto3" json:"sql,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1087,7 +1228,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{10}
+	return fileDescriptor_beade573c128ccc7, []int{11}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,6 +1299,13 @@ func (m *Input) GetCron() *CronInput {
 	return nil
 }
 
+func (m *Input) GetSQL() *SQLInput {
+	if m != nil {
+		return m.SQL
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Commit               *pfs.Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1172,7 +1320,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{11}
+	return fileDescriptor_beade573c128ccc7, []int{12}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,7 +1392,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{13}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1442,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1350,7 +1498,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,7 +1557,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1643,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+	return fileDescriptor_beade573c128ccc7, []int{17}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1722,7 @@ func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{18}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1653,7 +1801,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{19}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +1878,7 @@ func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1793,7 +1941,7 @@ func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
 func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
 func (*DatumStatus) ProtoMessage()    {}
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2007,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1930,7 +2078,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,7 +2133,7 @@ func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
 func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
 func (*JobSetInfo) ProtoMessage()    {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+	return fileDescriptor_beade573c128ccc7, []int{24}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2061,7 +2209,7 @@ func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2230,7 +2378,7 @@ func (m *JobInfo_Details) Reset()         { *m = JobInfo_Details{} }
 func (m *JobInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*JobInfo_Details) ProtoMessage()    {}
 func (*JobInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24, 0}
+	return fileDescriptor_beade573c128ccc7, []int{25, 0}
 }
 func (m *JobInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2397,7 +2545,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2451,7 +2599,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{27}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2519,7 +2667,7 @@ func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2630,46 +2778,49 @@ type PipelineInfo_Details struct {
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
 	// when running in a kubernetes cluster on which kubeflow has been installed.
 	// Exactly one of 'tf_job' and 'transform' should be set
-	TFJob                 *TFJob           `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
-	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                *Egress          `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
-	CreatedAt             *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	RecentError           string           `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
-	WorkersRequested      int64            `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
-	WorkersAvailable      int64            `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
-	OutputBranch          string           `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	ResourceRequests      *ResourceSpec    `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits        *ResourceSpec    `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                 *Input           `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
-	Description           string           `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	Salt                  string           `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
-	Reason                string           `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
-	Service               *Service         `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                 *Spout           `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout          *types.Duration  `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout            *types.Duration  `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries            int64            `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec               string           `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch              string           `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                 bool             `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata              *Metadata        `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec         string           `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	UnclaimedTasks        int64            `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
-	WorkerRc              string           `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
-	Autoscaling           bool             `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	TFJob                   *TFJob              `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
+	ParallelismSpec         *ParallelismSpec    `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                  *Egress             `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
+	CreatedAt               *types.Timestamp    `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RecentError             string              `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
+	WorkersRequested        int64               `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
+	WorkersAvailable        int64               `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
+	OutputBranch            string              `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	ResourceRequests        *ResourceSpec       `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits          *ResourceSpec       `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits   *ResourceSpec       `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                   *Input              `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
+	Description             string              `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Salt                    string              `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
+	Reason                  string              `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
+	Service                 *Service            `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                   *Spout              `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec            *DatumSetSpec       `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout            *types.Duration     `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout              *types.Duration     `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries              int64               `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec          *SchedulingSpec     `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec                 string              `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch                string              `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out                   bool                `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata                *Metadata           `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec           string              `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	UnclaimedTasks          int64               `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
+	WorkerRc                string              `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
+	Autoscaling             bool                `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DatumCache              bool                `protobuf:"varint,34,opt,name=datum_cache,json=datumCache,proto3" json:"datum_cache,omitempty"`
+	TaskSchedulingSpec      *TaskSchedulingSpec `protobuf:"bytes,35,opt,name=task_scheduling_spec,json=taskSchedulingSpec,proto3" json:"task_scheduling_spec,omitempty"`
+	DatumCheckpointInterval *types.Duration     `protobuf:"bytes,36,opt,name=datum_checkpoint_interval,json=datumCheckpointInterval,proto3" json:"datum_checkpoint_interval,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
+	XXX_unrecognized        []byte              `json:"-"`
+	XXX_sizecache           int32               `json:"-"`
 }
 
 func (m *PipelineInfo_Details) Reset()         { *m = PipelineInfo_Details{} }
 func (m *PipelineInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo_Details) ProtoMessage()    {}
 func (*PipelineInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{28, 0}
 }
 func (m *PipelineInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2915,6 +3066,27 @@ func (m *PipelineInfo_Details) GetAutoscaling() bool {
 	return false
 }
 
+func (m *PipelineInfo_Details) GetDatumCache() bool {
+	if m != nil {
+		return m.DatumCache
+	}
+	return false
+}
+
+func (m *PipelineInfo_Details) GetTaskSchedulingSpec() *TaskSchedulingSpec {
+	if m != nil {
+		return m.TaskSchedulingSpec
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetDatumCheckpointInterval() *types.Duration {
+	if m != nil {
+		return m.DatumCheckpointInterval
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -2926,7 +3098,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
+	return fileDescriptor_beade573c128ccc7, []int{29}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2973,7 +3145,7 @@ func (m *JobSet) Reset()         { *m = JobSet{} }
 func (m *JobSet) String() string { return proto.CompactTextString(m) }
 func (*JobSet) ProtoMessage()    {}
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{30}
 }
 func (m *JobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3022,7 +3194,7 @@ func (m *InspectJobSetRequest) Reset()         { *m = InspectJobSetRequest{} }
 func (m *InspectJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobSetRequest) ProtoMessage()    {}
 func (*InspectJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{31}
 }
 func (m *InspectJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3083,7 +3255,7 @@ func (m *ListJobSetRequest) Reset()         { *m = ListJobSetRequest{} }
 func (m *ListJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobSetRequest) ProtoMessage()    {}
 func (*ListJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{32}
 }
 func (m *ListJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3133,7 +3305,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3211,7 +3383,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3288,7 +3460,7 @@ func (m *SubscribeJobRequest) Reset()         { *m = SubscribeJobRequest{} }
 func (m *SubscribeJobRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeJobRequest) ProtoMessage()    {}
 func (*SubscribeJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *SubscribeJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3342,7 +3514,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{36}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3390,7 +3562,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{37}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3453,7 +3625,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3588,7 +3760,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3706,7 +3878,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3810,7 +3982,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3864,7 +4036,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3918,7 +4090,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{43}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3983,7 +4155,7 @@ func (m *DatumSetSpec) Reset()         { *m = DatumSetSpec{} }
 func (m *DatumSetSpec) String() string { return proto.CompactTextString(m) }
 func (*DatumSetSpec) ProtoMessage()    {}
 func (*DatumSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+	return fileDescriptor_beade573c128ccc7, []int{44}
 }
 func (m *DatumSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4045,7 +4217,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4088,6 +4260,68 @@ func (m *SchedulingSpec) GetPriorityClassName() string {
 	return ""
 }
 
+// TaskSchedulingSpec controls how the tasks of a pipeline's jobs are
+// scheduled on workers, relative to the tasks of other jobs.
+type TaskSchedulingSpec struct {
+	// priority is the priority class of the pipeline's jobs. The tasks of jobs
+	// with a higher priority are always processed before those of jobs with a
+	// lower priority.
+	Priority int64 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	// weight is the share of the workers that the pipeline's jobs get relative
+	// to other jobs with the same priority. It defaults to 1.
+	Weight               float64  `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskSchedulingSpec) Reset()         { *m = TaskSchedulingSpec{} }
+func (m *TaskSchedulingSpec) String() string { return proto.CompactTextString(m) }
+func (*TaskSchedulingSpec) ProtoMessage()    {}
+func (*TaskSchedulingSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{46}
+}
+func (m *TaskSchedulingSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSchedulingSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSchedulingSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskSchedulingSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSchedulingSpec.Merge(m, src)
+}
+func (m *TaskSchedulingSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSchedulingSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSchedulingSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskSchedulingSpec proto.InternalMessageInfo
+
+func (m *TaskSchedulingSpec) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskSchedulingSpec) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type CreatePipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
@@ -4112,31 +4346,40 @@ type CreatePipelineRequest struct {
 	Description           string        `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Service              *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec         *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumTries           int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	Autoscaling          bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Service        *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec   *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumTries     int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	Autoscaling    bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	// datum_cache, if set, reuses the output of datums which were processed by
+	// any pipeline with the same transform and user image, instead of running
+	// the user code again.
+	DatumCache         bool                `protobuf:"varint,31,opt,name=datum_cache,json=datumCache,proto3" json:"datum_cache,omitempty"`
+	TaskSchedulingSpec *TaskSchedulingSpec `protobuf:"bytes,32,opt,name=task_scheduling_spec,json=taskSchedulingSpec,proto3" json:"task_scheduling_spec,omitempty"`
+	// datum_checkpoint_interval, if set, exposes /pfs/checkpoint to the user
+	// code, and saves its contents at this interval so that a datum which is
+	// retried can resume from them.
+	DatumCheckpointInterval *types.Duration `protobuf:"bytes,33,opt,name=datum_checkpoint_interval,json=datumCheckpointInterval,proto3" json:"datum_checkpoint_interval,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
+	XXX_unrecognized        []byte          `json:"-"`
+	XXX_sizecache           int32           `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{47}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4361,28 +4604,49 @@ func (m *CreatePipelineRequest) GetAutoscaling() bool {
 	return false
 }
 
-type InspectPipelineRequest struct {
-	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	// When true, return PipelineInfos with the details field, which requires
-	// loading the pipeline spec from PFS.
-	Details              bool     `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
+func (m *CreatePipelineRequest) GetDatumCache() bool {
+	if m != nil {
+		return m.DatumCache
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetTaskSchedulingSpec() *TaskSchedulingSpec {
+	if m != nil {
+		return m.TaskSchedulingSpec
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetDatumCheckpointInterval() *types.Duration {
+	if m != nil {
+		return m.DatumCheckpointInterval
+	}
+	return nil
+}
+
+type DryRunPipelineRequest struct {
+	// create_pipeline_request is the pipeline to preview. Nothing is created.
+	CreatePipelineRequest *CreatePipelineRequest `protobuf:"bytes,1,opt,name=create_pipeline_request,json=createPipelineRequest,proto3" json:"create_pipeline_request,omitempty"`
+	// sample is the number of datums to return, it defaults to 10.
+	Sample               int64    `protobuf:"varint,2,opt,name=sample,proto3" json:"sample,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
-func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectPipelineRequest) ProtoMessage()    {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+func (m *DryRunPipelineRequest) Reset()         { *m = DryRunPipelineRequest{} }
+func (m *DryRunPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunPipelineRequest) ProtoMessage()    {}
+func (*DryRunPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
-func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
+func (m *DryRunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DryRunPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InspectPipelineRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DryRunPipelineRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4392,13 +4656,220 @@ func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *InspectPipelineRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InspectPipelineRequest.Merge(m, src)
+func (m *DryRunPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunPipelineRequest.Merge(m, src)
 }
-func (m *InspectPipelineRequest) XXX_Size() int {
+func (m *DryRunPipelineRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InspectPipelineRequest) XXX_DiscardUnknown() {
+func (m *DryRunPipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunPipelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunPipelineRequest proto.InternalMessageInfo
+
+func (m *DryRunPipelineRequest) GetCreatePipelineRequest() *CreatePipelineRequest {
+	if m != nil {
+		return m.CreatePipelineRequest
+	}
+	return nil
+}
+
+func (m *DryRunPipelineRequest) GetSample() int64 {
+	if m != nil {
+		return m.Sample
+	}
+	return 0
+}
+
+type DatumPreview struct {
+	// data is the list of input files in the datum.
+	Data []*pfs.FileInfo `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// size_bytes is the total size of the input files in the datum.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// skipped is true if the datum has already been processed by the
+	// pipeline being updated.
+	Skipped              bool     `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumPreview) Reset()         { *m = DatumPreview{} }
+func (m *DatumPreview) String() string { return proto.CompactTextString(m) }
+func (*DatumPreview) ProtoMessage()    {}
+func (*DatumPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{49}
+}
+func (m *DatumPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumPreview.Merge(m, src)
+}
+func (m *DatumPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumPreview proto.InternalMessageInfo
+
+func (m *DatumPreview) GetData() []*pfs.FileInfo {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DatumPreview) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *DatumPreview) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+type DryRunPipelineResponse struct {
+	// datums is the number of datums that the pipeline's input produces at the
+	// current input commits.
+	Datums int64 `protobuf:"varint,1,opt,name=datums,proto3" json:"datums,omitempty"`
+	// skipped is the number of those datums which would be skipped as already
+	// processed, if the request is an update.
+	Skipped              int64           `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	TotalBytes           int64           `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	MaxDatumBytes        int64           `protobuf:"varint,4,opt,name=max_datum_bytes,json=maxDatumBytes,proto3" json:"max_datum_bytes,omitempty"`
+	Sample               []*DatumPreview `protobuf:"bytes,5,rep,name=sample,proto3" json:"sample,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DryRunPipelineResponse) Reset()         { *m = DryRunPipelineResponse{} }
+func (m *DryRunPipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunPipelineResponse) ProtoMessage()    {}
+func (*DryRunPipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{50}
+}
+func (m *DryRunPipelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunPipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunPipelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunPipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunPipelineResponse.Merge(m, src)
+}
+func (m *DryRunPipelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunPipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunPipelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunPipelineResponse proto.InternalMessageInfo
+
+func (m *DryRunPipelineResponse) GetDatums() int64 {
+	if m != nil {
+		return m.Datums
+	}
+	return 0
+}
+
+func (m *DryRunPipelineResponse) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *DryRunPipelineResponse) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *DryRunPipelineResponse) GetMaxDatumBytes() int64 {
+	if m != nil {
+		return m.MaxDatumBytes
+	}
+	return 0
+}
+
+func (m *DryRunPipelineResponse) GetSample() []*DatumPreview {
+	if m != nil {
+		return m.Sample
+	}
+	return nil
+}
+
+type InspectPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// When true, return PipelineInfos with the details field, which requires
+	// loading the pipeline spec from PFS.
+	Details              bool     `protobuf:"varint,2,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
+func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectPipelineRequest) ProtoMessage()    {}
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{51}
+}
+func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectPipelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectPipelineRequest.Merge(m, src)
+}
+func (m *InspectPipelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectPipelineRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_InspectPipelineRequest.DiscardUnknown(m)
 }
 
@@ -4443,7 +4914,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4514,7 +4985,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4582,7 +5053,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4629,7 +5100,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +5149,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{56}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4739,7 +5210,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4786,7 +5257,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{58}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4833,7 +5304,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4880,7 +5351,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4927,7 +5398,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5447,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5037,7 +5508,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5083,7 +5554,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5122,7 +5593,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{65}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5163,7 +5634,7 @@ func (m *RenderTemplateRequest) Reset()         { *m = RenderTemplateRequest{} }
 func (m *RenderTemplateRequest) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateRequest) ProtoMessage()    {}
 func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{61}
+	return fileDescriptor_beade573c128ccc7, []int{66}
 }
 func (m *RenderTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5218,7 +5689,7 @@ func (m *RenderTemplateResponse) Reset()         { *m = RenderTemplateResponse{}
 func (m *RenderTemplateResponse) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateResponse) ProtoMessage()    {}
 func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{62}
+	return fileDescriptor_beade573c128ccc7, []int{67}
 }
 func (m *RenderTemplateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5280,6 +5751,7 @@ func init() {
 	proto.RegisterType((*Spout)(nil), "pps_v2.Spout")
 	proto.RegisterType((*PFSInput)(nil), "pps_v2.PFSInput")
 	proto.RegisterType((*CronInput)(nil), "pps_v2.CronInput")
+	proto.RegisterType((*SQLInput)(nil), "pps_v2.SQLInput")
 	proto.RegisterType((*Input)(nil), "pps_v2.Input")
 	proto.RegisterType((*JobInput)(nil), "pps_v2.JobInput")
 	proto.RegisterType((*ParallelismSpec)(nil), "pps_v2.ParallelismSpec")
@@ -5318,7 +5790,11 @@ func init() {
 	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
+	proto.RegisterType((*TaskSchedulingSpec)(nil), "pps_v2.TaskSchedulingSpec")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps_v2.CreatePipelineRequest")
+	proto.RegisterType((*DryRunPipelineRequest)(nil), "pps_v2.DryRunPipelineRequest")
+	proto.RegisterType((*DatumPreview)(nil), "pps_v2.DatumPreview")
+	proto.RegisterType((*DryRunPipelineResponse)(nil), "pps_v2.DryRunPipelineResponse")
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps_v2.InspectPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps_v2.ListPipelineRequest")
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps_v2.DeletePipelineRequest")
@@ -5342,308 +5818,332 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4812 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4b, 0x73, 0x1c, 0x47,
-	0x72, 0xe6, 0xbc, 0x67, 0x72, 0x1e, 0x18, 0x14, 0x1e, 0x1c, 0x0e, 0xdf, 0x2d, 0x2f, 0x45, 0x72,
-	0x25, 0x40, 0x02, 0xb5, 0xdc, 0x15, 0xb5, 0xa2, 0x16, 0x8f, 0x21, 0x05, 0x12, 0x02, 0xa1, 0x9e,
-	0x81, 0x14, 0xda, 0xb0, 0xa3, 0xb7, 0x67, 0xba, 0x66, 0xd0, 0x44, 0x4f, 0x77, 0xab, 0xab, 0x1b,
-	0x34, 0x74, 0xb1, 0x8f, 0x0e, 0x87, 0x4f, 0x96, 0x0f, 0x3e, 0xfa, 0xe2, 0xc3, 0xfa, 0x62, 0xff,
-	0x03, 0x7b, 0x23, 0x7c, 0xb0, 0x6f, 0x7b, 0xf2, 0xc5, 0x11, 0x0a, 0x07, 0xc3, 0xd7, 0xbd, 0xf8,
-	0xe8, 0xd3, 0x46, 0xbd, 0xfa, 0x31, 0xd3, 0x18, 0xbc, 0x74, 0x21, 0xab, 0x32, 0xb3, 0xb2, 0xaa,
-	0xb2, 0xaa, 0x32, 0xbf, 0xcc, 0x69, 0x40, 0xdd, 0x75, 0xc9, 0xaa, 0xeb, 0x92, 0x15, 0xd7, 0x73,
-	0x7c, 0x07, 0x15, 0x5d, 0x97, 0x68, 0x47, 0x6b, 0xed, 0xeb, 0x23, 0xc7, 0x19, 0x59, 0x78, 0x95,
-	0x51, 0xfb, 0xc1, 0x70, 0x15, 0x8f, 0x5d, 0xff, 0x98, 0x0b, 0xb5, 0x6f, 0x4f, 0x32, 0x7d, 0x73,
-	0x8c, 0x89, 0xaf, 0x8f, 0x5d, 0x21, 0x70, 0x6b, 0x52, 0xc0, 0x08, 0x3c, 0xdd, 0x37, 0x1d, 0x5b,
-	0xf0, 0x17, 0x47, 0xce, 0xc8, 0x61, 0xcd, 0x55, 0xda, 0x12, 0xd4, 0xba, 0x3b, 0x24, 0xab, 0xee,
-	0x50, 0x2c, 0xa5, 0x3d, 0xe7, 0xeb, 0xe4, 0x70, 0x95, 0xfe, 0xc3, 0x09, 0xca, 0x21, 0x54, 0xbb,
-	0x78, 0xe0, 0x61, 0xff, 0x0b, 0x27, 0xb0, 0x7d, 0x84, 0x20, 0x6f, 0xeb, 0x63, 0xdc, 0xca, 0xdc,
-	0xc9, 0xdc, 0xaf, 0xa8, 0xac, 0x8d, 0x9a, 0x90, 0x3b, 0xc4, 0xc7, 0xad, 0x2c, 0x23, 0xd1, 0x26,
-	0xba, 0x09, 0x30, 0xa6, 0xe2, 0x9a, 0xab, 0xfb, 0x07, 0xad, 0x1c, 0x63, 0x54, 0x18, 0x65, 0x4f,
-	0xf7, 0x0f, 0xd0, 0x55, 0x28, 0x61, 0xfb, 0x48, 0x3b, 0xd2, 0xbd, 0x56, 0x9e, 0xf1, 0x8a, 0xd8,
-	0x3e, 0xfa, 0x4a, 0xf7, 0x94, 0xff, 0xce, 0x41, 0xa5, 0xe7, 0xe9, 0x36, 0x19, 0x3a, 0xde, 0x18,
-	0x2d, 0x42, 0xc1, 0x1c, 0xeb, 0x23, 0x39, 0x19, 0xef, 0xd0, 0xd9, 0x06, 0x63, 0xa3, 0x95, 0xbd,
-	0x93, 0xa3, 0xb3, 0x0d, 0xc6, 0x06, 0x53, 0xe7, 0x79, 0x1a, 0xa5, 0xe6, 0x18, 0xb5, 0x88, 0x3d,
-	0x6f, 0x73, 0x6c, 0xa0, 0xf7, 0x20, 0x87, 0xed, 0xa3, 0x56, 0xfe, 0x4e, 0xee, 0x7e, 0x75, 0xad,
-	0xbd, 0xc2, 0xad, 0xbc, 0x12, 0x4e, 0xb0, 0xd2, 0xb1, 0x8f, 0x3a, 0xb6, 0xef, 0x1d, 0xab, 0x54,
-	0x0c, 0xbd, 0x0f, 0x25, 0xc2, 0x76, 0x4a, 0x5a, 0x05, 0x36, 0x62, 0x41, 0x8e, 0x88, 0x19, 0x40,
-	0x95, 0x32, 0xe8, 0x3d, 0x40, 0x6c, 0x41, 0x9a, 0x1b, 0x58, 0x96, 0x26, 0x47, 0x16, 0xd9, 0x02,
-	0x9a, 0x8c, 0xb3, 0x17, 0x58, 0x56, 0x57, 0x48, 0x2f, 0x42, 0x81, 0xf8, 0x86, 0x69, 0xb7, 0x4a,
-	0x4c, 0x80, 0x77, 0xd0, 0x75, 0xa8, 0xd0, 0x95, 0x73, 0x4e, 0x99, 0x71, 0xca, 0xd8, 0xf3, 0xba,
-	0x8c, 0xf9, 0x1e, 0x20, 0x7d, 0x30, 0xc0, 0xae, 0xaf, 0x79, 0xd8, 0x0f, 0x3c, 0x5b, 0x1b, 0x38,
-	0x06, 0x6e, 0x55, 0xee, 0xe4, 0xee, 0xe7, 0xd4, 0x26, 0xe7, 0xa8, 0x8c, 0xb1, 0xe9, 0x18, 0x98,
-	0x4e, 0x60, 0xe0, 0x7e, 0x30, 0x6a, 0xc1, 0x9d, 0xcc, 0xfd, 0xb2, 0xca, 0x3b, 0xf4, 0xb8, 0x02,
-	0x82, 0xbd, 0x56, 0x95, 0x1f, 0x17, 0x6d, 0xa3, 0xdb, 0x50, 0x7d, 0xe3, 0x78, 0x87, 0xa6, 0x3d,
-	0xd2, 0x0c, 0xd3, 0x6b, 0xd5, 0x18, 0x0b, 0x04, 0x69, 0xcb, 0xf4, 0xd0, 0x2d, 0x00, 0xc3, 0x19,
-	0x1c, 0x62, 0x6f, 0x68, 0x5a, 0xb8, 0x55, 0xe7, 0xfc, 0x88, 0xd2, 0x7e, 0x0c, 0x65, 0x69, 0x39,
-	0x79, 0xf6, 0x99, 0xe8, 0xec, 0x17, 0xa1, 0x70, 0xa4, 0x5b, 0x01, 0x16, 0xf7, 0x81, 0x77, 0x9e,
-	0x64, 0x7f, 0x91, 0x51, 0x1e, 0x40, 0xa1, 0xf7, 0xec, 0x85, 0xd3, 0x47, 0x77, 0xa0, 0xe8, 0x0f,
-	0xb5, 0xd7, 0x4e, 0x9f, 0x8f, 0xdb, 0xa8, 0xbc, 0xfd, 0xe1, 0x36, 0x67, 0xa9, 0x05, 0x7f, 0xf8,
-	0xc2, 0xe9, 0x2b, 0xff, 0x94, 0x81, 0x62, 0x67, 0xe4, 0x61, 0x42, 0xe8, 0x0c, 0xfb, 0xea, 0x8e,
-	0x9c, 0x61, 0x5f, 0xdd, 0x41, 0x5b, 0xd0, 0x70, 0xfa, 0xaf, 0xf1, 0xc0, 0xd7, 0x88, 0xef, 0x78,
-	0xf4, 0x82, 0xd0, 0xa9, 0xaa, 0x6b, 0xd7, 0x57, 0xdc, 0x21, 0x3b, 0xaf, 0x57, 0x8c, 0xdb, 0xe5,
-	0x4c, 0xae, 0xe6, 0xf3, 0x2b, 0x6a, 0xdd, 0x89, 0x93, 0xd1, 0x53, 0xa8, 0x91, 0x6f, 0x2d, 0xcd,
-	0xd0, 0x7d, 0xbd, 0xaf, 0x13, 0xcc, 0x6e, 0x69, 0x75, 0xed, 0x9a, 0xd4, 0xd1, 0xfd, 0x72, 0x67,
-	0x4b, 0xb0, 0x42, 0x0d, 0x55, 0xf2, 0xad, 0x25, 0x89, 0x1b, 0x65, 0x28, 0xfa, 0xba, 0x37, 0xc2,
-	0xbe, 0xf2, 0x25, 0xe4, 0xe8, 0xae, 0xde, 0x83, 0xb2, 0x6b, 0xba, 0xd8, 0x32, 0x6d, 0x7e, 0x63,
-	0xab, 0x6b, 0x4d, 0x79, 0x81, 0xf6, 0x04, 0x5d, 0x0d, 0x25, 0xd0, 0x32, 0x64, 0x4d, 0x83, 0xdb,
-	0x68, 0xa3, 0xf8, 0xf6, 0x87, 0xdb, 0xd9, 0xed, 0x2d, 0x35, 0x6b, 0x1a, 0x4f, 0xf2, 0x7f, 0xff,
-	0x0f, 0xb7, 0xaf, 0x28, 0x7f, 0x99, 0x85, 0xf2, 0x17, 0xd8, 0xd7, 0xe9, 0xea, 0xd0, 0x26, 0x54,
-	0x75, 0xdb, 0x76, 0x7c, 0xf6, 0x98, 0x49, 0x2b, 0xc3, 0x2e, 0xe7, 0x5d, 0xa9, 0x5b, 0x8a, 0xad,
-	0xac, 0x47, 0x32, 0xfc, 0x56, 0xc7, 0x47, 0xa1, 0x8f, 0xa0, 0x68, 0xe9, 0x7d, 0x6c, 0x11, 0xf6,
-	0x72, 0xaa, 0x6b, 0x37, 0xa6, 0xc6, 0xef, 0x30, 0x36, 0x1f, 0x2a, 0x64, 0xdb, 0x4f, 0xa1, 0x39,
-	0xa9, 0xf6, 0x3c, 0x47, 0xde, 0xfe, 0x18, 0xaa, 0x31, 0xb5, 0xe7, 0xba, 0x2d, 0x7f, 0x01, 0xa5,
-	0x2e, 0xf6, 0x8e, 0xcc, 0x01, 0x46, 0xef, 0x40, 0xdd, 0xb4, 0x7d, 0xec, 0xd9, 0xba, 0xa5, 0xb9,
-	0x8e, 0xe7, 0x33, 0x05, 0x05, 0xb5, 0x26, 0x89, 0x7b, 0x8e, 0xe7, 0x53, 0x21, 0xfc, 0xe7, 0x71,
-	0xa1, 0x2c, 0x17, 0x92, 0x44, 0x26, 0x44, 0xad, 0xee, 0x72, 0x87, 0x24, 0xac, 0xbe, 0xa7, 0x66,
-	0x4d, 0x97, 0xbe, 0x13, 0xff, 0xd8, 0xc5, 0xc2, 0x1d, 0xb1, 0xb6, 0xb2, 0x06, 0x85, 0xae, 0xeb,
-	0x04, 0x3e, 0x7a, 0x40, 0x1d, 0x03, 0x5b, 0x89, 0x38, 0xd7, 0xb9, 0xc8, 0x31, 0x30, 0xb2, 0x2a,
-	0xf9, 0xca, 0x7f, 0x65, 0xa1, 0xbc, 0xf7, 0xac, 0xbb, 0x6d, 0xbb, 0x41, 0xba, 0xaf, 0x44, 0x90,
-	0xf7, 0xb0, 0xeb, 0x88, 0xed, 0xb2, 0x36, 0xf5, 0x02, 0xf4, 0x7f, 0x8d, 0xad, 0x80, 0x3f, 0xb7,
-	0x32, 0x25, 0xf4, 0x8e, 0x5d, 0x7a, 0x4f, 0x8a, 0x7d, 0x4f, 0xb7, 0x07, 0xd2, 0x8d, 0x8a, 0x1e,
-	0xa5, 0x0f, 0x9c, 0xf1, 0xd8, 0xf4, 0xa5, 0x0b, 0xe5, 0x3d, 0x3a, 0xc1, 0xc8, 0x72, 0xfa, 0xad,
-	0x02, 0x9f, 0x80, 0xb6, 0xa9, 0x83, 0x7c, 0xed, 0x98, 0xb6, 0xe6, 0xd8, 0xad, 0x22, 0x17, 0xa6,
-	0xdd, 0x57, 0x36, 0xf5, 0xd3, 0x4e, 0xe0, 0x63, 0x4f, 0xa3, 0xfd, 0x56, 0x89, 0x79, 0x8e, 0x0a,
-	0xa3, 0xbc, 0x70, 0x4c, 0x1b, 0x5d, 0x83, 0xf2, 0xc8, 0x73, 0x02, 0x57, 0xeb, 0x1f, 0xb7, 0xca,
-	0x6c, 0x60, 0x89, 0xf5, 0x37, 0x8e, 0xe9, 0x34, 0x96, 0xfe, 0xdd, 0x71, 0xab, 0xc2, 0xc6, 0xb0,
-	0x36, 0x75, 0x2c, 0x2c, 0x60, 0x69, 0xd4, 0x4b, 0x10, 0xe1, 0x88, 0x80, 0x91, 0x9e, 0x51, 0x0a,
-	0x6a, 0x40, 0x96, 0x3c, 0x62, 0xbe, 0xa8, 0xac, 0x66, 0xc9, 0x23, 0x6a, 0x58, 0xdf, 0x33, 0x47,
-	0x23, 0xcc, 0xbd, 0x10, 0x33, 0xec, 0x50, 0xf8, 0x68, 0x46, 0x56, 0x25, 0x5f, 0xf9, 0xe7, 0x0c,
-	0x54, 0x36, 0x3d, 0xc7, 0x3e, 0x9f, 0x65, 0x23, 0x23, 0xe5, 0x26, 0x8d, 0x44, 0x5c, 0x3c, 0x90,
-	0xc7, 0x4d, 0xdb, 0xe8, 0x06, 0x54, 0x9c, 0x23, 0xec, 0xbd, 0xf1, 0x4c, 0x1f, 0x33, 0xeb, 0x51,
-	0x53, 0x48, 0x02, 0xfa, 0x80, 0xfa, 0x6f, 0xdd, 0xf3, 0x99, 0x01, 0x69, 0x30, 0xe1, 0xc1, 0x76,
-	0x45, 0x06, 0xdb, 0x95, 0x9e, 0x8c, 0xc6, 0x2a, 0x17, 0x54, 0xfe, 0x37, 0x03, 0x05, 0xbe, 0x5a,
-	0x05, 0x72, 0xee, 0x90, 0x4c, 0xf9, 0x04, 0x71, 0x4d, 0x54, 0xca, 0x44, 0x77, 0x21, 0xcf, 0xce,
-	0x80, 0x3f, 0xce, 0xba, 0x14, 0xe2, 0x12, 0x8c, 0x85, 0xde, 0x81, 0x02, 0xb3, 0x3e, 0x0b, 0x72,
-	0x53, 0x32, 0x9c, 0x47, 0x85, 0x06, 0x9e, 0x43, 0x88, 0x08, 0x7a, 0x93, 0x42, 0x8c, 0x47, 0x85,
-	0x02, 0xdb, 0x74, 0x6c, 0x11, 0xe7, 0x26, 0x85, 0x18, 0x0f, 0xfd, 0x04, 0xf2, 0x03, 0x4f, 0xdc,
-	0x98, 0xea, 0xda, 0xbc, 0x94, 0x09, 0x0f, 0x41, 0x65, 0x6c, 0xc5, 0x86, 0xf2, 0x0b, 0xa7, 0x7f,
-	0xf2, 0xb1, 0xdc, 0x0b, 0x8f, 0x80, 0x3b, 0xe9, 0x86, 0x3c, 0xe2, 0x4d, 0x46, 0x9d, 0xba, 0xb7,
-	0xb9, 0xd8, 0xbd, 0x95, 0x97, 0x2c, 0x1f, 0x5d, 0x32, 0xe5, 0x7d, 0x98, 0xdb, 0xd3, 0x3d, 0xdd,
-	0xb2, 0xb0, 0x65, 0x92, 0x71, 0x97, 0x9e, 0x5c, 0x1b, 0xca, 0x03, 0xc7, 0x26, 0xbe, 0x6e, 0x73,
-	0xcf, 0x90, 0x57, 0xc3, 0xbe, 0xf2, 0x08, 0x2a, 0x6c, 0x6d, 0xf4, 0x02, 0x52, 0x7d, 0x0c, 0x90,
-	0x88, 0xf5, 0xd1, 0x36, 0xa5, 0x1d, 0xe8, 0xe4, 0x80, 0xad, 0xae, 0xa6, 0xb2, 0xb6, 0xf2, 0x14,
-	0x0a, 0x5b, 0xba, 0x1f, 0x8c, 0xd1, 0x4d, 0xc8, 0xc9, 0x28, 0x55, 0x5d, 0xab, 0x4a, 0x13, 0xd0,
-	0x38, 0x45, 0xe9, 0x27, 0xf9, 0x70, 0xe5, 0xff, 0x32, 0x50, 0x61, 0x0a, 0xb6, 0xed, 0xa1, 0x43,
-	0xad, 0x6d, 0xd0, 0x8e, 0x50, 0x13, 0x5a, 0x9b, 0x49, 0xa8, 0x9c, 0x87, 0xee, 0xb3, 0xfb, 0xe5,
-	0x73, 0x3f, 0xd8, 0x58, 0x43, 0x09, 0xa1, 0x2e, 0xe5, 0xa8, 0x5c, 0x00, 0x3d, 0xe4, 0x92, 0x44,
-	0x04, 0xac, 0xc5, 0xf0, 0x3e, 0x79, 0xce, 0x00, 0x13, 0x42, 0x65, 0x09, 0x97, 0x25, 0xe8, 0x01,
-	0x54, 0xa8, 0xb5, 0xb9, 0xe6, 0x3c, 0x93, 0xaf, 0x49, 0xfb, 0x53, 0x8b, 0xa8, 0x65, 0x77, 0xc8,
-	0x46, 0x60, 0xf4, 0x27, 0x90, 0xa7, 0x51, 0x40, 0x5c, 0x89, 0x66, 0x5c, 0x8a, 0xee, 0x42, 0x65,
-	0x5c, 0xea, 0x11, 0x38, 0xe8, 0x31, 0x0d, 0xe1, 0x4a, 0x4a, 0xac, 0xbf, 0x6d, 0x28, 0xff, 0x92,
-	0x81, 0xca, 0xfa, 0x68, 0xe4, 0xe1, 0x11, 0x55, 0xb7, 0x08, 0x85, 0x01, 0xc5, 0x4b, 0x6c, 0xd3,
-	0x39, 0x95, 0x77, 0xa8, 0xb1, 0xc7, 0x58, 0xb7, 0xd9, 0x26, 0x33, 0x2a, 0x6b, 0xd3, 0x37, 0x4a,
-	0x7c, 0xc3, 0xc0, 0x47, 0x6c, 0x43, 0x19, 0x55, 0xf4, 0xd0, 0x03, 0x68, 0x0e, 0xcd, 0xa1, 0x7f,
-	0xa0, 0xb9, 0xd8, 0x1b, 0x60, 0xdb, 0xa7, 0x58, 0x24, 0xcf, 0x24, 0xe6, 0x18, 0x7d, 0x2f, 0x24,
-	0xa3, 0xc7, 0x70, 0xd5, 0x36, 0x6d, 0xcc, 0x3c, 0xcf, 0xc4, 0x88, 0x02, 0x1b, 0xb1, 0xc4, 0xd9,
-	0xcf, 0x92, 0xe3, 0x94, 0xbf, 0xcd, 0x42, 0x2d, 0x6e, 0x36, 0xf4, 0x14, 0xea, 0x86, 0xf3, 0xc6,
-	0xb6, 0x1c, 0xdd, 0xd0, 0x28, 0xbc, 0x16, 0x47, 0x76, 0x6d, 0xea, 0xb5, 0x6f, 0x09, 0x68, 0xad,
-	0xd6, 0xa4, 0x3c, 0x7d, 0xff, 0xe8, 0x97, 0x50, 0x73, 0xb9, 0x3e, 0x3e, 0x3c, 0x7b, 0xda, 0xf0,
-	0xaa, 0x10, 0x67, 0xa3, 0x9f, 0x40, 0x35, 0x70, 0xa3, 0xb9, 0x73, 0xa7, 0x0d, 0x06, 0x2e, 0xcd,
-	0xc6, 0xfe, 0x04, 0x1a, 0xe1, 0xca, 0xfb, 0xc7, 0x3e, 0x26, 0xcc, 0x56, 0x39, 0x35, 0xdc, 0xcf,
-	0x06, 0x25, 0xa2, 0xbb, 0x50, 0x13, 0x53, 0x70, 0xa1, 0x02, 0x13, 0x12, 0xd3, 0x32, 0x11, 0xe5,
-	0xb7, 0x59, 0x58, 0x0a, 0xcf, 0x31, 0x61, 0x9d, 0xc7, 0xe9, 0xd6, 0x09, 0x5d, 0x43, 0x38, 0x6a,
-	0xc2, 0x2a, 0x1f, 0xa5, 0x5a, 0x25, 0x65, 0x58, 0xc2, 0x1a, 0x6b, 0x69, 0xd6, 0x48, 0x19, 0x14,
-	0xb7, 0xc2, 0x2f, 0x52, 0xad, 0x90, 0x3a, 0x6c, 0xc2, 0x30, 0x1f, 0xa5, 0x18, 0x26, 0x7d, 0x8d,
-	0x71, 0x5b, 0x7d, 0x9f, 0x81, 0xda, 0xd7, 0x8e, 0x77, 0x88, 0x3d, 0x6a, 0xa1, 0x80, 0x3d, 0xb8,
-	0x37, 0xac, 0x4f, 0x1f, 0x08, 0x07, 0xb7, 0xb5, 0xb7, 0x3f, 0xdc, 0x2e, 0x73, 0xa1, 0xed, 0x2d,
-	0xb5, 0xcc, 0xd9, 0xdb, 0x06, 0x05, 0xc1, 0xaf, 0x9d, 0xbe, 0x16, 0x3a, 0x10, 0x06, 0x82, 0xa9,
-	0x2b, 0xdd, 0x52, 0x0b, 0xaf, 0x9d, 0xfe, 0xb6, 0x81, 0x1e, 0x43, 0x8d, 0x39, 0x07, 0xf6, 0x7e,
-	0x03, 0xf9, 0xe0, 0x17, 0xa6, 0x5c, 0x43, 0x40, 0xd4, 0xaa, 0x11, 0x75, 0x94, 0xd7, 0x50, 0x8d,
-	0xf1, 0xd0, 0x47, 0x50, 0x62, 0x11, 0x09, 0x1b, 0xe2, 0xc0, 0x66, 0x05, 0x2f, 0x29, 0x4a, 0xdd,
-	0x3f, 0xf3, 0x07, 0x3c, 0x20, 0xcd, 0x27, 0x42, 0x04, 0x73, 0x1d, 0x8c, 0xad, 0x38, 0x50, 0x53,
-	0x31, 0x71, 0x02, 0x6f, 0x80, 0x99, 0x2f, 0xa6, 0xd9, 0x99, 0x1b, 0xb0, 0x89, 0xb2, 0x2a, 0x6d,
-	0xd2, 0xf7, 0x3d, 0xc6, 0x63, 0xc7, 0x93, 0x09, 0xa2, 0xe8, 0xa1, 0xbb, 0x90, 0x1b, 0xb9, 0x81,
-	0xd8, 0x54, 0x88, 0xa8, 0x9e, 0xef, 0xed, 0x53, 0x3d, 0x2a, 0xe5, 0x51, 0x77, 0x61, 0x98, 0xe4,
-	0x50, 0x86, 0x69, 0xda, 0x56, 0x7e, 0x06, 0x25, 0x21, 0x13, 0x82, 0xb6, 0x4c, 0x04, 0xda, 0xe8,
-	0x6c, 0x76, 0x30, 0xee, 0x63, 0x8f, 0xcd, 0x96, 0x53, 0x45, 0x4f, 0xf9, 0x35, 0xc0, 0x0b, 0xa7,
-	0xdf, 0xc5, 0x3e, 0x73, 0xc9, 0xef, 0x52, 0x40, 0xd4, 0xd7, 0x08, 0xf6, 0x85, 0x49, 0x1a, 0x31,
-	0xdf, 0xde, 0xc5, 0x3e, 0x05, 0x48, 0xf4, 0x7f, 0xf4, 0x0e, 0x0d, 0xcb, 0x7d, 0x89, 0x99, 0xe7,
-	0x62, 0x52, 0xdc, 0x29, 0x52, 0xa6, 0xf2, 0x8f, 0x35, 0x28, 0x09, 0xca, 0x69, 0x11, 0xe3, 0x01,
-	0x34, 0x65, 0x06, 0xa0, 0x1d, 0x61, 0x8f, 0xd0, 0x20, 0x9c, 0x65, 0x21, 0x6b, 0x4e, 0xd2, 0xbf,
-	0xe2, 0x64, 0xf4, 0x08, 0xea, 0x4e, 0xe0, 0xbb, 0x81, 0xaf, 0xc5, 0x20, 0xcc, 0x74, 0xfc, 0xac,
-	0x71, 0x21, 0xde, 0x43, 0x2d, 0x28, 0x79, 0x98, 0x03, 0x95, 0x3c, 0x53, 0x2b, 0xbb, 0xcc, 0x41,
-	0xe8, 0xbe, 0xae, 0x89, 0x27, 0x86, 0x0d, 0xf1, 0xf6, 0xeb, 0x94, 0xba, 0x27, 0x89, 0xd4, 0x41,
-	0x30, 0x31, 0x72, 0x68, 0xba, 0x2e, 0xe6, 0x4e, 0x3e, 0xc7, 0xae, 0x97, 0xde, 0xe5, 0x24, 0x0a,
-	0x1a, 0x99, 0x88, 0xef, 0xf8, 0xba, 0xc5, 0x40, 0x63, 0x4e, 0xad, 0x50, 0x4a, 0x8f, 0x12, 0x28,
-	0x0a, 0x64, 0xec, 0xa1, 0x6e, 0x5a, 0xd8, 0x60, 0xb8, 0x31, 0xa7, 0xb2, 0x11, 0xcf, 0x18, 0x25,
-	0x5c, 0x89, 0x87, 0x07, 0x14, 0x5f, 0x61, 0x83, 0x81, 0x48, 0xb1, 0x12, 0x55, 0x12, 0xa3, 0x38,
-	0x07, 0xa7, 0xc7, 0xb9, 0x7b, 0x32, 0x7a, 0x56, 0x59, 0xf4, 0x6c, 0xc6, 0x4f, 0x33, 0x1e, 0x3b,
-	0x97, 0xa1, 0xe8, 0x61, 0x9d, 0x38, 0xb6, 0xc8, 0x7a, 0x45, 0x8f, 0x3e, 0x91, 0x81, 0x87, 0x75,
-	0xfa, 0x44, 0xea, 0xa7, 0x3f, 0x11, 0x21, 0x1a, 0x7f, 0x58, 0x8d, 0xb3, 0x3f, 0xac, 0xc7, 0x50,
-	0x1e, 0x9a, 0xb6, 0x49, 0x0e, 0xb0, 0xd1, 0x9a, 0x3b, 0x75, 0x58, 0x28, 0x8b, 0x3e, 0x84, 0x92,
-	0x81, 0x7d, 0xdd, 0xb4, 0x48, 0xab, 0xc9, 0x86, 0x5d, 0x9d, 0xb8, 0x8d, 0x2b, 0x5b, 0x9c, 0xad,
-	0x4a, 0xb9, 0xf6, 0xdf, 0x94, 0xa0, 0x24, 0x88, 0x68, 0x15, 0x2a, 0xbe, 0x2c, 0x7c, 0x4c, 0x3a,
-	0xee, 0xb0, 0x22, 0xa2, 0x46, 0x32, 0x68, 0x03, 0x9a, 0x6e, 0x04, 0xb4, 0x34, 0x86, 0x97, 0xb3,
-	0xc9, 0x89, 0x27, 0x80, 0x98, 0x3a, 0xe7, 0x4e, 0x20, 0xb3, 0x7b, 0x50, 0xc4, 0x2c, 0x79, 0x8e,
-	0x2e, 0x2f, 0x1f, 0xc9, 0x53, 0x6a, 0x55, 0x70, 0xe3, 0x19, 0x56, 0x7e, 0x76, 0x86, 0x45, 0xd1,
-	0x14, 0xa1, 0x59, 0x99, 0xf0, 0xd0, 0x21, 0x9a, 0x62, 0xa9, 0x9a, 0xca, 0x79, 0xe8, 0x63, 0xa8,
-	0x0b, 0x37, 0x2c, 0x5c, 0x67, 0x91, 0xbd, 0xdf, 0xf0, 0x0e, 0xc5, 0x7d, 0xb6, 0x5a, 0x7b, 0x13,
-	0xf7, 0xe0, 0xeb, 0x30, 0xef, 0x09, 0x87, 0xa6, 0x79, 0xf8, 0xdb, 0x00, 0x13, 0x9f, 0xb0, 0x4b,
-	0x1e, 0x1b, 0x1e, 0xf7, 0x78, 0x6a, 0x53, 0x8a, 0xab, 0x42, 0x1a, 0x7d, 0x0a, 0x73, 0xa1, 0x0a,
-	0xcb, 0x1c, 0x9b, 0x3e, 0x61, 0xaf, 0xe0, 0x24, 0x05, 0x0d, 0x29, 0xbc, 0xc3, 0x64, 0xd1, 0x0e,
-	0x5c, 0x25, 0xa6, 0x81, 0x07, 0xba, 0xa7, 0x4d, 0xaa, 0xa9, 0xcc, 0x50, 0xb3, 0x24, 0x06, 0xa9,
-	0x49, 0x6d, 0xef, 0x40, 0xc1, 0xa4, 0x3e, 0x5b, 0x3c, 0xa3, 0x49, 0xac, 0x6f, 0x4a, 0xe0, 0x4e,
-	0x74, 0xcb, 0x97, 0x65, 0x22, 0xda, 0x46, 0x4f, 0xd8, 0x33, 0xa5, 0xd1, 0x07, 0xfb, 0xfc, 0xf4,
-	0x6b, 0xc9, 0xd9, 0x79, 0x8c, 0xc1, 0x3e, 0x9b, 0x9d, 0x47, 0x2a, 0xd1, 0x63, 0x38, 0x8a, 0x8d,
-	0xa5, 0xa1, 0x9b, 0x1e, 0x56, 0xfd, 0x74, 0x1c, 0x45, 0xe5, 0x7b, 0x5c, 0x9c, 0x22, 0x21, 0xea,
-	0x9f, 0xe5, 0xe8, 0xc6, 0xa9, 0x48, 0xe8, 0xb5, 0xd3, 0x97, 0x63, 0xb9, 0xff, 0xa1, 0x73, 0x7b,
-	0x26, 0x26, 0xec, 0x89, 0x71, 0xff, 0x13, 0x8c, 0x7b, 0x94, 0x82, 0x3e, 0x83, 0x39, 0x32, 0x38,
-	0xc0, 0x46, 0x60, 0x99, 0xf6, 0x88, 0xef, 0x8c, 0x3f, 0xa8, 0xe5, 0xf0, 0x2e, 0x85, 0x6c, 0x7e,
-	0x40, 0x24, 0xd1, 0xa7, 0x20, 0xd8, 0x75, 0x0c, 0x3e, 0x72, 0x9e, 0x83, 0x60, 0xd7, 0x31, 0x18,
-	0xeb, 0x3a, 0x54, 0x28, 0xcb, 0xd5, 0xfd, 0xc1, 0x41, 0x0b, 0xf1, 0x54, 0xde, 0x75, 0x8c, 0x3d,
-	0xda, 0x57, 0x9e, 0x43, 0x91, 0x5f, 0xbc, 0xd4, 0x44, 0xe9, 0x41, 0x32, 0x03, 0x58, 0x98, 0xbe,
-	0xab, 0xd2, 0x8d, 0x29, 0xb7, 0xa0, 0x2c, 0x2b, 0x4a, 0x69, 0xaa, 0x94, 0x7f, 0x9b, 0x83, 0x9a,
-	0x14, 0x60, 0x51, 0xe9, 0x7c, 0xa5, 0xa9, 0x16, 0x94, 0x92, 0xb1, 0x49, 0x76, 0xd1, 0x2a, 0x54,
-	0xe9, 0xae, 0x67, 0x47, 0x24, 0xa0, 0x22, 0x51, 0x3c, 0x22, 0xbe, 0xc3, 0x22, 0x09, 0x4f, 0xe2,
-	0x64, 0x17, 0xfd, 0x54, 0x6e, 0xb7, 0xc0, 0xb6, 0xbb, 0x34, 0xb9, 0x9e, 0x13, 0xfc, 0x76, 0x31,
-	0xe1, 0xb7, 0x1f, 0x43, 0xc3, 0xd2, 0x89, 0xaf, 0xb1, 0x60, 0xce, 0xb4, 0x95, 0x4f, 0x08, 0x00,
-	0x35, 0x2a, 0x27, 0x7b, 0xe8, 0x0e, 0x54, 0x63, 0xae, 0x8a, 0x3d, 0xab, 0xbc, 0x1a, 0x27, 0xa1,
-	0x9f, 0x09, 0x6c, 0x01, 0x4c, 0xdf, 0xdd, 0xc9, 0xd5, 0x31, 0x7f, 0x2b, 0x3b, 0xbd, 0x63, 0x17,
-	0x0b, 0xf8, 0x71, 0x13, 0x40, 0x0f, 0xfc, 0x03, 0xcd, 0x77, 0x0e, 0xb1, 0x2d, 0x9e, 0x53, 0x85,
-	0x52, 0x7a, 0x94, 0x80, 0x1e, 0x47, 0x3e, 0x9c, 0x3f, 0xa6, 0x1b, 0xa9, 0x8a, 0xa7, 0x1c, 0xf9,
-	0x1f, 0xe0, 0x12, 0x8e, 0x7c, 0x35, 0xac, 0xb6, 0x66, 0x93, 0x2e, 0x80, 0x55, 0x5c, 0xa7, 0x8b,
-	0xaf, 0xa9, 0x9e, 0x3f, 0x77, 0x61, 0xcf, 0x9f, 0x9f, 0xe9, 0xf9, 0x3f, 0x06, 0x10, 0xe1, 0x54,
-	0xd3, 0xa5, 0x4f, 0x9f, 0x15, 0x0f, 0x2b, 0x42, 0x7a, 0xdd, 0xa7, 0x50, 0xc5, 0xc3, 0x34, 0x95,
-	0xd3, 0xb0, 0xe7, 0x39, 0x9e, 0xb8, 0x1a, 0x55, 0x4e, 0xeb, 0x50, 0x12, 0xfa, 0x29, 0xcc, 0x73,
-	0xe7, 0x4e, 0xa4, 0x2f, 0xc7, 0x86, 0x40, 0x2c, 0x4d, 0xc1, 0x50, 0x25, 0x3d, 0x2e, 0xac, 0x1f,
-	0xe9, 0xa6, 0xa5, 0xf7, 0x2d, 0x2c, 0xe0, 0x8b, 0x14, 0x5e, 0x97, 0x74, 0xf4, 0x4e, 0x88, 0xce,
-	0x44, 0x75, 0xae, 0xc2, 0x66, 0x17, 0x68, 0x6c, 0x83, 0xd7, 0xe8, 0x52, 0x63, 0x09, 0x5c, 0x36,
-	0x96, 0x54, 0x7f, 0x9c, 0x58, 0x52, 0xbb, 0x44, 0x2c, 0xa9, 0xcf, 0x88, 0x25, 0x77, 0xa0, 0x6a,
-	0x60, 0x32, 0xf0, 0x4c, 0x97, 0xba, 0x66, 0xe6, 0xbb, 0x2b, 0x6a, 0x9c, 0x14, 0x46, 0x9b, 0x66,
-	0x2c, 0xda, 0x44, 0x2f, 0x7c, 0x3e, 0xf1, 0xc2, 0x63, 0xc8, 0x60, 0xe1, 0xac, 0xc8, 0x60, 0x71,
-	0x06, 0x32, 0x98, 0x8e, 0x6a, 0x4b, 0x17, 0x8f, 0x6a, 0xcb, 0x97, 0x8a, 0x6a, 0x57, 0x2f, 0x11,
-	0xd5, 0x5a, 0x67, 0x89, 0x6a, 0xd7, 0x2e, 0x1c, 0xd5, 0xda, 0x33, 0xa2, 0xda, 0xf5, 0x64, 0x54,
-	0x43, 0x4b, 0x50, 0x24, 0x8f, 0x34, 0xba, 0xa1, 0x1b, 0xfc, 0x97, 0x27, 0xf2, 0xe8, 0x55, 0xe0,
-	0xd3, 0x90, 0x33, 0x16, 0xbf, 0x2c, 0xb4, 0x6e, 0x26, 0x43, 0x8e, 0xfc, 0xc5, 0x41, 0x0d, 0x25,
-	0x68, 0x4e, 0xe0, 0x61, 0x59, 0x24, 0x60, 0x4b, 0xb8, 0xc5, 0xa6, 0xa9, 0x87, 0x54, 0xb6, 0x90,
-	0x77, 0x61, 0x2e, 0xb0, 0x07, 0x96, 0x6e, 0x8e, 0xb1, 0xa1, 0xf9, 0x3a, 0x39, 0x24, 0xad, 0xdb,
-	0xcc, 0x12, 0x8d, 0x90, 0xdc, 0xa3, 0x54, 0xba, 0x62, 0x01, 0x00, 0xbd, 0x41, 0xeb, 0x0e, 0x5f,
-	0x31, 0x27, 0xa8, 0x03, 0x7a, 0x43, 0xf5, 0xc0, 0x77, 0xc8, 0x40, 0xa7, 0x9b, 0x6f, 0xdd, 0x65,
-	0xcb, 0x8e, 0x93, 0x94, 0xef, 0xa2, 0xf8, 0xc9, 0x8a, 0xf0, 0xd7, 0x60, 0x69, 0x6f, 0x7b, 0xaf,
-	0xb3, 0xb3, 0xbd, 0xdb, 0xd3, 0x7a, 0xdf, 0xec, 0x75, 0xb4, 0xfd, 0xdd, 0x97, 0xbb, 0xaf, 0xbe,
-	0xde, 0x6d, 0x5e, 0x41, 0xd7, 0xe1, 0xaa, 0x60, 0x75, 0x38, 0xab, 0xa7, 0xae, 0xef, 0x76, 0x9f,
-	0xbd, 0x52, 0xbf, 0x68, 0x66, 0xd0, 0x55, 0x58, 0x48, 0x32, 0xbb, 0x7b, 0xaf, 0xf6, 0x7b, 0xcd,
-	0x6c, 0x4c, 0xa1, 0x64, 0x74, 0xd4, 0xaf, 0xb6, 0x37, 0x3b, 0xcd, 0xdc, 0x8b, 0x7c, 0xb9, 0xd4,
-	0x2c, 0x2b, 0x2f, 0xa0, 0x1e, 0x0f, 0x09, 0xd4, 0x51, 0xd6, 0xc3, 0xcc, 0xd1, 0xb4, 0x87, 0x8e,
-	0xf8, 0x19, 0x68, 0x31, 0x2d, 0x80, 0xa8, 0x35, 0x37, 0xd6, 0x53, 0xee, 0x40, 0x91, 0xa7, 0xb5,
-	0xa2, 0x60, 0x99, 0x99, 0x2a, 0x58, 0x8e, 0x61, 0x71, 0xdb, 0xa6, 0x66, 0xf7, 0x45, 0xfe, 0xcb,
-	0xdd, 0xcf, 0xd9, 0xf3, 0x64, 0x04, 0xf9, 0x37, 0xba, 0xa8, 0xf1, 0x96, 0x55, 0xd6, 0xa6, 0xb1,
-	0x5f, 0x06, 0xbb, 0x1c, 0x8f, 0xfd, 0xa2, 0xab, 0xbc, 0x0f, 0xf3, 0x3b, 0x26, 0x99, 0x98, 0x2b,
-	0x26, 0x9e, 0x49, 0x8a, 0xff, 0x06, 0xe6, 0xa3, 0xd5, 0x49, 0xf1, 0x53, 0x12, 0xed, 0xf3, 0x2d,
-	0xe8, 0x77, 0x19, 0x68, 0x88, 0x15, 0x49, 0xfd, 0xe7, 0x83, 0x4c, 0x1f, 0x42, 0x8d, 0x79, 0x3f,
-	0x2d, 0xac, 0x75, 0xe7, 0x52, 0x90, 0x51, 0x95, 0xc9, 0x44, 0xd0, 0xe8, 0xc0, 0x24, 0xbe, 0xe3,
-	0x1d, 0x8b, 0x52, 0x9d, 0xec, 0xc6, 0xd7, 0x59, 0x48, 0xac, 0x13, 0xb5, 0xa1, 0xfc, 0xfa, 0xdb,
-	0x67, 0xa6, 0xe5, 0x63, 0x19, 0xee, 0xc2, 0xbe, 0xf2, 0x67, 0xb0, 0xd0, 0x0d, 0xfa, 0xd4, 0xcb,
-	0xf6, 0xf1, 0x85, 0xf7, 0x11, 0x9b, 0x3a, 0x9b, 0x34, 0xd1, 0x87, 0xd0, 0xdc, 0xc2, 0x16, 0xf6,
-	0xf1, 0x99, 0xcf, 0x40, 0x79, 0x0e, 0x8d, 0xae, 0xef, 0xb8, 0x67, 0x3f, 0xb4, 0x28, 0x08, 0xe4,
-	0xe2, 0x41, 0x40, 0xf9, 0x43, 0x16, 0x96, 0xf6, 0x5d, 0x43, 0x67, 0x93, 0x73, 0x3c, 0x77, 0x36,
-	0x85, 0xf7, 0x92, 0x98, 0xfa, 0x0c, 0x75, 0x81, 0xc4, 0xc4, 0xf1, 0x72, 0x4a, 0xe1, 0xb4, 0x72,
-	0x4a, 0xf1, 0x2c, 0xe5, 0x94, 0xd2, 0x74, 0x39, 0xe5, 0xc7, 0xaa, 0x97, 0x24, 0xcb, 0x32, 0x30,
-	0x59, 0x96, 0x09, 0xcb, 0x29, 0xd5, 0x53, 0xcb, 0x29, 0xca, 0xbf, 0x67, 0xa1, 0xf1, 0x1c, 0xfb,
-	0x3b, 0xce, 0x88, 0x5c, 0xec, 0x1a, 0x89, 0x63, 0xc9, 0x9e, 0x70, 0x2c, 0xd2, 0x2a, 0x43, 0x76,
-	0x73, 0x89, 0xf8, 0x6a, 0x83, 0x99, 0x81, 0x5f, 0x66, 0x12, 0xfd, 0x68, 0x92, 0x9f, 0xf1, 0xa3,
-	0xc9, 0x32, 0x14, 0xc7, 0x3a, 0xa1, 0x8f, 0x81, 0xbf, 0x13, 0xd1, 0xa3, 0xf4, 0xa1, 0x63, 0x59,
-	0xce, 0x1b, 0x76, 0x28, 0x65, 0x55, 0xf4, 0x58, 0xc1, 0x50, 0x37, 0x65, 0xcd, 0x8a, 0xb5, 0xd1,
-	0x7d, 0x68, 0x06, 0x04, 0x6b, 0x96, 0x73, 0x68, 0x6a, 0x7d, 0x7d, 0x70, 0x88, 0x6d, 0x7e, 0x06,
-	0x65, 0xb5, 0x11, 0x10, 0xbc, 0xe3, 0x1c, 0x9a, 0x1b, 0x9c, 0x8a, 0x56, 0xa1, 0x40, 0x4c, 0x7b,
-	0x80, 0x45, 0x16, 0x3e, 0x23, 0x70, 0x73, 0x39, 0xe5, 0x5f, 0xb3, 0x00, 0x3b, 0xce, 0xe8, 0x0b,
-	0x4c, 0x88, 0x3e, 0x62, 0x90, 0x31, 0xf4, 0xe0, 0xb1, 0x94, 0x2d, 0xf4, 0xd5, 0xbb, 0x34, 0x0b,
-	0x3c, 0xbd, 0x2a, 0x9c, 0x28, 0x31, 0xe7, 0x66, 0x96, 0x98, 0xef, 0x41, 0x99, 0x83, 0x06, 0x93,
-	0xa7, 0x5f, 0x95, 0x8d, 0xea, 0xdb, 0x1f, 0x6e, 0x97, 0xf8, 0x4f, 0x53, 0x5b, 0x6a, 0x89, 0x31,
-	0xb7, 0x8d, 0x13, 0xed, 0x28, 0x6b, 0xc0, 0xc5, 0x99, 0x35, 0xe0, 0xf0, 0x23, 0x13, 0xfe, 0xfb,
-	0x31, 0xff, 0xc8, 0xe4, 0x21, 0x64, 0xc3, 0xb2, 0xc7, 0x2c, 0x3c, 0x9f, 0xf5, 0x09, 0x7d, 0x65,
-	0x63, 0x6e, 0x23, 0x81, 0xa2, 0x65, 0x57, 0xf9, 0x1a, 0x16, 0x54, 0xfe, 0xe0, 0xf8, 0xb9, 0x9f,
-	0xed, 0xd5, 0x4f, 0x5e, 0xaf, 0xec, 0xd4, 0xf5, 0x52, 0x9e, 0xc0, 0x82, 0x08, 0x29, 0x09, 0xc5,
-	0x67, 0xf9, 0xa9, 0x4e, 0xf9, 0x0a, 0x9a, 0x34, 0x56, 0x9c, 0x67, 0x45, 0x21, 0x70, 0xce, 0x9e,
-	0x0c, 0x9c, 0x15, 0x03, 0x6a, 0x71, 0xf0, 0x19, 0x2b, 0x65, 0x67, 0xe2, 0xa5, 0x6c, 0xfa, 0xd0,
-	0x89, 0xf9, 0x1d, 0x16, 0x3f, 0x54, 0xf0, 0x32, 0x77, 0x85, 0x52, 0xf8, 0x2f, 0x19, 0x37, 0x01,
-	0x5c, 0xec, 0x69, 0xfc, 0x12, 0xb0, 0x0b, 0x92, 0x53, 0x2b, 0x2e, 0xf6, 0xf8, 0xfd, 0x50, 0x7e,
-	0x9f, 0x81, 0x46, 0x12, 0x09, 0xa2, 0x2f, 0xa0, 0x6e, 0x3b, 0x06, 0xd6, 0x08, 0xb6, 0xf0, 0xc0,
-	0x77, 0x3c, 0x01, 0x2d, 0xee, 0xa7, 0x03, 0xc7, 0x95, 0x5d, 0xc7, 0xc0, 0x5d, 0x21, 0xca, 0xbf,
-	0x16, 0xa9, 0xd9, 0x31, 0x12, 0x5a, 0x81, 0x05, 0xd7, 0x33, 0x1d, 0xcf, 0xf4, 0x8f, 0xb5, 0x81,
-	0xa5, 0x13, 0xc2, 0x6f, 0x3b, 0xaf, 0xfe, 0xcf, 0x4b, 0xd6, 0x26, 0xe5, 0xd0, 0x2b, 0xdf, 0xfe,
-	0x0c, 0xe6, 0xa7, 0x54, 0x9e, 0xeb, 0x4b, 0x91, 0xff, 0xaf, 0xc0, 0xd2, 0x26, 0x4b, 0x0b, 0x43,
-	0x57, 0x74, 0x21, 0xaf, 0x75, 0xee, 0x44, 0x39, 0x91, 0x8a, 0xe7, 0x2e, 0x58, 0x53, 0xcd, 0x5f,
-	0x38, 0xb3, 0x2e, 0xcc, 0xcc, 0xac, 0x97, 0xa1, 0x18, 0xb0, 0x98, 0x29, 0x9d, 0x20, 0xef, 0x4d,
-	0x67, 0xae, 0xa5, 0x94, 0xcc, 0x35, 0x02, 0xf5, 0xe5, 0x38, 0xa8, 0x4f, 0x4d, 0x68, 0x2b, 0x97,
-	0x4d, 0x68, 0xe1, 0xc7, 0x49, 0x68, 0xab, 0x97, 0x48, 0x68, 0x6b, 0x67, 0x4f, 0x68, 0xeb, 0xd3,
-	0x09, 0xed, 0x0d, 0xf6, 0x01, 0x0f, 0x0f, 0xa4, 0xac, 0xe0, 0x58, 0x56, 0x23, 0x42, 0x3c, 0x85,
-	0x9d, 0x3f, 0x6b, 0x0a, 0x8b, 0xce, 0x95, 0xc2, 0x2e, 0x5c, 0x3c, 0x85, 0x5d, 0xbc, 0x54, 0x0a,
-	0xbb, 0x74, 0x9e, 0x14, 0x56, 0xa6, 0xfd, 0xcb, 0xb1, 0xb4, 0x7f, 0x22, 0xad, 0xbd, 0x7a, 0x96,
-	0xb4, 0xb6, 0x75, 0xe1, 0xb4, 0xf6, 0xda, 0x8c, 0xb4, 0xb6, 0x3d, 0x91, 0xd6, 0x4e, 0x94, 0x3a,
-	0xaf, 0x9f, 0x5a, 0xea, 0x8c, 0x27, 0xbc, 0x37, 0x2e, 0x90, 0xf0, 0xde, 0x4c, 0x4b, 0x78, 0x27,
-	0x52, 0xd5, 0x5b, 0xd3, 0xa9, 0xea, 0x6f, 0x60, 0x59, 0x44, 0xb2, 0xcb, 0x39, 0xbf, 0x93, 0x91,
-	0xff, 0xf7, 0x19, 0x58, 0xa0, 0x01, 0xef, 0xd2, 0xfa, 0x65, 0xba, 0x93, 0x3d, 0x31, 0xdd, 0xc9,
-	0x9d, 0x9c, 0xee, 0xe4, 0x27, 0xd2, 0x9d, 0xbf, 0xce, 0xc0, 0x12, 0x4f, 0x48, 0x2e, 0xb7, 0xae,
-	0x26, 0xe4, 0x74, 0xcb, 0x12, 0x7b, 0xa6, 0x4d, 0x1a, 0x68, 0x86, 0x8e, 0x37, 0xc0, 0x62, 0x35,
-	0xbc, 0x43, 0x2f, 0xcb, 0x21, 0xc6, 0xae, 0xc6, 0xbe, 0x31, 0xe3, 0xb5, 0xec, 0x32, 0x25, 0xa8,
-	0xd8, 0x75, 0x94, 0x2d, 0x58, 0xec, 0x52, 0x94, 0x72, 0xa9, 0xa5, 0x28, 0x9b, 0xb0, 0x40, 0xf3,
-	0xa5, 0xcb, 0x29, 0xf9, 0xbb, 0x0c, 0x20, 0x35, 0xb0, 0x2f, 0x67, 0x94, 0x15, 0x00, 0xd7, 0x73,
-	0x8e, 0xb0, 0xad, 0x53, 0xbc, 0x9b, 0x9e, 0xcc, 0xc6, 0x24, 0x62, 0xa8, 0x35, 0x97, 0x8e, 0x5a,
-	0x95, 0xa7, 0xd0, 0x50, 0x03, 0x7b, 0xd3, 0x73, 0xec, 0x8b, 0x6d, 0xeb, 0x01, 0x2c, 0xf0, 0x10,
-	0xcf, 0x3f, 0xa8, 0x96, 0x4a, 0x10, 0xe4, 0xd9, 0x47, 0xca, 0x19, 0xfe, 0xf5, 0x16, 0x6d, 0x2b,
-	0x9f, 0xc2, 0x02, 0xbf, 0x18, 0x49, 0xd1, 0x7b, 0x50, 0xe4, 0x1f, 0x69, 0x4f, 0x96, 0x32, 0x84,
-	0x98, 0xe0, 0x2a, 0x4f, 0xc3, 0x5a, 0xc8, 0xc5, 0xc6, 0xdf, 0x80, 0x22, 0xa7, 0xa4, 0xfe, 0x34,
-	0xf3, 0x7d, 0x06, 0x80, 0xb3, 0xd9, 0x0f, 0x33, 0x67, 0x54, 0x1a, 0x7e, 0xea, 0x90, 0x8d, 0x7d,
-	0xea, 0xb0, 0x0d, 0x88, 0x15, 0xc3, 0x4d, 0xc7, 0xd6, 0xc2, 0xbf, 0x05, 0x10, 0x30, 0x64, 0x16,
-	0xe4, 0x9e, 0x97, 0xa3, 0x42, 0x92, 0xb2, 0x21, 0x3f, 0xf2, 0xe7, 0xb5, 0xa6, 0x47, 0x50, 0xe5,
-	0xf3, 0xc6, 0x2b, 0x4d, 0x28, 0xb9, 0x34, 0x56, 0x67, 0x02, 0x12, 0xb6, 0x95, 0x25, 0x58, 0x58,
-	0x1f, 0xf8, 0xe6, 0x91, 0xee, 0xe3, 0xf5, 0xc0, 0x3f, 0x10, 0x66, 0x53, 0x96, 0x61, 0x31, 0x49,
-	0x26, 0xae, 0x63, 0x13, 0xac, 0xfc, 0x36, 0x03, 0x4b, 0x2a, 0xb6, 0x0d, 0xec, 0xf5, 0xf0, 0xd8,
-	0xb5, 0x62, 0x39, 0x7d, 0x1b, 0xca, 0xbe, 0x20, 0x09, 0xd3, 0x85, 0x7d, 0xf4, 0x09, 0xe4, 0x75,
-	0x6f, 0x24, 0xbf, 0xc7, 0x78, 0x37, 0x8a, 0xf5, 0x29, 0x8a, 0x56, 0xd6, 0xbd, 0x91, 0xf8, 0x9c,
-	0x99, 0x0d, 0x6a, 0xff, 0x1c, 0x2a, 0x21, 0xe9, 0x5c, 0x00, 0x53, 0x87, 0xe5, 0xc9, 0x19, 0xf8,
-	0x2e, 0xe8, 0xb9, 0xbc, 0x26, 0x8e, 0x2d, 0x8f, 0x98, 0xb6, 0xd1, 0x23, 0x1a, 0xc4, 0xf1, 0x40,
-	0x2e, 0xf2, 0x66, 0xf4, 0xe5, 0x64, 0x0a, 0x44, 0x55, 0xb9, 0xec, 0xc3, 0xdf, 0x65, 0xd8, 0x77,
-	0x94, 0xfc, 0xe7, 0xa9, 0x25, 0x98, 0x7f, 0xf1, 0x6a, 0x43, 0xeb, 0xf6, 0xd6, 0x7b, 0xf1, 0x52,
-	0xe3, 0x1c, 0x54, 0x29, 0x79, 0x53, 0xed, 0xac, 0xf7, 0x3a, 0x5b, 0xcd, 0x0c, 0x6a, 0x42, 0x4d,
-	0xc8, 0xa9, 0xbd, 0xed, 0xdd, 0xe7, 0xcd, 0xac, 0x14, 0x51, 0xf7, 0x77, 0x77, 0x29, 0x21, 0x27,
-	0x09, 0xcf, 0xd6, 0xb7, 0x77, 0xf6, 0xd5, 0x4e, 0x33, 0x2f, 0x09, 0xdd, 0xfd, 0xcd, 0xcd, 0x4e,
-	0xb7, 0xdb, 0x2c, 0xa0, 0x06, 0x00, 0x25, 0xbc, 0xdc, 0xde, 0xd9, 0xe9, 0x6c, 0x35, 0x8b, 0x68,
-	0x1e, 0xea, 0xb4, 0xdf, 0x79, 0xae, 0x76, 0xba, 0x5d, 0xaa, 0xa4, 0x24, 0x49, 0xcf, 0xb6, 0x77,
-	0xb7, 0xbb, 0x9f, 0x53, 0x52, 0x19, 0x21, 0x68, 0x50, 0xd2, 0xfe, 0x2e, 0x9d, 0x6a, 0x7d, 0x63,
-	0xa7, 0xd3, 0xac, 0x3c, 0xfc, 0x53, 0x80, 0xe8, 0x73, 0x45, 0x54, 0x85, 0x52, 0xb4, 0x74, 0x80,
-	0x22, 0x5d, 0x02, 0x5b, 0x75, 0x15, 0x4a, 0x72, 0xf6, 0x2c, 0xeb, 0xbc, 0xdc, 0xde, 0xdb, 0xeb,
-	0x6c, 0x35, 0x73, 0xa8, 0x06, 0xe5, 0x70, 0x2f, 0x79, 0x54, 0x87, 0x8a, 0xda, 0xd9, 0x7c, 0xf5,
-	0x55, 0x47, 0xed, 0x6c, 0x35, 0x0b, 0x0f, 0xbf, 0x81, 0x6a, 0xec, 0xa7, 0x50, 0xd4, 0x82, 0xc5,
-	0xaf, 0x5f, 0xa9, 0x2f, 0x3b, 0x6a, 0x9a, 0x99, 0xf6, 0x5e, 0x6d, 0x85, 0x36, 0xc8, 0x48, 0x42,
-	0x34, 0x69, 0x03, 0x80, 0x12, 0xc4, 0x8a, 0x72, 0x0f, 0xff, 0x33, 0x13, 0x55, 0x5b, 0xb9, 0xf6,
-	0x36, 0x2c, 0x87, 0xf5, 0xd9, 0x49, 0xfd, 0x4b, 0x30, 0x1f, 0xe7, 0xf1, 0xe5, 0x66, 0xd0, 0x22,
-	0x34, 0x43, 0xb2, 0x9c, 0x3b, 0x9b, 0xa8, 0x00, 0xab, 0x9d, 0x50, 0x3c, 0x97, 0x10, 0x8f, 0x4e,
-	0x67, 0x01, 0xe6, 0x42, 0xea, 0xde, 0xfa, 0x7e, 0x97, 0xee, 0x3c, 0x21, 0xda, 0xed, 0xad, 0xef,
-	0x6e, 0x6d, 0x7c, 0xd3, 0x2c, 0x26, 0x96, 0xb1, 0xa9, 0xae, 0xf3, 0x83, 0x29, 0xad, 0xfd, 0x55,
-	0x13, 0x72, 0xeb, 0x7b, 0xdb, 0xe8, 0x09, 0x40, 0x54, 0x34, 0x45, 0xd7, 0x22, 0x64, 0x3b, 0x51,
-	0x48, 0x6d, 0x4f, 0x7e, 0xd4, 0xa4, 0x5c, 0x41, 0x1b, 0x50, 0x4f, 0x94, 0x83, 0xd1, 0x8d, 0xe9,
-	0xe1, 0x51, 0xe5, 0x36, 0x45, 0xc3, 0x07, 0x19, 0xf4, 0x18, 0x4a, 0xa2, 0xa2, 0x8a, 0x42, 0xa8,
-	0x96, 0x2c, 0xb1, 0xa6, 0x8f, 0xfb, 0x0c, 0x20, 0xaa, 0x0d, 0x47, 0xeb, 0x9e, 0xaa, 0x17, 0xb7,
-	0x51, 0xb2, 0x14, 0x1d, 0x2a, 0xf8, 0x15, 0xd4, 0xe2, 0x75, 0x50, 0x74, 0x3d, 0xf4, 0x5b, 0xd3,
-	0xd5, 0xd1, 0x93, 0x96, 0x50, 0x09, 0x4b, 0x9d, 0xa8, 0x15, 0xa2, 0xea, 0x89, 0xea, 0x67, 0x7b,
-	0x79, 0xca, 0xc7, 0x76, 0xc6, 0xae, 0x7f, 0xac, 0x5c, 0x41, 0x9f, 0x40, 0x49, 0x14, 0x3e, 0xa3,
-	0xbd, 0x27, 0x2b, 0xa1, 0x33, 0x06, 0xff, 0x0a, 0x6a, 0xf1, 0xd2, 0x44, 0xb4, 0xfe, 0x94, 0x82,
-	0x45, 0x7b, 0x3e, 0x81, 0xf9, 0xc5, 0xf1, 0xfd, 0x12, 0x2a, 0x61, 0x81, 0x22, 0x5a, 0xff, 0x64,
-	0xcd, 0x22, 0x75, 0xec, 0x07, 0x19, 0xd4, 0x61, 0x5f, 0xf4, 0x85, 0x35, 0x97, 0x68, 0xfe, 0x94,
-	0x4a, 0xcc, 0x8c, 0x6d, 0x6c, 0x43, 0x23, 0xe9, 0xf0, 0xd0, 0x6c, 0x47, 0x38, 0x53, 0xd5, 0xdc,
-	0x04, 0xc4, 0x45, 0xb7, 0x26, 0x8c, 0x32, 0xa9, 0x2c, 0xf5, 0x67, 0x11, 0xe5, 0x0a, 0xdd, 0x5c,
-	0x1c, 0xca, 0x46, 0x9b, 0x4b, 0x01, 0xb8, 0x27, 0x29, 0xf9, 0x20, 0x43, 0x37, 0x97, 0xc4, 0x9e,
-	0xd1, 0xe6, 0x52, 0x31, 0xe9, 0x8c, 0xcd, 0x3d, 0x87, 0x7a, 0x02, 0x3a, 0x46, 0x6f, 0x2d, 0x0d,
-	0x51, 0xce, 0x50, 0xd4, 0x81, 0x5a, 0x1c, 0x3d, 0xc6, 0xee, 0xfd, 0x34, 0xa6, 0x9c, 0xa1, 0x66,
-	0x13, 0xaa, 0x31, 0xf8, 0x88, 0xc2, 0xbf, 0x9a, 0x9b, 0xc6, 0x94, 0xb3, 0x1f, 0x80, 0x40, 0x7b,
-	0xd1, 0x03, 0x48, 0xc2, 0xbf, 0xd9, 0x1b, 0x89, 0x43, 0xbd, 0x68, 0x23, 0x29, 0x00, 0x70, 0xb6,
-	0x9a, 0x38, 0x0c, 0x8c, 0xd4, 0xa4, 0x80, 0xc3, 0x99, 0x5b, 0x61, 0xfe, 0x48, 0x28, 0x39, 0x41,
-	0xae, 0xbd, 0x30, 0x0d, 0x8e, 0x08, 0x33, 0x66, 0x3d, 0x81, 0x25, 0xa7, 0x1c, 0x69, 0x72, 0x15,
-	0x29, 0x10, 0x4b, 0xb9, 0x82, 0x3e, 0x95, 0xee, 0x68, 0xdd, 0xb2, 0x4e, 0x5c, 0xc0, 0xc9, 0x1b,
-	0xf8, 0x18, 0x4a, 0xa2, 0x96, 0x1f, 0x9d, 0x45, 0xb2, 0xb8, 0x1f, 0xcd, 0x1b, 0x55, 0xab, 0xd9,
-	0x35, 0x7f, 0x09, 0xb5, 0x38, 0x76, 0x8b, 0x4c, 0x98, 0x02, 0xf4, 0xda, 0x37, 0xd2, 0x99, 0x02,
-	0xee, 0x31, 0x87, 0x90, 0xfc, 0x0d, 0x27, 0x7a, 0x33, 0xa9, 0xbf, 0xed, 0xcc, 0xd8, 0xd2, 0xe7,
-	0xec, 0x8e, 0xee, 0x38, 0xba, 0xd1, 0x63, 0x80, 0x51, 0x66, 0x26, 0x31, 0xa2, 0x54, 0x72, 0x3d,
-	0x95, 0x17, 0x2e, 0xea, 0x25, 0x4b, 0x96, 0x24, 0x63, 0x0b, 0x0f, 0xf5, 0xc0, 0x3a, 0xf9, 0x94,
-	0x4f, 0x51, 0xf6, 0x25, 0x34, 0x92, 0x30, 0x31, 0xda, 0x61, 0x2a, 0x40, 0x6d, 0xdf, 0x3a, 0x89,
-	0x1d, 0xaa, 0xfc, 0x04, 0xca, 0xf4, 0xf6, 0xf5, 0x74, 0x72, 0x88, 0x5a, 0x2b, 0xbe, 0x4e, 0x0e,
-	0x75, 0xd7, 0x5c, 0x91, 0xa4, 0xc8, 0x93, 0x4b, 0x0e, 0xa5, 0x4a, 0x2f, 0xb5, 0xf1, 0xf3, 0xff,
-	0x78, 0x7b, 0x2b, 0xf3, 0xfb, 0xb7, 0xb7, 0x32, 0xff, 0xf3, 0xf6, 0x56, 0xe6, 0xd7, 0x0f, 0x46,
-	0xa6, 0x7f, 0x10, 0xf4, 0x57, 0x06, 0xce, 0x78, 0xd5, 0xd5, 0x07, 0x07, 0xc7, 0x06, 0xf6, 0xe2,
-	0xad, 0xa3, 0xb5, 0x55, 0xe2, 0x0d, 0x56, 0x5d, 0x97, 0xf4, 0x8b, 0x6c, 0xdf, 0x8f, 0xfe, 0x18,
-	0x00, 0x00, 0xff, 0xff, 0x25, 0xc7, 0x91, 0xb6, 0xa7, 0x3c, 0x00, 0x00,
+	// 5192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3c, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0xc2, 0x37, 0xf0, 0x00, 0x82, 0x60, 0xf3, 0x43, 0x23, 0xea, 0x8b, 0x1a, 0x79, 0x65, 0x49,
+	0xb6, 0x49, 0x9b, 0xf2, 0x6a, 0x6d, 0x79, 0x2d, 0x2f, 0x3f, 0x20, 0x99, 0x12, 0x4d, 0x51, 0x03,
+	0xd2, 0x2e, 0x6f, 0x25, 0x35, 0x3b, 0x00, 0x9a, 0xe0, 0x88, 0x83, 0x99, 0xd1, 0xcc, 0x80, 0x32,
+	0x7d, 0x49, 0x4e, 0x7b, 0x48, 0xe5, 0x92, 0x38, 0x87, 0x1c, 0x73, 0xc9, 0x61, 0x73, 0x49, 0xaa,
+	0xf2, 0x03, 0x92, 0xad, 0xca, 0x21, 0xb9, 0xed, 0x29, 0x97, 0xad, 0x72, 0xa5, 0x74, 0xcf, 0x25,
+	0xb7, 0xdc, 0xb6, 0x5e, 0x7f, 0xcc, 0x07, 0x30, 0x04, 0xbf, 0x7c, 0x11, 0xa7, 0xdf, 0x7b, 0xfd,
+	0xfa, 0xf5, 0xeb, 0xee, 0xf7, 0xd5, 0x0d, 0xc1, 0x84, 0xeb, 0xfa, 0x4b, 0xae, 0xeb, 0x2f, 0xba,
+	0x9e, 0x13, 0x38, 0xa4, 0xe8, 0xba, 0xbe, 0x7e, 0xb8, 0x3c, 0x7f, 0xb5, 0xe7, 0x38, 0x3d, 0x8b,
+	0x2e, 0x31, 0x68, 0x7b, 0xb0, 0xb7, 0x44, 0xfb, 0x6e, 0x70, 0xc4, 0x89, 0xe6, 0x6f, 0x0e, 0x23,
+	0x03, 0xb3, 0x4f, 0xfd, 0xc0, 0xe8, 0xbb, 0x82, 0xe0, 0xc6, 0x30, 0x41, 0x77, 0xe0, 0x19, 0x81,
+	0xe9, 0xd8, 0x02, 0x3f, 0xd3, 0x73, 0x7a, 0x0e, 0xfb, 0x5c, 0xc2, 0x2f, 0x01, 0x9d, 0x70, 0xf7,
+	0xfc, 0x25, 0x77, 0x4f, 0x88, 0x32, 0x3f, 0x19, 0x18, 0xfe, 0xc1, 0x12, 0xfe, 0xc3, 0x01, 0xea,
+	0x01, 0x54, 0x5b, 0xb4, 0xe3, 0xd1, 0xe0, 0x2b, 0x67, 0x60, 0x07, 0x84, 0x40, 0xde, 0x36, 0xfa,
+	0x54, 0xc9, 0x2c, 0x64, 0xee, 0x56, 0x34, 0xf6, 0x4d, 0x1a, 0x90, 0x3b, 0xa0, 0x47, 0x4a, 0x96,
+	0x81, 0xf0, 0x93, 0x5c, 0x07, 0xe8, 0x23, 0xb9, 0xee, 0x1a, 0xc1, 0xbe, 0x92, 0x63, 0x88, 0x0a,
+	0x83, 0x6c, 0x1b, 0xc1, 0x3e, 0xb9, 0x0c, 0x25, 0x6a, 0x1f, 0xea, 0x87, 0x86, 0xa7, 0xe4, 0x19,
+	0xae, 0x48, 0xed, 0xc3, 0xaf, 0x0d, 0x4f, 0xfd, 0x63, 0x0e, 0x2a, 0x3b, 0x9e, 0x61, 0xfb, 0x7b,
+	0x8e, 0xd7, 0x27, 0x33, 0x50, 0x30, 0xfb, 0x46, 0x4f, 0x0e, 0xc6, 0x1b, 0x38, 0x5a, 0xa7, 0xdf,
+	0x55, 0xb2, 0x0b, 0x39, 0x1c, 0xad, 0xd3, 0xef, 0x32, 0x76, 0x9e, 0xa7, 0x23, 0x34, 0xc7, 0xa0,
+	0x45, 0xea, 0x79, 0x6b, 0xfd, 0x2e, 0x79, 0x1f, 0x72, 0xd4, 0x3e, 0x54, 0xf2, 0x0b, 0xb9, 0xbb,
+	0xd5, 0xe5, 0xf9, 0x45, 0xae, 0xe5, 0xc5, 0x70, 0x80, 0xc5, 0xa6, 0x7d, 0xd8, 0xb4, 0x03, 0xef,
+	0x48, 0x43, 0x32, 0xf2, 0x01, 0x94, 0x7c, 0x36, 0x53, 0x5f, 0x29, 0xb0, 0x1e, 0xd3, 0xb2, 0x47,
+	0x4c, 0x01, 0x9a, 0xa4, 0x21, 0xef, 0x03, 0x61, 0x02, 0xe9, 0xee, 0xc0, 0xb2, 0x74, 0xd9, 0xb3,
+	0xc8, 0x04, 0x68, 0x30, 0xcc, 0xf6, 0xc0, 0xb2, 0x5a, 0x82, 0x7a, 0x06, 0x0a, 0x7e, 0xd0, 0x35,
+	0x6d, 0xa5, 0xc4, 0x08, 0x78, 0x83, 0x5c, 0x85, 0x0a, 0x4a, 0xce, 0x31, 0x65, 0x86, 0x29, 0x53,
+	0xcf, 0x6b, 0x31, 0xe4, 0xfb, 0x40, 0x8c, 0x4e, 0x87, 0xba, 0x81, 0xee, 0xd1, 0x60, 0xe0, 0xd9,
+	0x7a, 0xc7, 0xe9, 0x52, 0xa5, 0xb2, 0x90, 0xbb, 0x9b, 0xd3, 0x1a, 0x1c, 0xa3, 0x31, 0xc4, 0x9a,
+	0xd3, 0xa5, 0x38, 0x40, 0x97, 0xb6, 0x07, 0x3d, 0x05, 0x16, 0x32, 0x77, 0xcb, 0x1a, 0x6f, 0xe0,
+	0x72, 0x0d, 0x7c, 0xea, 0x29, 0x55, 0xbe, 0x5c, 0xf8, 0x4d, 0x6e, 0x42, 0xf5, 0x8d, 0xe3, 0x1d,
+	0x98, 0x76, 0x4f, 0xef, 0x9a, 0x9e, 0x52, 0x63, 0x28, 0x10, 0xa0, 0x75, 0xd3, 0x23, 0x37, 0x00,
+	0xba, 0x4e, 0xe7, 0x80, 0x7a, 0x7b, 0xa6, 0x45, 0x95, 0x09, 0x8e, 0x8f, 0x20, 0xf3, 0x0f, 0xa1,
+	0x2c, 0x35, 0x27, 0xd7, 0x3e, 0x13, 0xad, 0xfd, 0x0c, 0x14, 0x0e, 0x0d, 0x6b, 0x40, 0xc5, 0x7e,
+	0xe0, 0x8d, 0x47, 0xd9, 0x4f, 0x32, 0xea, 0x3d, 0x28, 0xec, 0x3c, 0x79, 0xe6, 0xb4, 0xc9, 0x02,
+	0x14, 0x83, 0x3d, 0xfd, 0x95, 0xd3, 0xe6, 0xfd, 0x56, 0x2b, 0x6f, 0x7f, 0xbc, 0xc9, 0x51, 0x5a,
+	0x21, 0xd8, 0x7b, 0xe6, 0xb4, 0xd5, 0x7f, 0xca, 0x40, 0xb1, 0xd9, 0xf3, 0xa8, 0xef, 0xe3, 0x08,
+	0xbb, 0xda, 0xa6, 0x1c, 0x61, 0x57, 0xdb, 0x24, 0xeb, 0x50, 0x77, 0xda, 0xaf, 0x68, 0x27, 0xd0,
+	0xfd, 0xc0, 0xf1, 0x8c, 0x1e, 0x1f, 0xaa, 0xba, 0x7c, 0x75, 0xd1, 0xdd, 0x63, 0xeb, 0xf5, 0x82,
+	0x61, 0x5b, 0x1c, 0xc9, 0xd9, 0x7c, 0x79, 0x49, 0x9b, 0x70, 0xe2, 0x60, 0xf2, 0x18, 0x6a, 0xfe,
+	0x6b, 0x4b, 0xef, 0x1a, 0x81, 0xd1, 0x36, 0x7c, 0xca, 0x76, 0x69, 0x75, 0xf9, 0x8a, 0xe4, 0xd1,
+	0x7a, 0xb9, 0xb9, 0x2e, 0x50, 0x21, 0x87, 0xaa, 0xff, 0xda, 0x92, 0xc0, 0xd5, 0x32, 0x14, 0x03,
+	0xc3, 0xeb, 0xd1, 0x40, 0x7d, 0x09, 0x39, 0x9c, 0xd5, 0xfb, 0x50, 0x76, 0x4d, 0x97, 0x5a, 0xa6,
+	0xcd, 0x77, 0x6c, 0x75, 0xb9, 0x21, 0x37, 0xd0, 0xb6, 0x80, 0x6b, 0x21, 0x05, 0x99, 0x83, 0xac,
+	0xd9, 0xe5, 0x3a, 0x5a, 0x2d, 0xbe, 0xfd, 0xf1, 0x66, 0x76, 0x63, 0x5d, 0xcb, 0x9a, 0xdd, 0x47,
+	0xf9, 0xbf, 0xff, 0x87, 0x9b, 0x97, 0xd4, 0xbf, 0xcc, 0x42, 0xf9, 0x2b, 0x1a, 0x18, 0x28, 0x1d,
+	0x59, 0x83, 0xaa, 0x61, 0xdb, 0x4e, 0xc0, 0x0e, 0xb3, 0xaf, 0x64, 0xd8, 0xe6, 0xbc, 0x25, 0x79,
+	0x4b, 0xb2, 0xc5, 0x95, 0x88, 0x86, 0xef, 0xea, 0x78, 0x2f, 0xf2, 0x31, 0x14, 0x2d, 0xa3, 0x4d,
+	0x2d, 0x9f, 0x9d, 0x9c, 0xea, 0xf2, 0xb5, 0x91, 0xfe, 0x9b, 0x0c, 0xcd, 0xbb, 0x0a, 0xda, 0xf9,
+	0xc7, 0xd0, 0x18, 0x66, 0x7b, 0x96, 0x25, 0x9f, 0xff, 0x14, 0xaa, 0x31, 0xb6, 0x67, 0xda, 0x2d,
+	0x7f, 0x01, 0xa5, 0x16, 0xf5, 0x0e, 0xcd, 0x0e, 0x25, 0xb7, 0x61, 0xc2, 0xb4, 0x03, 0xea, 0xd9,
+	0x86, 0xa5, 0xbb, 0x8e, 0x17, 0x30, 0x06, 0x05, 0xad, 0x26, 0x81, 0xdb, 0x8e, 0x17, 0x20, 0x11,
+	0xfd, 0x2e, 0x4e, 0x94, 0xe5, 0x44, 0xf4, 0xbb, 0x18, 0x11, 0x6a, 0xdd, 0x55, 0x72, 0x31, 0xad,
+	0x6f, 0x6b, 0x59, 0xd3, 0xc5, 0x73, 0x12, 0x1c, 0xb9, 0x54, 0x98, 0x23, 0xf6, 0xad, 0x2e, 0x43,
+	0xa1, 0xe5, 0x3a, 0x83, 0x80, 0xdc, 0x43, 0xc3, 0xc0, 0x24, 0x11, 0xeb, 0x3a, 0x19, 0x19, 0x06,
+	0x06, 0xd6, 0x24, 0x5e, 0xfd, 0xef, 0x2c, 0x94, 0xb7, 0x9f, 0xb4, 0x36, 0x6c, 0x77, 0x90, 0x6e,
+	0x2b, 0x09, 0xe4, 0x3d, 0xea, 0x3a, 0x62, 0xba, 0xec, 0x1b, 0xad, 0x00, 0xfe, 0xd5, 0x99, 0x04,
+	0xfc, 0xb8, 0x95, 0x11, 0xb0, 0x73, 0xe4, 0xe2, 0x3e, 0x29, 0xb6, 0x3d, 0xc3, 0xee, 0x48, 0x33,
+	0x2a, 0x5a, 0x08, 0xef, 0x38, 0xfd, 0xbe, 0x19, 0x48, 0x13, 0xca, 0x5b, 0x38, 0x40, 0xcf, 0x72,
+	0xda, 0x4a, 0x81, 0x0f, 0x80, 0xdf, 0x68, 0x20, 0x5f, 0x39, 0xa6, 0xad, 0x3b, 0xb6, 0x52, 0xe4,
+	0xc4, 0xd8, 0x7c, 0x61, 0xa3, 0x9d, 0x76, 0x06, 0x01, 0xf5, 0x74, 0x6c, 0x2b, 0x25, 0x66, 0x39,
+	0x2a, 0x0c, 0xf2, 0xcc, 0x31, 0x6d, 0x72, 0x05, 0xca, 0x3d, 0xcf, 0x19, 0xb8, 0x7a, 0xfb, 0x48,
+	0x29, 0xb3, 0x8e, 0x25, 0xd6, 0x5e, 0x3d, 0xc2, 0x61, 0x2c, 0xe3, 0xfb, 0x23, 0xa5, 0xc2, 0xfa,
+	0xb0, 0x6f, 0x34, 0x2c, 0xcc, 0x61, 0xe9, 0x68, 0x25, 0x7c, 0x61, 0x88, 0x80, 0x81, 0x9e, 0x20,
+	0x84, 0xd4, 0x21, 0xeb, 0x3f, 0x60, 0xb6, 0xa8, 0xac, 0x65, 0xfd, 0x07, 0xa8, 0xd8, 0xc0, 0x33,
+	0x7b, 0x3d, 0xca, 0xad, 0x10, 0x53, 0xec, 0x9e, 0xb0, 0xd1, 0x0c, 0xac, 0x49, 0xbc, 0xfa, 0xcf,
+	0x19, 0xa8, 0xac, 0x79, 0x8e, 0x7d, 0x36, 0xcd, 0x46, 0x4a, 0xca, 0x0d, 0x2b, 0xc9, 0x77, 0x69,
+	0x47, 0x2e, 0x37, 0x7e, 0x93, 0x6b, 0x50, 0x71, 0x0e, 0xa9, 0xf7, 0xc6, 0x33, 0x03, 0xaa, 0x14,
+	0x84, 0x2a, 0x24, 0x80, 0x7c, 0x88, 0xf6, 0xdb, 0xf0, 0x02, 0xa6, 0x40, 0x74, 0x26, 0xdc, 0xd9,
+	0x2e, 0x4a, 0x67, 0xbb, 0xb8, 0x23, 0xbd, 0xb1, 0xc6, 0x09, 0xd5, 0xff, 0xcf, 0x42, 0xb9, 0xf5,
+	0x72, 0xf3, 0xa7, 0x11, 0xf8, 0x0a, 0xe4, 0x06, 0x9e, 0xc5, 0xe5, 0x5d, 0x2d, 0xbd, 0xfd, 0xf1,
+	0x26, 0x1a, 0x42, 0x0d, 0x61, 0xe4, 0x13, 0x28, 0x72, 0xe7, 0xc3, 0x84, 0xae, 0x2e, 0x2f, 0x1c,
+	0x6b, 0xc1, 0x84, 0x1f, 0xd3, 0x04, 0x3d, 0x9e, 0xbd, 0xd7, 0x03, 0xea, 0x1d, 0x89, 0x4d, 0xc1,
+	0x1b, 0xa1, 0x6e, 0x4a, 0x31, 0xdd, 0x34, 0xa1, 0x8a, 0x6b, 0xaa, 0xa3, 0xd7, 0x34, 0x02, 0xb6,
+	0x17, 0xaa, 0xcb, 0xef, 0x1c, 0x3f, 0x10, 0x2e, 0xf7, 0x13, 0x46, 0xab, 0xc1, 0x5e, 0xf8, 0x8d,
+	0xdb, 0xed, 0x80, 0x1e, 0xe9, 0x1d, 0xc7, 0x1a, 0xf4, 0x6d, 0xb6, 0x75, 0x2a, 0x5a, 0xe5, 0x80,
+	0x1e, 0xad, 0x31, 0x00, 0x4e, 0xde, 0xdf, 0x37, 0xbc, 0x2e, 0xdf, 0x3a, 0x39, 0x4d, 0xb4, 0x22,
+	0xdd, 0x57, 0x4f, 0xab, 0xfb, 0xbf, 0xc9, 0x42, 0x81, 0x2b, 0x5e, 0x85, 0x9c, 0xbb, 0xe7, 0x8f,
+	0xd8, 0x63, 0x71, 0x44, 0x35, 0x44, 0x92, 0x5b, 0x90, 0x67, 0xfb, 0x9f, 0x1b, 0xc6, 0x09, 0x49,
+	0xc4, 0x29, 0x18, 0x8a, 0xdc, 0x86, 0x02, 0xdb, 0xf9, 0x4a, 0x2e, 0x8d, 0x86, 0xe3, 0x90, 0xa8,
+	0xe3, 0x39, 0xbe, 0xaf, 0xe4, 0x53, 0x89, 0x18, 0x0e, 0x89, 0x06, 0xb6, 0xe9, 0xd8, 0x4a, 0x21,
+	0x95, 0x88, 0xe1, 0xc8, 0xcf, 0x20, 0xdf, 0xf1, 0xc4, 0x69, 0xad, 0x2e, 0x4f, 0x49, 0x9a, 0xf0,
+	0x00, 0x68, 0x0c, 0x4d, 0xde, 0x83, 0x9c, 0xff, 0xda, 0x52, 0x4a, 0xc9, 0xc9, 0xc9, 0x4d, 0xc7,
+	0xf7, 0x49, 0xeb, 0xe5, 0xa6, 0x86, 0x54, 0xaa, 0x0d, 0xe5, 0x67, 0x4e, 0xfb, 0xf8, 0xed, 0x78,
+	0x27, 0xdc, 0x7a, 0xdc, 0x9b, 0xd6, 0xe5, 0xf2, 0xae, 0x31, 0xe8, 0x88, 0x81, 0xc9, 0xc5, 0x0c,
+	0x8c, 0xb4, 0x06, 0xf9, 0xc8, 0x1a, 0xa8, 0x1f, 0xc0, 0xe4, 0xb6, 0xe1, 0x19, 0x96, 0x45, 0x2d,
+	0xd3, 0xef, 0xb7, 0x70, 0x1b, 0xcd, 0x43, 0xb9, 0xe3, 0xd8, 0x7e, 0x60, 0xd8, 0xdc, 0x84, 0xe7,
+	0xb5, 0xb0, 0xad, 0x3e, 0x80, 0x0a, 0x93, 0x0d, 0xb7, 0x0e, 0xf2, 0x63, 0x91, 0xa3, 0x90, 0x0f,
+	0xbf, 0x11, 0xb6, 0x6f, 0xf8, 0xfb, 0x4c, 0xba, 0x9a, 0xc6, 0xbe, 0xd5, 0xc7, 0x50, 0x58, 0x37,
+	0x82, 0x41, 0x9f, 0x5c, 0x87, 0x9c, 0x0c, 0x27, 0xaa, 0xcb, 0x55, 0xa9, 0x09, 0x0c, 0x28, 0x10,
+	0x7e, 0x9c, 0xb3, 0x55, 0xff, 0x2f, 0x03, 0x15, 0xc6, 0x60, 0xc3, 0xde, 0x73, 0x70, 0x69, 0xba,
+	0xd8, 0x10, 0x6c, 0xc2, 0xa5, 0x61, 0x14, 0x1a, 0xc7, 0x91, 0xbb, 0x6c, 0x33, 0x06, 0xdc, 0x61,
+	0xd5, 0x97, 0x49, 0x82, 0xa8, 0x85, 0x18, 0x8d, 0x13, 0x90, 0xfb, 0x9c, 0xd2, 0x17, 0x91, 0xc5,
+	0x4c, 0xb8, 0xf9, 0x3c, 0xa7, 0x43, 0x7d, 0x1f, 0x69, 0x7d, 0x4e, 0xeb, 0x93, 0x7b, 0x50, 0x41,
+	0x6d, 0x73, 0xce, 0x79, 0x46, 0x5f, 0x93, 0xfa, 0x47, 0x8d, 0x68, 0x65, 0x77, 0x8f, 0xf5, 0xa0,
+	0xe4, 0x1d, 0xc8, 0xa3, 0xbb, 0x16, 0xfb, 0xa7, 0x11, 0xa7, 0xc2, 0x59, 0x68, 0x0c, 0x8b, 0xa6,
+	0x9b, 0x47, 0xa7, 0x66, 0x57, 0x1c, 0xef, 0x12, 0x6b, 0x6f, 0x74, 0xd5, 0x7f, 0xc9, 0x40, 0x65,
+	0xa5, 0xd7, 0xf3, 0x68, 0x0f, 0xd9, 0xcd, 0x40, 0xa1, 0x83, 0x81, 0x2d, 0x9b, 0x74, 0x4e, 0xe3,
+	0x0d, 0x54, 0x76, 0x9f, 0x1a, 0x36, 0x9b, 0x64, 0x46, 0x63, 0xdf, 0xec, 0x78, 0x06, 0xdd, 0x2e,
+	0x3d, 0x64, 0x13, 0xca, 0x68, 0xa2, 0x45, 0xee, 0x41, 0x63, 0xcf, 0xdc, 0x0b, 0xf6, 0x75, 0x97,
+	0x7a, 0x1d, 0x6a, 0x07, 0xa6, 0xc5, 0xa7, 0x90, 0xd1, 0x26, 0x19, 0x7c, 0x3b, 0x04, 0x93, 0x87,
+	0x70, 0xd9, 0x36, 0x6d, 0xca, 0x5c, 0xc4, 0x50, 0x8f, 0x02, 0xeb, 0x31, 0xcb, 0xd1, 0x4f, 0x92,
+	0xfd, 0xd4, 0xbf, 0xcd, 0x42, 0x2d, 0xae, 0x36, 0xf2, 0x18, 0x26, 0xba, 0xce, 0x1b, 0xdb, 0x72,
+	0x8c, 0xae, 0x8e, 0x79, 0x90, 0x58, 0xb2, 0x2b, 0x23, 0xa6, 0x61, 0x5d, 0xe4, 0x40, 0x5a, 0x4d,
+	0xd2, 0xa3, 0xb1, 0x20, 0xbf, 0x84, 0x9a, 0xcb, 0xf9, 0xf1, 0xee, 0xd9, 0x93, 0xba, 0x57, 0x05,
+	0x39, 0xeb, 0xfd, 0x08, 0xaa, 0x03, 0x37, 0x1a, 0x3b, 0x77, 0x52, 0x67, 0xe0, 0xd4, 0xac, 0xef,
+	0xcf, 0xa0, 0x1e, 0x4a, 0xde, 0x3e, 0x0a, 0xa8, 0xcf, 0x74, 0x95, 0xd3, 0xc2, 0xf9, 0xac, 0x22,
+	0x90, 0xdc, 0x82, 0xda, 0xc0, 0x8d, 0x11, 0x15, 0x18, 0x91, 0x18, 0x96, 0x91, 0xa8, 0xbf, 0xcb,
+	0xc2, 0x6c, 0xb8, 0x8e, 0x09, 0xed, 0x3c, 0x4c, 0xd7, 0x4e, 0x68, 0x47, 0xc2, 0x5e, 0x43, 0x5a,
+	0xf9, 0x38, 0x55, 0x2b, 0x29, 0xdd, 0x12, 0xda, 0x58, 0x4e, 0xd3, 0x46, 0x4a, 0xa7, 0xb8, 0x16,
+	0x3e, 0x49, 0xd5, 0x42, 0x6a, 0xb7, 0x21, 0xc5, 0x7c, 0x9c, 0xa2, 0x98, 0x74, 0x19, 0xe3, 0xba,
+	0xfa, 0x21, 0x03, 0xb5, 0x6f, 0x1c, 0xef, 0x80, 0x7a, 0xa8, 0xa1, 0x01, 0x3b, 0x70, 0x6f, 0x58,
+	0x1b, 0x0f, 0x08, 0xcf, 0x42, 0x6a, 0x6f, 0x7f, 0xbc, 0x59, 0xe6, 0x44, 0x1b, 0xeb, 0x5a, 0x99,
+	0xa3, 0x37, 0xba, 0x98, 0xad, 0xbc, 0x72, 0xda, 0x7a, 0x68, 0x40, 0x58, 0xb6, 0x82, 0xa6, 0x74,
+	0x5d, 0x2b, 0xbc, 0x72, 0xda, 0x1b, 0x5d, 0xf2, 0x10, 0x6a, 0xcc, 0x38, 0xb0, 0xf3, 0x3b, 0x90,
+	0x07, 0x7e, 0x7a, 0xc4, 0x34, 0x0c, 0x7c, 0xad, 0xda, 0x8d, 0x1a, 0xea, 0x2b, 0xa8, 0xc6, 0x70,
+	0xe4, 0x63, 0x28, 0x31, 0xf7, 0x45, 0xbb, 0x4a, 0xe6, 0x44, 0x4f, 0x27, 0x49, 0xd1, 0x57, 0x30,
+	0x7b, 0xc0, 0xbd, 0xd7, 0x54, 0xc2, 0x9f, 0x30, 0xd3, 0xc1, 0xd0, 0xaa, 0x03, 0x35, 0x8d, 0xfa,
+	0xce, 0xc0, 0xeb, 0x50, 0x66, 0x8b, 0x31, 0x8d, 0x76, 0x07, 0x6c, 0xa0, 0xac, 0x86, 0x9f, 0x78,
+	0xbe, 0xfb, 0xb4, 0xef, 0x78, 0x32, 0x93, 0x17, 0x2d, 0x72, 0x0b, 0x72, 0x3d, 0x77, 0xa0, 0xe4,
+	0x92, 0xa1, 0xef, 0xd3, 0xed, 0x5d, 0xe4, 0xa3, 0x21, 0x0e, 0xcd, 0x45, 0xd7, 0xf4, 0x0f, 0x64,
+	0x3c, 0x85, 0xdf, 0xea, 0xcf, 0xa1, 0x24, 0x68, 0xc2, 0xe8, 0x3a, 0x13, 0x45, 0xd7, 0x38, 0x9a,
+	0x3d, 0xe8, 0xb7, 0xa9, 0xc7, 0x46, 0xcb, 0x69, 0xa2, 0xa5, 0xfe, 0x1a, 0xe0, 0x99, 0xd3, 0x6e,
+	0xd1, 0x80, 0x99, 0xe4, 0x77, 0x31, 0x72, 0x6d, 0xeb, 0x3e, 0x0d, 0x84, 0x4a, 0xea, 0x31, 0xdb,
+	0xde, 0xc2, 0x58, 0xe6, 0x15, 0xfb, 0x4b, 0x6e, 0xa3, 0x0f, 0x6f, 0xcb, 0xe4, 0x66, 0x32, 0x46,
+	0xc5, 0x8d, 0x22, 0x22, 0xd5, 0x7f, 0xac, 0x41, 0x49, 0x40, 0x4e, 0xf2, 0x18, 0xf7, 0xa0, 0x21,
+	0x53, 0x35, 0xfd, 0x90, 0x7a, 0x3e, 0x7a, 0xec, 0x2c, 0x73, 0x59, 0x93, 0x12, 0xfe, 0x35, 0x07,
+	0x93, 0x07, 0x30, 0xe1, 0x0c, 0x02, 0x77, 0x10, 0xe8, 0xb1, 0xd0, 0x6d, 0xd4, 0x7f, 0xd6, 0x38,
+	0x11, 0x6f, 0x11, 0x05, 0x4a, 0x1e, 0xe5, 0x51, 0x4d, 0x9e, 0xb1, 0x95, 0x4d, 0x66, 0x20, 0x8c,
+	0xc0, 0xd0, 0xc5, 0x11, 0xa3, 0x5d, 0x71, 0xf6, 0x27, 0x10, 0xba, 0x2d, 0x81, 0x68, 0x20, 0x18,
+	0x99, 0x7f, 0x60, 0xba, 0x2e, 0xe5, 0x46, 0x3e, 0xc7, 0xb6, 0x97, 0xd1, 0xe2, 0x20, 0x0c, 0xb7,
+	0x18, 0x49, 0xe0, 0x04, 0x06, 0x8f, 0x12, 0x72, 0x5a, 0x05, 0x21, 0x3b, 0x08, 0xc0, 0x70, 0x9d,
+	0xa1, 0xf7, 0x0c, 0xd3, 0xa2, 0x5d, 0x16, 0xd4, 0xe5, 0x34, 0xd6, 0xe3, 0x09, 0x83, 0x84, 0x92,
+	0x78, 0xb4, 0x83, 0x81, 0x30, 0xed, 0x2a, 0x95, 0x48, 0x12, 0x4d, 0x02, 0x23, 0x3f, 0x07, 0x27,
+	0xfb, 0xb9, 0x3b, 0xd2, 0x7b, 0x56, 0x99, 0xf7, 0x6c, 0xc4, 0x57, 0x33, 0xee, 0x3b, 0xe7, 0xa0,
+	0xe8, 0x51, 0xc3, 0x77, 0x6c, 0x51, 0x9e, 0x10, 0x2d, 0x3c, 0x22, 0x1d, 0x8f, 0x1a, 0x78, 0x44,
+	0x26, 0x4e, 0x3e, 0x22, 0x82, 0x34, 0x7e, 0xb0, 0xea, 0xa7, 0x3f, 0x58, 0x0f, 0xa1, 0xbc, 0x67,
+	0xda, 0xa6, 0xbf, 0x4f, 0xbb, 0xca, 0xe4, 0x89, 0xdd, 0x42, 0x5a, 0xf2, 0x11, 0x94, 0xba, 0x34,
+	0x30, 0x4c, 0xcb, 0x57, 0x1a, 0xac, 0xdb, 0xe5, 0xa1, 0xdd, 0xb8, 0xb8, 0xce, 0xd1, 0x9a, 0xa4,
+	0x9b, 0xff, 0xeb, 0x12, 0x94, 0x04, 0x90, 0x2c, 0x41, 0x25, 0x90, 0x15, 0xaa, 0x61, 0xc3, 0x1d,
+	0x96, 0xae, 0xb4, 0x88, 0x86, 0xac, 0x42, 0xc3, 0x8d, 0x02, 0x2d, 0x9d, 0x05, 0xef, 0xd9, 0xe4,
+	0xc0, 0x43, 0x81, 0x98, 0x36, 0xe9, 0x26, 0x01, 0x18, 0xfc, 0x51, 0x16, 0xba, 0x47, 0x9b, 0x97,
+	0xf7, 0xe4, 0x01, 0xbd, 0x26, 0xb0, 0xf1, 0x54, 0x38, 0x3f, 0x3e, 0x15, 0xc6, 0x68, 0xca, 0xc7,
+	0xf4, 0x59, 0x29, 0x24, 0xa3, 0x29, 0x96, 0x53, 0x6b, 0x1c, 0x47, 0x3e, 0x85, 0x09, 0x61, 0x86,
+	0x85, 0xe9, 0x2c, 0x2e, 0xe4, 0xe2, 0x7b, 0x28, 0x6e, 0xb3, 0xb5, 0xda, 0x9b, 0x58, 0x8b, 0xac,
+	0xc0, 0x94, 0x27, 0x0c, 0x9a, 0xee, 0xd1, 0xd7, 0x03, 0xea, 0x07, 0xbe, 0x08, 0x85, 0xc3, 0xee,
+	0x71, 0x8b, 0xa7, 0x35, 0x24, 0xb9, 0x26, 0xa8, 0xc9, 0xe7, 0x30, 0x19, 0xb2, 0xb0, 0xcc, 0xbe,
+	0x19, 0xf8, 0x4a, 0x79, 0x0c, 0x83, 0xba, 0x24, 0xde, 0x64, 0xb4, 0x64, 0x13, 0x2e, 0xfb, 0x66,
+	0x97, 0x76, 0x0c, 0x4f, 0x1f, 0x66, 0x53, 0x19, 0xc3, 0x66, 0x56, 0x74, 0xd2, 0x92, 0xdc, 0x6e,
+	0x43, 0xc1, 0x44, 0x9b, 0xad, 0x40, 0x52, 0x5f, 0x22, 0x31, 0x30, 0x65, 0xe0, 0xee, 0x1b, 0x56,
+	0x20, 0xeb, 0x79, 0xf8, 0x4d, 0x1e, 0x41, 0x5d, 0x78, 0x1f, 0x1a, 0xf0, 0xd5, 0xaf, 0x25, 0x47,
+	0xe7, 0x3e, 0x86, 0x06, 0x6c, 0xf4, 0x5a, 0x37, 0xd6, 0x62, 0x71, 0x14, 0xeb, 0x8b, 0xae, 0x1b,
+	0x17, 0x6b, 0xe2, 0xe4, 0x38, 0x0a, 0xe9, 0x77, 0x38, 0x39, 0x46, 0x42, 0x68, 0x9f, 0x65, 0xef,
+	0xfa, 0x49, 0xbd, 0xe1, 0x95, 0xd3, 0x96, 0x7d, 0xb9, 0xfd, 0xc1, 0xb1, 0x3d, 0x93, 0xfa, 0xca,
+	0x64, 0x68, 0x7f, 0x06, 0xfd, 0x1d, 0x84, 0x90, 0x2f, 0x60, 0xd2, 0xef, 0xec, 0xd3, 0xee, 0xc0,
+	0xc2, 0x5a, 0x25, 0x9b, 0x19, 0x3f, 0x50, 0x73, 0xe1, 0x5e, 0x0a, 0xd1, 0x7c, 0x81, 0xfc, 0x44,
+	0x1b, 0x83, 0x60, 0xd7, 0xe9, 0xf2, 0x9e, 0x53, 0x3c, 0x08, 0x76, 0x9d, 0x2e, 0x43, 0x5d, 0x85,
+	0x0a, 0xa2, 0x5c, 0x23, 0xe8, 0xec, 0x2b, 0x84, 0xe1, 0x90, 0x76, 0x1b, 0xdb, 0xea, 0x53, 0x28,
+	0xf2, 0x8d, 0x97, 0x9a, 0x28, 0xdd, 0x4b, 0x66, 0x00, 0xd3, 0xa3, 0x7b, 0x55, 0x9a, 0x31, 0xf5,
+	0x06, 0x94, 0x65, 0xe9, 0x2f, 0x8d, 0x95, 0xfa, 0xdb, 0x29, 0xa8, 0x49, 0x02, 0xe6, 0x95, 0xce,
+	0x56, 0x43, 0x54, 0xa0, 0x94, 0xf4, 0x4d, 0xb2, 0x49, 0x96, 0xa0, 0x8a, 0xb3, 0x1e, 0xef, 0x91,
+	0x00, 0x49, 0x22, 0x7f, 0xe4, 0x07, 0x0e, 0xf3, 0x24, 0x3c, 0x89, 0x93, 0x4d, 0xf2, 0x9e, 0x9c,
+	0x6e, 0x81, 0x4d, 0x77, 0x76, 0x58, 0x9e, 0x63, 0xec, 0x76, 0x31, 0x61, 0xb7, 0x1f, 0x42, 0xdd,
+	0x32, 0xfc, 0x40, 0x67, 0xce, 0x9c, 0x71, 0x2b, 0x1f, 0xe3, 0x00, 0x6a, 0x48, 0x27, 0x5b, 0x64,
+	0x01, 0xaa, 0x31, 0x53, 0xc5, 0x8e, 0x55, 0x5e, 0x8b, 0x83, 0xc8, 0xcf, 0x45, 0x6c, 0x01, 0x8c,
+	0xdf, 0xad, 0x61, 0xe9, 0x98, 0xbd, 0x95, 0x0d, 0x2c, 0xa8, 0x89, 0xf0, 0xe3, 0x3a, 0x80, 0x31,
+	0x08, 0xf6, 0xf5, 0xc0, 0x39, 0xa0, 0xb6, 0x38, 0x4e, 0x15, 0x84, 0xec, 0x20, 0x80, 0x3c, 0x8c,
+	0x6c, 0x38, 0x3f, 0x4c, 0xd7, 0x52, 0x19, 0x8f, 0x18, 0xf2, 0x7f, 0xad, 0x5d, 0xc0, 0x90, 0x2f,
+	0x85, 0x65, 0xf1, 0x6c, 0xd2, 0x04, 0xb0, 0xd2, 0xf8, 0x68, 0x95, 0x3c, 0xd5, 0xf2, 0xe7, 0xce,
+	0x6d, 0xf9, 0xf3, 0x63, 0x2d, 0xff, 0xa7, 0x00, 0xc2, 0x9d, 0xea, 0x86, 0xb4, 0xe9, 0xe3, 0xfc,
+	0x61, 0x45, 0x50, 0xaf, 0x04, 0x18, 0xaa, 0x78, 0x14, 0x53, 0x39, 0x9d, 0x7a, 0x9e, 0xe3, 0x89,
+	0xad, 0x51, 0xe5, 0xb0, 0x26, 0x82, 0xc8, 0x7b, 0x30, 0xc5, 0x8d, 0xbb, 0x2f, 0x6d, 0x39, 0xed,
+	0x8a, 0x88, 0xa5, 0x21, 0x10, 0x9a, 0x84, 0xc7, 0x89, 0x8d, 0x43, 0xc3, 0xb4, 0x8c, 0xb6, 0x45,
+	0x95, 0x72, 0x82, 0x78, 0x45, 0xc2, 0xb1, 0x2c, 0x2c, 0xa2, 0x33, 0x51, 0x46, 0xe5, 0x65, 0x27,
+	0x11, 0x8d, 0xad, 0x32, 0x58, 0xba, 0x2f, 0x81, 0x8b, 0xfa, 0x92, 0xea, 0x4f, 0xe3, 0x4b, 0x6a,
+	0x17, 0xf0, 0x25, 0x13, 0x63, 0x7c, 0xc9, 0x02, 0x54, 0xbb, 0xd4, 0xef, 0x78, 0xa6, 0x8b, 0xa6,
+	0x99, 0xd9, 0xee, 0x8a, 0x16, 0x07, 0x85, 0xde, 0xa6, 0x11, 0xf3, 0x36, 0xd1, 0x09, 0x9f, 0x4a,
+	0x9c, 0xf0, 0x58, 0x64, 0x30, 0x7d, 0xda, 0xc8, 0x60, 0x66, 0x4c, 0x64, 0x30, 0xea, 0xd5, 0x66,
+	0xcf, 0xef, 0xd5, 0xe6, 0x2e, 0xe4, 0xd5, 0x2e, 0x5f, 0xc0, 0xab, 0x29, 0xa7, 0xf1, 0x6a, 0x57,
+	0xce, 0xed, 0xd5, 0xe6, 0xc7, 0x78, 0xb5, 0xab, 0x49, 0xaf, 0x46, 0x66, 0xa1, 0xe8, 0x3f, 0xd0,
+	0x71, 0x42, 0xd7, 0xf8, 0x15, 0xa1, 0xff, 0xe0, 0xc5, 0x20, 0x40, 0x97, 0xd3, 0x17, 0x57, 0x40,
+	0xca, 0xf5, 0xa4, 0xcb, 0x91, 0x57, 0x43, 0x5a, 0x48, 0x81, 0x39, 0x81, 0x47, 0x65, 0x91, 0x80,
+	0x89, 0x70, 0x83, 0x0d, 0x33, 0x11, 0x42, 0x99, 0x20, 0xef, 0xc2, 0xe4, 0xc0, 0xee, 0x58, 0x86,
+	0xd9, 0xa7, 0x5d, 0x1d, 0x6f, 0x93, 0x7d, 0xe5, 0x26, 0xd3, 0x44, 0x3d, 0x04, 0xef, 0x20, 0x14,
+	0x25, 0x16, 0x01, 0xa0, 0xd7, 0x51, 0x16, 0xb8, 0xc4, 0x1c, 0xa0, 0x75, 0x70, 0x87, 0x1a, 0x83,
+	0xc0, 0xf1, 0x3b, 0x06, 0x4e, 0x5e, 0xb9, 0xc5, 0xc4, 0x8e, 0x83, 0x22, 0x6d, 0x77, 0x8c, 0xce,
+	0x3e, 0x55, 0x54, 0x46, 0xc1, 0xb5, 0xbd, 0x86, 0x10, 0xb2, 0x09, 0x33, 0x38, 0xbc, 0x3e, 0xac,
+	0xf2, 0xdb, 0xc2, 0x80, 0x49, 0x0b, 0x6b, 0xf8, 0x07, 0x43, 0x6a, 0x27, 0xc1, 0x08, 0x8c, 0xec,
+	0xc2, 0x15, 0x31, 0xdc, 0x3e, 0xed, 0x1c, 0xb8, 0x8e, 0x69, 0x07, 0x3a, 0xbb, 0x84, 0x3a, 0x34,
+	0x2c, 0xe5, 0x9d, 0x93, 0xb6, 0xc9, 0x65, 0x2e, 0x57, 0xd8, 0x75, 0x43, 0xf4, 0x54, 0xbf, 0x87,
+	0x5a, 0xdc, 0x45, 0x91, 0x2b, 0x30, 0xbb, 0xbd, 0xb1, 0xdd, 0xdc, 0xdc, 0xd8, 0xda, 0xd1, 0x77,
+	0xbe, 0xdd, 0x6e, 0xea, 0xbb, 0x5b, 0xcf, 0xb7, 0x5e, 0x7c, 0xb3, 0xd5, 0xb8, 0x44, 0xae, 0xc2,
+	0x65, 0x81, 0x6a, 0x72, 0xd4, 0x8e, 0xb6, 0xb2, 0xd5, 0x7a, 0xf2, 0x42, 0xfb, 0xaa, 0x91, 0x21,
+	0x97, 0x61, 0x3a, 0x89, 0x6c, 0x6d, 0xbf, 0xd8, 0xdd, 0x69, 0x64, 0x63, 0x0c, 0x25, 0xa2, 0xa9,
+	0x7d, 0xbd, 0xb1, 0xd6, 0x6c, 0xe4, 0x9e, 0xe5, 0xcb, 0xa5, 0x46, 0x59, 0x7d, 0x06, 0x13, 0x71,
+	0xc7, 0x86, 0xe6, 0x7e, 0x22, 0xcc, 0x7f, 0x4d, 0x7b, 0xcf, 0x11, 0xb7, 0x8e, 0x33, 0x69, 0x6e,
+	0x50, 0xab, 0xb9, 0xb1, 0x96, 0xba, 0x00, 0x45, 0x9e, 0x9c, 0x8b, 0xb2, 0x6b, 0x66, 0xa4, 0xec,
+	0xda, 0x87, 0x99, 0x0d, 0x1b, 0x97, 0x21, 0xe0, 0x84, 0xc2, 0x88, 0x9e, 0x3e, 0xdb, 0x27, 0x90,
+	0x7f, 0x63, 0x88, 0x4a, 0x75, 0x59, 0x63, 0xdf, 0x18, 0xc1, 0x48, 0x97, 0x9d, 0xe3, 0x11, 0x8c,
+	0x68, 0xaa, 0x1f, 0xc0, 0xd4, 0xa6, 0xe9, 0x0f, 0x8d, 0x15, 0x23, 0xcf, 0x24, 0xc9, 0x7f, 0x03,
+	0x53, 0x91, 0x74, 0x92, 0xfc, 0x84, 0x72, 0xc1, 0xd9, 0x04, 0xfa, 0x7d, 0x06, 0xea, 0x42, 0x22,
+	0xc9, 0xff, 0x6c, 0x81, 0xdf, 0x47, 0x50, 0x63, 0x36, 0x5c, 0x0f, 0x2b, 0xf6, 0xb9, 0x94, 0xf8,
+	0xae, 0xca, 0x68, 0xa2, 0x00, 0x6f, 0xdf, 0xf4, 0x03, 0x2c, 0xef, 0xf0, 0x82, 0xa3, 0x6c, 0xc6,
+	0xe5, 0x2c, 0x24, 0xe4, 0xc4, 0x7a, 0xfd, 0xab, 0xd7, 0x4f, 0x4c, 0x2b, 0xa0, 0xd2, 0x69, 0x87,
+	0x6d, 0xf5, 0xcf, 0x61, 0xba, 0x35, 0x68, 0xa3, 0xaf, 0x68, 0xd3, 0x73, 0xcf, 0x23, 0x36, 0x74,
+	0x36, 0xa9, 0xa2, 0x8f, 0xa0, 0xb1, 0x4e, 0x2d, 0x1a, 0xd0, 0x53, 0xaf, 0x81, 0xfa, 0x14, 0xea,
+	0xad, 0xc0, 0x71, 0x4f, 0xbf, 0x68, 0x91, 0x2b, 0xcb, 0xc5, 0x5d, 0x99, 0xfa, 0xbf, 0x59, 0x98,
+	0xdd, 0x75, 0xbb, 0x46, 0x40, 0x65, 0x1c, 0x7a, 0x4a, 0x86, 0x77, 0x92, 0x99, 0xc1, 0x29, 0xaa,
+	0x1b, 0x89, 0x81, 0xe3, 0x45, 0xa1, 0xc2, 0x49, 0x45, 0xa1, 0xe2, 0x69, 0x8a, 0x42, 0xa5, 0xd1,
+	0xa2, 0xd0, 0x4f, 0x55, 0xf5, 0x49, 0x16, 0x97, 0x60, 0xb8, 0xb8, 0x14, 0x16, 0x85, 0xaa, 0x27,
+	0x16, 0x85, 0xd4, 0xff, 0xc8, 0x42, 0xfd, 0x29, 0x0d, 0x36, 0x9d, 0x9e, 0x7f, 0xbe, 0x6d, 0x24,
+	0x96, 0x25, 0x7b, 0xcc, 0xb2, 0x48, 0xad, 0xec, 0xb1, 0x9d, 0xeb, 0x8b, 0x47, 0x42, 0x4c, 0x0d,
+	0x7c, 0x33, 0xfb, 0xd1, 0xd5, 0x4f, 0x7e, 0xcc, 0xd5, 0x0f, 0x16, 0x48, 0x0d, 0x1f, 0x0f, 0x03,
+	0x3f, 0x27, 0xa2, 0x85, 0xf0, 0x3d, 0xc7, 0xb2, 0x9c, 0x37, 0x6c, 0x51, 0xca, 0x9a, 0x68, 0xb1,
+	0xb2, 0xa7, 0x61, 0xca, 0xca, 0x1b, 0xfb, 0x26, 0x77, 0xa1, 0x31, 0xf0, 0xa9, 0x6e, 0x39, 0x07,
+	0xa6, 0xde, 0x36, 0x3a, 0x07, 0xd4, 0xe6, 0x6b, 0x50, 0xd6, 0xea, 0x03, 0x9f, 0x6e, 0x3a, 0x07,
+	0xe6, 0x2a, 0x87, 0x92, 0x25, 0x28, 0xf8, 0xa6, 0xdd, 0xa1, 0x4a, 0xe5, 0x24, 0xbf, 0xc2, 0xe9,
+	0xd4, 0x7f, 0xcb, 0x02, 0x6c, 0x3a, 0xbd, 0xaf, 0xa8, 0xef, 0xe3, 0xfb, 0x96, 0xdb, 0x31, 0x0b,
+	0x1e, 0x4b, 0x3c, 0x43, 0x5b, 0xbd, 0x85, 0xb9, 0xec, 0xc9, 0xb5, 0xed, 0x44, 0xa1, 0x3c, 0x37,
+	0xb6, 0x50, 0x7e, 0x07, 0xca, 0xdc, 0x3b, 0x9a, 0x5d, 0x71, 0x53, 0x5d, 0x7d, 0xfb, 0xe3, 0xcd,
+	0x12, 0xbf, 0x60, 0x5b, 0xd7, 0x4a, 0x0c, 0xb9, 0xd1, 0x3d, 0x56, 0x8f, 0xb2, 0x92, 0x5d, 0x1c,
+	0x5b, 0xc9, 0x0e, 0xdf, 0x34, 0xf1, 0xe7, 0x0a, 0xec, 0x9b, 0xdc, 0x87, 0x6c, 0x58, 0xbc, 0x19,
+	0x97, 0x95, 0x64, 0x03, 0x1f, 0x4f, 0x59, 0x9f, 0xeb, 0x48, 0xe4, 0x02, 0xb2, 0xa9, 0x7e, 0x03,
+	0xd3, 0x1a, 0x3f, 0x70, 0x7c, 0xdd, 0x4f, 0x77, 0xea, 0x87, 0xb7, 0x57, 0x76, 0x64, 0x7b, 0xa9,
+	0x8f, 0x60, 0x5a, 0xb8, 0x94, 0x04, 0xe3, 0xd3, 0x5c, 0x38, 0xaa, 0x5f, 0x43, 0x03, 0x7d, 0xc5,
+	0x59, 0x24, 0x0a, 0xc3, 0xff, 0xec, 0xf1, 0xe1, 0xbf, 0xda, 0x85, 0x5a, 0x3c, 0x84, 0x8e, 0x15,
+	0xe4, 0x33, 0xf1, 0x82, 0x3c, 0x1e, 0x74, 0xdf, 0xfc, 0x9e, 0x8a, 0xeb, 0x16, 0x5e, 0xac, 0xaf,
+	0x20, 0x84, 0xdf, 0xc7, 0x5c, 0x07, 0x70, 0xa9, 0xa7, 0xf3, 0x4d, 0xc0, 0x36, 0x48, 0x4e, 0xab,
+	0xb8, 0xd4, 0xe3, 0xfb, 0x43, 0xfd, 0x43, 0x06, 0xea, 0x43, 0x41, 0xd4, 0x57, 0x30, 0x61, 0x3b,
+	0x5d, 0xaa, 0xfb, 0xd4, 0xa2, 0x9d, 0xc0, 0xf1, 0x44, 0x68, 0x71, 0x37, 0x3d, 0xfc, 0x5d, 0xdc,
+	0x72, 0xba, 0xb4, 0x25, 0x48, 0xf9, 0xe3, 0xa4, 0x9a, 0x1d, 0x03, 0x91, 0x45, 0x98, 0x76, 0x3d,
+	0xd3, 0xf1, 0xcc, 0xe0, 0x48, 0xef, 0x58, 0x86, 0xef, 0xf3, 0xdd, 0xce, 0xef, 0x30, 0xa6, 0x24,
+	0x6a, 0x0d, 0x31, 0xb8, 0xe5, 0xe7, 0xbf, 0x80, 0xa9, 0x11, 0x96, 0x67, 0x7a, 0x98, 0xf4, 0x25,
+	0x90, 0xd1, 0x70, 0x11, 0x7d, 0xa5, 0x1c, 0x4b, 0x28, 0x30, 0x6c, 0xa3, 0x6a, 0xdf, 0x50, 0xb3,
+	0xb7, 0x1f, 0x88, 0xfb, 0x54, 0xd1, 0x52, 0xff, 0xbd, 0x0a, 0xb3, 0x6b, 0x2c, 0x4d, 0x0e, 0x8d,
+	0xda, 0xb9, 0xec, 0xdf, 0x99, 0x0b, 0x07, 0x89, 0xd2, 0x44, 0xee, 0x9c, 0x35, 0xe6, 0xfc, 0xb9,
+	0x2b, 0x0d, 0x85, 0xb1, 0x95, 0x86, 0x39, 0x28, 0x0e, 0x98, 0xf7, 0x95, 0xe6, 0x94, 0xb7, 0x46,
+	0x33, 0xf9, 0x52, 0x4a, 0x26, 0x1f, 0x25, 0x39, 0xe5, 0x78, 0x92, 0x93, 0x9a, 0xe0, 0x57, 0x2e,
+	0x9a, 0xe0, 0xc3, 0x4f, 0x93, 0xe0, 0x57, 0x2f, 0x90, 0xe0, 0xd7, 0x4e, 0x9f, 0xe0, 0x4f, 0x8c,
+	0x26, 0xf8, 0xd7, 0xd8, 0xcb, 0x33, 0xee, 0x92, 0x59, 0x01, 0xb6, 0xac, 0x45, 0x80, 0x78, 0x4a,
+	0x3f, 0x75, 0xda, 0x94, 0x9e, 0x9c, 0x29, 0xa5, 0x9f, 0x3e, 0x7f, 0x4a, 0x3f, 0x73, 0xa1, 0x94,
+	0x7e, 0xf6, 0x2c, 0x29, 0xbd, 0x2c, 0x83, 0xcc, 0xc5, 0xca, 0x20, 0x43, 0x69, 0xfe, 0xe5, 0xd3,
+	0xa4, 0xf9, 0xca, 0xb9, 0xd3, 0xfc, 0x2b, 0x63, 0xd2, 0xfc, 0xf9, 0xa1, 0x34, 0x7f, 0xa8, 0xf4,
+	0x7b, 0xf5, 0xc4, 0xd2, 0x6f, 0xbc, 0x00, 0x70, 0xed, 0x1c, 0x05, 0x80, 0xeb, 0x69, 0x05, 0x80,
+	0xa1, 0xd4, 0xfd, 0xc6, 0x89, 0xa9, 0xfb, 0xcd, 0x53, 0xa7, 0xee, 0x0b, 0x3f, 0x7d, 0xea, 0x7e,
+	0xeb, 0xdc, 0xa9, 0xfb, 0x6f, 0x33, 0x30, 0xbb, 0xee, 0x1d, 0x69, 0x03, 0x7b, 0xd8, 0x84, 0xef,
+	0xc2, 0x65, 0x5e, 0x02, 0xd5, 0xc3, 0x30, 0x4c, 0x58, 0x1e, 0x61, 0xd1, 0xaf, 0x47, 0xcf, 0xba,
+	0x52, 0x5c, 0x80, 0x36, 0xdb, 0x49, 0x03, 0xb3, 0x57, 0x38, 0x46, 0xdf, 0xb5, 0xa8, 0xbc, 0x37,
+	0xe7, 0x2d, 0xb5, 0x2f, 0xdc, 0xf9, 0xb6, 0x47, 0x0f, 0x4d, 0xfa, 0x26, 0x7c, 0x26, 0x94, 0x19,
+	0xfb, 0x4c, 0xe8, 0x04, 0xe7, 0x8e, 0xb7, 0x02, 0x22, 0x95, 0x10, 0x29, 0xac, 0x68, 0x62, 0x0a,
+	0x3b, 0x37, 0x3c, 0x6f, 0xdf, 0x75, 0x6c, 0x9f, 0x65, 0x37, 0x4c, 0x5b, 0xbe, 0x0c, 0x24, 0x78,
+	0x2b, 0xce, 0x8c, 0x0f, 0x24, 0x9b, 0xb8, 0x15, 0x58, 0x1a, 0x21, 0xc4, 0xe0, 0x41, 0x04, 0x30,
+	0x10, 0x97, 0xe3, 0x0e, 0x4c, 0xf6, 0x8d, 0xef, 0x74, 0xbe, 0x80, 0x89, 0x57, 0x33, 0x7d, 0xe3,
+	0x3b, 0x36, 0x6d, 0x4e, 0xf7, 0x7e, 0xa8, 0x9c, 0x42, 0xb2, 0x5c, 0x11, 0x57, 0x4d, 0xa8, 0xb2,
+	0xdf, 0xc0, 0x9c, 0x88, 0xca, 0x2e, 0xe6, 0x7e, 0x8f, 0xcf, 0x62, 0x7f, 0xc8, 0xc0, 0x34, 0x06,
+	0x6f, 0x17, 0xe6, 0x2f, 0x53, 0xf7, 0xec, 0xb1, 0xa9, 0x7b, 0xee, 0xf8, 0xd4, 0x3d, 0x3f, 0x94,
+	0xba, 0xff, 0x15, 0xee, 0x59, 0x96, 0x5c, 0x5f, 0x4c, 0xae, 0x06, 0xe4, 0x0c, 0xcb, 0x12, 0x73,
+	0xc6, 0x4f, 0x0c, 0x9a, 0xf6, 0x1c, 0xaf, 0x43, 0x85, 0x34, 0xbc, 0x81, 0xe6, 0xea, 0x80, 0x52,
+	0x57, 0x67, 0xaf, 0x5d, 0xf9, 0xed, 0x52, 0x19, 0x01, 0x1a, 0x75, 0x1d, 0x75, 0x1d, 0x66, 0x5a,
+	0x18, 0x71, 0x5f, 0x48, 0x14, 0x75, 0x0d, 0xa6, 0x31, 0xf7, 0xbf, 0x18, 0x93, 0xbf, 0xcb, 0x00,
+	0x49, 0x39, 0xc8, 0x67, 0x53, 0xca, 0x22, 0x80, 0xeb, 0x39, 0x87, 0xd4, 0x36, 0x30, 0x77, 0x4b,
+	0x2f, 0xcc, 0xc4, 0x28, 0x62, 0x19, 0x58, 0x2e, 0x3d, 0x03, 0x53, 0x1f, 0x43, 0x5d, 0x1b, 0xd8,
+	0xf8, 0xf6, 0xf3, 0x7c, 0xd3, 0xba, 0x07, 0xd3, 0xdc, 0xc2, 0x88, 0xe7, 0xbf, 0x82, 0x09, 0x81,
+	0x3c, 0xfb, 0x7d, 0x47, 0x86, 0xbf, 0xa7, 0xc4, 0x6f, 0xf5, 0x73, 0x98, 0xe6, 0x1b, 0x23, 0x49,
+	0x7a, 0x27, 0x7c, 0x62, 0x3c, 0x54, 0x96, 0x4b, 0x3e, 0x28, 0x56, 0x1f, 0x87, 0x75, 0xbd, 0xf3,
+	0xf5, 0xbf, 0x06, 0x45, 0x0e, 0x49, 0xbd, 0x2c, 0xfd, 0x21, 0x03, 0xc0, 0xd1, 0xec, 0xaa, 0xf4,
+	0x94, 0x4c, 0xc3, 0xc7, 0x47, 0xd9, 0xd8, 0xe3, 0xa3, 0x0d, 0x20, 0xcc, 0xba, 0x9a, 0x8e, 0xad,
+	0x87, 0x3f, 0xa3, 0x52, 0x72, 0x27, 0xa6, 0x8f, 0x53, 0xb2, 0x57, 0x08, 0x52, 0x57, 0xa1, 0x1a,
+	0x09, 0xe5, 0x93, 0x07, 0x50, 0xe5, 0xe3, 0xc6, 0xab, 0xa6, 0x24, 0x29, 0x1a, 0x52, 0x6a, 0xe0,
+	0x87, 0xdf, 0xea, 0x2c, 0x4c, 0xaf, 0x74, 0x02, 0xf3, 0xd0, 0x08, 0xe8, 0xca, 0x20, 0xd8, 0x17,
+	0x6a, 0x53, 0xe7, 0x60, 0x26, 0x09, 0xe6, 0x06, 0x56, 0xfd, 0x5d, 0x06, 0x66, 0x35, 0x6a, 0x77,
+	0xa9, 0xb7, 0x43, 0xfb, 0xae, 0x15, 0xab, 0x4f, 0xcd, 0x43, 0x39, 0x10, 0x20, 0xa1, 0xba, 0xb0,
+	0x4d, 0x3e, 0x83, 0xbc, 0xe1, 0xf5, 0xe4, 0x0b, 0xa9, 0x77, 0xa3, 0x68, 0x33, 0x85, 0xd1, 0xe2,
+	0x8a, 0xd7, 0x13, 0xbf, 0x04, 0x61, 0x9d, 0xe6, 0x7f, 0x01, 0x95, 0x10, 0x74, 0xa6, 0x64, 0xc9,
+	0x80, 0xb9, 0xe1, 0x11, 0x84, 0x9b, 0x20, 0x90, 0x7f, 0x85, 0x25, 0x30, 0xb1, 0xc4, 0xf8, 0x4d,
+	0x1e, 0x60, 0x18, 0x49, 0x3b, 0x52, 0xc8, 0x13, 0x3c, 0x24, 0xa7, 0xbd, 0xff, 0xfb, 0x0c, 0x7b,
+	0xd9, 0xcc, 0x2f, 0x8c, 0x67, 0x61, 0xea, 0xd9, 0x8b, 0x55, 0xbd, 0xb5, 0xb3, 0xb2, 0x13, 0x2f,
+	0x9b, 0x4f, 0x42, 0x15, 0xc1, 0x6b, 0x5a, 0x73, 0x65, 0xa7, 0xb9, 0xde, 0xc8, 0x90, 0x06, 0xd4,
+	0x04, 0x9d, 0xb6, 0xb3, 0xb1, 0xf5, 0xb4, 0x91, 0x95, 0x24, 0xda, 0xee, 0xd6, 0x16, 0x02, 0x72,
+	0x12, 0xf0, 0x64, 0x65, 0x63, 0x73, 0x57, 0x6b, 0x36, 0xf2, 0x12, 0xd0, 0xda, 0x5d, 0x5b, 0x6b,
+	0xb6, 0x5a, 0x8d, 0x02, 0xa9, 0x03, 0x20, 0xe0, 0xf9, 0xc6, 0xe6, 0x66, 0x73, 0xbd, 0x51, 0x24,
+	0x53, 0x30, 0x81, 0xed, 0xe6, 0x53, 0xad, 0xd9, 0x6a, 0x21, 0x93, 0x92, 0x04, 0x3d, 0xd9, 0xd8,
+	0xda, 0x68, 0x7d, 0x89, 0xa0, 0x32, 0x21, 0x50, 0x47, 0xd0, 0xee, 0x16, 0x0e, 0xb5, 0xb2, 0xba,
+	0xd9, 0x6c, 0x54, 0xee, 0xff, 0x19, 0x40, 0xf4, 0x80, 0x98, 0x54, 0xa1, 0x14, 0x89, 0x0e, 0x50,
+	0x44, 0x11, 0x98, 0xd4, 0x55, 0x28, 0xc9, 0xd1, 0xb3, 0xac, 0xf1, 0x7c, 0x63, 0x7b, 0xbb, 0xb9,
+	0xde, 0xc8, 0x91, 0x1a, 0x94, 0xc3, 0xb9, 0xe4, 0xc9, 0x04, 0x54, 0xb4, 0xe6, 0xda, 0x8b, 0xaf,
+	0x9b, 0x5a, 0x73, 0xbd, 0x51, 0xb8, 0xff, 0x2d, 0x54, 0x63, 0x8f, 0x13, 0x88, 0x02, 0x33, 0xdf,
+	0xbc, 0xd0, 0x9e, 0x37, 0xb5, 0x34, 0x35, 0x6d, 0xbf, 0x58, 0x0f, 0x75, 0x90, 0x91, 0x80, 0x68,
+	0xd0, 0x3a, 0x00, 0x02, 0x84, 0x44, 0xb9, 0xfb, 0xff, 0x95, 0x89, 0x6e, 0x0e, 0x38, 0xf7, 0x79,
+	0x98, 0x0b, 0xef, 0x1a, 0x86, 0xf9, 0xcf, 0xc2, 0x54, 0x1c, 0xc7, 0xc5, 0xcd, 0x90, 0x19, 0x68,
+	0x84, 0x60, 0x39, 0x76, 0x36, 0x71, 0x9b, 0xa1, 0x35, 0x43, 0xf2, 0x5c, 0x82, 0x3c, 0x5a, 0x9d,
+	0x69, 0x98, 0x0c, 0xa1, 0xdb, 0x2b, 0xbb, 0x2d, 0x9c, 0x79, 0x82, 0xb4, 0xb5, 0xb3, 0xb2, 0xb5,
+	0xbe, 0xfa, 0x6d, 0xa3, 0x98, 0x10, 0x63, 0x4d, 0x5b, 0xe1, 0x0b, 0x53, 0x5a, 0xfe, 0x63, 0x03,
+	0x72, 0x2b, 0xdb, 0x1b, 0xe4, 0x11, 0x40, 0x74, 0x01, 0x40, 0xae, 0x44, 0xb9, 0xd5, 0xd0, 0xa5,
+	0xc0, 0xfc, 0xf0, 0x33, 0x43, 0xf5, 0x12, 0x59, 0x85, 0x89, 0xc4, 0xd5, 0x06, 0xb9, 0x36, 0xda,
+	0x3d, 0xba, 0x85, 0x48, 0xe1, 0xf0, 0x61, 0x06, 0x1f, 0x1f, 0x88, 0xdb, 0x01, 0x12, 0x26, 0x0b,
+	0xc9, 0xeb, 0x82, 0xf4, 0x7e, 0x5f, 0x00, 0x44, 0xf7, 0x1c, 0x91, 0xdc, 0x23, 0x77, 0x1f, 0xf3,
+	0x24, 0x79, 0xad, 0x12, 0x32, 0xf8, 0x15, 0xd4, 0xe2, 0x35, 0x7d, 0x72, 0x35, 0xb4, 0x5b, 0xa3,
+	0x95, 0xfe, 0xe3, 0x44, 0xa8, 0x84, 0x65, 0x7b, 0xa2, 0x84, 0xd1, 0xd7, 0x50, 0x25, 0x7f, 0x7e,
+	0x6e, 0xc4, 0xc6, 0x36, 0xf1, 0x57, 0x42, 0xea, 0x25, 0xf2, 0x19, 0x94, 0x44, 0x11, 0x3f, 0x9a,
+	0x7b, 0xb2, 0xaa, 0x3f, 0xa6, 0xf3, 0xaf, 0xa0, 0x16, 0x2f, 0xb3, 0x45, 0xf2, 0xa7, 0x14, 0xdf,
+	0xe6, 0xa7, 0x12, 0xb1, 0xa1, 0x58, 0xbe, 0x5f, 0x42, 0x25, 0x2c, 0xb6, 0x45, 0xf2, 0x0f, 0xd7,
+	0xdf, 0x52, 0xfb, 0x7e, 0x98, 0x21, 0x4d, 0xf6, 0xc6, 0x36, 0xac, 0x1f, 0x46, 0xe3, 0xa7, 0x54,
+	0x15, 0xc7, 0x4c, 0x63, 0x03, 0xea, 0x49, 0x83, 0x47, 0xc6, 0x1b, 0xc2, 0x31, 0xac, 0x5e, 0x42,
+	0x3d, 0x19, 0xa5, 0x47, 0xac, 0x52, 0xb3, 0x96, 0xf9, 0x1b, 0xc7, 0xa1, 0x85, 0xef, 0x41, 0xe9,
+	0x26, 0x87, 0xa2, 0x66, 0x72, 0x63, 0x48, 0xcf, 0xc3, 0x4c, 0x53, 0x6f, 0x0d, 0xd5, 0x4b, 0xa8,
+	0xaf, 0x78, 0x74, 0x1c, 0xe9, 0x2b, 0x25, 0x66, 0x3e, 0x8e, 0xc9, 0x87, 0x19, 0xd4, 0x57, 0x32,
+	0x9c, 0x8d, 0x4d, 0x32, 0x2d, 0xcc, 0x1d, 0xa3, 0xaf, 0xa7, 0x30, 0x91, 0x88, 0x46, 0xa3, 0xe3,
+	0x9b, 0x16, 0xa4, 0x8e, 0x61, 0xd4, 0x84, 0x5a, 0x3c, 0x20, 0x8d, 0x1d, 0xa5, 0xd1, 0x30, 0x75,
+	0x0c, 0x9b, 0x35, 0xa8, 0xc6, 0x17, 0x2f, 0x4c, 0x7a, 0x53, 0x56, 0x6e, 0xec, 0x99, 0x12, 0x01,
+	0x64, 0x74, 0xa6, 0x92, 0x11, 0xe5, 0xf8, 0x89, 0xc4, 0xa3, 0xc7, 0x68, 0x22, 0x29, 0x31, 0xe5,
+	0x78, 0x36, 0xf1, 0xc8, 0x32, 0x62, 0x93, 0x12, 0x6f, 0x8e, 0x9d, 0x0a, 0x33, 0x71, 0x82, 0xc9,
+	0x31, 0x74, 0xf3, 0xd3, 0xa3, 0xf1, 0x96, 0xcf, 0x94, 0x39, 0x91, 0x08, 0x4f, 0x47, 0x6c, 0x73,
+	0x52, 0x8a, 0x94, 0xa8, 0x4d, 0xbd, 0x44, 0x3e, 0x97, 0x16, 0x6e, 0xc5, 0xb2, 0x8e, 0x15, 0xe0,
+	0xf8, 0x09, 0x7c, 0x0a, 0x25, 0x71, 0xd5, 0x15, 0xad, 0x45, 0xf2, 0xee, 0x2b, 0x1a, 0x37, 0xba,
+	0xcc, 0x61, 0xdb, 0xfc, 0x39, 0xd4, 0xe2, 0xe1, 0x60, 0xa4, 0xc2, 0x94, 0xd8, 0x71, 0xfe, 0x5a,
+	0x3a, 0x32, 0x76, 0x8a, 0xeb, 0xc9, 0x2b, 0xce, 0xe8, 0xcc, 0xa4, 0x5e, 0x7d, 0x8e, 0x99, 0xd2,
+	0x97, 0x6c, 0x8f, 0x6e, 0xe2, 0x4f, 0x3b, 0x58, 0x0c, 0x2a, 0x93, 0x9d, 0x18, 0x50, 0x32, 0xb9,
+	0x9a, 0x8a, 0x0b, 0x85, 0x7a, 0x0e, 0x24, 0x86, 0x58, 0xa7, 0x7b, 0xc6, 0xc0, 0x3a, 0x7e, 0x95,
+	0x4f, 0x60, 0xf6, 0x12, 0xea, 0xc9, 0xc8, 0x33, 0x9a, 0x61, 0x6a, 0xcc, 0x3b, 0x7f, 0xe3, 0x38,
+	0x74, 0xc8, 0xf2, 0x33, 0x28, 0xe3, 0xee, 0xc3, 0x8a, 0x13, 0x51, 0x16, 0xb1, 0xc8, 0x64, 0xb8,
+	0xe6, 0xa2, 0x04, 0x45, 0xce, 0x41, 0x62, 0x10, 0x2a, 0xad, 0xd4, 0xea, 0x2f, 0xfe, 0xf3, 0xed,
+	0x8d, 0xcc, 0x1f, 0xde, 0xde, 0xc8, 0xfc, 0xcf, 0xdb, 0x1b, 0x99, 0x5f, 0xdf, 0xeb, 0x99, 0xc1,
+	0xfe, 0xa0, 0xbd, 0xd8, 0x71, 0xfa, 0x4b, 0xae, 0xd1, 0xd9, 0x3f, 0xea, 0x52, 0x2f, 0xfe, 0x75,
+	0xb8, 0xbc, 0xe4, 0x7b, 0x1d, 0xfc, 0x3f, 0x22, 0xda, 0x45, 0x36, 0xef, 0x07, 0x7f, 0x1a, 0x00,
+	0x43, 0xec, 0x0f, 0xe7, 0x35, 0x42, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5671,6 +6171,7 @@ type APIClient interface {
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DryRunPipeline(ctx context.Context, in *DryRunPipelineRequest, opts ...grpc.CallOption) (*DryRunPipelineResponse, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (API_ListPipelineClient, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
@@ -5922,6 +6423,15 @@ func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineReques
 	return out, nil
 }
 
+func (c *aPIClient) DryRunPipeline(ctx context.Context, in *DryRunPipelineRequest, opts ...grpc.CallOption) (*DryRunPipelineResponse, error) {
+	out := new(DryRunPipelineResponse)
+	err := c.cc.Invoke(ctx, "/pps_v2.API/DryRunPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
 	out := new(PipelineInfo)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/InspectPipeline", in, out, opts...)
@@ -6177,6 +6687,7 @@ type APIServer interface {
 	ListDatum(*ListDatumRequest, API_ListDatumServer) error
 	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
+	DryRunPipeline(context.Context, *DryRunPipelineRequest) (*DryRunPipelineResponse, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(*ListPipelineRequest, API_ListPipelineServer) error
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
@@ -6243,6 +6754,9 @@ func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDat
 func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
 }
+func (*UnimplementedAPIServer) DryRunPipeline(ctx context.Context, req *DryRunPipelineRequest) (*DryRunPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunPipeline not implemented")
+}
 func (*UnimplementedAPIServer) InspectPipeline(ctx context.Context, req *InspectPipelineRequest) (*PipelineInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectPipeline not implemented")
 }
@@ -6518,6 +7032,24 @@ func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_DryRunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DryRunPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps_v2.API/DryRunPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DryRunPipeline(ctx, req.(*DryRunPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InspectPipelineRequest)
 	if err := dec(in); err != nil {
@@ -6897,6 +7429,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePipeline",
 			Handler:    _API_CreatePipeline_Handler,
 		},
+		{
+			MethodName: "DryRunPipeline",
+			Handler:    _API_DryRunPipeline_Handler,
+		},
 		{
 			MethodName: "InspectPipeline",
 			Handler:    _API_InspectPipeline_Handler,
@@ -7756,7 +8292,7 @@ func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
+func (m *SQLInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7766,12 +8302,12 @@ func (m *Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQLInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQLInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7780,9 +8316,9 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Cron != nil {
+	if m.Start != nil {
 		{
-			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7790,34 +8326,163 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x5a
 	}
-	if len(m.Union) > 0 {
-		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.Shards != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Shards))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.KeyColumn) > 0 {
+		i -= len(m.KeyColumn)
+		copy(dAtA[i:], m.KeyColumn)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.KeyColumn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FileFormat != nil {
+		{
+			size, err := m.FileFormat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Cross) > 0 {
-		for iNdEx := len(m.Cross) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cross[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Cron != nil {
+		{
+			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Union) > 0 {
+		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Cross) > 0 {
+		for iNdEx := len(m.Cross) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cross[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
 	if len(m.Group) > 0 {
@@ -9205,6 +9870,46 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumCheckpointInterval != nil {
+		{
+			size, err := m.DatumCheckpointInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.TaskSchedulingSpec != nil {
+		{
+			size, err := m.TaskSchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.DatumCache {
+		i--
+		if m.DatumCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -10529,6 +11234,44 @@ func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskSchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskSchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Weight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Priority != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10553,6 +11296,46 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumCheckpointInterval != nil {
+		{
+			size, err := m.DatumCheckpointInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.TaskSchedulingSpec != nil {
+		{
+			size, err := m.TaskSchedulingSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DatumCache {
+		i--
+		if m.DatumCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -10875,7 +11658,7 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *DryRunPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10885,12 +11668,12 @@ func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DryRunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DryRunPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10899,19 +11682,14 @@ func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Details {
-		i--
-		if m.Details {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Sample != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Sample))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Pipeline != nil {
+	if m.CreatePipelineRequest != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatePipelineRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10924,7 +11702,7 @@ func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *DatumPreview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10934,12 +11712,12 @@ func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumPreview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DatumPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10948,16 +11726,9 @@ func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.JqFilter) > 0 {
-		i -= len(m.JqFilter)
-		copy(dAtA[i:], m.JqFilter)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Details {
+	if m.Skipped {
 		i--
-		if m.Details {
+		if m.Skipped {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -10965,27 +11736,29 @@ func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.History != 0 {
-		i = encodeVarintPps(dAtA, i, uint64(m.History))
+	if m.SizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Pipeline != nil {
-		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *DryRunPipelineResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10995,12 +11768,12 @@ func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DryRunPipelineResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DryRunPipelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11009,8 +11782,179 @@ func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.KeepRepo {
-		i--
+	if len(m.Sample) > 0 {
+		for iNdEx := len(m.Sample) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sample[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxDatumBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxDatumBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Skipped != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Skipped))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Datums != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Datums))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JqFilter) > 0 {
+		i -= len(m.JqFilter)
+		copy(dAtA[i:], m.JqFilter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JqFilter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Details {
+		i--
+		if m.Details {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.History != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.History))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeepRepo {
+		i--
 		if m.KeepRepo {
 			dAtA[i] = 1
 		} else {
@@ -11996,6 +12940,61 @@ func (m *CronInput) Size() (n int) {
 	return n
 }
 
+func (m *SQLInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.FileFormat != nil {
+		l = m.FileFormat.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.KeyColumn)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Shards != 0 {
+		n += 1 + sovPps(uint64(m.Shards))
+	}
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12034,6 +13033,10 @@ func (m *Input) Size() (n int) {
 		l = m.Cron.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12724,6 +13727,17 @@ func (m *PipelineInfo_Details) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DatumCache {
+		n += 3
+	}
+	if m.TaskSchedulingSpec != nil {
+		l = m.TaskSchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumCheckpointInterval != nil {
+		l = m.DatumCheckpointInterval.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13152,6 +14166,24 @@ func (m *SchedulingSpec) Size() (n int) {
 	return n
 }
 
+func (m *TaskSchedulingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovPps(uint64(m.Priority))
+	}
+	if m.Weight != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CreatePipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13265,24 +14297,35 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DatumCache {
+		n += 3
+	}
+	if m.TaskSchedulingSpec != nil {
+		l = m.TaskSchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumCheckpointInterval != nil {
+		l = m.DatumCheckpointInterval.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InspectPipelineRequest) Size() (n int) {
+func (m *DryRunPipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.CreatePipelineRequest != nil {
+		l = m.CreatePipelineRequest.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Details {
-		n += 2
+	if m.Sample != 0 {
+		n += 1 + sovPps(uint64(m.Sample))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13290,18 +14333,91 @@ func (m *InspectPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *ListPipelineRequest) Size() (n int) {
+func (m *DatumPreview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.History != 0 {
-		n += 1 + sovPps(uint64(m.History))
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.Skipped {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DryRunPipelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datums != 0 {
+		n += 1 + sovPps(uint64(m.Datums))
+	}
+	if m.Skipped != 0 {
+		n += 1 + sovPps(uint64(m.Skipped))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovPps(uint64(m.TotalBytes))
+	}
+	if m.MaxDatumBytes != 0 {
+		n += 1 + sovPps(uint64(m.MaxDatumBytes))
+	}
+	if len(m.Sample) > 0 {
+		for _, e := range m.Sample {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Details {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.History != 0 {
+		n += 1 + sovPps(uint64(m.History))
 	}
 	if m.Details {
 		n += 2
@@ -15930,7 +17046,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *SQLInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15953,17 +17069,17 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15973,33 +17089,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
-			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16009,31 +17121,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16043,31 +17153,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = append(m.Group, &Input{})
-			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16077,29 +17185,27 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16126,16 +17232,18 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Secret == nil {
+				m.Secret = &pfs.SQLDatabaseEgress_Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16145,82 +17253,27 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16248,11 +17301,11 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileFormat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16279,16 +17332,16 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.FileFormat == nil {
+				m.FileFormat = &pfs.SQLDatabaseEgress_FileFormat{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FileFormat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyColumn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16316,13 +17369,13 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.KeyColumn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			var v int
+			m.Shards = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16332,25 +17385,60 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Shards |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -16360,7 +17448,7 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16383,17 +17471,17 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
 			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16403,67 +17491,33 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16473,29 +17527,31 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16505,80 +17561,97 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16605,18 +17678,18 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16626,23 +17699,27 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.SQL == nil {
+				m.SQL = &SQLInput{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16666,7 +17743,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *JobInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16689,17 +17766,17 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16709,50 +17786,27 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16779,18 +17833,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16800,33 +17854,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16836,31 +17886,68 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.Lazy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16870,24 +17957,11 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16910,7 +17984,7 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *InputFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16933,17 +18007,17 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16953,55 +18027,58 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17024,7 +18101,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *Datum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17047,15 +18124,15 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17082,54 +18159,18 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17139,66 +18180,24 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
-			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
-			}
-			m.DownloadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownloadBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
-			}
-			m.UploadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17221,7 +18220,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17244,15 +18243,15 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17279,16 +18278,35 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17315,16 +18333,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17351,16 +18369,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17387,18 +18405,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
-			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17408,27 +18424,23 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
-			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17452,7 +18464,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17475,17 +18487,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17495,92 +18507,55 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumStatus == nil {
-				m.DatumStatus = &DatumStatus{}
-			}
-			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17603,7 +18578,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumStatus) Unmarshal(dAtA []byte) error {
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17626,15 +18601,15 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17661,16 +18636,16 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17697,11 +18672,87 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17724,7 +18775,7 @@ func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17747,28 +18798,53 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17778,27 +18854,31 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17825,18 +18905,18 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17846,23 +18926,63 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17886,7 +19006,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17909,15 +19029,15 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17945,13 +19065,13 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			m.Number = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17961,11 +19081,60 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumStatus == nil {
+				m.DatumStatus = &DatumStatus{}
+			}
+			if err := m.DatumStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17988,7 +19157,7 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18011,15 +19180,15 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18046,16 +19215,16 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18082,8 +19251,8 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jobs = append(m.Jobs, &JobInfo{})
-			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18109,7 +19278,7 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18132,17 +19301,28 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18152,50 +19332,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
-			}
-			m.PipelineVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18222,18 +19379,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			m.Restart = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18243,16 +19400,80 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataProcessed = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18262,16 +19483,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.DataSkipped = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18281,71 +19515,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
-			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataFailed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.DataRecovered = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18372,37 +19600,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18412,27 +19621,80 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Jobs = append(m.Jobs, &JobInfo{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18459,18 +19721,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			var msglen int
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18480,31 +19742,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PipelineVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18531,18 +19776,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18552,84 +19797,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &JobInfo_Details{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
-			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18639,33 +19816,35 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var msglen int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18675,31 +19854,52 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18726,18 +19926,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18747,33 +19947,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18783,31 +19966,27 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18834,14 +20013,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18868,16 +20049,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18904,16 +20085,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18940,16 +20121,67 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.Details == nil {
+				m.Details = &JobInfo_Details{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18976,18 +20208,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18997,27 +20229,31 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19044,16 +20280,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19080,16 +20316,16 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19116,18 +20352,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
-			m.DatumTries = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19137,14 +20373,29 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19171,18 +20422,18 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19192,29 +20443,33 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19224,80 +20479,33 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19307,97 +20515,31 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19425,62 +20567,11 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19507,18 +20598,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19528,14 +20619,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19562,18 +20670,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var v int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19583,17 +20691,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19603,14 +20710,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
+			}
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19638,13 +20762,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			m.LastJobState = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19654,52 +20778,78 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Parallelism = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Parallelism |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19727,13 +20877,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19743,28 +20893,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= WorkerState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &PipelineInfo_Details{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -19787,7 +20920,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19810,17 +20943,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19830,31 +20963,78 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19881,16 +21061,35 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19917,18 +21116,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19938,33 +21137,17 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Stopped = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19974,31 +21157,14 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= PipelineState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20026,13 +21192,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
 			}
-			m.WorkersRequested = 0
+			m.LastJobState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20042,16 +21208,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersRequested |= int64(b&0x7F) << shift
+				m.LastJobState |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
 			}
-			m.WorkersAvailable = 0
+			m.Parallelism = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20061,14 +21227,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersAvailable |= int64(b&0x7F) << shift
+				m.Parallelism |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PipelineInfo_PipelineType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20096,11 +21281,11 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20127,16 +21312,67 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Details == nil {
+				m.Details = &PipelineInfo_Details{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20163,16 +21399,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20199,16 +21435,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20235,18 +21471,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20256,29 +21492,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20288,27 +21528,31 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20336,13 +21580,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.RecentError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
 			}
-			var msglen int
+			m.WorkersRequested = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20352,33 +21596,35 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WorkersRequested |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WorkersAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkersAvailable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 20:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20388,31 +21634,27 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20439,16 +21681,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20475,16 +21717,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20511,35 +21753,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
-			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20566,16 +21789,16 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20603,11 +21826,11 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20635,13 +21858,13 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20651,15 +21874,27 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3Out = bool(v != 0)
-		case 29:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20686,18 +21921,18 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20707,29 +21942,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 31:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
-			m.UnclaimedTasks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20739,16 +21978,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnclaimedTasks |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
+			}
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20758,29 +22014,33 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerRc = string(dAtA[iNdEx:postIndex])
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 33:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20790,66 +22050,50 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Autoscaling = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20876,16 +22120,1125 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
+			}
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3Out = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedTasks", wireType)
+			}
+			m.UnclaimedTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnclaimedTasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerRc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerRc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Autoscaling = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DatumCache = bool(v != 0)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSchedulingSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskSchedulingSpec == nil {
+				m.TaskSchedulingSpec = &TaskSchedulingSpec{}
+			}
+			if err := m.TaskSchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCheckpointInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumCheckpointInterval == nil {
+				m.DatumCheckpointInterval = &types.Duration{}
+			}
+			if err := m.DatumCheckpointInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobSet == nil {
+				m.JobSet = &JobSet{}
+			}
+			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			m.History = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.History |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Details = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
@@ -20903,7 +23256,7 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSet) Unmarshal(dAtA []byte) error {
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20926,17 +23279,17 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20946,23 +23299,27 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20986,7 +23343,7 @@ func (m *JobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21009,15 +23366,15 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21044,18 +23401,18 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobSet == nil {
-				m.JobSet = &JobSet{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.JobSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21065,32 +23422,24 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Details = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21113,7 +23462,7 @@ func (m *InspectJobSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21136,17 +23485,17 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21156,68 +23505,141 @@ func (m *ListJobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			}
+			m.Restart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			}
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			}
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21227,33 +23649,35 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var v int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21263,17 +23687,16 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21283,12 +23706,28 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21311,7 +23750,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21334,10 +23773,10 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21378,7 +23817,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21405,53 +23844,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
-			}
-			m.History = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.History |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Details = bool(v != 0)
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21479,62 +23881,11 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21561,16 +23912,75 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21587,61 +23997,10 @@ func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Details = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UseLokiBackend = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21668,10 +24027,10 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Since == nil {
+				m.Since = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21697,7 +24056,7 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21720,17 +24079,17 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21740,31 +24099,27 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21792,64 +24147,13 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21859,50 +24163,27 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21930,13 +24211,13 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			m.Restart = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21946,35 +24227,17 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Master = bool(v != 0)
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.DataSkipped = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21984,35 +24247,31 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataFailed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.DataRecovered = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22022,16 +24281,17 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			m.User = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			m.DataTotal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22041,16 +24301,33 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
+			}
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22060,27 +24337,23 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22104,7 +24377,7 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22127,49 +24400,13 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
@@ -22205,7 +24442,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
@@ -22237,7 +24474,58 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
@@ -22273,70 +24561,62 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Master = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			m.Follow = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Tail = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tail |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22346,15 +24626,31 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseLokiBackend = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22381,10 +24677,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &types.Duration{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22410,7 +24706,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
+func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22433,17 +24729,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var stringLen uint64
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22453,29 +24749,16 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22485,29 +24768,16 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
 			}
-			var stringLen uint64
+			m.PerWorker = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22517,29 +24787,67 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PerWorker |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22549,49 +24857,124 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.Master = bool(v != 0)
-		case 6:
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22601,87 +24984,80 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.User = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskSchedulingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskSchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskSchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22691,24 +25067,22 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Priority |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Weight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22731,7 +25105,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22754,15 +25128,15 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22789,18 +25163,18 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22810,78 +25184,67 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
+			}
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22908,67 +25271,16 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22995,18 +25307,38 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23016,84 +25348,29 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumSetSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
 			}
-			m.Number = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23103,16 +25380,17 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			m.S3Out = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23122,16 +25400,33 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerWorker", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.PerWorker = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
+			}
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23141,65 +25436,67 @@ func (m *DatumSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PerWorker |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23226,107 +25523,16 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23354,62 +25560,31 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Reprocess = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23436,16 +25611,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23472,16 +25647,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23508,16 +25683,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.DatumSetSpec == nil {
+				m.DatumSetSpec = &DatumSetSpec{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23544,16 +25719,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23580,36 +25755,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
-		case 7:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23637,13 +25792,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var v int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23653,15 +25808,14 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3Out = bool(v != 0)
-		case 9:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23688,18 +25842,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23709,31 +25863,59 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23760,16 +25942,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23796,16 +25978,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23833,11 +26015,11 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23854,10 +26036,30 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Reprocess = bool(v != 0)
-		case 17:
+			m.Autoscaling = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DatumCache = bool(v != 0)
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23884,16 +26086,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.TaskSchedulingSpec == nil {
+				m.TaskSchedulingSpec = &TaskSchedulingSpec{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskSchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCheckpointInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23920,16 +26122,67 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.DatumCheckpointInterval == nil {
+				m.DatumCheckpointInterval = &types.Duration{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumCheckpointInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumSetSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePipelineRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23956,18 +26209,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumSetSpec == nil {
-				m.DatumSetSpec = &DatumSetSpec{}
+			if m.CreatePipelineRequest == nil {
+				m.CreatePipelineRequest = &CreatePipelineRequest{}
 			}
-			if err := m.DatumSetSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatePipelineRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sample", wireType)
 			}
-			var msglen int
+			m.Sample = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23977,31 +26230,65 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sample |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 21:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24028,50 +26315,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			m.DatumTries = 0
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24081,16 +26334,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24100,33 +26353,68 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			m.Skipped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunPipelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunPipelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunPipelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			m.Datums = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24136,29 +26424,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Datums |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
 			}
-			var stringLen uint64
+			m.Skipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24168,29 +26443,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Skipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
 			}
-			var msglen int
+			m.TotalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24200,33 +26462,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDatumBytes", wireType)
 			}
-			var msglen int
+			m.MaxDatumBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24236,33 +26481,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDatumBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 29:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sample", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24272,44 +26500,26 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 30:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Sample = append(m.Sample, &DatumPreview{})
+			if err := m.Sample[len(m.Sample)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Autoscaling = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -135,11 +135,26 @@ func InputBranches(input *Input) []*pfs.Branch {
 				Name: "master",
 			})
 		}
+		if input.SQL != nil {
+			result = append(result, SQLInputBranch(input.SQL))
+		}
 		return nil
 	})
 	return result
 }
 
+// SQLInputBranch returns the branch of the pipeline's sql repo which holds
+// the results of a SQL input's query.
+func SQLInputBranch(input *SQLInput) *pfs.Branch {
+	return &pfs.Branch{
+		Repo: &pfs.Repo{
+			Name: input.Repo,
+			Type: pfs.SQLRepoType,
+		},
+		Name: input.Name,
+	}
+}
+
 // JobStateFromName attempts to interpret a string as a
 // JobState, accepting either the enum names or the pretty printed state
 // names
@@ -2,13 +2,27 @@ package cmds
 
 import (
 	"fmt"
+	"io"
+	"os"
 
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 
 	"github.com/spf13/cobra"
 )
 
+const (
+	storageStatsHeader     = "LOGICAL\tPHYSICAL\tDEDUP RATIO\tCHUNKS\tFREEABLE\t\n"
+	repoStorageHeader      = "REPO\t" + storageStatsHeader
+	branchStorageHeader    = "BRANCH\t" + storageStatsHeader
+	commitStorageHeader    = "COMMIT\t" + storageStatsHeader
+	commitSetStorageHeader = "COMMITSET\t" + storageStatsHeader
+)
+
 // Cmds returns a slice containing admin commands.
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
@@ -32,5 +46,93 @@ func Cmds() []*cobra.Command {
 	}
 	commands = append(commands, cmdutil.CreateAlias(inspectCluster, "inspect cluster"))
 
+	var raw bool
+	var output string
+	var details bool
+	inspectStorage := &cobra.Command{
+		Use:   "{{alias}} [<repo>...]",
+		Short: "Returns storage usage and deduplication statistics.",
+		Long: "Returns storage usage and deduplication statistics. Logical bytes are the " +
+			"sum of the sizes of the commits, physical bytes are the size of the distinct " +
+			"chunks they reference before compression, and freeable bytes are what deleting " +
+			"the repo (or dropping the commit set) would free.",
+		Run: cmdutil.Run(func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			storageInfo, err := c.InspectStorage(details, args...)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(storageInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			printStorageInfo(os.Stdout, storageInfo)
+			writer := tabwriter.NewWriter(os.Stdout, repoStorageHeader)
+			for _, repoInfo := range storageInfo.Repos {
+				fmt.Fprintf(writer, "%s\t", repoInfo.Repo)
+				printStorageStats(writer, repoInfo.Stats)
+			}
+			if err := writer.Flush(); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if !details {
+				return nil
+			}
+			for _, repoInfo := range storageInfo.Repos {
+				fmt.Printf("\nRepo %s:\n", repoInfo.Repo)
+				writer = tabwriter.NewWriter(os.Stdout, branchStorageHeader)
+				for _, branchInfo := range repoInfo.Branches {
+					fmt.Fprintf(writer, "%s\t", branchInfo.Branch.Name)
+					printStorageStats(writer, branchInfo.Stats)
+				}
+				if err := writer.Flush(); err != nil {
+					return errors.EnsureStack(err)
+				}
+				fmt.Println()
+				writer = tabwriter.NewWriter(os.Stdout, commitStorageHeader)
+				for _, commitInfo := range repoInfo.Commits {
+					fmt.Fprintf(writer, "%s\t", commitInfo.Commit.ID)
+					printStorageStats(writer, commitInfo.Stats)
+				}
+				if err := writer.Flush(); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			fmt.Println()
+			writer = tabwriter.NewWriter(os.Stdout, commitSetStorageHeader)
+			for _, commitSetInfo := range storageInfo.CommitSets {
+				fmt.Fprintf(writer, "%s\t", commitSetInfo.CommitSet.ID)
+				printStorageStats(writer, commitSetInfo.Stats)
+			}
+			return errors.EnsureStack(writer.Flush())
+		}),
+	}
+	inspectStorage.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	inspectStorage.Flags().BoolVar(&details, "details", false, "include a breakdown for every branch, commit and commit set")
+	commands = append(commands, cmdutil.CreateAlias(inspectStorage, "inspect storage"))
+
 	return commands
 }
+
+func printStorageInfo(w io.Writer, storageInfo *admin.StorageInfo) {
+	total := storageInfo.Total
+	fmt.Fprintf(w, "Logical size: %s\n", units.BytesSize(float64(total.LogicalBytes)))
+	fmt.Fprintf(w, "Physical size: %s\n", units.BytesSize(float64(total.PhysicalBytes)))
+	fmt.Fprintf(w, "Dedup ratio: %.2f\n", total.DedupRatio)
+	fmt.Fprintf(w, "Chunks: %d\n", total.Chunks)
+	fmt.Fprintf(w, "Chunks shared across repos: %d (%s)\n\n", storageInfo.SharedChunks, units.BytesSize(float64(storageInfo.SharedBytes)))
+}
+
+func printStorageStats(w io.Writer, stats *admin.StorageStats) {
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.LogicalBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.PhysicalBytes)))
+	fmt.Fprintf(w, "%.2f\t", stats.DedupRatio)
+	fmt.Fprintf(w, "%d\t", stats.Chunks)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(stats.FreeableBytes)))
+	fmt.Fprintln(w)
+}
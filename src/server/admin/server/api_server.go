@@ -3,7 +3,9 @@ package server
 import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/sirupsen/logrus"
 
 	"golang.org/x/net/context"
@@ -14,13 +16,17 @@ type Env struct {
 	ClusterID string
 	Config    *serviceenv.Configuration
 	Logger    *logrus.Logger
+
+	// circular dependency
+	GetPfsServer func() pfs.APIServer
 }
 
 func EnvFromServiceEnv(senv serviceenv.ServiceEnv) Env {
 	return Env{
-		ClusterID: senv.ClusterID(),
-		Config:    senv.Config(),
-		Logger:    senv.Logger(),
+		ClusterID:    senv.ClusterID(),
+		Config:       senv.Config(),
+		Logger:       senv.Logger(),
+		GetPfsServer: senv.PfsServer,
 	}
 }
 
@@ -36,13 +42,23 @@ func NewAPIServer(env Env) APIServer {
 			ID:           env.ClusterID,
 			DeploymentID: env.Config.DeploymentID,
 		},
+		getPfsServer: env.GetPfsServer,
 	}
 }
 
 type apiServer struct {
-	clusterInfo *admin.ClusterInfo
+	clusterInfo  *admin.ClusterInfo
+	getPfsServer func() pfs.APIServer
 }
 
 func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (*admin.ClusterInfo, error) {
 	return a.clusterInfo, nil
 }
+
+func (a *apiServer) InspectStorage(ctx context.Context, request *admin.InspectStorageRequest) (*admin.StorageInfo, error) {
+	if a.getPfsServer == nil || a.getPfsServer() == nil {
+		return nil, errors.Errorf("storage cannot be inspected in this pachd mode")
+	}
+	info, err := a.getPfsServer().InspectStorage(ctx, request)
+	return info, errors.EnsureStack(err)
+}
@@ -45,6 +45,7 @@ func init() {
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_BRANCH,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
@@ -64,6 +65,8 @@ func init() {
 			auth.Permission_REPO_DELETE_COMMIT,
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
 		}),
 	})
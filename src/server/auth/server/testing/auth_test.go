@@ -1148,6 +1148,9 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
+			auth.Permission_REPO_LIST_TAG,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -1164,6 +1167,9 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
+			auth.Permission_REPO_LIST_TAG,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -1176,6 +1182,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_LIST_COMMIT,
 			auth.Permission_REPO_LIST_BRANCH,
+			auth.Permission_REPO_LIST_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -720,6 +720,36 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(squashDocs, "squash"))
 
+	mergeDocs := &cobra.Command{
+		Short: "Merge an existing Pachyderm resource into another.",
+		Long:  "Merge an existing Pachyderm resource into another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	revertDocs := &cobra.Command{
+		Short: "Revert an existing Pachyderm resource.",
+		Long:  "Revert an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(revertDocs, "revert"))
+
+	enforceDocs := &cobra.Command{
+		Short: "Enforce the rules of a Pachyderm resource.",
+		Long:  "Enforce the rules of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(enforceDocs, "enforce"))
+
+	exportDocs := &cobra.Command{
+		Short: "Export a Pachyderm resource to an archive.",
+		Long:  "Export a Pachyderm resource to an archive.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(exportDocs, "export"))
+
+	importDocs := &cobra.Command{
+		Short: "Import a Pachyderm resource from an archive.",
+		Long:  "Import a Pachyderm resource from an archive.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(importDocs, "import"))
+
 	createDocs := &cobra.Command{
 		Short: "Create a new instance of a Pachyderm resource.",
 		Long:  "Create a new instance of a Pachyderm resource.",
@@ -798,6 +828,12 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(diffDocs, "diff"))
 
+	presignDocs := &cobra.Command{
+		Short: "Create a time-limited URL for a Pachyderm resource.",
+		Long:  "Create a time-limited URL for a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(presignDocs, "presign"))
+
 	stopDocs := &cobra.Command{
 		Short: "Cancel an ongoing task.",
 		Long:  "Cancel an ongoing task.",
@@ -872,6 +908,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"object",
 			"pipeline",
 			"repo",
+			"retention",
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
@@ -880,14 +917,20 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"delete",
 			"diff",
 			"edit",
+			"enforce",
+			"export",
 			"finish",
 			"wait",
 			"get",
 			"glob",
+			"import",
 			"inspect",
 			"list",
+			"merge",
+			"presign",
 			"put",
 			"restart",
+			"revert",
 			"squash",
 			"start",
 			"stop",
@@ -981,7 +981,7 @@ func doFullMode(config interface{}) (retErr error) {
 		return internalServer.Wait()
 	})
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient)
+		router := s3.Router(s3.NewMasterDriver([]byte(env.Config().S3GatewayPresignKey)), env.GetPachClient)
 		server := s3.Server(env.Config().S3GatewayPort, router)
 		certPath, keyPath, err := tls.GetCertPaths()
 		if err != nil {
@@ -1320,7 +1320,7 @@ func doPausedMode(config interface{}) (retErr error) {
 		return internalServer.Wait()
 	})
 	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-		router := s3.Router(s3.NewMasterDriver(), env.GetPachClient)
+		router := s3.Router(s3.NewMasterDriver([]byte(env.Config().S3GatewayPresignKey)), env.GetPachClient)
 		server := s3.Server(env.Config().S3GatewayPort, router)
 		certPath, keyPath, err := tls.GetCertPaths()
 		if err != nil {
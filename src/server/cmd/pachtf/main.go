@@ -1,20 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/secrets"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transforms"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
 const (
-	pfs    = "/pfs"
+	pfsDir = "/pfs"
 	pfsOut = "/pfs/out"
 )
 
@@ -31,7 +35,7 @@ func main() {
 	if !ok {
 		log.Fatalf("unrecognized transform name %q", transformName)
 	}
-	ents, err := os.ReadDir(filepath.FromSlash(pfs))
+	ents, err := os.ReadDir(filepath.FromSlash(pfsDir))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -65,6 +69,20 @@ func sqlIngest(ctx context.Context, log *logrus.Logger, args []string) error {
 			return errors.EnsureStack(err)
 		}
 	}
+	var watermarkColumn, tombstoneColumn, watermark string
+	if len(args) > 3 {
+		watermarkColumn = args[3]
+	}
+	if len(args) > 4 {
+		tombstoneColumn = args[4]
+	}
+	if watermarkColumn != "" {
+		var err error
+		watermark, err = previousWatermark(ctx)
+		if err != nil {
+			return err
+		}
+	}
 	password, ok := os.LookupEnv(passwordEnvar)
 	if !ok {
 		return errors.Errorf("must set %v", passwordEnvar)
@@ -74,7 +92,7 @@ func sqlIngest(ctx context.Context, log *logrus.Logger, args []string) error {
 		return err
 	}
 	log.Infof("DB protocol=%v host=%v port=%v database=%v\n", u.Protocol, u.Host, u.Port, u.Database)
-	inputDir, err := filepath.EvalSymlinks(filepath.FromSlash(pfs + "/in"))
+	inputDir, err := filepath.EvalSymlinks(filepath.FromSlash(pfsDir + "/in"))
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -92,15 +110,80 @@ func sqlIngest(ctx context.Context, log *logrus.Logger, args []string) error {
 		Password:  secrets.Secret(password),
 		Format:    formatName,
 		HasHeader: hasHeader,
+
+		WatermarkColumn: watermarkColumn,
+		Watermark:       watermark,
+		TombstoneColumn: tombstoneColumn,
 	})
 }
 
+// previousWatermark returns the latest watermark in the last successful
+// ancestor of the output commit, or "" if there isn't one. The ingest of each
+// tick is a single datum, so that it always follows the previous tick's.
+func previousWatermark(ctx context.Context) (string, error) {
+	pipeline, commitID := os.Getenv(client.PPSPipelineNameEnv), os.Getenv(client.OutputCommitIDEnv)
+	if pipeline == "" || commitID == "" {
+		return "", errors.Errorf("must set %v and %v", client.PPSPipelineNameEnv, client.OutputCommitIDEnv)
+	}
+	c, err := client.NewInWorker()
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+	c = c.WithCtx(ctx)
+	commitInfo, err := c.InspectCommit(pipeline, "", commitID)
+	if err != nil {
+		return "", err
+	}
+	// The output commits of failed jobs don't have the rows of their tick, so
+	// the next tick must start from the watermark before them.
+	for {
+		if commitInfo.ParentCommit == nil {
+			return "", nil
+		}
+		if commitInfo, err = c.InspectCommit(pipeline, "", commitInfo.ParentCommit.ID); err != nil {
+			return "", err
+		}
+		if commitInfo.Error == "" {
+			break
+		}
+	}
+	fileInfos, err := c.GlobFileAll(commitInfo.Commit, path.Join("/", transforms.WatermarksDir, "*"))
+	if err != nil {
+		return "", err
+	}
+	// The queries are named after the cron tick, so the last file holds the
+	// latest watermark.
+	var latest string
+	for _, fi := range fileInfos {
+		if fi.FileType == pfs.FileType_FILE && fi.File.Path > latest {
+			latest = fi.File.Path
+		}
+	}
+	if latest == "" {
+		return "", nil
+	}
+	buf := &bytes.Buffer{}
+	if err := c.GetFile(commitInfo.Commit, latest, buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func sqlGenQueries(ctx context.Context, log *logrus.Logger, args []string) error {
 	if len(args) < 1 {
 		return errors.Errorf("must provide query")
 	}
 	query := args[0]
-	inputDir, err := filepath.EvalSymlinks(filepath.FromSlash(pfs + "/in"))
+	var incremental bool
+	if len(args) > 1 {
+		var err error
+		incremental, err = strconv.ParseBool(args[1])
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	inputDir, err := filepath.EvalSymlinks(filepath.FromSlash(pfsDir + "/in"))
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -113,5 +196,7 @@ func sqlGenQueries(ctx context.Context, log *logrus.Logger, args []string) error
 		InputDir:  inputDir,
 		OutputDir: outputDir,
 		Query:     query,
+
+		Incremental: incremental,
 	})
 }
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"net"
 	"os"
 	"path"
+	"strconv"
 	"time"
 
 	debugclient "github.com/pachyderm/pachyderm/v2/src/debug"
@@ -59,7 +61,7 @@ func do(config interface{}) error {
 
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, "/")
+	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, env.Config().PPSWorkerRoot)
 	if err != nil {
 		return err
 	}
@@ -74,8 +76,13 @@ func do(config interface{}) error {
 	versionpb.RegisterAPIServer(server.Server, version.NewAPIServer(version.Version, version.APIServerOptions{}))
 	debugclient.RegisterDebugServer(server.Server, debugserver.NewDebugServer(env, env.Config().PodName, pachClient, env.GetDBClient()))
 
-	// Put our IP address into etcd, so pachd can discover us
-	key := path.Join(env.Config().PPSEtcdPrefix, workerserver.WorkerEtcdPrefix, workerRcName, env.Config().PPSWorkerIP)
+	// Put our IP address into etcd, so pachd can discover us. Local workers
+	// share an IP address, so they put their port too.
+	address := env.Config().PPSWorkerIP
+	if env.Config().PPSInfraDriver == serviceenv.InfraDriverLocal {
+		address = net.JoinHostPort(address, strconv.Itoa(int(env.Config().PPSWorkerPort)))
+	}
+	key := path.Join(env.Config().PPSEtcdPrefix, workerserver.WorkerEtcdPrefix, workerRcName, address)
 
 	// Prepare to write "key" into etcd by creating lease -- if worker dies, our
 	// IP will be removed from etcd
@@ -119,7 +119,11 @@ func (s *debugServer) handleRedirect(
 						}
 						return collectDebugStream(tw, r)
 					}
-					pod, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).Get(ctx, f.Worker.Pod, metav1.GetOptions{})
+					kubeClient, err := serviceenv.KubeClient(s.env)
+					if err != nil {
+						return errors.Wrapf(err, "collecting worker %s", f.Worker.Pod)
+					}
+					pod, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).Get(ctx, f.Worker.Pod, metav1.GetOptions{})
 					if err != nil {
 						return errors.EnsureStack(err)
 					}
@@ -143,7 +147,9 @@ func (s *debugServer) handleRedirect(
 					return err
 				}
 			}
-			if wantAppLogs {
+			// There are no other apps when pipelines run locally, without
+			// kubernetes.
+			if wantAppLogs && s.env.Config().PPSInfraDriver != serviceenv.InfraDriverLocal {
 				// All other pachyderm apps (console, pg-bouncer, etcd, etc.).
 				if err := s.appLogs(ctx, tw); err != nil {
 					return err
@@ -160,7 +166,11 @@ func (s *debugServer) handleRedirect(
 }
 
 func (s *debugServer) appLogs(ctx context.Context, tw *tar.Writer) error {
-	pods, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).List(ctx, metav1.ListOptions{
+	kubeClient, err := serviceenv.KubeClient(s.env)
+	if err != nil {
+		return err
+	}
+	pods, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).List(ctx, metav1.ListOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ListOptions",
 			APIVersion: "v1",
@@ -243,7 +253,11 @@ func (s *debugServer) forEachWorker(ctx context.Context, pipelineInfo *pps.Pipel
 }
 
 func (s *debugServer) getWorkerPods(ctx context.Context, pipelineInfo *pps.PipelineInfo) ([]v1.Pod, error) {
-	podList, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).List(
+	kubeClient, err := serviceenv.KubeClient(s.env)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).List(
 		ctx,
 		metav1.ListOptions{
 			TypeMeta: metav1.TypeMeta{
@@ -615,8 +629,12 @@ func (s *debugServer) collectPachdVersion(tw *tar.Writer, pachClient *client.API
 
 func (s *debugServer) collectDescribe(tw *tar.Writer, pod string, prefix ...string) error {
 	return collectDebugFile(tw, "describe", "txt", func(w io.Writer) error {
+		kubeClient, err := serviceenv.KubeClient(s.env)
+		if err != nil {
+			return err
+		}
 		pd := describe.PodDescriber{
-			Interface: s.env.GetKubeClient(),
+			Interface: kubeClient,
 		}
 		output, err := pd.Describe(s.env.Config().Namespace, pod, describe.DescriberSettings{ShowEvents: true})
 		if err != nil {
@@ -629,7 +647,11 @@ func (s *debugServer) collectDescribe(tw *tar.Writer, pod string, prefix ...stri
 
 func (s *debugServer) collectLogs(ctx context.Context, tw *tar.Writer, pod, container string, prefix ...string) error {
 	if err := collectDebugFile(tw, "logs", "txt", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container}).Stream(ctx)
+		kubeClient, err := serviceenv.KubeClient(s.env)
+		if err != nil {
+			return err
+		}
+		stream, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container}).Stream(ctx)
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
@@ -644,7 +666,11 @@ func (s *debugServer) collectLogs(ctx context.Context, tw *tar.Writer, pod, cont
 		return err
 	}
 	return collectDebugFile(tw, "logs-previous", "txt", func(w io.Writer) (retErr error) {
-		stream, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true}).Stream(ctx)
+		kubeClient, err := serviceenv.KubeClient(s.env)
+		if err != nil {
+			return err
+		}
+		stream, err := kubeClient.CoreV1().Pods(s.env.Config().Namespace).GetLogs(pod, &v1.PodLogOptions{Container: container, Previous: true}).Stream(ctx)
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
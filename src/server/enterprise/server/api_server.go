@@ -378,10 +378,11 @@ func (a *apiServer) Pause(ctx context.Context, req *ec.PauseRequest) (resp *ec.P
 // There is a special case in main to handle the case where there is no
 // ConfigMap and the '$(MODE)' reference is verbatim.
 func (a *apiServer) rollPachd(ctx context.Context, paused bool) error {
-	var (
-		kc        *kubernetes.Clientset = a.env.getKubeClient()
-		namespace                       = a.env.namespace
-	)
+	kc, err := a.env.getKubeClient()
+	if err != nil {
+		return errors.Wrap(err, "could not roll pachd")
+	}
+	namespace := a.env.namespace
 	cc := kc.CoreV1().ConfigMaps(namespace)
 	c, err := cc.Get(ctx, "pachd-config", metav1.GetOptions{})
 	if k8serrors.IsNotFound(err) {
@@ -512,7 +513,10 @@ func (a *apiServer) Unpause(ctx context.Context, req *ec.UnpauseRequest) (resp *
 // after the ConfigMap was updated then it has taken effect and the cluster is
 // in the indicated state.
 func (a *apiServer) PauseStatus(ctx context.Context, req *ec.PauseStatusRequest) (resp *ec.PauseStatusResponse, retErr error) {
-	kc := a.env.getKubeClient()
+	kc, err := a.env.getKubeClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pause status")
+	}
 	cc := kc.CoreV1().ConfigMaps(a.env.namespace)
 	c, err := cc.Get(ctx, "pachd-config", metav1.GetOptions{})
 	if k8serrors.IsNotFound(err) {
@@ -26,7 +26,7 @@ type Env struct {
 
 	AuthServer    auth.APIServer
 	GetPachClient func(context.Context) *client.APIClient
-	getKubeClient func() *kube.Clientset
+	getKubeClient func() (*kube.Clientset, error)
 
 	BackgroundContext context.Context
 	namespace         string
@@ -70,7 +70,9 @@ func EnvFromServiceEnv(senv serviceenv.ServiceEnv, etcdPrefix string, txEnv *txn
 
 		AuthServer:    senv.AuthServer(),
 		GetPachClient: senv.GetPachClient,
-		getKubeClient: senv.GetKubeClient,
+		getKubeClient: func() (*kube.Clientset, error) {
+			return serviceenv.KubeClient(senv)
+		},
 
 		BackgroundContext: senv.Context(),
 		namespace:         senv.Config().Namespace,
@@ -135,5 +137,9 @@ func DeleteEnterpriseConfigFromEtcd(ctx context.Context, etcd *clientv3.Client)
 
 // StopWorkers stops all workers
 func (env Env) StopWorkers(ctx context.Context) error {
-	return scaleDownWorkers(ctx, env.getKubeClient(), env.namespace)
+	kc, err := env.getKubeClient()
+	if err != nil {
+		return errors.Wrap(err, "could not stop workers")
+	}
+	return scaleDownWorkers(ctx, kc, env.namespace)
 }
@@ -9546,6 +9546,79 @@ func TestListDatum(t *testing.T) {
 	require.Equal(t, 25, len(dis))
 }
 
+func TestDryRunPipeline(t *testing.T) {
+	t.Parallel()
+	c, _ := minikubetestenv.AcquireCluster(t)
+
+	dataRepo := tu.UniqueString("TestDryRunPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	numFiles := 5
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), fmt.Sprintf("file-%d", i), strings.NewReader(strings.Repeat("a", i+1))))
+	}
+
+	pipeline := tu.UniqueString("TestDryRunPipeline")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline(pipeline),
+		Transform: &pps.Transform{
+			Image: tu.DefaultTransformImage,
+			Cmd:   []string{"bash"},
+			Stdin: []string{
+				fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+			},
+		},
+		Input: client.NewPFSInput(dataRepo, "/*"),
+	}
+	resp, err := c.DryRunPipeline(request, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(numFiles), resp.Datums)
+	require.Equal(t, int64(0), resp.Skipped)
+	require.Equal(t, int64(15), resp.TotalBytes)
+	require.Equal(t, int64(5), resp.MaxDatumBytes)
+	require.Equal(t, 2, len(resp.Sample))
+	require.Equal(t, 1, len(resp.Sample[0].Data))
+	require.Equal(t, resp.Sample[0].Data[0].SizeBytes, resp.Sample[0].SizeBytes)
+	// the dry run doesn't create the pipeline
+	_, err = c.InspectPipeline(pipeline, false)
+	require.YesError(t, err)
+
+	_, err = c.PpsAPIClient.CreatePipeline(c.Ctx(), request)
+	require.NoError(t, err)
+	commitInfo, err := c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, "", commitInfo.Error)
+
+	// an update with the same spec would skip every datum
+	request.Update = true
+	resp, err = c.DryRunPipeline(request, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(numFiles), resp.Datums)
+	require.Equal(t, int64(numFiles), resp.Skipped)
+	require.Equal(t, numFiles, len(resp.Sample))
+	for _, preview := range resp.Sample {
+		require.True(t, preview.Skipped)
+	}
+	request.Reprocess = true
+	resp, err = c.DryRunPipeline(request, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resp.Skipped)
+
+	// creating a pipeline which exists is an error, as with CreatePipeline
+	request.Update = false
+	_, err = c.DryRunPipeline(request, 0)
+	require.YesError(t, err)
+
+	// cron inputs have no datums until the pipeline creates them
+	request.Pipeline = client.NewPipeline(tu.UniqueString("TestDryRunPipeline_cron"))
+	request.Input = client.NewCrossInput(
+		client.NewPFSInput(dataRepo, "/*"),
+		client.NewCronInput("time", "@every 10s"),
+	)
+	_, err = c.DryRunPipeline(request, 0)
+	require.YesError(t, err)
+	require.Matches(t, "not supported for pipelines with cron or SQL inputs", err.Error())
+}
+
 func TestDebug(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/proto"
@@ -44,10 +45,13 @@ const (
 	DefaultParallelism = 10
 
 	// Plural variables are used below for user convenience.
-	branches = "branches"
-	commits  = "commits"
-	files    = "files"
-	repos    = "repos"
+	branches     = "branches"
+	commits      = "commits"
+	files        = "files"
+	repos        = "repos"
+	tags         = "tags"
+	retentions   = "retentions"
+	replications = "replications"
 )
 
 // Cmds returns a slice containing pfs commands.
@@ -215,11 +219,11 @@ or type (e.g. csv, binary, images, etc).`,
 	listRepo.Flags().StringVar(&repoType, "type", "", "only include repos of the given type")
 	commands = append(commands, cmdutil.CreateAliases(listRepo, "list repo", repos))
 
-	var force bool
+	var force, shred bool
 	deleteRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Delete a repo.",
-		Long:  "Delete a repo.",
+		Long:  "Delete a repo. If the repo has its own encryption key, the key is destroyed, which makes its data unreadable even before it is garbage collected. With --shred, the delete fails if the repo does not have its own key.",
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -229,6 +233,7 @@ or type (e.g. csv, binary, images, etc).`,
 
 			request := &pfs.DeleteRepoRequest{
 				Force: force,
+				Shred: shred,
 			}
 			if len(args) > 0 {
 				if all {
@@ -237,6 +242,8 @@ or type (e.g. csv, binary, images, etc).`,
 				request.Repo = cmdutil.ParseRepo(args[0])
 			} else if !all {
 				return errors.Errorf("either a repo name or the --all flag needs to be provided")
+			} else if shred {
+				return errors.Errorf("cannot use the --shred flag with the --all flag")
 			}
 
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
@@ -252,9 +259,88 @@ or type (e.g. csv, binary, images, etc).`,
 	}
 	deleteRepo.Flags().BoolVarP(&force, "force", "f", false, "remove the repo regardless of errors; use with care")
 	deleteRepo.Flags().BoolVar(&all, "all", false, "remove all repos")
+	deleteRepo.Flags().BoolVar(&shred, "shred", false, "fail unless the repo's own encryption key is destroyed, which makes its data unreadable")
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAliases(deleteRepo, "delete repo", repos))
 
+	var referencesOnly bool
+	var exportPath string
+	exportRepo := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Export a repo to an archive.",
+		Long: `Export the finished commits, branches and data of a repo to an archive,
+which 'pachctl import repo' creates the repo from in this or another cluster.
+The imported files have the same hashes. The archive holds the keys needed to
+decrypt the repo's data, so it must be protected like the data.
+
+With --references-only, the archive refers to the repo's data in object storage
+rather than holding it, so it can only be imported by a cluster which shares
+the bucket. The data stays owned by this cluster, so it must keep the repo for
+as long as the imported repo is used.`,
+		Example: `
+# export repo "foo" to foo.tar.gz
+$ {{alias}} foo -o foo.tar.gz
+
+# export references to the data of repo "foo"
+$ {{alias}} foo -o foo.tar.gz --references-only`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if exportPath == "" {
+				return c.ExportRepo(args[0], referencesOnly, os.Stdout)
+			}
+			f, err := os.Create(exportPath)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			defer func() {
+				if err := f.Close(); retErr == nil {
+					retErr = errors.EnsureStack(err)
+				}
+			}()
+			return c.ExportRepo(args[0], referencesOnly, f)
+		}),
+	}
+	exportRepo.Flags().StringVarP(&exportPath, "output", "o", "", "The path to write the archive to, instead of stdout.")
+	exportRepo.Flags().BoolVar(&referencesOnly, "references-only", false, "Refer to the repo's data in object storage rather than copying it into the archive.")
+	shell.RegisterCompletionFunc(exportRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(exportRepo, "export repo", repos))
+
+	var importPath string
+	importRepo := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Import a repo from an archive.",
+		Long:  "Create a repo from an archive written by 'pachctl export repo', with the same commits, branches and data. The commits keep their IDs, but not their provenance.",
+		Example: `
+# create repo "foo" from foo.tar.gz
+$ {{alias}} foo -f foo.tar.gz`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if importPath == "-" {
+				return c.ImportRepo(args[0], os.Stdin)
+			}
+			f, err := os.Open(importPath)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			defer func() {
+				if err := f.Close(); retErr == nil {
+					retErr = errors.EnsureStack(err)
+				}
+			}()
+			return c.ImportRepo(args[0], f)
+		}),
+	}
+	importRepo.Flags().StringVarP(&importPath, "file", "f", "-", "The archive to import, or - for stdin.")
+	commands = append(commands, cmdutil.CreateAliases(importRepo, "import repo", repos))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -272,6 +358,7 @@ Commits can be created with another commit as a parent.`,
 	commands = append(commands, cmdutil.CreateDocsAliases(commitDocs, "commit", " commit$", commits))
 
 	var parent string
+	var expectedHead string
 	startCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch>",
 		Short: "Start a new commit.",
@@ -312,9 +399,10 @@ $ {{alias}} test@fork -p XXX`,
 				commit, err = c.PfsAPIClient.StartCommit(
 					c.Ctx(),
 					&pfs.StartCommitRequest{
-						Branch:      branch,
-						Parent:      parentCommit,
-						Description: description,
+						Branch:       branch,
+						Parent:       parentCommit,
+						Description:  description,
+						ExpectedHead: expectedHead,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -329,6 +417,7 @@ $ {{alias}} test@fork -p XXX`,
 	startCommit.MarkFlagCustom("parent", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	startCommit.Flags().StringVar(&expectedHead, "expected-head", "", "Only start the commit if the branch's head is currently this commit ID.")
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(startCommit, "start commit", commits))
 
@@ -351,9 +440,10 @@ $ {{alias}} test@fork -p XXX`,
 				_, err = c.PfsAPIClient.FinishCommit(
 					c.Ctx(),
 					&pfs.FinishCommitRequest{
-						Commit:      commit,
-						Description: description,
-						Force:       force,
+						Commit:       commit,
+						Description:  description,
+						Force:        force,
+						ExpectedHead: expectedHead,
 					},
 				)
 				return errors.EnsureStack(err)
@@ -364,6 +454,7 @@ $ {{alias}} test@fork -p XXX`,
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 	finishCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
 	finishCommit.Flags().BoolVarP(&force, "force", "f", false, "finish the commit even if it has provenance, which could break jobs; prefer 'stop job'")
+	finishCommit.Flags().StringVar(&expectedHead, "expected-head", "", "Only finish the commit if its branch's head is currently this commit ID.")
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(finishCommit, "finish commit", commits))
 
@@ -512,11 +603,15 @@ $ {{alias}} foo@master --from XXX`,
 						})
 					}
 
+					tags, err := commitTags(c, "")
+					if err != nil {
+						return err
+					}
 					return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
 						writer := tabwriter.NewWriter(w, pretty.CommitHeader)
 						if err := clientsdk.ForEachCommitSet(listCommitSetClient, func(commitSetInfo *pfs.CommitSetInfo) error {
 							for _, commitInfo := range commitSetInfo.Commits {
-								pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps)
+								pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps, tags[commitTagKey(commitInfo.Commit)])
 								count++
 								if number != 0 && count >= int(number) {
 									return errutil.ErrBreak
@@ -558,10 +653,14 @@ $ {{alias}} foo@master --from XXX`,
 					return nil
 				}
 
+				tags, err := commitTags(c, "")
+				if err != nil {
+					return err
+				}
 				return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
 					writer := tabwriter.NewWriter(w, pretty.CommitHeader)
 					for _, commitInfo := range commitInfos {
-						pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps)
+						pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps, tags[commitTagKey(commitInfo.Commit)])
 					}
 					return writer.Flush()
 				})
@@ -607,9 +706,13 @@ $ {{alias}} foo@master --from XXX`,
 						return errors.EnsureStack(encoder.EncodeProto(ci))
 					})
 				}
+				tags, err := commitTags(c, repo.Name)
+				if err != nil {
+					return err
+				}
 				writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 				if err := clientsdk.ForEachCommit(listClient, func(ci *pfs.CommitInfo) error {
-					pretty.PrintCommitInfo(writer, ci, fullTimestamps)
+					pretty.PrintCommitInfo(writer, ci, fullTimestamps, tags[commitTagKey(ci.Commit)])
 					return nil
 				}); err != nil {
 					return grpcutil.ScrubGRPC(err)
@@ -739,7 +842,7 @@ $ {{alias}} test@master --new`,
 				}
 			}()
 			if err := clientsdk.ForEachSubscribeCommit(subscribeClient, func(ci *pfs.CommitInfo) error {
-				pretty.PrintCommitInfo(w, ci, fullTimestamps)
+				pretty.PrintCommitInfo(w, ci, fullTimestamps, nil)
 				return nil
 			}); err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -761,7 +864,7 @@ $ {{alias}} test@master --new`,
 		Use:   "{{alias}} <commit-id>",
 		Short: "Squash the sub-commits of a commit.",
 		Long: `Squash the sub-commits of a commit.  The data in the sub-commits will remain in their child commits.
-The squash will fail if it includes a commit with no children`,
+The squash will fail if it includes a commit with no children, or a tagged commit unless --force is set.`,
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			c, err := client.NewOnUserMachine("user")
@@ -771,10 +874,15 @@ The squash will fail if it includes a commit with no children`,
 			defer c.Close()
 
 			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
-				return c.SquashCommitSet(args[0])
+				_, err := c.PfsAPIClient.SquashCommitSet(c.Ctx(), &pfs.SquashCommitSetRequest{
+					CommitSet: client.NewCommitSet(args[0]),
+					Force:     force,
+				})
+				return grpcutil.ScrubGRPC(err)
 			})
 		}),
 	}
+	squashCommit.Flags().BoolVarP(&force, "force", "f", false, "squash the commits even if they are tagged, deleting the tags")
 	shell.RegisterCompletionFunc(squashCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(squashCommit, "squash commit", commits))
 
@@ -782,7 +890,7 @@ The squash will fail if it includes a commit with no children`,
 		Use:   "{{alias}} <commit-id>",
 		Short: "Delete the sub-commits of a commit.",
 		Long: `Delete the sub-commits of a commit.  The data in the sub-commits will be lost.
-This operation is only supported if none of the sub-commits have children.`,
+This operation is only supported if none of the sub-commits have children, and none are tagged unless --force is set.`,
 
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			c, err := client.NewOnUserMachine("user")
@@ -792,13 +900,65 @@ This operation is only supported if none of the sub-commits have children.`,
 			defer c.Close()
 
 			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
-				return c.DropCommitSet(args[0])
+				_, err := c.PfsAPIClient.DropCommitSet(c.Ctx(), &pfs.DropCommitSetRequest{
+					CommitSet: client.NewCommitSet(args[0]),
+					Force:     force,
+				})
+				return grpcutil.ScrubGRPC(err)
 			})
 		}),
 	}
+	deleteCommit.Flags().BoolVarP(&force, "force", "f", false, "delete the commits even if they are tagged, deleting the tags")
 	shell.RegisterCompletionFunc(deleteCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(deleteCommit, "delete commit", commits))
 
+	var restore bool
+	var revertBranch string
+	revertCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Revert a commit.",
+		Long: `Revert a commit by creating a new commit which reverses its changes, on the commit's branch by default.  The history of the branch is kept, and the new commit propagates downstream like any other commit.
+
+The revert fails if later commits changed the same paths.  With '--restore', the new commit instead has the same content as the reverted commit, restoring the branch to that point in time.`,
+		Example: `
+# Reverse the changes made by the head of branch "master" in repo "test"
+$ {{alias}} test@master
+
+# Restore branch "master" to the content of commit 0001a0100b1c10d01111e001fc00a111
+$ {{alias}} test@0001a0100b1c10d01111e001fc00a111 --branch master --restore`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			request := &pfs.RevertCommitRequest{
+				Commit:      commit,
+				Restore:     restore,
+				Description: description,
+			}
+			if revertBranch != "" {
+				request.Branch = commit.Branch.Repo.NewBranch(revertBranch)
+			}
+			newCommit, err := c.PfsAPIClient.RevertCommit(c.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Println(newCommit.ID)
+			return nil
+		}),
+	}
+	revertCommit.Flags().BoolVar(&restore, "restore", false, "Restore the content of the commit instead of reversing its changes.")
+	revertCommit.Flags().StringVarP(&revertBranch, "branch", "b", "", "The branch to create the new commit on, defaults to the commit's branch.")
+	revertCommit.Flags().StringVarP(&description, "message", "m", "", "A description of the new commit.")
+	shell.RegisterCompletionFunc(revertCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(revertCommit, "revert commit", commits))
+
 	branchDocs := &cobra.Command{
 		Short: "Docs for branches.",
 		Long: `A branch in Pachyderm records provenance relationships between data in different repos,
@@ -859,10 +1019,11 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 				_, err := c.PfsAPIClient.CreateBranch(
 					c.Ctx(),
 					&pfs.CreateBranchRequest{
-						Head:       headCommit,
-						Branch:     branch,
-						Provenance: provenance,
-						Trigger:    trigger,
+						Head:         headCommit,
+						Branch:       branch,
+						Provenance:   provenance,
+						Trigger:      trigger,
+						ExpectedHead: expectedHead,
 					})
 				return grpcutil.ScrubGRPC(err)
 			})
@@ -877,6 +1038,7 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "The number of commits to use in triggering.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
+	createBranch.Flags().StringVar(&expectedHead, "expected-head", "", "Only update the branch if its head is currently this commit ID.")
 	commands = append(commands, cmdutil.CreateAliases(createBranch, "create branch", branches))
 
 	inspectBranch := &cobra.Command{
@@ -979,6 +1141,441 @@ Any pachctl command that can take a commit, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAliases(deleteBranch, "delete branch", branches))
 
+	var strategy string
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <target-branch>",
+		Short: "Merge a branch into another branch.",
+		Long: `Merge the changes made on a branch since its common ancestor with another branch into that branch, as a new commit.
+
+Paths which were changed differently on both branches are conflicts. By default the merge fails and reports them, '--strategy ours' keeps the target branch's version, and '--strategy theirs' takes the source branch's version.`,
+		Example: `
+# Merge the branch "feature" into "master" in repo "test"
+$ {{alias}} test@feature master
+
+# Merge, resolving conflicts in favor of "feature"
+$ {{alias}} test@feature master --strategy theirs`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			mergeStrategy, err := parseMergeStrategy(strategy)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.PfsAPIClient.MergeBranch(c.Ctx(), &pfs.MergeBranchRequest{
+				Source:      source,
+				Target:      source.Repo.NewBranch(args[1]),
+				Strategy:    mergeStrategy,
+				Description: description,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, p := range resp.Conflicts {
+				fmt.Fprintf(os.Stderr, "resolved conflict: %s\n", p)
+			}
+			fmt.Println(resp.Commit.ID)
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVarP(&strategy, "strategy", "s", "fail", "How to resolve conflicting paths: 'fail', 'ours' or 'theirs'.")
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit.")
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(mergeBranch, "merge branch", branches))
+
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: `A tag is an immutable, named reference to a commit in a repo.
+
+Unlike a branch, a tag always refers to the same commit. Tags share their names
+with branches, so any pachctl command that can take a commit, can take a tag
+name instead. Tagged commits can't be squashed or deleted without --force.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(tagDocs, "tag", " tag$", tags))
+
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit> <tag>",
+		Short: "Create a new tag.",
+		Long:  "Create a new, immutable tag which points to a commit.",
+		Example: `
+# Tag the head of branch "master" in repo "test" as "v1"
+$ {{alias}} test@master v1
+
+# Tag commit XXX in repo "test" as "v1"
+$ {{alias}} test@XXX v1`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+				Tag:         commit.Branch.Repo.NewTag(args[1]),
+				Commit:      commit,
+				Description: description,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createTag.Flags().StringVarP(&description, "message", "m", "", "A description of the tag.")
+	createTag.Flags().StringVar(&description, "description", "", "A description of the tag (synonym for --message)")
+	shell.RegisterCompletionFunc(createTag, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createTag, "create tag", tags))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long:  "Return info about a tag.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			tagInfo, err := c.InspectTag(branch.Repo.Name, branch.Name)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(tagInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			pretty.PrintTagInfo(writer, tagInfo, fullTimestamps)
+			return writer.Flush()
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(outputFlags)
+	inspectTag.Flags().AddFlagSet(timestampFlags)
+	commands = append(commands, cmdutil.CreateAliases(inspectTag, "inspect tag", tags))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Return the tags of a repo.",
+		Long:  "Return the tags of a repo, or of all repos if no repo is given.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var repo *pfs.Repo
+			if len(args) > 0 {
+				repo = cmdutil.ParseRepo(args[0])
+			}
+			tagClient, err := c.PfsAPIClient.ListTag(c.Ctx(), &pfs.ListTagRequest{Repo: repo})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				err := clientsdk.ForEachTagInfo(tagClient, func(tagInfo *pfs.TagInfo) error {
+					return errors.EnsureStack(encoder.EncodeProto(tagInfo))
+				})
+				return grpcutil.ScrubGRPC(err)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+
+			header := pretty.TagHeader
+			if repo == nil {
+				header = "REPO\t" + header
+			}
+			writer := tabwriter.NewWriter(os.Stdout, header)
+			if err := clientsdk.ForEachTagInfo(tagClient, func(tagInfo *pfs.TagInfo) error {
+				if repo == nil {
+					fmt.Fprintf(writer, "%s\t", tagInfo.Tag.Repo)
+				}
+				pretty.PrintTagInfo(writer, tagInfo, fullTimestamps)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(outputFlags)
+	listTag.Flags().AddFlagSet(timestampFlags)
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(listTag, "list tag", tags))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag",
+		Long:  "Delete a tag, while leaving the commit it points to intact",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.DeleteTag(branch.Repo.Name, branch.Name)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAliases(deleteTag, "delete tag", tags))
+
+	retentionDocs := &cobra.Command{
+		Short: "Docs for retention policies.",
+		Long: `A retention policy determines which commits of a repo or branch are kept.
+
+The head of a branch is always kept, as is any commit kept by one of the
+policy's rules. Pachyderm periodically squashes the other commits into their
+children, along with the rest of their commit sets. Commit sets with a tagged
+commit, or with a commit made by a user on a branch without a retention
+policy, are never squashed.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(retentionDocs, "retention", " retention$", retentions))
+
+	var keepLast int64
+	var keepWithin, keepDailyWithin time.Duration
+	var clearPolicy bool
+	updateRetention := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Set the retention policy of a repo or branch.",
+		Long:  "Set the retention policy of a repo or branch. The policy of a branch overrides the policy of its repo.",
+		Example: `
+# Keep the last 10 commits of each branch in repo "test"
+$ {{alias}} test --keep-last 10
+
+# Keep a week of commits on branch "master", and one commit per day for a year
+$ {{alias}} test@master --keep-within 168h --keep-daily-within 8760h
+
+# Remove the retention policy of repo "test"
+$ {{alias}} test --clear`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			var policy *pfs.RetentionPolicy
+			if !clearPolicy {
+				policy = &pfs.RetentionPolicy{KeepLast: keepLast}
+				if keepWithin != 0 {
+					policy.KeepWithin = types.DurationProto(keepWithin)
+				}
+				if keepDailyWithin != 0 {
+					policy.KeepDailyWithin = types.DurationProto(keepDailyWithin)
+				}
+			} else if keepLast != 0 || keepWithin != 0 || keepDailyWithin != 0 {
+				return errors.New("cannot set --clear with a retention rule")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.SetRetentionPolicy(branch.Repo.Name, branch.Name, policy)
+		}),
+	}
+	updateRetention.Flags().Int64Var(&keepLast, "keep-last", 0, "Keep the newest N commits of each branch.")
+	updateRetention.Flags().DurationVar(&keepWithin, "keep-within", 0, "Keep the commits started within this duration of now.")
+	updateRetention.Flags().DurationVar(&keepDailyWithin, "keep-daily-within", 0, "Keep the newest commit of each day, for the days within this duration of now.")
+	updateRetention.Flags().BoolVar(&clearPolicy, "clear", false, "Remove the retention policy.")
+	shell.RegisterCompletionFunc(updateRetention, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAliases(updateRetention, "update retention", retentions))
+
+	var dryRun bool
+	enforceRetention := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Enforce retention policies.",
+		Long:  "Squash the commit sets of a repo, or of all repos, which aren't kept by any retention policy. Pachyderm also does this periodically.",
+		Example: `
+# List the commit sets that the retention policy of repo "test" would squash
+$ {{alias}} test --dry-run`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var repoName string
+			if len(args) > 0 {
+				repoName = args[0]
+			}
+			commitSets, err := c.EnforceRetention(repoName, dryRun)
+			if err != nil {
+				return err
+			}
+			for _, commitSet := range commitSets {
+				fmt.Println(commitSet.ID)
+			}
+			return nil
+		}),
+	}
+	enforceRetention.Flags().BoolVar(&dryRun, "dry-run", false, "List the commit sets which would be squashed, without squashing them.")
+	shell.RegisterCompletionFunc(enforceRetention, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(enforceRetention, "enforce retention", retentions))
+
+	replicationDocs := &cobra.Command{
+		Short: "Docs for replications.",
+		Long: `A replication mirrors the commits of a repo to a repo of the same name in
+another Pachyderm cluster, e.g. for disaster recovery.
+
+Commits are recreated in the other cluster with the same IDs, branches, parents
+and descriptions, in the order they were started, once they're finished. Only
+the files which changed in each commit are sent. Replication resumes where it
+left off if either cluster restarts.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAliases(replicationDocs, "replication", " replication$", replications))
+
+	var readAuthToken bool
+	createReplication := func(update bool) func([]string) error {
+		return func(args []string) error {
+			var authToken string
+			if readAuthToken {
+				token, err := cmdutil.ReadPassword("Auth token for the target cluster: ")
+				if err != nil {
+					return errors.Wrapf(err, "error reading token")
+				}
+				authToken = strings.TrimSpace(token)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.CreateReplication(args[0], args[1], authToken, update)
+		}
+	}
+	createReplicationCmd := &cobra.Command{
+		Use:   "{{alias}} <repo> <target>",
+		Short: "Replicate a repo to another cluster.",
+		Long:  "Replicate the commits of a repo to a repo of the same name in another cluster. The target is the address of the other cluster's pachd.",
+		Example: `
+# Replicate repo "test" to the cluster at pachd.dr.example.com
+$ {{alias}} test grpc://pachd.dr.example.com:30650
+
+# Replicate repo "test" to a cluster with auth activated, reading a token for
+# it from stdin
+$ {{alias}} test grpcs://pachd.dr.example.com:30650 --auth-token`,
+		Run: cmdutil.RunFixedArgs(2, createReplication(false)),
+	}
+	createReplicationCmd.Flags().BoolVar(&readAuthToken, "auth-token", false, "If set, read an auth token for the target cluster on stdin.")
+	shell.RegisterCompletionFunc(createReplicationCmd, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(createReplicationCmd, "create replication", replications))
+
+	updateReplicationCmd := &cobra.Command{
+		Use:   "{{alias}} <repo> <target>",
+		Short: "Change the target of a replication.",
+		Long:  "Change the target or the auth token of a replication, or create it if it doesn't exist.",
+		Run:   cmdutil.RunFixedArgs(2, createReplication(true)),
+	}
+	updateReplicationCmd.Flags().BoolVar(&readAuthToken, "auth-token", false, "If set, read an auth token for the target cluster on stdin.")
+	shell.RegisterCompletionFunc(updateReplicationCmd, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(updateReplicationCmd, "update replication", replications))
+
+	inspectReplication := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return info about a replication.",
+		Long:  "Return the target of a repo's replication, the last commit that was replicated and the last error.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			replicationInfo, err := c.InspectReplication(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return errors.EnsureStack(cmdutil.Encoder(output, os.Stdout).EncodeProto(replicationInfo))
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ReplicationHeader)
+			pretty.PrintReplicationInfo(writer, replicationInfo, fullTimestamps)
+			return writer.Flush()
+		}),
+	}
+	inspectReplication.Flags().AddFlagSet(outputFlags)
+	inspectReplication.Flags().AddFlagSet(timestampFlags)
+	shell.RegisterCompletionFunc(inspectReplication, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(inspectReplication, "inspect replication", replications))
+
+	listReplication := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Return all replications.",
+		Long:  "Return the replications of all repos.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			replicationClient, err := c.PfsAPIClient.ListReplication(c.Ctx(), &pfs.ListReplicationRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				err := clientsdk.ForEachReplicationInfo(replicationClient, func(replicationInfo *pfs.ReplicationInfo) error {
+					return errors.EnsureStack(encoder.EncodeProto(replicationInfo))
+				})
+				return grpcutil.ScrubGRPC(err)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ReplicationHeader)
+			if err := clientsdk.ForEachReplicationInfo(replicationClient, func(replicationInfo *pfs.ReplicationInfo) error {
+				pretty.PrintReplicationInfo(writer, replicationInfo, fullTimestamps)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	listReplication.Flags().AddFlagSet(outputFlags)
+	listReplication.Flags().AddFlagSet(timestampFlags)
+	commands = append(commands, cmdutil.CreateAliases(listReplication, "list replication", replications))
+
+	deleteReplication := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Stop replicating a repo.",
+		Long:  "Stop replicating a repo. The commits which were already replicated are kept in the other cluster.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			return c.DeleteReplication(args[0])
+		}),
+	}
+	shell.RegisterCompletionFunc(deleteReplication, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAliases(deleteReplication, "delete replication", replications))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -1105,40 +1702,42 @@ $ {{alias}} repo@branch -i http://host/path`,
 				sources = filePaths
 			}
 
-			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
-				for _, source := range sources {
-					source := source
-					if file.Path == "" {
-						// The user has not specified a path so we use source as path.
-						if source == "-" {
-							return errors.Errorf("must specify filename when reading data from stdin")
-						}
-						target := source
-						if !fullPath {
-							target = filepath.Base(source)
-						}
-						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else if len(sources) == 1 {
-						// We have a single source and the user has specified a path,
-						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else {
-						// We have multiple sources and the user has specified a path,
-						// we use that path as a prefix for the filepaths.
-						target := source
-						if !fullPath {
-							target = filepath.Base(source)
-						}
-						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile); err != nil {
-							return err
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
+					for _, source := range sources {
+						source := source
+						if file.Path == "" {
+							// The user has not specified a path so we use source as path.
+							if source == "-" {
+								return errors.Errorf("must specify filename when reading data from stdin")
+							}
+							target := source
+							if !fullPath {
+								target = filepath.Base(source)
+							}
+							if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else if len(sources) == 1 {
+							// We have a single source and the user has specified a path,
+							// we use the path and ignore source (in terms of naming the file).
+							if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else {
+							// We have multiple sources and the user has specified a path,
+							// we use that path as a prefix for the filepaths.
+							target := source
+							if !fullPath {
+								target = filepath.Base(source)
+							}
+							if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile); err != nil {
+								return err
+							}
 						}
 					}
-				}
-				return nil
+					return nil
+				})
 			})
 		}),
 	}
@@ -1186,11 +1785,13 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if appendFile {
 				opts = append(opts, client.WithAppendCopyFile())
 			}
-			return c.CopyFile(
-				destFile.Commit, destFile.Path,
-				srcFile.Commit, srcFile.Path,
-				opts...,
-			)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.CopyFile(
+					destFile.Commit, destFile.Path,
+					srcFile.Commit, srcFile.Path,
+					opts...,
+				)
+			})
 		}),
 	}
 	copyFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
@@ -1338,6 +1939,40 @@ $ {{alias}} foo@master:XXX -r
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAliases(inspectFile, "inspect file", files))
 
+	var expires time.Duration
+	var endpoint string
+	presignFile := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
+		Short: "Return an s3 gateway URL which downloads a file without a token.",
+		Long: "Return an s3 gateway URL which downloads a file without a token, until it expires. " +
+			"Pachd must be deployed with a presign key, the pachd.s3GatewayPresignKey helm value. " +
+			"The URL reads the file from the given commit, or from the head of the branch, and stops working if you lose access to the repo.",
+		Example: `
+# get a URL for "foo.txt" on branch "master" in repo "data", which is valid for a day
+$ {{alias}} data@master:/foo.txt --expires 24h --endpoint http://localhost:30600`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			file, err := cmdutil.ParseFile(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			u, err := c.PresignFile(file.Commit, file.Path, expires)
+			if err != nil {
+				return err
+			}
+			fmt.Println(strings.TrimSuffix(endpoint, "/") + u)
+			return nil
+		}),
+	}
+	presignFile.Flags().DurationVar(&expires, "expires", time.Hour, "How long the URL is valid for, at most 168h.")
+	presignFile.Flags().StringVar(&endpoint, "endpoint", "", "The address of the s3 gateway, which is prepended to the URL.")
+	shell.RegisterCompletionFunc(presignFile, shell.FileCompletion)
+	commands = append(commands, cmdutil.CreateAliases(presignFile, "presign file", files))
+
 	listFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/in/pfs>]",
 		Short: "Return the files in a directory.",
@@ -1566,7 +2201,9 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			if recursive {
 				opts = append(opts, client.WithRecursiveDeleteFile())
 			}
-			return c.DeleteFile(file.Commit, file.Path, opts...)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.DeleteFile(file.Commit, file.Path, opts...)
+			})
 		}),
 	}
 	deleteFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively delete the files in a directory.")
@@ -1829,6 +2466,33 @@ func newClient(name string, options ...client.Option) (*client.APIClient, error)
 	return client.NewOnUserMachine(name, options...)
 }
 
+// commitTags returns the names of the tags in repoName, or in all repos if
+// repoName is empty, keyed by commitTagKey.
+func commitTags(c *client.APIClient, repoName string) (map[string][]string, error) {
+	tagInfos, err := c.ListTag(repoName)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string][]string)
+	for _, tagInfo := range tagInfos {
+		key := commitTagKey(tagInfo.Commit)
+		tags[key] = append(tags[key], tagInfo.Tag.Name)
+	}
+	return tags, nil
+}
+
+func commitTagKey(commit *pfs.Commit) string {
+	return commit.Branch.Repo.String() + "@" + commit.ID
+}
+
+func parseMergeStrategy(input string) (pfs.MergeStrategy, error) {
+	value, ok := pfs.MergeStrategy_value[strings.ToUpper(input)]
+	if !ok {
+		return pfs.MergeStrategy_FAIL, errors.Errorf("unknown merge strategy '%s', must be one of: fail, ours, theirs", input)
+	}
+	return pfs.MergeStrategy(value), nil
+}
+
 func parseOriginKind(input string) (pfs.OriginKind, error) {
 	if input == "" {
 		return pfs.OriginKind_ORIGIN_KIND_UNKNOWN, nil
@@ -12,21 +12,28 @@ import (
 )
 
 const (
-	branch = "branch"
-	commit = "commit"
-	file   = "file"
-	repo   = "repo"
+	branch      = "branch"
+	commit      = "commit"
+	file        = "file"
+	repo        = "repo"
+	replication = "replication"
+	retention   = "retention"
+	tag         = "tag"
 
 	copy      = "copy"
 	create    = "create"
 	delete    = "delete"
 	diff      = "diff"
+	enforce   = "enforce"
+	export    = "export"
 	finish    = "finish"
 	get       = "get"
 	glob      = "glob"
+	importCmd = "import"
 	inspect   = "inspect"
 	list      = "list"
 	put       = "put"
+	revert    = "revert"
 	squash    = "squash"
 	start     = "start"
 	subscribe = "subscribe"
@@ -266,18 +273,24 @@ func TestSynonymsDocs(t *testing.T) {
 
 func resourcesMap() map[string][]string {
 	return map[string][]string{
-		branch: {create, delete, inspect, list},
-		commit: {delete, finish, inspect, list, squash, start, subscribe, wait},
-		file:   {copy, delete, diff, get, glob, inspect, list, put},
-		repo:   {create, delete, inspect, list, update},
+		branch:      {create, delete, inspect, list},
+		commit:      {delete, finish, inspect, list, revert, squash, start, subscribe, wait},
+		file:        {copy, delete, diff, get, glob, inspect, list, put},
+		repo:        {create, delete, export, importCmd, inspect, list, update},
+		tag:         {create, delete, inspect, list},
+		retention:   {enforce, update},
+		replication: {create, delete, inspect, list, update},
 	}
 }
 
 func synonymsMap() map[string]string {
 	return map[string]string{
-		branch: branches,
-		commit: commits,
-		file:   files,
-		repo:   repos,
+		branch:      branches,
+		commit:      commits,
+		file:        files,
+		repo:        repos,
+		tag:         tags,
+		retention:   retentions,
+		replication: replications,
 	}
 }
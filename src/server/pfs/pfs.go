@@ -136,6 +136,56 @@ type ErrDropWithChildren struct {
 	Commit *pfs.Commit
 }
 
+// ErrTagNotFound represents a tag-not-found error.
+type ErrTagNotFound struct {
+	Tag *pfs.Tag
+}
+
+// ErrTagExists represents a tag-exists error.
+type ErrTagExists struct {
+	Tag *pfs.Tag
+}
+
+// ErrTaggedCommit represents an error when attempting to squash or drop a
+// commit that is tagged. Tags are meant to keep the data they point to, so the
+// tags have to be deleted first, or the operation forced.
+type ErrTaggedCommit struct {
+	Commit *pfs.Commit
+	Tags   []string
+}
+
+// ErrMergeConflict represents an error when merging a branch into another
+// changes the same paths differently on both branches, and the merge strategy
+// doesn't resolve the conflict.
+type ErrMergeConflict struct {
+	Source *pfs.Branch
+	Target *pfs.Branch
+	Paths  []string
+}
+
+// ErrRevertConflict represents an error when reverting a commit whose changes
+// were changed again by later commits on the branch.
+type ErrRevertConflict struct {
+	Commit *pfs.Commit
+	Branch *pfs.Branch
+	Paths  []string
+}
+
+// ErrReplicationNotFound represents a replication-not-found error.
+type ErrReplicationNotFound struct {
+	Repo *pfs.Repo
+}
+
+// ErrReplicationExists represents a replication-exists error.
+type ErrReplicationExists struct {
+	Repo *pfs.Repo
+}
+
+// ErrCacheEntryNotFound represents a cache-entry-not-found error.
+type ErrCacheEntryNotFound struct {
+	Key string
+}
+
 const GetFileTARSuggestion = "Use GetFileTAR instead"
 
 var (
@@ -259,6 +309,70 @@ func (e ErrDropWithChildren) Error() string {
 	return fmt.Sprintf("cannot drop a commit that has children: %s", e.Commit)
 }
 
+func (e ErrTagNotFound) Error() string {
+	return fmt.Sprintf("tag %q not found in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
+func (e ErrTagNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrTagExists) Error() string {
+	return fmt.Sprintf("tag %q already exists in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
+func (e ErrTagExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+func (e ErrTaggedCommit) Error() string {
+	return fmt.Sprintf("commit %s is tagged (%s), delete the tags or use the force option", e.Commit, strings.Join(e.Tags, ", "))
+}
+
+func (e ErrTaggedCommit) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+func (e ErrMergeConflict) Error() string {
+	return fmt.Sprintf("cannot merge branch %s into %s, conflicting changes to: %s", e.Source, e.Target, strings.Join(e.Paths, ", "))
+}
+
+func (e ErrMergeConflict) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+func (e ErrRevertConflict) Error() string {
+	return fmt.Sprintf("cannot revert commit %s on branch %s, later commits changed: %s", e.Commit, e.Branch, strings.Join(e.Paths, ", "))
+}
+
+func (e ErrRevertConflict) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+func (e ErrReplicationNotFound) Error() string {
+	return fmt.Sprintf("replication of repo %v not found", e.Repo)
+}
+
+func (e ErrReplicationNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
+func (e ErrReplicationExists) Error() string {
+	return fmt.Sprintf("replication of repo %v already exists", e.Repo)
+}
+
+func (e ErrReplicationExists) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+func (e ErrCacheEntryNotFound) Error() string {
+	return fmt.Sprintf("cache entry %s not found", e.Key)
+}
+
+func (e ErrCacheEntryNotFound) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, e.Error())
+}
+
 var (
 	commitNotFoundRe          = regexp.MustCompile("commit [^ ]+ not found")
 	commitsetNotFoundRe       = regexp.MustCompile("no commits found for commitset")
@@ -277,6 +391,14 @@ var (
 	commitOnOutputBranchRe    = regexp.MustCompile("cannot start a commit on an output branch")
 	squashWithoutChildrenRe   = regexp.MustCompile("cannot squash a commit that has no children")
 	dropWithChildrenRe        = regexp.MustCompile("cannot drop a commit that has children")
+	mergeConflictRe           = regexp.MustCompile("cannot merge branch .+ into .+, conflicting changes to")
+	revertConflictRe          = regexp.MustCompile("cannot revert commit .+ on branch .+, later commits changed")
+	tagNotFoundRe             = regexp.MustCompile(`tag [^ ]+ not found in repo [^ ]+`)
+	tagExistsRe               = regexp.MustCompile(`tag [^ ]+ already exists in repo [^ ]+`)
+	taggedCommitRe            = regexp.MustCompile(`commit [^ ]+ is tagged`)
+	replicationNotFoundRe     = regexp.MustCompile(`replication of repo [^ ]+ not found`)
+	replicationExistsRe       = regexp.MustCompile(`replication of repo [^ ]+ already exists`)
+	cacheEntryNotFoundRe      = regexp.MustCompile(`cache entry [^ ]+ not found`)
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -433,6 +555,78 @@ func IsDropWithChildrenErr(err error) bool {
 	return dropWithChildrenRe.MatchString(err.Error())
 }
 
+// IsTagNotFoundErr returns true if 'err' has an error message that matches
+// ErrTagNotFound
+func IsTagNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return tagNotFoundRe.MatchString(err.Error())
+}
+
+// IsTagExistsErr returns true if 'err' has an error message that matches
+// ErrTagExists
+func IsTagExistsErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return tagExistsRe.MatchString(err.Error())
+}
+
+// IsTaggedCommitErr returns true if the err is due to an attempt to squash or
+// drop a tagged commit.
+func IsTaggedCommitErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return taggedCommitRe.MatchString(err.Error())
+}
+
+// IsMergeConflictErr returns true if the err is due to conflicting changes on
+// the branches of a merge.
+func IsMergeConflictErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return mergeConflictRe.MatchString(err.Error())
+}
+
+// IsRevertConflictErr returns true if the err is due to reverting a commit
+// whose changes were changed again by later commits.
+func IsRevertConflictErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return revertConflictRe.MatchString(err.Error())
+}
+
+// IsReplicationNotFoundErr returns true if 'err' has an error message that
+// matches ErrReplicationNotFound
+func IsReplicationNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return replicationNotFoundRe.MatchString(err.Error())
+}
+
+// IsReplicationExistsErr returns true if 'err' has an error message that
+// matches ErrReplicationExists
+func IsReplicationExistsErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return replicationExistsRe.MatchString(err.Error())
+}
+
+// IsCacheEntryNotFoundErr returns true if 'err' has an error message that
+// matches ErrCacheEntryNotFound
+func IsCacheEntryNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return cacheEntryNotFoundRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
 func ValidateSQLDatabaseEgress(sql *pfs.SQLDatabaseEgress) error {
 	if sql == nil {
 		return nil
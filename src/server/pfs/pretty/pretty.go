@@ -21,11 +21,15 @@ const (
 	// RepoAuthHeader is the header for repos with auth information attached.
 	RepoAuthHeader = "NAME\tCREATED\tSIZE (MASTER)\tACCESS LEVEL\t\n"
 	// CommitHeader is the header for commits.
-	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tORIGIN\tDESCRIPTION\n"
+	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tORIGIN\tDESCRIPTION\tTAGS\n"
 	// CommitSetHeader is the header for commitsets.
 	CommitSetHeader = "ID\tSUBCOMMITS\tPROGRESS\tCREATED\tMODIFIED\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\tTRIGGER\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\tDESCRIPTION\t\n"
+	// ReplicationHeader is the header for replications.
+	ReplicationHeader = "REPO\tTARGET\tLAST COMMIT\tLAST FINISHED\tERROR\t\n"
 	// FileHeader is the header for files.
 	FileHeader = "NAME\tTYPE\tSIZE\t\n"
 	// FileHeaderWithCommit is the header for files that includes a commit field.
@@ -75,7 +79,9 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
-Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}}{{end}}{{if .EncryptionKeyId}}
+Encryption Key: {{.EncryptionKeyId}}{{end}}{{if .AuthInfo}}
 Roles: {{ .AuthInfo.Roles | commafy }}
 Permissions: {{ .AuthInfo.Permissions | commafy }}{{end}}
 `)
@@ -105,6 +111,24 @@ func printTrigger(trigger *pfs.Trigger) string {
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
+func printRetentionPolicy(policy *pfs.RetentionPolicy) string {
+	var rules []string
+	if policy.KeepLast != 0 {
+		rules = append(rules, fmt.Sprintf("last %d commits", policy.KeepLast))
+	}
+	if policy.KeepWithin != nil {
+		if d, err := types.DurationFromProto(policy.KeepWithin); err == nil && d > 0 {
+			rules = append(rules, fmt.Sprintf("commits within %v", d))
+		}
+	}
+	if policy.KeepDailyWithin != nil {
+		if d, err := types.DurationFromProto(policy.KeepDailyWithin); err == nil && d > 0 {
+			rules = append(rules, fmt.Sprintf("one commit per day within %v", d))
+		}
+	}
+	return "keep " + strings.Join(rules, ", ")
+}
+
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branchInfo.Branch.Name)
@@ -121,13 +145,45 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintln(w)
 }
 
+// PrintTagInfo pretty-prints tag info.
+func PrintTagInfo(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Tag.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit.ID)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", tagInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t", tagInfo.Description)
+	fmt.Fprintln(w)
+}
+
+// PrintReplicationInfo pretty-prints replication info.
+func PrintReplicationInfo(w io.Writer, replicationInfo *pfs.ReplicationInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", replicationInfo.Replication.Repo)
+	fmt.Fprintf(w, "%s\t", replicationInfo.Replication.Target)
+	if replicationInfo.LastCommit != nil {
+		fmt.Fprintf(w, "%s\t", replicationInfo.LastCommit.ID)
+		if fullTimestamps {
+			fmt.Fprintf(w, "%s\t", replicationInfo.LastFinished.String())
+		} else {
+			fmt.Fprintf(w, "%s\t", pretty.Ago(replicationInfo.LastFinished))
+		}
+	} else {
+		fmt.Fprintf(w, "-\t-\t")
+	}
+	fmt.Fprintf(w, "%s\t", replicationInfo.Error)
+	fmt.Fprintln(w)
+}
+
 // PrintDetailedBranchInfo pretty-prints detailed branch info.
 func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 	template, err := template.New("BranchInfo").Funcs(funcMap).Parse(
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .RetentionPolicy}}
+Retention Policy: {{printRetentionPolicy .RetentionPolicy}} {{end}}
 `)
 	if err != nil {
 		return errors.EnsureStack(err)
@@ -135,8 +191,9 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return errors.EnsureStack(template.Execute(os.Stdout, branchInfo))
 }
 
-// PrintCommitInfo pretty-prints commit info.
-func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool) {
+// PrintCommitInfo pretty-prints commit info, along with the names of the tags
+// which point to the commit.
+func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool, tags []string) {
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Branch.Repo)
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Branch.Name)
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.ID)
@@ -156,6 +213,11 @@ func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps boo
 	}
 	fmt.Fprintf(w, "%v\t", commitInfo.Origin.Kind)
 	fmt.Fprintf(w, "%s\t", commitInfo.Description)
+	if len(tags) > 0 {
+		fmt.Fprintf(w, "%s\t", strings.Join(tags, ", "))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
 	fmt.Fprintln(w)
 }
 
@@ -303,11 +365,12 @@ func fileType(fileType pfs.FileType) string {
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
-	"commafy":      pretty.Commafy,
+	"prettyAgo":            pretty.Ago,
+	"prettySize":           pretty.Size,
+	"fileType":             fileType,
+	"printTrigger":         printTrigger,
+	"printRetentionPolicy": printRetentionPolicy,
+	"commafy":              pretty.Commafy,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
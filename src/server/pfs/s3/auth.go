@@ -40,6 +40,9 @@ func (c *controller) SecretKey(r *http.Request, accessKey string, region *string
 
 func (c *controller) CustomAuth(r *http.Request) (bool, error) {
 	c.logger.Debug("CustomAuth")
+	if r.URL.Query().Get("X-Amz-Signature") != "" {
+		return c.presignedAuth(r)
+	}
 	pc := c.clientFactory(r.Context())
 	active, err := pc.IsAuthActive()
 	if err != nil {
@@ -4,12 +4,18 @@
 package s3
 
 import (
+	"encoding/xml"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/gorilla/mux"
 	glob "github.com/pachyderm/ohmyglob"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
@@ -247,12 +253,362 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
+	result, err := c.listObjectVersions(r, bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ListObjectVersionsResult, nil
+}
+
+// objectVersions is a page of the versions of the objects in a bucket. s2's
+// result does not have the common prefixes or the markers of the next page,
+// which listVersionsMiddleware returns.
+type objectVersions struct {
+	s2.ListObjectVersionsResult
+	CommonPrefixes      []*s2.CommonPrefixes
+	NextKeyMarker       string
+	NextVersionIDMarker string
+}
+
+func (c *controller) listObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*objectVersions, error) {
 	c.logger.Debugf("ListObjectVersions: bucketName=%+v, prefix=%+v, keyMarker=%+v, versionIDMarker=%+v, delimiter=%+v, maxKeys=%+v", bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
-	return nil, s2.NotImplementedError(r)
+
+	// Strip / from prefix to normalize: "/" means "all objects" and "/foo"
+	// means the same as "foo"
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc := c.requestClient(r)
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	result := objectVersions{
+		ListObjectVersionsResult: s2.ListObjectVersionsResult{
+			Versions:      []*s2.Version{},
+			DeleteMarkers: []*s2.DeleteMarker{},
+		},
+		CommonPrefixes: []*s2.CommonPrefixes{},
+	}
+
+	if !bucketCaps.readable || maxKeys == 0 {
+		// serve empty results if we can't read the bucket; this helps with s3
+		// conformance
+		return &result, nil
+	}
+	if !bucketCaps.historicVersions {
+		return nil, s2.NotImplementedError(r)
+	}
+
+	page := &versionPage{
+		prefix:          prefix,
+		delimiter:       delimiter,
+		keyMarker:       keyMarker,
+		versionIDMarker: versionIDMarker,
+		maxKeys:         maxKeys,
+		entries:         make(map[string]*versionEntry),
+	}
+	if err := page.walk(pc, bucket.Commit); err != nil {
+		return nil, maybeNotFoundError(r, grpcutil.ScrubGRPC(err))
+	}
+
+	// S3 lists versions by key, and then from newest to oldest, with the
+	// common prefixes in between.
+	count := 0
+	full := func() bool {
+		if count < maxKeys {
+			count++
+			return false
+		}
+		result.IsTruncated = true
+		return true
+	}
+entries:
+	for _, name := range page.names {
+		entry := page.entries[name]
+		if entry.isPrefix {
+			if full() {
+				break
+			}
+			result.CommonPrefixes = append(result.CommonPrefixes, &s2.CommonPrefixes{
+				Prefix: name,
+				Owner:  defaultUser,
+			})
+			result.NextKeyMarker, result.NextVersionIDMarker = name, ""
+			continue
+		}
+		for _, v := range entry.versions {
+			if full() {
+				break entries
+			}
+			result.NextKeyMarker, result.NextVersionIDMarker = v.key, v.commitID
+			if v.fileInfo == nil {
+				result.DeleteMarkers = append(result.DeleteMarkers, &s2.DeleteMarker{
+					Key:          v.key,
+					Version:      v.commitID,
+					IsLatest:     v.isLatest,
+					LastModified: v.modified,
+					Owner:        defaultUser,
+				})
+				continue
+			}
+			contents, err := newContents(v.fileInfo)
+			if err != nil {
+				return nil, err
+			}
+			result.Versions = append(result.Versions, &s2.Version{
+				Key:          contents.Key,
+				Version:      v.commitID,
+				IsLatest:     v.isLatest,
+				LastModified: contents.LastModified,
+				ETag:         contents.ETag,
+				Size:         contents.Size,
+				StorageClass: contents.StorageClass,
+				Owner:        contents.Owner,
+			})
+		}
+	}
+	if !result.IsTruncated {
+		result.NextKeyMarker, result.NextVersionIDMarker = "", ""
+	}
+	return &result, nil
+}
+
+// objectVersion is a version of an object in a versioned bucket: the commit
+// where a file was written, or deleted if fileInfo is nil.
+type objectVersion struct {
+	key      string
+	commitID string
+	isLatest bool
+	modified time.Time
+	fileInfo *pfsClient.FileInfo
+}
+
+// versionEntry is a key or a common prefix in a page of versions.
+type versionEntry struct {
+	name     string
+	isPrefix bool
+	// seen is set once a version of the key was walked, so the following
+	// versions are not the latest.
+	seen bool
+	// skipping is set while the versions of the key marker up to the version
+	// ID marker are walked.
+	skipping bool
+	versions []*objectVersion
+}
+
+func (e *versionEntry) size() int {
+	if e.isPrefix {
+		return 1
+	}
+	return len(e.versions)
+}
+
+// versionPage collects a page of the versions of the objects in a bucket. It
+// only keeps the first entries which are enough to fill the page, so its size
+// doesn't depend on the number of objects or commits.
+type versionPage struct {
+	prefix, delimiter          string
+	keyMarker, versionIDMarker string
+	maxKeys                    int
+
+	entries map[string]*versionEntry
+	// names are the sorted names of the entries
+	names []string
+	// count is the number of versions and common prefixes in the entries
+	count int
+	// cutoff is set to the first name which was dropped from a full page,
+	// any name from there on is after the page
+	cutoff string
+	full   bool
+}
+
+// walk walks the history of commit from newest to oldest, and adds the
+// versions of the files under the page's prefix. A file has a version in each
+// commit which changed its content, and a delete marker in each commit which
+// removed it. Each commit is diffed with its parent, so only the files it
+// changed are read.
+func (p *versionPage) walk(pc *client.APIClient, commit *pfsClient.Commit) error {
+	dir := "/"
+	if i := strings.LastIndex(p.prefix, "/"); i >= 0 {
+		dir = "/" + p.prefix[:i]
+	}
+	return pc.ListCommitF(commit.Branch.Repo, commit, nil, 0, false, func(ci *pfsClient.CommitInfo) error {
+		if ci.Finished == nil {
+			// only finished commits are stable versions
+			return nil
+		}
+		modified, err := types.TimestampFromProto(ci.Finished)
+		if err != nil {
+			return err
+		}
+		return pc.DiffFile(ci.Commit, dir, nil, "", false, func(newFi, oldFi *pfsClient.FileInfo) error {
+			switch {
+			case newFi != nil && newFi.FileType == pfsClient.FileType_FILE:
+				newFi.File.Path = newFi.File.Path[1:] // strip leading slash
+				p.add(&objectVersion{key: newFi.File.Path, commitID: ci.Commit.ID, fileInfo: newFi})
+			case newFi == nil && oldFi.FileType == pfsClient.FileType_FILE:
+				p.add(&objectVersion{key: oldFi.File.Path[1:], commitID: ci.Commit.ID, modified: modified})
+			}
+			return nil
+		})
+	})
+}
+
+// add adds a version to the page, if it belongs to it.
+func (p *versionPage) add(v *objectVersion) {
+	if !strings.HasPrefix(v.key, p.prefix) {
+		return
+	}
+	name, isPrefix := v.key, false
+	if p.delimiter != "" {
+		if i := strings.Index(v.key[len(p.prefix):], p.delimiter); i >= 0 {
+			name, isPrefix = v.key[:len(p.prefix)+i+len(p.delimiter)], true
+		}
+	}
+	// The versions of the key marker are listed after the version ID
+	// marker, or after all of them if no version ID marker is given.
+	if name < p.keyMarker || (name == p.keyMarker && (isPrefix || p.versionIDMarker == "")) {
+		return
+	}
+	if p.full && name >= p.cutoff {
+		return
+	}
+	entry, ok := p.entries[name]
+	if !ok {
+		entry = &versionEntry{
+			name:     name,
+			isPrefix: isPrefix,
+			skipping: name == p.keyMarker,
+		}
+		p.entries[name] = entry
+		i := sort.SearchStrings(p.names, name)
+		p.names = append(p.names, "")
+		copy(p.names[i+1:], p.names[i:])
+		p.names[i] = name
+		if isPrefix {
+			p.count++
+		}
+	}
+	if isPrefix {
+		return
+	}
+	v.isLatest = !entry.seen
+	entry.seen = true
+	if entry.skipping {
+		entry.skipping = v.commitID != p.versionIDMarker
+		return
+	}
+	entry.versions = append(entry.versions, v)
+	p.count++
+	// Drop the last entries while the page is full without them. One more
+	// entry than fits in the page is kept, to know if the page is truncated.
+	for len(p.names) > 0 {
+		last := p.entries[p.names[len(p.names)-1]]
+		if p.count-last.size() <= p.maxKeys {
+			break
+		}
+		delete(p.entries, last.name)
+		p.names = p.names[:len(p.names)-1]
+		p.count -= last.size()
+		p.cutoff, p.full = last.name, true
+	}
+}
+
+// listVersionsMiddleware serves the ListObjectVersions requests instead of
+// s2, which does not return the common prefixes or the markers of the next
+// page. It runs after s2's middlewares, so the requests are authenticated.
+func (c *controller) listVersionsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if _, ok := r.URL.Query()["versions"]; !ok || r.Method != http.MethodGet || vars["bucket"] == "" || vars["key"] != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		c.listVersions(w, r)
+	})
+}
+
+func (c *controller) listVersions(w http.ResponseWriter, r *http.Request) {
+	bucketName := mux.Vars(r)["bucket"]
+	maxKeys := defaultMaxKeys
+	if s := r.FormValue("max-keys"); s != "" {
+		i, err := strconv.Atoi(s)
+		if err != nil || i < 0 || i > defaultMaxKeys {
+			s2.WriteError(c.logger, w, r, s2.InvalidArgumentError(r))
+			return
+		}
+		maxKeys = i
+	}
+	prefix := r.FormValue("prefix")
+	keyMarker := r.FormValue("key-marker")
+	versionIDMarker := r.FormValue("version-id-marker")
+	delimiter := r.FormValue("delimiter")
+
+	result, err := c.listObjectVersions(r, bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
+	if err != nil {
+		s2.WriteError(c.logger, w, r, err)
+		return
+	}
+
+	// like s2, round the timestamps to seconds, which some clients (e.g.
+	// minio-python) need, and quote the ETags
+	for _, version := range result.Versions {
+		version.LastModified = version.LastModified.UTC().Round(time.Second)
+		version.ETag = fmt.Sprintf("%q", version.ETag)
+	}
+	for _, deleteMarker := range result.DeleteMarkers {
+		deleteMarker.LastModified = deleteMarker.LastModified.UTC().Round(time.Second)
+	}
+
+	marshallable := struct {
+		XMLName             xml.Name             `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult"`
+		Delimiter           string               `xml:"Delimiter,omitempty"`
+		IsTruncated         bool                 `xml:"IsTruncated"`
+		KeyMarker           string               `xml:"KeyMarker"`
+		NextKeyMarker       string               `xml:"NextKeyMarker,omitempty"`
+		MaxKeys             int                  `xml:"MaxKeys"`
+		Name                string               `xml:"Name"`
+		VersionIDMarker     string               `xml:"VersionIdMarker"`
+		NextVersionIDMarker string               `xml:"NextVersionIdMarker,omitempty"`
+		Prefix              string               `xml:"Prefix"`
+		Versions            []*s2.Version        `xml:"Version"`
+		DeleteMarkers       []*s2.DeleteMarker   `xml:"DeleteMarker"`
+		CommonPrefixes      []*s2.CommonPrefixes `xml:"CommonPrefixes"`
+	}{
+		Delimiter:           delimiter,
+		IsTruncated:         result.IsTruncated,
+		KeyMarker:           keyMarker,
+		NextKeyMarker:       result.NextKeyMarker,
+		MaxKeys:             maxKeys,
+		Name:                bucketName,
+		VersionIDMarker:     versionIDMarker,
+		NextVersionIDMarker: result.NextVersionIDMarker,
+		Prefix:              prefix,
+		Versions:            result.Versions,
+		DeleteMarkers:       result.DeleteMarkers,
+		CommonPrefixes:      result.CommonPrefixes,
+	}
+
+	requestID := mux.Vars(r)["requestID"]
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("x-amz-id-2", requestID)
+	w.Header().Set("x-amz-request-id", requestID)
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, xml.Header)
+	if err := xml.NewEncoder(w).Encode(marshallable); err != nil {
+		// just log a message since a response has already been partially
+		// written
+		c.logger.Errorf("could not encode xml response: %v", err)
+	}
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
@@ -4,7 +4,6 @@
 package s3
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -15,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3/presign"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/s2"
@@ -41,15 +41,19 @@ type Driver interface {
 	bucket(pc *client.APIClient, r *http.Request, name string) (*Bucket, error)
 	bucketCapabilities(pc *client.APIClient, r *http.Request, bucket *Bucket) (bucketCapabilities, error)
 	canModifyBuckets() bool
+	presignKey() []byte
 }
 
 // MasterDriver is the driver for the s3gateway instance running on pachd
 // master
-type MasterDriver struct{}
+type MasterDriver struct {
+	key []byte
+}
 
-// NewMasterDriver constructs a new master driver
-func NewMasterDriver() *MasterDriver {
-	return &MasterDriver{}
+// NewMasterDriver constructs a new master driver. presignKey verifies the
+// presigned URLs minted by pachd, which are rejected if it is empty.
+func NewMasterDriver(presignKey []byte) *MasterDriver {
+	return &MasterDriver{key: presignKey}
 }
 
 func (d *MasterDriver) listBuckets(pc *client.APIClient, r *http.Request, buckets *[]*s2.Bucket) error {
@@ -67,14 +71,8 @@ func (d *MasterDriver) listBuckets(pc *client.APIClient, r *http.Request, bucket
 			return err
 		}
 		for _, branch := range repo.Branches {
-			var name string
-			if branch.Repo.Type == pfs.UserRepoType {
-				name = fmt.Sprintf("%s.%s", branch.Name, branch.Repo.Name)
-			} else {
-				name = fmt.Sprintf("%s.%s.%s", branch.Name, branch.Repo.Type, branch.Repo.Name)
-			}
 			*buckets = append(*buckets, &s2.Bucket{
-				Name:         name,
+				Name:         presign.BucketName(branch),
 				CreationDate: t,
 			})
 		}
@@ -128,6 +126,10 @@ func (d *MasterDriver) canModifyBuckets() bool {
 	return true
 }
 
+func (d *MasterDriver) presignKey() []byte {
+	return d.key
+}
+
 // WorkerDriver is the driver for the s3gateway instance running on pachd
 // workers
 type WorkerDriver struct {
@@ -216,3 +218,7 @@ func (d *WorkerDriver) bucketCapabilities(pc *client.APIClient, r *http.Request,
 func (d *WorkerDriver) canModifyBuckets() bool {
 	return false
 }
+
+func (d *WorkerDriver) presignKey() []byte {
+	return nil
+}
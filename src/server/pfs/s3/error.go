@@ -11,6 +11,10 @@ func invalidDelimiterError(r *http.Request) *s2.Error {
 	return s2.NewError(r, http.StatusBadRequest, "InvalidDelimiter", "The delimiter you specified is invalid. It must be '' or '/'.")
 }
 
+func authorizationQueryParametersError(r *http.Request) *s2.Error {
+	return s2.NewError(r, http.StatusBadRequest, "AuthorizationQueryParametersError", "The presigned URL's authorization query parameters are invalid.")
+}
+
 func invalidFilePathError(r *http.Request) *s2.Error {
 	return s2.NewError(r, http.StatusBadRequest, "InvalidFilePath", "Invalid file path")
 }
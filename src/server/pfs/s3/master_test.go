@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3/presign"
 )
 
 func masterListBuckets(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
@@ -516,6 +518,193 @@ func masterResolveSystemRepoBucket(t *testing.T, pachClient *client.APIClient, m
 	require.Equal(t, "spec", fetchedContent)
 }
 
+func masterGetObjectRange(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testgetobjectrange")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("0123456789")))
+	bucket := fmt.Sprintf("master.%s", repo)
+
+	status, body := getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"Range": "bytes=2-5"})
+	require.Equal(t, http.StatusPartialContent, status)
+	require.Equal(t, "2345", body)
+	status, body = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"Range": "bytes=7-"})
+	require.Equal(t, http.StatusPartialContent, status)
+	require.Equal(t, "789", body)
+	status, body = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"Range": "bytes=-2"})
+	require.Equal(t, http.StatusPartialContent, status)
+	require.Equal(t, "89", body)
+	status, _ = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"Range": "bytes=20-"})
+	require.Equal(t, http.StatusRequestedRangeNotSatisfiable, status)
+
+	// the same range through the client
+	obj, err := minioClient.GetObject(bucket, "file", minio.GetObjectOptions{})
+	require.NoError(t, err)
+	defer obj.Close()
+	buf := make([]byte, 3)
+	_, err = obj.ReadAt(buf, 4)
+	require.NoError(t, err)
+	require.Equal(t, "456", string(buf))
+}
+
+func masterGetObjectConditional(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testgetobjectconditional")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("content")))
+	bucket := fmt.Sprintf("master.%s", repo)
+
+	info, err := minioClient.StatObject(bucket, "file", minio.StatObjectOptions{})
+	require.NoError(t, err)
+	etag := fmt.Sprintf("%q", info.ETag)
+	before := info.LastModified.Add(-time.Hour).UTC().Format(http.TimeFormat)
+	after := info.LastModified.Add(time.Hour).UTC().Format(http.TimeFormat)
+
+	status, body := getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-Match": etag})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "content", body)
+	status, _ = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-Match": `"other"`})
+	require.Equal(t, http.StatusPreconditionFailed, status)
+	status, _ = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusNotModified, status)
+	status, _ = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-Modified-Since": after})
+	require.Equal(t, http.StatusNotModified, status)
+	status, body = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-Modified-Since": before})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "content", body)
+	status, _ = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-Unmodified-Since": before})
+	require.Equal(t, http.StatusPreconditionFailed, status)
+
+	// a new commit changes the ETag
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("new content")))
+	status, body = getObjectWithHeaders(t, minioClient, bucket, "file", map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "new content", body)
+}
+
+func masterObjectVersions(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testobjectversions")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	bucket := fmt.Sprintf("master.%s", repo)
+	headID := func() string {
+		ci, err := pachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		return ci.Commit.ID
+	}
+
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("content1")))
+	version1 := headID()
+	require.NoError(t, pachClient.PutFile(commit, "file", strings.NewReader("content2")))
+	version2 := headID()
+	require.NoError(t, pachClient.PutFile(commit, "other", strings.NewReader("other")))
+	version3 := headID()
+	require.NoError(t, pachClient.DeleteFile(commit, "file"))
+	version4 := headID()
+	require.NoError(t, pachClient.PutFile(commit, "dir/file", strings.NewReader("dir")))
+	version5 := headID()
+
+	var versioning struct {
+		Status string `xml:"Status"`
+	}
+	require.Equal(t, http.StatusOK, getXML(t, minioClient, bucket, "versioning", &versioning))
+	require.Equal(t, "Enabled", versioning.Status)
+
+	// each version of the object is readable from its commit
+	require.Equal(t, "content1", getObjectVersion(t, minioClient, bucket, "file", version1))
+	require.Equal(t, "content2", getObjectVersion(t, minioClient, bucket, "file", version2))
+	require.Equal(t, "content2", getObjectVersion(t, minioClient, bucket, "file", version3))
+
+	type listedVersion struct {
+		Key      string `xml:"Key"`
+		Version  string `xml:"VersionId"`
+		IsLatest bool   `xml:"IsLatest"`
+	}
+	type listedVersions struct {
+		IsTruncated         bool            `xml:"IsTruncated"`
+		NextKeyMarker       string          `xml:"NextKeyMarker"`
+		NextVersionIDMarker string          `xml:"NextVersionIdMarker"`
+		Versions            []listedVersion `xml:"Version"`
+		DeleteMarkers       []listedVersion `xml:"DeleteMarker"`
+		CommonPrefixes      []string        `xml:"CommonPrefixes>Prefix"`
+	}
+	var versions listedVersions
+	require.Equal(t, http.StatusOK, getXML(t, minioClient, bucket, "versions", &versions))
+	require.Equal(t, []listedVersion{
+		{Key: "dir/file", Version: version5, IsLatest: true},
+		{Key: "file", Version: version2},
+		{Key: "file", Version: version1},
+		{Key: "other", Version: version3, IsLatest: true},
+	}, versions.Versions)
+	require.Equal(t, []listedVersion{
+		{Key: "file", Version: version4, IsLatest: true},
+	}, versions.DeleteMarkers)
+	require.Equal(t, 0, len(versions.CommonPrefixes))
+
+	// with a delimiter, the keys under a directory are rolled up into a
+	// common prefix
+	versions = listedVersions{}
+	require.Equal(t, http.StatusOK, getXML(t, minioClient, bucket, "versions&delimiter=/", &versions))
+	require.Equal(t, []string{"dir/"}, versions.CommonPrefixes)
+	require.Equal(t, 3, len(versions.Versions))
+	require.Equal(t, 1, len(versions.DeleteMarkers))
+
+	// a page ends after max-keys versions, and the next one starts after its
+	// markers
+	versions = listedVersions{}
+	require.Equal(t, http.StatusOK, getXML(t, minioClient, bucket, "versions&max-keys=2", &versions))
+	require.True(t, versions.IsTruncated)
+	require.Equal(t, "file", versions.NextKeyMarker)
+	require.Equal(t, version4, versions.NextVersionIDMarker)
+	require.Equal(t, []listedVersion{
+		{Key: "dir/file", Version: version5, IsLatest: true},
+	}, versions.Versions)
+	versions = listedVersions{}
+	require.Equal(t, http.StatusOK, getXML(t, minioClient, bucket, fmt.Sprintf("versions&max-keys=2&key-marker=file&version-id-marker=%s", version4), &versions))
+	require.True(t, versions.IsTruncated)
+	require.Equal(t, []listedVersion{
+		{Key: "file", Version: version2},
+		{Key: "file", Version: version1},
+	}, versions.Versions)
+	require.Equal(t, 0, len(versions.DeleteMarkers))
+}
+
+func masterPresignedURL(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testpresignedurl")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, pachClient.PutFile(commit, "dir/file", strings.NewReader("content")))
+	require.NoError(t, pachClient.PutFile(commit, "other", strings.NewReader("other")))
+	bucket := fmt.Sprintf("master.%s", repo)
+	presignURL := func(key string, now time.Time, expires time.Duration) string {
+		u, err := presign.URL(testPresignKey, presign.Scope{Bucket: bucket, Key: key}, now, expires)
+		require.NoError(t, err)
+		return u
+	}
+
+	u := presignURL("dir/file", time.Now(), time.Hour)
+	status, body := getPresignedURL(t, minioClient, u)
+	require.Equal(t, http.StatusOK, status, body)
+	require.Equal(t, "content", body)
+
+	// the URL is only valid for the object it was minted for
+	status, _ = getPresignedURL(t, minioClient, strings.Replace(u, "dir/file", "other", 1))
+	require.Equal(t, http.StatusForbidden, status)
+
+	// the signature covers the query
+	status, _ = getPresignedURL(t, minioClient, strings.Replace(u, "X-Amz-Expires=3600", "X-Amz-Expires=3601", 1))
+	require.Equal(t, http.StatusForbidden, status)
+
+	status, _ = getPresignedURL(t, minioClient, presignURL("dir/file", time.Now().Add(-2*time.Hour), time.Hour))
+	require.Equal(t, http.StatusForbidden, status)
+
+	// URLs minted with a different key are rejected
+	u, err := presign.URL([]byte("other key"), presign.Scope{Bucket: bucket, Key: "dir/file"}, time.Now(), time.Hour)
+	require.NoError(t, err)
+	status, _ = getPresignedURL(t, minioClient, u)
+	require.Equal(t, http.StatusForbidden, status)
+}
+
 // TODO: This should be readded as an integration test (probably in src/server/pachyderm_test.go).
 // Commenting out for now to enable the other tests to run against mock pachd.
 //func masterAuthV2(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
@@ -526,13 +715,15 @@ func masterResolveSystemRepoBucket(t *testing.T, pachClient *client.APIClient, m
 //	require.NoError(t, err)
 //}
 
+var testPresignKey = []byte("test presign key")
+
 func TestMasterDriver(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
 	t.Parallel()
 	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-	testRunner(t, env.PachClient, "master", NewMasterDriver(), func(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	testRunner(t, env.PachClient, "master", NewMasterDriver(testPresignKey), func(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 		t.Run("ListBuckets", func(t *testing.T) {
 			masterListBuckets(t, pachClient, minioClient)
 		})
@@ -605,6 +796,18 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("ResolveSystemRepoBucket", func(t *testing.T) {
 			masterResolveSystemRepoBucket(t, pachClient, minioClient)
 		})
+		t.Run("GetObjectRange", func(t *testing.T) {
+			masterGetObjectRange(t, pachClient, minioClient)
+		})
+		t.Run("GetObjectConditional", func(t *testing.T) {
+			masterGetObjectConditional(t, pachClient, minioClient)
+		})
+		t.Run("ObjectVersions", func(t *testing.T) {
+			masterObjectVersions(t, pachClient, minioClient)
+		})
+		t.Run("PresignedURL", func(t *testing.T) {
+			masterPresignedURL(t, pachClient, minioClient)
+		})
 		// TODO: Refer to masterAuthV2 function definition.
 		//t.Run("AuthV2", func(t *testing.T) {
 		//	masterAuthV2(t, pachClient, minioClient)
@@ -35,16 +35,20 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, s2.NoSuchKeyError(r)
 	}
 
-	commitID := bucket.Commit.ID
+	// Object versions are the IDs of the commits on the bucket's branch
+	commit := bucket.Commit
 	if version != "" {
 		if !bucketCaps.historicVersions {
 			return nil, s2.NotImplementedError(r)
 		}
-		commitID = version
+		commit = bucket.Commit.Branch.NewCommit(version)
 	}
 
-	fileInfo, err := pc.InspectFile(bucket.Commit, file)
+	fileInfo, err := pc.InspectFile(commit, file)
 	if err != nil {
+		if version != "" && pfsServer.IsCommitNotFoundErr(err) {
+			return nil, s2.NoSuchVersionError(r)
+		}
 		return nil, maybeNotFoundError(r, err)
 	}
 
@@ -53,7 +57,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, err
 	}
 
-	content, err := pc.GetFileReadSeeker(bucket.Commit, file)
+	content, err := pc.GetFileReadSeeker(commit, file)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +66,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		ModTime:      modTime,
 		Content:      content,
 		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
-		Version:      commitID,
+		Version:      fileInfo.File.Commit.ID,
 		DeleteMarker: false,
 	}
 
@@ -0,0 +1,241 @@
+// Package presign mints and verifies the SigV4 presigned URLs which the s3
+// gateway accepts in place of a Pachyderm token. It is separate from the s3
+// package so that pachd's PFS server can mint URLs without depending on the
+// gateway.
+package presign
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const (
+	// Algorithm is the only signing algorithm which presigned URLs can use.
+	Algorithm = "AWS4-HMAC-SHA256"
+	// DateFormat is the format of a presigned URL's X-Amz-Date parameter.
+	DateFormat = "20060102T150405Z"
+	// MaxExpires is the longest a presigned URL can be valid for. This is the
+	// same limit as S3's.
+	MaxExpires = 7 * 24 * time.Hour
+
+	// accessKeyPrefix marks the access keys minted by URL, which hold a
+	// sealed Scope instead of a Pachyderm token.
+	accessKeyPrefix = "PACHPRESIGN"
+	// location is the region of the s3 gateway.
+	location        = "PACHYDERM"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// Scope is sealed in the access key of a URL minted by URL. It only grants
+// access to a single object, so that the token isn't handed out with the URL.
+type Scope struct {
+	Token  string `json:"token,omitempty"`
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+}
+
+// BucketName returns the name of the bucket which the s3 gateway serves
+// branch as.
+func BucketName(branch *pfs.Branch) string {
+	if branch.Repo.Type == pfs.UserRepoType {
+		return fmt.Sprintf("%s.%s", branch.Name, branch.Repo.Name)
+	}
+	return fmt.Sprintf("%s.%s.%s", branch.Name, branch.Repo.Type, branch.Repo.Name)
+}
+
+// URL returns the path and query of a presigned URL, which gets the object in
+// scope as its token until expires has passed. The URL is relative to the
+// address of an s3 gateway configured with key.
+func URL(key []byte, scope Scope, now time.Time, expires time.Duration) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("presigned URLs are not enabled")
+	}
+	if expires < time.Second || expires > MaxExpires {
+		return "", errors.Errorf("presigned URL expiration must be between 1s and %v", MaxExpires)
+	}
+	accessKey, err := seal(key, scope)
+	if err != nil {
+		return "", err
+	}
+	now = now.UTC()
+	date := now.Format("20060102")
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", Algorithm)
+	query.Set("X-Amz-Credential", fmt.Sprintf("%s/%s/%s/s3/aws4_request", accessKey, date, location))
+	query.Set("X-Amz-Date", now.Format(DateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	// The host isn't signed, because pachd doesn't know the address that
+	// clients use to reach the s3 gateway.
+	query.Set("X-Amz-SignedHeaders", "")
+	p := fmt.Sprintf("/%s/%s", scope.Bucket, scope.Key)
+	signature := Signature(Secret(key, accessKey), http.MethodGet, p, query, nil, date, location)
+	query.Set("X-Amz-Signature", signature)
+	return canonicalURI(p) + "?" + canonicalQuery(query), nil
+}
+
+// IsSealed returns whether accessKey was minted by URL.
+func IsSealed(accessKey string) bool {
+	return strings.HasPrefix(accessKey, accessKeyPrefix)
+}
+
+// Secret returns the secret key of an access key minted by URL.
+func Secret(key []byte, accessKey string) string {
+	return hex.EncodeToString(hmacSHA256(hmacSHA256(key, "sign"), accessKey))
+}
+
+// Open returns the scope sealed in an access key minted by URL.
+func Open(key []byte, accessKey string) (*Scope, error) {
+	if len(key) == 0 {
+		return nil, errors.New("presigned URLs are not enabled")
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(accessKey, accessKeyPrefix))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("presigned access key is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	data, err := aead.Open(nil, nonce, ciphertext, []byte(accessKeyPrefix))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	scope := &Scope{}
+	if err := json.Unmarshal(data, scope); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return scope, nil
+}
+
+// Signature computes the SigV4 signature of a presigned request. The
+// timestamp is the X-Amz-Date in query, and header returns the values of the
+// signed headers listed in query.
+func Signature(secretKey, method, path string, query url.Values, header func(string) string, date, region string) string {
+	var signedHeaderKeys []string
+	if h := query.Get("X-Amz-SignedHeaders"); h != "" {
+		signedHeaderKeys = strings.Split(h, ";")
+		sort.Strings(signedHeaderKeys)
+	}
+	var signedHeaders strings.Builder
+	for _, key := range signedHeaderKeys {
+		signedHeaders.WriteString(key)
+		signedHeaders.WriteString(":")
+		signedHeaders.WriteString(strings.TrimSpace(header(key)))
+		signedHeaders.WriteString("\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI(path),
+		canonicalQuery(query),
+		signedHeaders.String(),
+		strings.Join(signedHeaderKeys, ";"),
+		unsignedPayload,
+	}, "\n")
+	stringToSign := fmt.Sprintf(
+		"%s\n%s\n%s/%s/s3/aws4_request\n%x",
+		Algorithm,
+		query.Get("X-Amz-Date"),
+		date,
+		region,
+		sha256.Sum256([]byte(canonicalRequest)),
+	)
+	dateKey := hmacSHA256([]byte("AWS4"+secretKey), date)
+	dateRegionKey := hmacSHA256(dateKey, region)
+	dateRegionServiceKey := hmacSHA256(dateRegionKey, "s3")
+	signingKey := hmacSHA256(dateRegionServiceKey, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(hmacSHA256(key, "seal"))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.EnsureStack(err)
+}
+
+func seal(key []byte, scope Scope) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(scope)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	sealed := aead.Seal(nonce, nonce, data, []byte(accessKeyPrefix))
+	return accessKeyPrefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+func hmacSHA256(key []byte, content string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
+
+// canonicalURI encodes each segment of a path the way SigV4 does.
+func canonicalURI(p string) string {
+	parts := strings.Split(p, "/")
+	for i := range parts {
+		parts[i] = uriEncode(parts[i])
+	}
+	return strings.Join(parts, "/")
+}
+
+// canonicalQuery encodes a query the way SigV4 does, with sorted keys.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vs := append([]string(nil), query[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode escapes everything but the unreserved characters, as SigV4 does.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package presign
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestURL(t *testing.T) {
+	key := []byte("presign key")
+	scope := Scope{Token: "token", Bucket: "master.repo", Key: "dir/a file"}
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	u, err := URL(key, scope, now, time.Hour)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(u, "/master.repo/dir/a%20file?"))
+
+	parsed, err := url.Parse(u)
+	require.NoError(t, err)
+	query := parsed.Query()
+	require.Equal(t, Algorithm, query.Get("X-Amz-Algorithm"))
+	require.Equal(t, "20220501T120000Z", query.Get("X-Amz-Date"))
+	require.Equal(t, "3600", query.Get("X-Amz-Expires"))
+
+	// the access key holds the scope, which can only be opened with the key
+	credential := strings.Split(query.Get("X-Amz-Credential"), "/")
+	require.Equal(t, 5, len(credential))
+	require.Equal(t, "20220501", credential[1])
+	require.True(t, IsSealed(credential[0]))
+	require.False(t, strings.Contains(credential[0], scope.Token))
+	opened, err := Open(key, credential[0])
+	require.NoError(t, err)
+	require.Equal(t, scope, *opened)
+	_, err = Open([]byte("other key"), credential[0])
+	require.YesError(t, err)
+	_, err = Open(key, credential[0][:len(credential[0])-1])
+	require.YesError(t, err)
+
+	// the signature can be recomputed from the request
+	signature := query.Get("X-Amz-Signature")
+	query.Del("X-Amz-Signature")
+	require.Equal(t, signature, Signature(Secret(key, credential[0]), http.MethodGet, parsed.Path, query, nil, credential[1], credential[2]))
+	query.Set("X-Amz-Expires", "3601")
+	require.NotEqual(t, signature, Signature(Secret(key, credential[0]), http.MethodGet, parsed.Path, query, nil, credential[1], credential[2]))
+}
+
+func TestURLErrors(t *testing.T) {
+	scope := Scope{Bucket: "master.repo", Key: "file"}
+	_, err := URL(nil, scope, time.Now(), time.Hour)
+	require.YesError(t, err)
+	_, err = URL([]byte("key"), scope, time.Now(), 0)
+	require.YesError(t, err)
+	_, err = URL([]byte("key"), scope, time.Now(), MaxExpires+time.Second)
+	require.YesError(t, err)
+}
@@ -29,6 +29,8 @@ const (
 	maxRequestBodyLength = 128 * 1024 * 1024 //128mb
 	requestTimeout       = 10 * time.Second
 	readBodyTimeout      = 5 * time.Second
+	// the maximum and default number of keys listed at once, like s2's
+	defaultMaxKeys = 1000
 
 	// The S3 storage class that all PFS content will be reported to be stored in
 	globalStorageClass = "STANDARD"
@@ -53,6 +55,10 @@ type controller struct {
 	driver Driver
 
 	clientFactory ClientFactory
+
+	// presignKey verifies the presigned URLs minted by pachd. They are
+	// rejected if it is empty.
+	presignKey []byte
 }
 
 // requestPachClient uses the clientFactory to construct a request-scoped
@@ -103,6 +109,7 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 		maxAllowedParts: maxAllowedParts,
 		driver:          driver,
 		clientFactory:   clientFactory,
+		presignKey:      driver.presignKey(),
 	}
 
 	s3Server := s2.NewS2(logger, maxRequestBodyLength, readBodyTimeout)
@@ -111,7 +118,9 @@ func Router(driver Driver, clientFactory ClientFactory) *mux.Router {
 	s3Server.Bucket = c
 	s3Server.Object = c
 	s3Server.Multipart = c
-	return s3Server.Router()
+	router := s3Server.Router()
+	router.Use(c.listVersionsMiddleware)
+	return router
 }
 
 // S3Server wraps an HTTP server with an S3-like API for PFS. This allows you to
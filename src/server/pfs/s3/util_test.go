@@ -3,10 +3,13 @@ package s3
 import (
 	"context"
 	"crypto/md5"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -35,6 +38,72 @@ func getObject(t *testing.T, minioClient *minio.Client, bucket, file string) (st
 	return string(bytes), err
 }
 
+// getObjectWithHeaders gets an object with the given request headers, and
+// returns the response status and body. The request is made directly to the
+// gateway, so that the response status is not interpreted by the client.
+func getObjectWithHeaders(t *testing.T, minioClient *minio.Client, bucket, file string, headers map[string]string) (int, string) {
+	t.Helper()
+
+	u := minioClient.EndpointURL()
+	u.Path = fmt.Sprintf("/%s/%s", bucket, file)
+	req, err := http.NewRequest("GET", u.String(), nil)
+	require.NoError(t, err)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(bytes)
+}
+
+// getObjectVersion gets a version of an object. The minio client doesn't
+// support versions, so the request is made directly to the gateway.
+func getObjectVersion(t *testing.T, minioClient *minio.Client, bucket, file, version string) string {
+	t.Helper()
+
+	u := minioClient.EndpointURL()
+	u.Path = fmt.Sprintf("/%s/%s", bucket, file)
+	u.RawQuery = url.Values{"versionId": []string{version}}.Encode()
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(bytes))
+	return string(bytes)
+}
+
+// getPresignedURL gets a presigned URL, which is relative to the gateway, and
+// returns the response status and body.
+func getPresignedURL(t *testing.T, minioClient *minio.Client, presignedURL string) (int, string) {
+	t.Helper()
+
+	resp, err := http.Get(minioClient.EndpointURL().String() + presignedURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(bytes)
+}
+
+// getXML makes a bucket request with the given subresource directly to the
+// gateway, and decodes the response into v.
+func getXML(t *testing.T, minioClient *minio.Client, bucket, subresource string, v interface{}) int {
+	t.Helper()
+
+	u := minioClient.EndpointURL()
+	u.Path = fmt.Sprintf("/%s/", bucket)
+	u.RawQuery = subresource
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(v))
+	return resp.StatusCode
+}
+
 func checkListObjects(t *testing.T, ch <-chan minio.ObjectInfo, startTime *time.Time, endTime *time.Time, expectedFiles []string, expectedDirs []string) {
 	t.Helper()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 
+	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
@@ -23,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
@@ -33,6 +35,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/s3/presign"
 	taskapi "github.com/pachyderm/pachyderm/v2/src/task"
 )
 
@@ -134,7 +137,7 @@ func (a *apiServer) ListRepo(request *pfs.ListRepoRequest, srv pfs.API_ListRepoS
 // DeleteRepoInTransaction is identical to DeleteRepo except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) DeleteRepoInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.DeleteRepoRequest) error {
-	return a.driver.deleteRepo(txnCtx, request.Repo, request.Force)
+	return a.driver.deleteRepo(txnCtx, request.Repo, request.Force, request.Shred)
 }
 
 // DeleteRepo implements the protobuf pfs.DeleteRepo RPC
@@ -150,6 +153,9 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
+	if err := a.driver.checkBranchHead(txnCtx, request.Branch, request.ExpectedHead); err != nil {
+		return nil, err
+	}
 	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description)
 }
 
@@ -161,7 +167,7 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 		commit, err = txn.StartCommit(request)
 		return errors.EnsureStack(err)
 	}, nil); err != nil {
-		return nil, errors.EnsureStack(err)
+		return nil, pacherr.UnwrapConflict(errors.EnsureStack(err))
 	}
 	return commit, nil
 }
@@ -170,6 +176,15 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) FinishCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.FinishCommitRequest) error {
 	return metrics.ReportRequest(func() error {
+		if request.ExpectedHead != "" {
+			commitInfo, err := a.driver.resolveCommit(txnCtx.SqlTx, proto.Clone(request.Commit).(*pfs.Commit))
+			if err != nil {
+				return err
+			}
+			if err := a.driver.checkBranchHead(txnCtx, commitInfo.Commit.Branch, request.ExpectedHead); err != nil {
+				return err
+			}
+		}
 		return a.driver.finishCommit(txnCtx, request.Commit, request.Description, request.Error, request.Force)
 	})
 }
@@ -179,7 +194,7 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	if err := a.env.TxnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
 		return errors.EnsureStack(txn.FinishCommit(request))
 	}, nil); err != nil {
-		return nil, err
+		return nil, pacherr.UnwrapConflict(err)
 	}
 	return &types.Empty{}, nil
 }
@@ -225,7 +240,7 @@ func (a *apiServer) ListCommitSet(request *pfs.ListCommitSetRequest, serv pfs.AP
 // SquashCommitSetInTransaction is identical to SquashCommitSet except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) SquashCommitSetInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.SquashCommitSetRequest) error {
-	return a.driver.squashCommitSet(txnCtx, request.CommitSet)
+	return a.driver.squashCommitSet(txnCtx, request.CommitSet, request.Force)
 }
 
 // SquashCommitSet implements the protobuf pfs.SquashCommitSet RPC
@@ -241,7 +256,7 @@ func (a *apiServer) SquashCommitSet(ctx context.Context, request *pfs.SquashComm
 // DropCommitSet implements the protobuf pfs.DropCommitSet RPC
 func (a *apiServer) DropCommitSet(ctx context.Context, request *pfs.DropCommitSetRequest) (response *types.Empty, retErr error) {
 	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.driver.dropCommitSet(txnCtx, request.CommitSet)
+		return a.driver.dropCommitSet(txnCtx, request.CommitSet, request.Force)
 	}); err != nil {
 		return nil, err
 	}
@@ -261,6 +276,9 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
+	if err := a.driver.checkBranchHead(txnCtx, request.Branch, request.ExpectedHead); err != nil {
+		return err
+	}
 	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
 }
 
@@ -288,7 +306,7 @@ func (a *apiServer) CreateBranch(ctx context.Context, request *pfs.CreateBranchR
 		}
 		return commitInfo.Commit.ID, nil
 	}); err != nil {
-		return nil, err
+		return nil, pacherr.UnwrapConflict(err)
 	}
 	return &types.Empty{}, nil
 }
@@ -336,6 +354,188 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	response, err := a.driver.mergeBranch(ctx, request.Source, request.Target, request.Strategy, request.Description)
+	return response, pacherr.UnwrapConflict(err)
+}
+
+// RevertCommit implements the protobuf pfs.RevertCommit RPC
+func (a *apiServer) RevertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (response *pfs.Commit, retErr error) {
+	return a.driver.revertCommit(ctx, request.Commit, request.Branch, request.Restore, request.Description)
+}
+
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createTag(txnCtx, request.Tag, request.Commit, request.Description)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (response *pfs.TagInfo, retErr error) {
+	var tagInfo *pfs.TagInfo
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		tagInfo, err = a.driver.inspectTag(txnCtx, request.Tag)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(request *pfs.ListTagRequest, srv pfs.API_ListTagServer) (retErr error) {
+	return a.driver.listTag(srv.Context(), request.Repo, srv.Send)
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteTag(txnCtx, request.Tag)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SetRetentionPolicy implements the protobuf pfs.SetRetentionPolicy RPC
+func (a *apiServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.setRetentionPolicy(txnCtx, request.Repo, request.Branch, request.Policy)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// EnforceRetention implements the protobuf pfs.EnforceRetention RPC
+func (a *apiServer) EnforceRetention(ctx context.Context, request *pfs.EnforceRetentionRequest) (response *pfs.EnforceRetentionResponse, retErr error) {
+	commitSets, err := a.driver.enforceRetention(ctx, request.Repo, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.EnforceRetentionResponse{CommitSets: commitSets}, nil
+}
+
+// CreateReplication implements the protobuf pfs.CreateReplication RPC
+func (a *apiServer) CreateReplication(ctx context.Context, request *pfs.CreateReplicationRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createReplication(txnCtx, request.Replication, request.AuthToken, request.Update)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectReplication implements the protobuf pfs.InspectReplication RPC
+func (a *apiServer) InspectReplication(ctx context.Context, request *pfs.InspectReplicationRequest) (response *pfs.ReplicationInfo, retErr error) {
+	var replicationInfo *pfs.ReplicationInfo
+	if err := a.env.TxnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		replicationInfo, err = a.driver.inspectReplication(txnCtx, request.Repo)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return replicationInfo, nil
+}
+
+// ListReplication implements the protobuf pfs.ListReplication RPC
+func (a *apiServer) ListReplication(request *pfs.ListReplicationRequest, srv pfs.API_ListReplicationServer) (retErr error) {
+	return a.driver.listReplication(srv.Context(), srv.Send)
+}
+
+// DeleteReplication implements the protobuf pfs.DeleteReplication RPC
+func (a *apiServer) DeleteReplication(ctx context.Context, request *pfs.DeleteReplicationRequest) (response *types.Empty, retErr error) {
+	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteReplication(txnCtx, request.Repo)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// FindMissingChunks implements the protobuf pfs.FindMissingChunks RPC
+func (a *apiServer) FindMissingChunks(ctx context.Context, request *pfs.FindMissingChunksRequest) (response *pfs.FindMissingChunksResponse, retErr error) {
+	missing, err := a.driver.findMissingChunks(ctx, request.ChunkIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.FindMissingChunksResponse{ChunkIds: missing}, nil
+}
+
+// ReplicateCommit implements the protobuf pfs.ReplicateCommit RPC
+func (a *apiServer) ReplicateCommit(server pfs.API_ReplicateCommitServer) (retErr error) {
+	r := &archiveReader{recv: func() ([]byte, error) {
+		msg, err := server.Recv()
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return msg.Data, nil
+	}}
+	if err := a.driver.replicateCommit(server.Context(), r); err != nil {
+		return err
+	}
+	return errors.EnsureStack(server.SendAndClose(&types.Empty{}))
+}
+
+// ExportRepo implements the protobuf pfs.ExportRepo RPC
+func (a *apiServer) ExportRepo(request *pfs.ExportRepoRequest, server pfs.API_ExportRepoServer) (retErr error) {
+	return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
+		return a.driver.exportRepo(server.Context(), request.Repo, request.ReferencesOnly, w)
+	})
+}
+
+// ImportRepo implements the protobuf pfs.ImportRepo RPC
+func (a *apiServer) ImportRepo(server pfs.API_ImportRepoServer) (retErr error) {
+	msg, err := server.Recv()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if msg.Repo == nil {
+		return errors.Errorf("first message must be a repo")
+	}
+	r := &archiveReader{
+		recv: func() ([]byte, error) {
+			msg, err := server.Recv()
+			if err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			return msg.Data, nil
+		},
+		buf: msg.Data,
+	}
+	if err := a.driver.importRepo(server.Context(), msg.Repo, r); err != nil {
+		return err
+	}
+	return errors.EnsureStack(server.SendAndClose(&types.Empty{}))
+}
+
+// archiveReader reads an archive sent in the data of ImportRepo or
+// ReplicateCommit requests, which are received by recv.
+type archiveReader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *archiveReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	commit, err := readCommit(server)
 	if err != nil {
@@ -361,6 +561,26 @@ type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
 
+// peekedModifyFileSource is a modifyFileSource whose first message has
+// already been received.
+type peekedModifyFileSource struct {
+	modifyFileSource
+	msg *pfs.ModifyFileRequest
+	eof bool
+}
+
+func (s *peekedModifyFileSource) Recv() (*pfs.ModifyFileRequest, error) {
+	if s.eof {
+		return nil, io.EOF
+	}
+	if s.msg != nil {
+		msg := s.msg
+		s.msg = nil
+		return msg, nil
+	}
+	return s.modifyFileSource.Recv()
+}
+
 // modifyFile reads from a modifyFileSource until io.EOF and writes changes to an UnorderedWriter.
 // SetCommit messages will result in an error.
 func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (int64, error) {
@@ -512,7 +732,7 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		if err := src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
 			n = fileset.SizeFromIndex(file.Index())
 			return grpcutil.WithStreamingBytesWriter(server, func(w io.Writer) error {
-				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset)))
+				return errors.EnsureStack(file.Content(ctx, w, chunk.WithOffsetBytes(request.Offset), chunk.WithSizeBytes(request.SizeBytes)))
 			})
 		}); err != nil {
 			return 0, errors.EnsureStack(err)
@@ -588,6 +808,39 @@ func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileReq
 	return a.driver.inspectFile(ctx, request.File)
 }
 
+// PresignFile implements the protobuf pfs.PresignFile RPC
+func (a *apiServer) PresignFile(ctx context.Context, request *pfs.PresignFileRequest) (response *pfs.PresignFileResponse, retErr error) {
+	expires, err := types.DurationFromProto(request.Expires)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	fi, err := a.driver.inspectFile(ctx, request.File)
+	if err != nil {
+		return nil, err
+	}
+	if fi.FileType != pfs.FileType_FILE {
+		return nil, errors.Errorf("cannot presign %q, which is not a file", request.File.Path)
+	}
+	// The URL is used as the caller, so that it stops working if the caller
+	// loses access to the repo.
+	token, err := auth.GetAuthToken(ctx)
+	if err != nil && !errors.Is(err, auth.ErrNoMetadata) && !errors.Is(err, auth.ErrNotSignedIn) {
+		return nil, errors.EnsureStack(err)
+	}
+	// The bucket is the branch, or the commit if one was given, as the s3
+	// gateway names them.
+	bucket := presign.BucketName(fi.File.Commit.Branch)
+	if request.File.Commit.ID != "" {
+		bucket = fi.File.Commit.ID + "." + bucket
+	}
+	key := strings.TrimPrefix(fi.File.Path, "/")
+	presigned, err := presign.URL(a.env.S3PresignKey, presign.Scope{Token: token, Bucket: bucket, Key: key}, time.Now(), expires)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.PresignFileResponse{URL: presigned}, nil
+}
+
 // ListFile implements the protobuf pfs.ListFile RPC
 func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFileServer) (retErr error) {
 	return a.driver.listFile(server.Context(), request.File, func(fi *pfs.FileInfo) error {
@@ -639,8 +892,26 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 
 // CreateFileSet implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileSet(server pfs.API_CreateFileSetServer) (retErr error) {
-	fsID, err := a.driver.createFileSet(server.Context(), func(uw *fileset.UnorderedWriter) error {
-		_, err := a.modifyFile(server.Context(), uw, server)
+	// The stream may start by setting the commit that the file set will be
+	// added to, in which case the file set is encrypted with its repo's key.
+	var repo *pfs.Repo
+	src := &peekedModifyFileSource{modifyFileSource: server}
+	msg, err := server.Recv()
+	if err != nil && err != io.EOF {
+		return errors.EnsureStack(err)
+	}
+	if err == io.EOF {
+		src.eof = true
+	} else if setCommit, ok := msg.Body.(*pfs.ModifyFileRequest_SetCommit); ok {
+		if setCommit.SetCommit.GetBranch().GetRepo() == nil {
+			return errors.Errorf("commit must have a repo")
+		}
+		repo = setCommit.SetCommit.Branch.Repo
+	} else {
+		src.msg = msg
+	}
+	fsID, err := a.driver.createFileSet(server.Context(), repo, func(uw *fileset.UnorderedWriter) error {
+		_, err := a.modifyFile(server.Context(), uw, src)
 		return err
 	})
 	if err != nil {
@@ -661,15 +932,19 @@ func (a *apiServer) GetFileSet(ctx context.Context, req *pfs.GetFileSetRequest)
 	}, nil
 }
 
+// AddFileSet implements the pfs.AddFileSet RPC
 func (a *apiServer) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest) (_ *types.Empty, retErr error) {
-	if err := a.env.TxnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.AddFileSetInTransaction(txnCtx, req)
-	}); err != nil {
+	if err := a.env.TxnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.AddFileSet(req))
+	}, nil); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
 }
 
+// AddFileSetInTransaction is identical to AddFileSet except that it can run
+// inside an existing postgres transaction.  This is not an RPC.  The file set
+// must be kept alive until the transaction is finished.
 func (a *apiServer) AddFileSetInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.AddFileSetRequest) error {
 	fsid, err := fileset.ParseID(request.FileSetId)
 	if err != nil {
@@ -753,6 +1028,12 @@ func (a *apiServer) ClearCache(ctx context.Context, req *pfs.ClearCacheRequest)
 	return &types.Empty{}, nil
 }
 
+// InspectStorage reports logical and physical storage usage for the admin API.
+// This is not an RPC.
+func (a *apiServer) InspectStorage(ctx context.Context, request *admin.InspectStorageRequest) (*admin.StorageInfo, error) {
+	return a.driver.inspectStorage(ctx, request)
+}
+
 // RunLoadTest implements the pfs.RunLoadTest RPC
 func (a *apiServer) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest) (_ *pfs.RunLoadTestResponse, retErr error) {
 	pachClient := a.env.GetPachClient(ctx)
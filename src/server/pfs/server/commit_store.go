@@ -36,6 +36,8 @@ type commitStore interface {
 	DropFileSets(ctx context.Context, commit *pfs.Commit) error
 	// DropFileSetsTx is identical to DropFileSets except it runs in the provided transaction.
 	DropFileSetsTx(tx *pachsql.Tx, commit *pfs.Commit) error
+	// TrackerIDs returns the IDs of the tracker objects that keep the commit's filesets alive.
+	TrackerIDs(ctx context.Context, commit *pfs.Commit) ([]string, error)
 }
 
 var _ commitStore = &postgresCommitStore{}
@@ -149,6 +151,32 @@ func (cs *postgresCommitStore) DropFileSetsTx(tx *pachsql.Tx, commit *pfs.Commit
 	return cs.dropDiff(tx, commit)
 }
 
+func (cs *postgresCommitStore) TrackerIDs(ctx context.Context, commit *pfs.Commit) ([]string, error) {
+	var ids []string
+	if err := dbutil.WithTx(ctx, cs.db, func(tx *pachsql.Tx) error {
+		ids = nil
+		diffIDs, err := getDiff(tx, commit)
+		if err != nil {
+			return err
+		}
+		for _, diffID := range diffIDs {
+			ids = append(ids, commitDiffTrackerID(commit, diffID))
+		}
+		totalID, err := getTotal(tx, commit)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		ids = append(ids, commitTotalTrackerID(commit, *totalID))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (cs *postgresCommitStore) dropDiff(tx *pachsql.Tx, commit *pfs.Commit) error {
 	diffIDs, err := getDiff(tx, commit)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/task"
@@ -123,6 +124,7 @@ func (c *compactor) processCompactTasks(ctx context.Context, taskDoer task.Doer,
 	inputs := make([]*types.Any, len(tasks))
 	for i, task := range tasks {
 		task := proto.Clone(task).(*CompactTask)
+		task.EncryptionKeyId = chunk.EncryptionKeyID(ctx)
 		input, err := serializeCompactTask(task)
 		if err != nil {
 			return nil, err
@@ -162,7 +164,8 @@ func (c *compactor) concat(ctx context.Context, taskDoer task.Doer, renewer *fil
 		}
 		ids = ids[taskLen:]
 		input, err := serializeConcatTask(&ConcatTask{
-			Inputs: serInputs,
+			Inputs:          serInputs,
+			EncryptionKeyId: chunk.EncryptionKeyID(ctx),
 		})
 		if err != nil {
 			return nil, err
@@ -277,6 +280,9 @@ func processShardTask(ctx context.Context, storage *fileset.Storage, task *Shard
 
 func processCompactTask(ctx context.Context, storage *fileset.Storage, task *CompactTask) (*types.Any, error) {
 	result := &CompactTaskResult{}
+	if task.EncryptionKeyId != "" {
+		ctx = chunk.WithEncryptionKey(ctx, task.EncryptionKeyId)
+	}
 	if err := miscutil.LogStep("processing compact task", func() error {
 		ids, err := fileset.HexStringsToIDs(task.Inputs)
 		if err != nil {
@@ -302,6 +308,9 @@ func processCompactTask(ctx context.Context, storage *fileset.Storage, task *Com
 
 func processConcatTask(ctx context.Context, storage *fileset.Storage, task *ConcatTask) (*types.Any, error) {
 	result := &ConcatTaskResult{}
+	if task.EncryptionKeyId != "" {
+		ctx = chunk.WithEncryptionKey(ctx, task.EncryptionKeyId)
+	}
 	if err := miscutil.LogStep("processing concat task", func() error {
 		ids, err := fileset.HexStringsToIDs(task.Inputs)
 		if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
@@ -48,6 +49,7 @@ const (
 	fileSetsRepo         = client.FileSetsRepoName
 	defaultTTL           = client.DefaultTTL
 	maxTTL               = 30 * time.Minute
+	datumCacheMaxSize    = 10000
 )
 
 // IsPermissionError returns true if a given error is a permission error.
@@ -76,14 +78,20 @@ type driver struct {
 	prefix     string
 
 	// collections
-	repos    col.PostgresCollection
-	commits  col.PostgresCollection
-	branches col.PostgresCollection
+	repos        col.PostgresCollection
+	commits      col.PostgresCollection
+	branches     col.PostgresCollection
+	tags         col.PostgresCollection
+	replications col.PostgresCollection
 
+	tracker     track.Tracker
 	storage     *fileset.Storage
 	commitStore commitStore
 
 	cache *fileset.Cache
+	// datumCache holds the output of datums, which is reused across
+	// pipelines, apart from the entries of jobs.
+	datumCache *fileset.Cache
 }
 
 func newDriver(env Env) (*driver, error) {
@@ -100,20 +108,25 @@ func newDriver(env Env) (*driver, error) {
 	repos := pfsdb.Repos(env.DB, env.Listener)
 	commits := pfsdb.Commits(env.DB, env.Listener)
 	branches := pfsdb.Branches(env.DB, env.Listener)
+	tags := pfsdb.Tags(env.DB, env.Listener)
+	replications := pfsdb.Replications(env.DB, env.Listener)
 
 	// Setup driver struct.
 	d := &driver{
-		env:        env,
-		etcdClient: env.EtcdClient,
-		txnEnv:     env.TxnEnv,
-		prefix:     env.EtcdPrefix,
-		repos:      repos,
-		commits:    commits,
-		branches:   branches,
-		log:        env.Logger,
+		env:          env,
+		etcdClient:   env.EtcdClient,
+		txnEnv:       env.TxnEnv,
+		prefix:       env.EtcdPrefix,
+		repos:        repos,
+		commits:      commits,
+		branches:     branches,
+		tags:         tags,
+		replications: replications,
+		log:          env.Logger,
 	}
 	// Setup tracker and chunk / fileset storage.
 	tracker := track.NewPostgresTracker(env.DB)
+	d.tracker = tracker
 	chunkStorageOpts, err := chunk.StorageOptions(&storageConfig)
 	if err != nil {
 		return nil, err
@@ -132,7 +145,8 @@ func newDriver(env Env) (*driver, error) {
 	go compactionWorker(env.BackgroundContext, taskSource, d.storage)
 	d.commitStore = newPostgresCommitStore(env.DB, tracker, d.storage)
 	// TODO: Make the cache max size configurable.
-	d.cache = fileset.NewCache(env.DB, tracker, 10000)
+	d.cache = fileset.NewCache(env.DB, tracker, 10000, fileset.WithoutCacheTags(pfs.DatumCacheTag))
+	d.datumCache = fileset.NewCache(env.DB, tracker, datumCacheMaxSize, fileset.WithCacheTag(pfs.DatumCacheTag))
 	return d, nil
 }
 
@@ -220,11 +234,18 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 				return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create role binding for new repo %q", repo)
 			}
 		}
-		return errors.EnsureStack(repos.Create(repo, &pfs.RepoInfo{
+		repoInfo := &pfs.RepoInfo{
 			Repo:        repo,
 			Created:     txnCtx.Timestamp,
 			Description: description,
-		}))
+		}
+		if d.env.StorageConfig.StorageRepoEncryptionKeys {
+			repoInfo.EncryptionKeyId = "repo/" + uuid.NewWithoutDashes()
+			if err := d.storage.ChunkStorage().CreateEncryptionKeyTx(txnCtx.SqlTx, repoInfo.EncryptionKeyId); err != nil {
+				return errors.Wrapf(err, "could not create encryption key for new repo %q", repo)
+			}
+		}
+		return errors.EnsureStack(repos.Create(repo, repoInfo))
 	}
 }
 
@@ -323,7 +344,7 @@ func (d *driver) deleteAllBranchesFromRepos(txnCtx *txncontext.TransactionContex
 	return nil
 }
 
-func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, force bool) error {
+func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, force, shred bool) error {
 	repos := d.repos.ReadWrite(txnCtx.SqlTx)
 
 	// check if 'repo' is already gone. If so, return that error. Otherwise,
@@ -335,6 +356,8 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		if !col.IsErrNotFound(err) {
 			return errors.Wrapf(err, "error checking whether %q exists", repo)
 		}
+	} else if shred && repoInfo.EncryptionKeyId == "" {
+		return errors.Errorf("cannot shred %q because it does not have its own encryption key", repo)
 	}
 
 	// Check if the caller is authorized to delete this repo
@@ -370,7 +393,7 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 
 		// delete the repos we found
 		for _, dep := range dependentRepos {
-			if err := d.deleteRepo(txnCtx, dep.Repo, force); err != nil {
+			if err := d.deleteRepo(txnCtx, dep.Repo, force, shred && dep.EncryptionKeyId != ""); err != nil {
 				return errors.Wrapf(err, "error deleting dependent repo %q", dep.Repo)
 			}
 		}
@@ -408,12 +431,30 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return errors.EnsureStack(err)
 	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.deleteReplicationInTransaction(txnCtx, repo); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
 	if err := repos.Delete(repo); err != nil && !col.IsErrNotFound(err) {
 		return errors.Wrapf(err, "repos.Delete")
 	}
+	// destroying the key makes the repo's data unreadable, even before it is
+	// garbage collected
+	if repoInfo.EncryptionKeyId != "" {
+		if err := chunk.DeleteEncryptionKeyTx(txnCtx.SqlTx, repoInfo.EncryptionKeyId); err != nil {
+			return errors.Wrapf(err, "error destroying encryption key of %q", repo)
+		}
+	}
 
 	// since system repos share a role binding, only delete it if this is the user repo, in which case the other repos will be deleted anyway
 	if repo.Type == pfs.UserRepoType {
+		// the cache entries of the repo's jobs, such as datum checkpoints,
+		// hold its data, so they are cleared with it
+		if err := d.cache.ClearTx(txnCtx.SqlTx, repo.Name+"@"); err != nil {
+			return errors.Wrapf(err, "error clearing the cache of %q", repo)
+		}
 		if err := d.env.AuthServer.DeleteRoleBindingInTransaction(txnCtx, &auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name}); err != nil && !auth.IsErrNotActivated(err) {
 			return grpcutil.ScrubGRPC(err)
 		}
@@ -470,6 +511,9 @@ func (d *driver) startCommit(
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(branch, branchInfo, func() error {
 		if branchInfo.Branch == nil {
+			if err := d.checkNotTag(txnCtx, branch); err != nil {
+				return err
+			}
 			// New branch, update the RepoInfo
 			add(&repoInfo.Branches, branch)
 			if err := d.repos.ReadWrite(txnCtx.SqlTx).Put(repoInfo.Repo, repoInfo); err != nil {
@@ -1006,9 +1050,21 @@ func (d *driver) resolveCommit(sqlTx *pachsql.Tx, userCommit *pfs.Commit) (*pfs.
 		// If commit.ID is unspecified, get it from the branch head
 		branchInfo := &pfs.BranchInfo{}
 		if err := d.branches.ReadWrite(sqlTx).Get(commit.Branch, branchInfo); err != nil {
-			return nil, errors.EnsureStack(err)
+			if !col.IsErrNotFound(err) {
+				return nil, errors.EnsureStack(err)
+			}
+			// The branch name may be a tag instead
+			tagInfo := &pfs.TagInfo{}
+			if tagErr := d.tags.ReadWrite(sqlTx).Get(commit.Branch.Repo.NewTag(commit.Branch.Name), tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, errors.EnsureStack(err)
+				}
+				return nil, errors.EnsureStack(tagErr)
+			}
+			commit = proto.Clone(tagInfo.Commit).(*pfs.Commit)
+		} else {
+			commit.ID = branchInfo.Head.ID
 		}
-		commit.ID = branchInfo.Head.ID
 	} else if commit.Branch.Name == "" {
 		// If the branch is unspecified, make sure the ID is unique (a repo may have
 		// one commit on each branch with the same ID) and load the branch name.
@@ -1526,7 +1582,7 @@ func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext,
 
 // dropCommitSet is only implemented for commits with no children, so if any
 // commits in the commitSet have children the operation will fail.
-func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet) error {
+func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet, force bool) error {
 	// Look up the commits in the CommitSet
 	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitset)
 	if err != nil {
@@ -1538,6 +1594,9 @@ func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset
 			return &pfsserver.ErrDropWithChildren{Commit: ci.Commit}
 		}
 	}
+	if err := d.checkTaggedCommits(txnCtx, commitInfos, force); err != nil {
+		return err
+	}
 
 	// While this is a 'drop' operation and not a 'squash', proper drop semantics
 	// aren't implemented at the moment.  Squashing the head of a branch is
@@ -1554,7 +1613,7 @@ func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset
 	return nil
 }
 
-func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet) error {
+func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet, force bool) error {
 	// Look up the commits in the CommitSet
 	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitset)
 	if err != nil {
@@ -1569,6 +1628,9 @@ func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitse
 			return &pfsserver.ErrSquashWithoutChildren{Commit: ci.Commit}
 		}
 	}
+	if err := d.checkTaggedCommits(txnCtx, commitInfos, force); err != nil {
+		return err
+	}
 
 	if err := d.squashCommitSetInternal(txnCtx, commitInfos); err != nil {
 		return err
@@ -1679,6 +1741,9 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	if err := ancestry.ValidateName(branch.Name); err != nil {
 		return err
 	}
+	if err := d.checkNotTag(txnCtx, branch); err != nil {
+		return err
+	}
 
 	// Retrieve (and create, if necessary) the current version of this branch
 	branchInfo := &pfs.BranchInfo{}
@@ -1846,6 +1911,31 @@ func (d *driver) inspectBranch(txnCtx *txncontext.TransactionContext, branch *pf
 	return result, nil
 }
 
+// checkBranchHead returns a conflict error if branch doesn't currently point
+// to the commit with ID expected. Branches which don't exist have an empty
+// head. An empty expected ID skips the check.
+func (d *driver) checkBranchHead(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	if branch == nil || branch.Repo == nil {
+		return errors.New("branch must be specified to check its head")
+	}
+	var actual string
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(branch, branchInfo); err != nil {
+		if !col.IsErrNotFound(err) {
+			return errors.EnsureStack(err)
+		}
+	} else if branchInfo.Head != nil {
+		actual = branchInfo.Head.ID
+	}
+	if actual != expected {
+		return pacherr.NewConflict("branches", branch.String(), expected, actual)
+	}
+	return nil
+}
+
 func (d *driver) listBranch(ctx context.Context, reverse bool, cb func(*pfs.BranchInfo) error) error {
 	if _, err := d.env.AuthServer.WhoAmI(ctx, &auth.WhoAmIRequest{}); err != nil && !auth.IsErrNotActivated(err) {
 		return errors.EnsureStack(err)
@@ -2030,7 +2120,7 @@ func (d *driver) deleteAll(ctx context.Context) error {
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
 		// the list does not use the transaction
 		for _, repoInfo := range repoInfos {
-			if err := d.deleteRepo(txnCtx, repoInfo.Repo, true); err != nil && !auth.IsErrNotAuthorized(err) {
+			if err := d.deleteRepo(txnCtx, repoInfo.Repo, true, false); err != nil && !auth.IsErrNotAuthorized(err) {
 				return err
 			}
 		}
@@ -2076,11 +2166,18 @@ func (d *driver) makeEmptyCommit(txnCtx *txncontext.TransactionContext, branchIn
 }
 
 func (d *driver) putCache(ctx context.Context, key string, value *types.Any, fileSetIds []fileset.ID, tag string) error {
+	if tag == pfs.DatumCacheTag {
+		return d.datumCache.Put(ctx, key, value, fileSetIds, tag)
+	}
 	return d.cache.Put(ctx, key, value, fileSetIds, tag)
 }
 
 func (d *driver) getCache(ctx context.Context, key string) (*types.Any, error) {
-	return d.cache.Get(ctx, key)
+	value, err := d.cache.Get(ctx, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pfsserver.ErrCacheEntryNotFound{Key: key}
+	}
+	return value, err
 }
 
 func (d *driver) clearCache(ctx context.Context, tagPrefix string) error {
@@ -8,10 +8,12 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -21,7 +23,27 @@ import (
 	"golang.org/x/net/context"
 )
 
+// withEncryptionKey returns a context which encrypts new chunks with repo's
+// own encryption key, if it has one.
+func (d *driver) withEncryptionKey(ctx context.Context, repo *pfs.Repo) (context.Context, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return ctx, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if repoInfo.EncryptionKeyId == "" {
+		return ctx, nil
+	}
+	return chunk.WithEncryptionKey(ctx, repoInfo.EncryptionKeyId), nil
+}
+
 func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
+	ctx, err := d.withEncryptionKey(ctx, commit.Branch.Repo)
+	if err != nil {
+		return err
+	}
 	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
 		// Store the originally-requested parameters because they will be overwritten by inspectCommit
 		branch := proto.Clone(commit.Branch).(*pfs.Branch)
@@ -394,8 +416,16 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 	return diff.Iterate(ctx, cb)
 }
 
-// createFileSet creates a new temporary fileset and returns it.
-func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
+// createFileSet creates a new temporary fileset and returns it. If repo is
+// set, the fileset is encrypted with repo's own encryption key, if it has one.
+func (d *driver) createFileSet(ctx context.Context, repo *pfs.Repo, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
+	if repo != nil {
+		var err error
+		ctx, err = d.withEncryptionKey(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var id *fileset.ID
 	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
 		var err error
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// chunkUse records which commits reference a chunk.
+type chunkUse struct {
+	size int64
+	// commits is the number of commits that reference the chunk.
+	commits int
+	// repo and commitSet are the only repo and commit set that reference the
+	// chunk, unless sharedRepo or sharedCommitSet is set.
+	repo            string
+	sharedRepo      bool
+	commitSet       string
+	sharedCommitSet bool
+}
+
+func (u *chunkUse) add(repo, commitSet string) {
+	if u.commits > 0 && u.repo != repo {
+		u.sharedRepo = true
+	}
+	if u.commits > 0 && u.commitSet != commitSet {
+		u.sharedCommitSet = true
+	}
+	u.commits++
+	u.repo = repo
+	u.commitSet = commitSet
+}
+
+// storageUsage accumulates the logical size of a set of commits and the
+// chunks they reference.
+type storageUsage struct {
+	logical int64
+	chunks  map[string]struct{}
+}
+
+func newStorageUsage() *storageUsage {
+	return &storageUsage{chunks: make(map[string]struct{})}
+}
+
+func (su *storageUsage) add(logical int64, chunks map[string]struct{}) {
+	su.logical += logical
+	for id := range chunks {
+		su.chunks[id] = struct{}{}
+	}
+}
+
+type storageCommit struct {
+	commit  *pfs.Commit
+	logical int64
+	chunks  map[string]struct{}
+}
+
+// storageWalker walks the tracker graph from commits to the chunks they
+// reference. Filesets are usually shared between commits, so the downstream
+// objects of each tracker object are cached for the duration of the walk.
+type storageWalker struct {
+	tracker    track.Tracker
+	downstream map[string][]string
+	uses       map[string]*chunkUse
+}
+
+// chunks returns the chunks reachable from the tracker objects in ids.
+func (w *storageWalker) chunks(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	chunks := make(map[string]struct{})
+	visited := make(map[string]struct{})
+	for len(ids) > 0 {
+		id := ids[len(ids)-1]
+		ids = ids[:len(ids)-1]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		if chunkID, err := chunk.ParseTrackerID(id); err == nil {
+			chunks[string(chunkID)] = struct{}{}
+		}
+		dwn, ok := w.downstream[id]
+		if !ok {
+			var err error
+			dwn, err = w.tracker.GetDownstream(ctx, id)
+			if err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			w.downstream[id] = dwn
+		}
+		ids = append(ids, dwn...)
+	}
+	return chunks, nil
+}
+
+// stats computes the stats for usage. A chunk counts towards freeable_bytes if
+// owned returns true for it.
+func (w *storageWalker) stats(usage *storageUsage, owned func(*chunkUse) bool) *admin.StorageStats {
+	stats := &admin.StorageStats{
+		LogicalBytes: usage.logical,
+		Chunks:       int64(len(usage.chunks)),
+	}
+	for id := range usage.chunks {
+		u := w.uses[id]
+		stats.PhysicalBytes += u.size
+		if owned(u) {
+			stats.FreeableBytes += u.size
+		}
+	}
+	if stats.PhysicalBytes > 0 {
+		stats.DedupRatio = float64(stats.LogicalBytes) / float64(stats.PhysicalBytes)
+	}
+	return stats
+}
+
+// inspectStorage reports the logical and physical storage used by the commits
+// in the cluster. Freeable bytes only account for references from commits, so
+// chunks that are also referenced by temporary filesets are not freed until
+// those expire.
+func (d *driver) inspectStorage(ctx context.Context, request *admin.InspectStorageRequest) (*admin.StorageInfo, error) {
+	repoInfos := make(map[string]*pfs.RepoInfo)
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+		repoInfos[pfsdb.RepoKey(repoInfo.Repo)] = proto.Clone(repoInfo).(*pfs.RepoInfo)
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// Determine which repos to report on.
+	var repoKeys []string
+	if len(request.Repos) > 0 {
+		for _, repo := range request.Repos {
+			if _, ok := repoInfos[pfsdb.RepoKey(repo)]; !ok {
+				return nil, pfsserver.ErrRepoNotFound{Repo: repo}
+			}
+			repoKeys = append(repoKeys, pfsdb.RepoKey(repo))
+		}
+	} else {
+		for key := range repoInfos {
+			repoKeys = append(repoKeys, key)
+		}
+	}
+	sort.Strings(repoKeys)
+	reported := make(map[string]bool)
+	heads := make(map[string]bool)
+	branchInfos := make(map[string][]*pfs.BranchInfo)
+	for _, key := range repoKeys {
+		reported[key] = true
+		for _, branch := range repoInfos[key].Branches {
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches.ReadOnly(ctx).Get(branch, branchInfo); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			branchInfos[key] = append(branchInfos[key], branchInfo)
+			if branchInfo.Head != nil {
+				heads[pfsdb.CommitKey(branchInfo.Head)] = true
+			}
+		}
+	}
+	// Walk every commit to find the chunks it references.
+	w := &storageWalker{
+		tracker:    d.tracker,
+		downstream: make(map[string][]string),
+		uses:       make(map[string]*chunkUse),
+	}
+	total := newStorageUsage()
+	repoUsage := make(map[string]*storageUsage)
+	commitSetUsage := make(map[string]*storageUsage)
+	reportedCommitSets := make(map[string]bool)
+	commits := make(map[string]*storageCommit)
+	var commitOrder []string
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).List(commitInfo, col.DefaultOptions(), func(string) error {
+		ids, err := d.commitStore.TrackerIDs(ctx, commitInfo.Commit)
+		if err != nil {
+			return err
+		}
+		chunks, err := w.chunks(ctx, ids)
+		if err != nil {
+			return err
+		}
+		repoKey := pfsdb.RepoKey(commitInfo.Commit.Branch.Repo)
+		for id := range chunks {
+			u, ok := w.uses[id]
+			if !ok {
+				u = &chunkUse{}
+				w.uses[id] = u
+			}
+			u.add(repoKey, commitInfo.Commit.ID)
+		}
+		var logical int64
+		if commitInfo.Details != nil {
+			logical = commitInfo.Details.SizeBytes
+		}
+		total.add(logical, chunks)
+		if _, ok := repoUsage[repoKey]; !ok {
+			repoUsage[repoKey] = newStorageUsage()
+		}
+		repoUsage[repoKey].add(logical, chunks)
+		if request.Details {
+			if _, ok := commitSetUsage[commitInfo.Commit.ID]; !ok {
+				commitSetUsage[commitInfo.Commit.ID] = newStorageUsage()
+			}
+			commitSetUsage[commitInfo.Commit.ID].add(logical, chunks)
+		}
+		if !reported[repoKey] {
+			return nil
+		}
+		commitKey := pfsdb.CommitKey(commitInfo.Commit)
+		if request.Details || heads[commitKey] {
+			commits[commitKey] = &storageCommit{
+				commit:  proto.Clone(commitInfo.Commit).(*pfs.Commit),
+				logical: logical,
+				chunks:  chunks,
+			}
+			commitOrder = append(commitOrder, commitKey)
+		}
+		if request.Details {
+			reportedCommitSets[commitInfo.Commit.ID] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := d.storage.ChunkStorage().ListSizes(ctx, func(id chunk.ID, size int64) error {
+		if u, ok := w.uses[string(id)]; ok {
+			u.size = size
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	info := &admin.StorageInfo{
+		Total: w.stats(total, func(*chunkUse) bool { return true }),
+	}
+	for _, u := range w.uses {
+		if u.sharedRepo {
+			info.SharedChunks++
+			info.SharedBytes += u.size
+		}
+	}
+	commitOwned := func(u *chunkUse) bool { return u.commits == 1 }
+	for _, key := range repoKeys {
+		usage, ok := repoUsage[key]
+		if !ok {
+			usage = newStorageUsage()
+		}
+		repoStorageInfo := &admin.RepoStorageInfo{
+			Repo:  repoInfos[key].Repo,
+			Stats: w.stats(usage, func(u *chunkUse) bool { return !u.sharedRepo }),
+		}
+		for _, branchInfo := range branchInfos[key] {
+			branchStats := w.stats(newStorageUsage(), commitOwned)
+			if branchInfo.Head != nil {
+				if c, ok := commits[pfsdb.CommitKey(branchInfo.Head)]; ok {
+					branchStats = w.stats(&storageUsage{logical: c.logical, chunks: c.chunks}, commitOwned)
+				}
+			}
+			repoStorageInfo.Branches = append(repoStorageInfo.Branches, &admin.BranchStorageInfo{
+				Branch: branchInfo.Branch,
+				Stats:  branchStats,
+			})
+		}
+		info.Repos = append(info.Repos, repoStorageInfo)
+	}
+	if request.Details {
+		repoStorageInfos := make(map[string]*admin.RepoStorageInfo)
+		for _, repoStorageInfo := range info.Repos {
+			repoStorageInfos[pfsdb.RepoKey(repoStorageInfo.Repo)] = repoStorageInfo
+		}
+		for _, commitKey := range commitOrder {
+			c := commits[commitKey]
+			repoStorageInfo := repoStorageInfos[pfsdb.RepoKey(c.commit.Branch.Repo)]
+			repoStorageInfo.Commits = append(repoStorageInfo.Commits, &admin.CommitStorageInfo{
+				Commit: c.commit,
+				Stats:  w.stats(&storageUsage{logical: c.logical, chunks: c.chunks}, commitOwned),
+			})
+		}
+		var commitSetIDs []string
+		for id := range reportedCommitSets {
+			commitSetIDs = append(commitSetIDs, id)
+		}
+		sort.Strings(commitSetIDs)
+		for _, id := range commitSetIDs {
+			info.CommitSets = append(info.CommitSets, &admin.CommitSetStorageInfo{
+				CommitSet: client.NewCommitSet(id),
+				Stats:     w.stats(commitSetUsage[id], func(u *chunkUse) bool { return !u.sharedCommitSet }),
+			})
+		}
+	}
+	return info, nil
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -94,6 +95,22 @@ func copyToSQLDB(ctx context.Context, src Source, destURL string, fileFormat *pf
 					tr = sdata.NewCSVParser(r).WithHeaderFields(fileFormat.Columns)
 				case pfs.SQLDatabaseEgress_FileFormat_JSON:
 					tr = sdata.NewJSONParser(r, fileFormat.Columns)
+				case pfs.SQLDatabaseEgress_FileFormat_PARQUET:
+					// Parquet files need random access, so they are read into memory.
+					data, err := io.ReadAll(r)
+					if err != nil {
+						return errors.EnsureStack(err)
+					}
+					columns := fileFormat.Columns
+					if len(columns) == 0 {
+						for _, ci := range tableInfo.Columns {
+							columns = append(columns, ci.Name)
+						}
+					}
+					tr, err = sdata.NewParquetParser(bytes.NewReader(data), int64(len(data)), columns)
+					if err != nil {
+						return err
+					}
 				default:
 					return errors.Errorf("unknown file format %v", fileFormat.Type)
 				}
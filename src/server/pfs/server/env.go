@@ -37,6 +37,9 @@ type Env struct {
 	BackgroundContext context.Context
 	StorageConfig     serviceenv.StorageConfiguration
 	Logger            *logrus.Logger
+	// S3PresignKey signs the URLs returned by PresignFile. PresignFile fails
+	// if it is empty.
+	S3PresignKey []byte
 }
 
 func EnvFromServiceEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (*Env, error) {
@@ -65,5 +68,6 @@ func EnvFromServiceEnv(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv)
 		BackgroundContext: env.Context(),
 		StorageConfig:     env.Config().StorageConfiguration,
 		Logger:            env.Logger(),
+		S3PresignKey:      []byte(env.Config().S3GatewayPresignKey),
 	}, nil
 }
@@ -0,0 +1,530 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// The entries of a repo archive, in the order they are written:
+//
+//	repo                         the RepoInfo
+//	key                          the repo's encryption key, if it has one
+//	chunks/<chunk id>            an exported chunk
+//	filesets/<file set id>       the metadata of a file set
+//	commits/<branch>/<id>/total  the ID of a commit's total file set
+//	commits/<branch>/<id>/diff   the ID of an errored commit's diff file set
+//	commits/<branch>/<id>/info   the CommitInfo
+//	branches/<branch>            the BranchInfo
+//
+// Chunks are written before the file sets that point to them, and file sets
+// before the commits and composite file sets that point to them, so the
+// archive can be imported as it is read.
+const (
+	archiveRepo     = "repo"
+	archiveKey      = "key"
+	archiveChunks   = "chunks"
+	archiveFileSets = "filesets"
+	archiveCommits  = "commits"
+	archiveBranches = "branches"
+	archiveTotal    = "total"
+	archiveDiff     = "diff"
+	archiveInfo     = "info"
+)
+
+// exportRepo writes an archive of the finished commits and the branches of a
+// repo to w, with the chunks and file sets holding their data. Chunks and file
+// sets keep their IDs when imported, so the imported files have the same
+// hashes. The archive holds the keys needed to decrypt the chunks, so it must
+// be protected like the data, and exporting a repo with its own encryption key
+// requires the same permission as managing who can access the repo.
+func (d *driver) exportRepo(ctx context.Context, repo *pfs.Repo, referencesOnly bool, w io.Writer) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+		return errors.EnsureStack(err)
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return errors.EnsureStack(err)
+	}
+	var key []byte
+	if repoInfo.EncryptionKeyId != "" {
+		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+			return errors.EnsureStack(err)
+		}
+		var err error
+		key, err = d.storage.ChunkStorage().GetEncryptionKey(ctx, repoInfo.EncryptionKeyId)
+		if err != nil {
+			return err
+		}
+	}
+	commitInfos, err := d.exportedCommits(ctx, repo)
+	if err != nil {
+		return err
+	}
+	branchInfos, err := d.exportedBranches(ctx, repo, commitInfos)
+	if err != nil {
+		return err
+	}
+	return withArchiveWriter(w, func(tw *tar.Writer) error {
+		if err := writeArchiveProto(tw, archiveRepo, repoInfo); err != nil {
+			return err
+		}
+		if key != nil {
+			if err := writeArchiveFile(tw, archiveKey, key); err != nil {
+				return err
+			}
+		}
+		chunks := d.storage.ChunkStorage().NewExporter(referencesOnly)
+		fileSets := make(map[string]struct{})
+		for _, commitInfo := range commitInfos {
+			// Errored commits have no total file set, their files are
+			// composed from their diff and their ancestors.
+			kind := archiveTotal
+			var id *fileset.ID
+			var err error
+			if commitInfo.Error != "" {
+				kind = archiveDiff
+				id, err = d.commitStore.GetDiffFileSet(ctx, commitInfo.Commit)
+			} else {
+				id, err = d.commitStore.GetTotalFileSet(ctx, commitInfo.Commit)
+			}
+			if err != nil && !errors.Is(err, errNoTotalFileSet) {
+				return errors.EnsureStack(err)
+			}
+			name := path.Join(archiveCommits, commitInfo.Commit.Branch.Name, commitInfo.Commit.ID)
+			if id != nil {
+				if err := d.exportFileSet(ctx, tw, chunks, fileSets, *id); err != nil {
+					return err
+				}
+				if err := writeArchiveFile(tw, path.Join(name, kind), []byte(id.HexString())); err != nil {
+					return err
+				}
+			}
+			if err := writeArchiveProto(tw, path.Join(name, archiveInfo), commitInfo); err != nil {
+				return err
+			}
+		}
+		for _, branchInfo := range branchInfos {
+			if err := writeArchiveProto(tw, path.Join(archiveBranches, branchInfo.Branch.Name), branchInfo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// exportedCommits returns the finished commits of a repo, oldest first. Open
+// commits are left out, so the parents of the exported commits are set to
+// their closest finished ancestors.
+func (d *driver) exportedCommits(ctx context.Context, repo *pfs.Repo) ([]*pfs.CommitInfo, error) {
+	all := make(map[string]*pfs.CommitInfo)
+	commitInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), commitInfo, col.DefaultOptions(), func(string) error {
+		all[pfsdb.CommitKey(commitInfo.Commit)] = proto.Clone(commitInfo).(*pfs.CommitInfo)
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var commitInfos []*pfs.CommitInfo
+	exported := make(map[string]*pfs.CommitInfo)
+	for key, commitInfo := range all {
+		if commitInfo.Finished != nil {
+			commitInfos = append(commitInfos, commitInfo)
+			exported[key] = commitInfo
+		}
+	}
+	sort.Slice(commitInfos, func(i, j int) bool {
+		return commitInfos[i].Started.Compare(commitInfos[j].Started) < 0
+	})
+	for _, commitInfo := range commitInfos {
+		commitInfo.ChildCommits = nil
+	}
+	for _, commitInfo := range commitInfos {
+		parent := commitInfo.ParentCommit
+		for parent != nil && exported[pfsdb.CommitKey(parent)] == nil {
+			if parentInfo, ok := all[pfsdb.CommitKey(parent)]; ok {
+				parent = parentInfo.ParentCommit
+			} else {
+				parent = nil
+			}
+		}
+		commitInfo.ParentCommit = parent
+		if parent != nil {
+			parentInfo := exported[pfsdb.CommitKey(parent)]
+			parentInfo.ChildCommits = append(parentInfo.ChildCommits, commitInfo.Commit)
+		}
+	}
+	return commitInfos, nil
+}
+
+// exportedBranches returns the branches of a repo, with their heads set to
+// their closest finished commits.
+func (d *driver) exportedBranches(ctx context.Context, repo *pfs.Repo, commitInfos []*pfs.CommitInfo) ([]*pfs.BranchInfo, error) {
+	exported := make(map[string]*pfs.CommitInfo)
+	for _, commitInfo := range commitInfos {
+		exported[pfsdb.CommitKey(commitInfo.Commit)] = commitInfo
+	}
+	var branchInfos []*pfs.BranchInfo
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).GetByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(repo), branchInfo, col.DefaultOptions(), func(string) error {
+		branchInfos = append(branchInfos, proto.Clone(branchInfo).(*pfs.BranchInfo))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, branchInfo := range branchInfos {
+		head := branchInfo.Head
+		for head != nil && exported[pfsdb.CommitKey(head)] == nil {
+			headInfo := &pfs.CommitInfo{}
+			if err := d.commits.ReadOnly(ctx).Get(pfsdb.CommitKey(head), headInfo); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			head = headInfo.ParentCommit
+		}
+		if head == nil {
+			return nil, errors.Errorf("branch %v has no finished commits to export", branchInfo.Branch)
+		}
+		branchInfo.Head = head
+	}
+	return branchInfos, nil
+}
+
+// exportFileSet writes a file set to an archive, after the chunks and file
+// sets it points to. File sets which have already been written are skipped.
+func (d *driver) exportFileSet(ctx context.Context, tw *tar.Writer, chunks *chunk.Exporter, exported map[string]struct{}, id fileset.ID) error {
+	return errors.EnsureStack(d.storage.Export(ctx, id, func(id fileset.ID, md *fileset.Metadata) error {
+		if _, ok := exported[id.HexString()]; ok {
+			return nil
+		}
+		if prim := md.GetPrimitive(); prim != nil {
+			for _, chunkID := range prim.PointsTo() {
+				if err := chunks.Export(ctx, chunkID, func(export *chunk.Export) error {
+					return writeArchiveProto(tw, path.Join(archiveChunks, chunk.ID(export.Id).HexString()), export)
+				}); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+		}
+		exported[id.HexString()] = struct{}{}
+		return writeArchiveProto(tw, path.Join(archiveFileSets, id.HexString()), md)
+	}))
+}
+
+type importedCommit struct {
+	info        *pfs.CommitInfo
+	total, diff *fileset.ID
+}
+
+// importRepo creates repo from an archive written by exportRepo. The chunks
+// and file sets are imported as the archive is read, and the repo, commits and
+// branches are created in one transaction at the end. The commits keep their
+// IDs, but not their provenance, which refers to repos that are not imported.
+func (d *driver) importRepo(ctx context.Context, repo *pfs.Repo, r io.Reader) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+	if repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot import %s, only user repos can be imported", repo)
+	}
+	// Fail before importing the data if the repo already exists.
+	if err := d.repos.ReadOnly(ctx).Get(repo, &pfs.RepoInfo{}); err == nil {
+		return pfsserver.ErrRepoExists{Repo: repo}
+	} else if !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	// Chunks exported by reference are trusted to be objects of the exporting
+	// cluster, so only cluster admins can import them.
+	references := true
+	if err := d.env.AuthServer.CheckClusterIsAuthorized(ctx, auth.Permission_CLUSTER_MODIFY_BINDINGS); err != nil {
+		if !auth.IsErrNotAuthorized(err) {
+			return errors.EnsureStack(err)
+		}
+		references = false
+	}
+	chunks := d.storage.ChunkStorage().NewImporter(ctx, "import-"+repo.Name, references)
+	defer chunks.Close()
+	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		archive, err := d.readRepoArchive(ctx, r, chunks, renewer)
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			return d.importRepoInTransaction(txnCtx, repo, archive)
+		})
+	})
+}
+
+// repoArchive is what is read from an archive written by exportRepo, or sent
+// by replication.
+type repoArchive struct {
+	repoInfo    *pfs.RepoInfo
+	key         []byte
+	commits     []*importedCommit
+	branchInfos []*pfs.BranchInfo
+}
+
+// readRepoArchive reads an archive, importing its chunks and file sets as they
+// are read. The file sets are kept alive by renewer.
+func (d *driver) readRepoArchive(ctx context.Context, r io.Reader, chunks *chunk.Importer, renewer *fileset.Renewer) (*repoArchive, error) {
+	archive := &repoArchive{}
+	byName := make(map[string]*importedCommit)
+	if err := readArchive(r, func(name string, data []byte) error {
+		dir, base := path.Split(name)
+		switch {
+		case name == archiveRepo:
+			archive.repoInfo = &pfs.RepoInfo{}
+			return errors.EnsureStack(proto.Unmarshal(data, archive.repoInfo))
+		case name == archiveKey:
+			archive.key = data
+			return nil
+		case dir == archiveChunks+"/":
+			export := &chunk.Export{}
+			if err := proto.Unmarshal(data, export); err != nil {
+				return errors.EnsureStack(err)
+			}
+			return errors.EnsureStack(chunks.Import(ctx, export))
+		case dir == archiveFileSets+"/":
+			id, err := fileset.ParseID(base)
+			if err != nil {
+				return err
+			}
+			md := &fileset.Metadata{}
+			if err := proto.Unmarshal(data, md); err != nil {
+				return errors.EnsureStack(err)
+			}
+			if err := d.storage.Import(ctx, *id, md, defaultTTL); err != nil {
+				return errors.EnsureStack(err)
+			}
+			return errors.EnsureStack(renewer.Add(ctx, *id))
+		case strings.HasPrefix(dir, archiveCommits+"/"):
+			c, ok := byName[dir]
+			if !ok {
+				c = &importedCommit{}
+				byName[dir] = c
+				archive.commits = append(archive.commits, c)
+			}
+			switch base {
+			case archiveTotal, archiveDiff:
+				id, err := fileset.ParseID(string(data))
+				if err != nil {
+					return err
+				}
+				if base == archiveTotal {
+					c.total = id
+				} else {
+					c.diff = id
+				}
+			case archiveInfo:
+				c.info = &pfs.CommitInfo{}
+				return errors.EnsureStack(proto.Unmarshal(data, c.info))
+			default:
+				return errors.Errorf("unrecognized archive entry %q", name)
+			}
+			return nil
+		case dir == archiveBranches+"/":
+			branchInfo := &pfs.BranchInfo{}
+			if err := proto.Unmarshal(data, branchInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			archive.branchInfos = append(archive.branchInfos, branchInfo)
+			return nil
+		default:
+			return errors.Errorf("unrecognized archive entry %q", name)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if archive.repoInfo == nil {
+		return nil, errors.New("archive has no repo")
+	}
+	for _, c := range archive.commits {
+		if c.info == nil {
+			return nil, errors.New("archive has a file set for a commit with no info")
+		}
+	}
+	return archive, nil
+}
+
+func (d *driver) importRepoInTransaction(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, archive *repoArchive) error {
+	if err := d.createRepo(txnCtx, repo, archive.repoInfo.Description, false); err != nil {
+		return err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(repo, repoInfo, func() error {
+		if err := d.importEncryptionKey(txnCtx, repoInfo, archive); err != nil {
+			return err
+		}
+		for _, branchInfo := range archive.branchInfos {
+			add(&repoInfo.Branches, repo.NewBranch(branchInfo.Branch.Name))
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	ids, err := d.importedCommitIDs(txnCtx, archive)
+	if err != nil {
+		return err
+	}
+	for _, c := range archive.commits {
+		commitInfo := c.info
+		importCommit(repo, ids, commitInfo.Commit)
+		importCommit(repo, ids, commitInfo.ParentCommit)
+		for _, child := range commitInfo.ChildCommits {
+			importCommit(repo, ids, child)
+		}
+		commitInfo.DirectProvenance = nil
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Create(commitInfo.Commit, commitInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if c.total != nil {
+			if err := d.commitStore.SetTotalFileSetTx(txnCtx.SqlTx, commitInfo.Commit, *c.total); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		if c.diff != nil {
+			if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commitInfo.Commit, *c.diff); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+	}
+	for _, branchInfo := range archive.branchInfos {
+		branchInfo.Branch.Repo = repo
+		importCommit(repo, ids, branchInfo.Head)
+		branchInfo.Provenance = nil
+		branchInfo.DirectProvenance = nil
+		branchInfo.Subvenance = nil
+		branchInfo.Trigger = nil
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Put(branchInfo.Branch, branchInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// importEncryptionKey gives a newly created repo the encryption key of the
+// archived repo, as the archived chunks refer to the key by its ID.
+func (d *driver) importEncryptionKey(txnCtx *txncontext.TransactionContext, repoInfo *pfs.RepoInfo, archive *repoArchive) error {
+	archived := archive.repoInfo
+	if archived.EncryptionKeyId == "" {
+		return nil
+	}
+	if archive.key == nil {
+		return errors.Errorf("archive has no encryption key for repo %v", archived.Repo)
+	}
+	if repoInfo.EncryptionKeyId != "" {
+		if err := chunk.DeleteEncryptionKeyTx(txnCtx.SqlTx, repoInfo.EncryptionKeyId); err != nil {
+			return err
+		}
+	}
+	if err := d.storage.ChunkStorage().ImportEncryptionKeyTx(txnCtx.SqlTx, archived.EncryptionKeyId, archive.key); err != nil {
+		return errors.Wrapf(err, "could not import encryption key %v, the archive may already have been imported", archived.EncryptionKeyId)
+	}
+	repoInfo.EncryptionKeyId = archived.EncryptionKeyId
+	return nil
+}
+
+// importedCommitIDs returns the IDs that the archived commits are imported
+// with. A commit keeps its ID unless the ID is already used by a commit set on
+// this cluster, in which case it gets a new one, so that the imported commits
+// don't join unrelated commit sets.
+func (d *driver) importedCommitIDs(txnCtx *txncontext.TransactionContext, archive *repoArchive) (map[string]string, error) {
+	ids := make(map[string]string)
+	for _, c := range archive.commits {
+		id := c.info.Commit.ID
+		ids[id] = id
+		existing := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.CommitsCommitSetIndex, id, existing, col.DefaultOptions(), func(string) error {
+			ids[id] = uuid.NewWithoutDashes()
+			return errutil.ErrBreak
+		}); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	return ids, nil
+}
+
+// importCommit moves an archived commit to the repo it is imported into, with
+// the ID it is imported with.
+func importCommit(repo *pfs.Repo, ids map[string]string, commit *pfs.Commit) {
+	if commit != nil {
+		commit.Branch.Repo = repo
+		if id, ok := ids[commit.ID]; ok {
+			commit.ID = id
+		}
+	}
+}
+
+func withArchiveWriter(w io.Writer, cb func(*tar.Writer) error) (retErr error) {
+	gw := gzip.NewWriter(w)
+	defer func() {
+		if retErr == nil {
+			retErr = errors.EnsureStack(gw.Close())
+		}
+	}()
+	return tarutil.WithWriter(gw, cb)
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, data []byte) error {
+	return errors.EnsureStack(tarutil.WriteFile(tw, tarutil.NewMemFile(name, data)))
+}
+
+func writeArchiveProto(tw *tar.Writer, name string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return writeArchiveFile(tw, name, data)
+}
+
+func readArchive(r io.Reader, cb func(name string, data []byte) error) (retErr error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := gr.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return errors.EnsureStack(tarutil.Iterate(gr, func(file tarutil.File) error {
+		hdr, err := file.Header()
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		buf := &bytes.Buffer{}
+		if err := file.Content(buf); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return cb(hdr.Name, buf.Bytes())
+	}))
+}
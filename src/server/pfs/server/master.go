@@ -11,14 +11,18 @@ import (
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/task"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 
 	log "github.com/sirupsen/logrus"
@@ -59,9 +63,40 @@ func (d *driver) master(ctx context.Context) {
 				return gc.RunForever(ctx)
 			})
 		}
+		rewrapPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageKeyRewrapPeriod)
+		if d.env.StorageConfig.StorageEncryptionKeys == "" || rewrapPeriod <= 0 {
+			d.log.Info("Skipping Chunk Key Rewrap")
+		} else {
+			d.log.Infof("Starting Chunk Key Rewrap with period=%v", rewrapPeriod)
+			eg.Go(func() error {
+				return d.storage.ChunkStorage().RewrapKeysForever(ctx, rewrapPeriod, d.log)
+			})
+		}
+		tieringPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageTieringPeriod)
+		if d.env.StorageConfig.StorageColdTierURL == "" || tieringPeriod <= 0 {
+			d.log.Info("Skipping Chunk Tiering")
+		} else {
+			coldTierAfter := 24 * time.Hour * time.Duration(d.env.StorageConfig.StorageColdTierAfterDays)
+			d.log.Infof("Starting Chunk Tiering with period=%v, moving chunks not read for %v", tieringPeriod, coldTierAfter)
+			eg.Go(func() error {
+				return d.storage.ChunkStorage().DemoteChunksForever(ctx, tieringPeriod, coldTierAfter, d.log)
+			})
+		}
+		retentionPeriod := time.Second * time.Duration(d.env.StorageConfig.StorageRetentionPeriod)
+		if retentionPeriod <= 0 {
+			d.log.Info("Skipping Retention Policy Enforcement")
+		} else {
+			d.log.Infof("Starting Retention Policy Enforcement with period=%v", retentionPeriod)
+			eg.Go(func() error {
+				return d.enforceRetentionForever(ctx, retentionPeriod)
+			})
+		}
 		eg.Go(func() error {
 			return d.finishCommits(ctx)
 		})
+		eg.Go(func() error {
+			return d.replicateRepos(ctx)
+		})
 		return errors.EnsureStack(eg.Wait())
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
@@ -108,6 +143,13 @@ func (d *driver) finishCommits(ctx context.Context) error {
 }
 
 func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, repoKey string) error {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repoKey, repoInfo); err != nil && !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	if repoInfo.EncryptionKeyId != "" {
+		ctx = chunk.WithEncryptionKey(ctx, repoInfo.EncryptionKeyId)
+	}
 	err := d.commits.ReadOnly(ctx).WatchByIndexF(pfsdb.CommitsRepoIndex, repoKey, func(ev *watch.Event) error {
 		if ev.Type == watch.EventError {
 			return ev.Err
@@ -142,8 +184,14 @@ func (d *driver) finishRepoCommits(ctx context.Context, compactor *compactor, re
 					return err
 				}
 				details := &pfs.CommitInfo_Details{}
-				// Compact the commit.
-				taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, commit.ID, cache)
+				// Compact the commit. The storage tasks of all commits share a
+				// namespace, so the commits of pipelines are compacted according
+				// to their pipeline's task scheduling spec.
+				opts, err := d.compactionDoerOptions(ctx, commit.Branch.Repo)
+				if err != nil {
+					return err
+				}
+				taskDoer := d.env.TaskService.NewDoer(storageTaskNamespace, commit.ID, cache, opts...)
 				var totalId *fileset.ID
 				start := time.Now()
 				if err := miscutil.LogStep(fmt.Sprintf("compacting commit %v", commit), func() error {
@@ -247,3 +295,23 @@ func (d *driver) finishAliasDescendents(txnCtx *txncontext.TransactionContext, p
 	}
 	return nil
 }
+
+// compactionDoerOptions returns the options for the storage tasks of repo's
+// commits, which are those of the pipeline that repo belongs to, if any.
+func (d *driver) compactionDoerOptions(ctx context.Context, repo *pfs.Repo) ([]task.DoerOption, error) {
+	if repo.Type != pfs.UserRepoType && repo.Type != pfs.MetaRepoType {
+		return nil, nil
+	}
+	var pipelineInfo *pps.PipelineInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		pipelineInfo, err = d.env.GetPPSServer().InspectPipelineInTransaction(txnCtx, repo.Name)
+		return errors.EnsureStack(err)
+	}); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ppsutil.TaskDoerOptions(pipelineInfo), nil
+}
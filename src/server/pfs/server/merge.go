@@ -0,0 +1,275 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// mergeBranch merges the changes made on source since its common ancestor with
+// target into target. The merge is computed per file path: paths changed only
+// on source are applied to target, and paths changed differently on both
+// branches are resolved with strategy. The result is a new commit on target,
+// whose parent is the head of target and whose merge parent is the head of
+// source.
+func (d *driver) mergeBranch(ctx context.Context, source, target *pfs.Branch, strategy pfs.MergeStrategy, description string) (*pfs.MergeBranchResponse, error) {
+	if source == nil || target == nil {
+		return nil, errors.New("source and target branches must be specified")
+	}
+	if source.Repo == nil || target.Repo == nil {
+		return nil, errors.New("branch repo cannot be nil")
+	}
+	if !proto.Equal(source.Repo, target.Repo) {
+		return nil, errors.Errorf("cannot merge branch %s into %s, the branches must be in the same repo", source, target)
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge branch %s into itself", source)
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, target.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	ours, err := d.finishedHead(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	theirs, err := d.finishedHead(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	base, err := d.mergeBase(ctx, ours, theirs)
+	if err != nil {
+		return nil, err
+	}
+	if base != nil && proto.Equal(base.Commit, theirs.Commit) {
+		// target already contains all of the changes on source.
+		return &pfs.MergeBranchResponse{Commit: ours.Commit}, nil
+	}
+	ourChanges, err := d.changesSince(ctx, base, ours)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := d.changesSince(ctx, base, theirs)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []string
+	for p, theirFi := range changes {
+		ourFi, ok := ourChanges[p]
+		if !ok {
+			continue
+		}
+		if (ourFi == nil && theirFi == nil) || (ourFi != nil && theirFi != nil && equalFileInfos(ourFi, theirFi)) {
+			// Both branches made the same change.
+			delete(changes, p)
+			continue
+		}
+		conflicts = append(conflicts, p)
+		if strategy == pfs.MergeStrategy_OURS {
+			delete(changes, p)
+		}
+	}
+	sort.Strings(conflicts)
+	if len(conflicts) > 0 && strategy == pfs.MergeStrategy_FAIL {
+		return nil, pfsserver.ErrMergeConflict{Source: source, Target: target, Paths: conflicts}
+	}
+	if description == "" {
+		description = fmt.Sprintf("Merge branch %s into %s", source.Name, target.Name)
+	}
+	commit, err := d.commitChanges(ctx, target, ours, theirs, changes, description, theirs.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.MergeBranchResponse{Commit: commit, Conflicts: conflicts}, nil
+}
+
+// commitChanges applies the changes from theirs on top of ours, the head of
+// target, as a new commit on target. The commit's merge parent is set to
+// mergeParent, if it isn't nil.
+func (d *driver) commitChanges(ctx context.Context, target *pfs.Branch, ours, theirs *pfs.CommitInfo, changes map[string]*pfs.FileInfo, description string, mergeParent *pfs.Commit) (*pfs.Commit, error) {
+	ctx, err := d.withEncryptionKey(ctx, target.Repo)
+	if err != nil {
+		return nil, err
+	}
+	var commit *pfs.Commit
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		id, err := d.withUnorderedWriter(ctx, renewer, func(uw *fileset.UnorderedWriter) error {
+			return d.applyChanges(ctx, uw, ours, theirs, changes)
+		})
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			// Fail rather than drop the changes made to target in the meantime.
+			if err := d.checkBranchHead(txnCtx, target, ours.Commit.ID); err != nil {
+				return err
+			}
+			var err error
+			commit, err = d.startCommit(txnCtx, ours.Commit, target, description)
+			if err != nil {
+				return err
+			}
+			if mergeParent != nil {
+				commitInfo := &pfs.CommitInfo{}
+				if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(commit, commitInfo, func() error {
+					commitInfo.MergeParent = mergeParent
+					return nil
+				}); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, *id); err != nil {
+				return errors.EnsureStack(err)
+			}
+			return d.finishCommit(txnCtx, commit, "", "", false)
+		})
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return commit, nil
+}
+
+// finishedHead returns the head of branch, which must be finished.
+func (d *driver) finishedHead(ctx context.Context, branch *pfs.Branch) (*pfs.CommitInfo, error) {
+	commitInfo, err := d.inspectCommit(ctx, branch.NewCommit(""), pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finishing == nil {
+		return nil, pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	return commitInfo, nil
+}
+
+// mergeBase returns the closest common ancestor of ours and theirs, following
+// both the parents and the merge parents of commits. It returns nil if the
+// commits have no common ancestor.
+func (d *driver) mergeBase(ctx context.Context, ours, theirs *pfs.CommitInfo) (*pfs.CommitInfo, error) {
+	ancestors := make(map[string]bool)
+	if err := d.walkAncestors(ctx, ours, func(commitInfo *pfs.CommitInfo) error {
+		ancestors[pfsdb.CommitKey(commitInfo.Commit)] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.CommitInfo
+	if err := d.walkAncestors(ctx, theirs, func(commitInfo *pfs.CommitInfo) error {
+		if ancestors[pfsdb.CommitKey(commitInfo.Commit)] {
+			base = commitInfo
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkAncestors calls cb with commitInfo and each of its ancestors, breadth
+// first. Ancestors which have since been squashed or dropped are skipped.
+func (d *driver) walkAncestors(ctx context.Context, commitInfo *pfs.CommitInfo, cb func(*pfs.CommitInfo) error) error {
+	seen := make(map[string]bool)
+	queue := []*pfs.CommitInfo{commitInfo}
+	for len(queue) > 0 {
+		commitInfo, queue = queue[0], queue[1:]
+		if err := cb(commitInfo); err != nil {
+			return err
+		}
+		for _, parent := range []*pfs.Commit{commitInfo.ParentCommit, commitInfo.MergeParent} {
+			if parent == nil || seen[pfsdb.CommitKey(parent)] {
+				continue
+			}
+			seen[pfsdb.CommitKey(parent)] = true
+			parentInfo := &pfs.CommitInfo{}
+			if err := d.commits.ReadOnly(ctx).Get(parent, parentInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return errors.EnsureStack(err)
+			}
+			queue = append(queue, parentInfo)
+		}
+	}
+	return nil
+}
+
+// changesSince returns the files which changed between base and head, keyed by
+// path. Deleted files map to nil. A nil base is treated as an empty commit.
+func (d *driver) changesSince(ctx context.Context, base, head *pfs.CommitInfo) (map[string]*pfs.FileInfo, error) {
+	var old Source = emptySource{}
+	if base != nil {
+		baseInfo, fs, err := d.openCommit(ctx, base.Commit)
+		if err != nil {
+			return nil, err
+		}
+		old = NewSource(baseInfo, fs)
+	}
+	headInfo, fs, err := d.openCommit(ctx, head.Commit)
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string]*pfs.FileInfo)
+	if err := NewDiffer(old, NewSource(headInfo, fs)).Iterate(ctx, func(oldFi, newFi *pfs.FileInfo) error {
+		// Directories are implied by the files in them.
+		if oldFi != nil && oldFi.FileType != pfs.FileType_FILE {
+			oldFi = nil
+		}
+		if newFi != nil && newFi.FileType != pfs.FileType_FILE {
+			newFi = nil
+		}
+		switch {
+		case newFi != nil:
+			changes[newFi.File.Path] = newFi
+		case oldFi != nil:
+			changes[oldFi.File.Path] = nil
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// applyChanges writes the changes from theirs on top of ours to uw. Changed
+// files replace every datum of the path in ours. theirs may be nil if all of
+// the changes are deletions.
+func (d *driver) applyChanges(ctx context.Context, uw *fileset.UnorderedWriter, ours, theirs *pfs.CommitInfo, changes map[string]*pfs.FileInfo) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	_, fs, err := d.openCommit(ctx, ours.Commit)
+	if err != nil {
+		return err
+	}
+	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+		_, ok := changes[idx.Path]
+		return ok
+	})
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		return uw.Delete(f.Index().Path, f.Index().File.Datum)
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if theirs == nil {
+		return nil
+	}
+	_, fs, err = d.openCommit(ctx, theirs.Commit)
+	if err != nil {
+		return err
+	}
+	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+		return changes[idx.Path] != nil
+	})
+	return errors.EnsureStack(uw.Copy(ctx, fs, "", false))
+}
@@ -188,11 +188,14 @@ func (m *PathRange) GetUpperDatum() string {
 }
 
 type CompactTask struct {
-	Inputs               []string   `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	PathRange            *PathRange `protobuf:"bytes,2,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Inputs    []string   `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	PathRange *PathRange `protobuf:"bytes,2,opt,name=path_range,json=pathRange,proto3" json:"path_range,omitempty"`
+	// encryption_key_id is the ID of the key which encrypts the output's
+	// chunks, if the repo has its own key.
+	EncryptionKeyId      string   `protobuf:"bytes,3,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactTask) Reset()         { *m = CompactTask{} }
@@ -242,6 +245,13 @@ func (m *CompactTask) GetPathRange() *PathRange {
 	return nil
 }
 
+func (m *CompactTask) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
 type CompactTaskResult struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -291,6 +301,7 @@ func (m *CompactTaskResult) GetId() string {
 
 type ConcatTask struct {
 	Inputs               []string `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	EncryptionKeyId      string   `protobuf:"bytes,2,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -336,6 +347,13 @@ func (m *ConcatTask) GetInputs() []string {
 	return nil
 }
 
+func (m *ConcatTask) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
 type ConcatTaskResult struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -500,31 +518,32 @@ func init() {
 func init() { proto.RegisterFile("server/pfs/server/pfsserver.proto", fileDescriptor_a5a92e512e703e9c) }
 
 var fileDescriptor_a5a92e512e703e9c = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xbd, 0x4e, 0xeb, 0x30,
-	0x14, 0x56, 0xda, 0x7b, 0x2b, 0xe5, 0xa4, 0xf7, 0x02, 0x51, 0x55, 0x65, 0x21, 0x14, 0x97, 0xa1,
-	0x53, 0x23, 0xb5, 0x03, 0x03, 0x5b, 0x0b, 0x03, 0x0b, 0x42, 0x01, 0x31, 0x74, 0x89, 0xdc, 0xc4,
-	0x34, 0x51, 0x7f, 0x6c, 0xd9, 0x4e, 0x51, 0x79, 0x42, 0x46, 0x1e, 0x01, 0xf5, 0x49, 0x90, 0xed,
-	0x90, 0x44, 0xa0, 0xb2, 0x9d, 0xef, 0xc7, 0xc7, 0xe7, 0x3b, 0x3a, 0x70, 0x2e, 0x08, 0xdf, 0x12,
-	0x1e, 0xb0, 0x67, 0x11, 0x54, 0xa5, 0xa9, 0x86, 0x8c, 0x53, 0x49, 0x5d, 0xbb, 0x24, 0x50, 0x1f,
-	0xec, 0x87, 0x14, 0xf3, 0xe4, 0x11, 0x8b, 0xa5, 0xdb, 0x85, 0x56, 0xb6, 0x61, 0xb9, 0x14, 0x9e,
-	0xd5, 0x6b, 0x0e, 0xec, 0xb0, 0x40, 0xe8, 0x0e, 0x8e, 0x4a, 0x53, 0x48, 0x44, 0xbe, 0x92, 0xee,
-	0x15, 0xfc, 0x8b, 0xe9, 0x9a, 0xe1, 0x58, 0x46, 0x12, 0x8b, 0xa5, 0x79, 0xe1, 0x8c, 0xba, 0xc3,
-	0xea, 0xaf, 0xa9, 0xd1, 0xf5, 0xa3, 0x76, 0x5c, 0x01, 0x81, 0x76, 0x60, 0xdf, 0x63, 0x99, 0x86,
-	0x78, 0xb3, 0x20, 0x6e, 0x07, 0xfe, 0xae, 0xe8, 0x0b, 0xe1, 0x9e, 0xd5, 0xb3, 0x06, 0x76, 0x68,
-	0x80, 0x62, 0x73, 0xc6, 0x08, 0xf7, 0x1a, 0x86, 0xd5, 0xc0, 0x3d, 0x03, 0x47, 0xcb, 0x51, 0x82,
-	0x65, 0xbe, 0xf6, 0x9a, 0x5a, 0x03, 0x4d, 0x5d, 0x2b, 0x46, 0x19, 0xb4, 0xb3, 0x30, 0xfc, 0x31,
-	0x06, 0x4d, 0x69, 0x03, 0x9a, 0x81, 0x53, 0x9b, 0xeb, 0x50, 0x62, 0x77, 0x0c, 0xc0, 0xb0, 0x4c,
-	0x23, 0xae, 0x46, 0xd4, 0x33, 0x38, 0xa3, 0x4e, 0x2d, 0x5b, 0x39, 0x7e, 0x68, 0xb3, 0xaf, 0x12,
-	0xf5, 0xe1, 0xa4, 0x9e, 0xd9, 0x2c, 0xea, 0x3f, 0x34, 0xb2, 0xa4, 0xc8, 0xd6, 0xc8, 0x12, 0x74,
-	0x01, 0x30, 0xa5, 0x9b, 0x18, 0xff, 0xfa, 0x3f, 0x42, 0x70, 0x5c, 0xb9, 0x0e, 0x74, 0xf2, 0xa1,
-	0xfd, 0x84, 0x57, 0x59, 0x82, 0x25, 0xd1, 0xbd, 0xbe, 0xeb, 0xb7, 0xe0, 0xd6, 0xf5, 0xa2, 0xcb,
-	0x29, 0x80, 0xc8, 0x5e, 0x49, 0x34, 0xdf, 0x49, 0x22, 0xb4, 0xbb, 0x19, 0xda, 0x8a, 0x99, 0x28,
-	0x42, 0xed, 0x9d, 0x70, 0x4e, 0xcb, 0xbd, 0x6b, 0x30, 0xb9, 0x79, 0xdb, 0xfb, 0xd6, 0xfb, 0xde,
-	0xb7, 0x3e, 0xf6, 0xbe, 0x35, 0xbb, 0x5c, 0x64, 0x32, 0xcd, 0xe7, 0xc3, 0x98, 0xae, 0x03, 0x86,
-	0xe3, 0x74, 0x97, 0x10, 0x5e, 0xaf, 0xb6, 0xa3, 0x40, 0xf0, 0x38, 0xf8, 0x71, 0x87, 0xf3, 0x96,
-	0x3e, 0xbf, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x74, 0x04, 0x8f, 0xa3, 0x02, 0x00,
-	0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x4d, 0x8b, 0x92, 0xf4, 0x16, 0x45, 0x1a, 0x42, 0xba, 0xb1, 0x62, 0xd9, 0x10, 0x17, 0x34,
+	0x81, 0x85, 0x0b, 0x77, 0xa0, 0x0b, 0x62, 0x62, 0x48, 0x35, 0x2e, 0xdc, 0x34, 0xc3, 0xcc, 0x48,
+	0x27, 0x40, 0x67, 0x32, 0x33, 0xc5, 0xd4, 0x0f, 0xf0, 0xdb, 0x5c, 0xfa, 0x09, 0x86, 0x2f, 0x31,
+	0x9d, 0x36, 0x6d, 0xe3, 0x83, 0xb7, 0xbb, 0xf7, 0x9c, 0x33, 0x73, 0xce, 0xbd, 0x33, 0xf0, 0x5a,
+	0x51, 0x79, 0xa1, 0x32, 0x12, 0xdf, 0x55, 0xd4, 0x96, 0x55, 0xb5, 0x10, 0x92, 0x6b, 0xee, 0x39,
+	0x0d, 0x10, 0xce, 0xc0, 0xf9, 0x9c, 0x22, 0x49, 0xbe, 0x20, 0x75, 0xf4, 0x26, 0xd0, 0x67, 0x99,
+	0xc8, 0xb5, 0xf2, 0xad, 0x69, 0x6f, 0xee, 0xc4, 0x75, 0x17, 0x7e, 0x82, 0x61, 0x23, 0x8a, 0xa9,
+	0xca, 0x4f, 0xda, 0x7b, 0x07, 0xcf, 0x30, 0x3f, 0x0b, 0x84, 0x75, 0xa2, 0x91, 0x3a, 0x56, 0x27,
+	0xdc, 0xe5, 0x64, 0xd1, 0x7a, 0x6d, 0x2a, 0xde, 0x1c, 0x1a, 0xe0, 0xb6, 0x51, 0x61, 0x01, 0xce,
+	0x0e, 0xe9, 0x34, 0x46, 0xd9, 0x81, 0x7a, 0x63, 0x78, 0x7a, 0xe2, 0x3f, 0xa8, 0xf4, 0xad, 0xa9,
+	0x35, 0x77, 0xe2, 0xaa, 0x29, 0xd1, 0x5c, 0x08, 0x2a, 0x7d, 0xbb, 0x42, 0x4d, 0xe3, 0xbd, 0x02,
+	0xd7, 0xd0, 0x09, 0x41, 0x3a, 0x3f, 0xfb, 0x3d, 0xc3, 0x81, 0x81, 0xde, 0x97, 0x48, 0x29, 0x30,
+	0xca, 0x5a, 0xf0, 0xa4, 0x12, 0x18, 0xc8, 0x08, 0xc2, 0x5f, 0x16, 0xb8, 0x9d, 0x60, 0xf7, 0x46,
+	0xf6, 0x56, 0x00, 0x02, 0xe9, 0x34, 0x91, 0x65, 0x46, 0x13, 0xc2, 0x5d, 0x8e, 0x3b, 0xc3, 0x35,
+	0xf9, 0x63, 0x47, 0x34, 0xa3, 0xbc, 0x81, 0x11, 0xcd, 0xb0, 0x2c, 0x84, 0x66, 0x3c, 0x4b, 0x8e,
+	0xb4, 0x48, 0x18, 0xa9, 0x43, 0x0e, 0x5b, 0xe2, 0x23, 0x2d, 0xb6, 0x24, 0x9c, 0xc1, 0xa8, 0xbb,
+	0xa0, 0x6a, 0xab, 0xcf, 0xc1, 0x66, 0xa4, 0x5e, 0x84, 0xcd, 0x48, 0xb8, 0x03, 0xd8, 0xf0, 0x0c,
+	0xa3, 0xc7, 0xb3, 0xde, 0xb4, 0xb5, 0x6f, 0xdb, 0x86, 0xf0, 0xa2, 0xbd, 0xf1, 0x8e, 0x6b, 0x00,
+	0x83, 0xaf, 0xe8, 0xc4, 0x08, 0xd2, 0xd4, 0xf8, 0xfe, 0xcf, 0x6f, 0xc1, 0xeb, 0xf2, 0xf5, 0x2d,
+	0x2f, 0x01, 0x14, 0xfb, 0x49, 0x93, 0x7d, 0xa1, 0xa9, 0x32, 0xea, 0x5e, 0xec, 0x94, 0xc8, 0xba,
+	0x04, 0xca, 0x07, 0xa5, 0x52, 0xf2, 0xe6, 0x41, 0x4d, 0xb3, 0xfe, 0xf0, 0xfb, 0x1a, 0x58, 0x7f,
+	0xae, 0x81, 0xf5, 0xf7, 0x1a, 0x58, 0xdf, 0xde, 0x1e, 0x98, 0x4e, 0xf3, 0xfd, 0x02, 0xf3, 0x73,
+	0x24, 0x10, 0x4e, 0x0b, 0x42, 0x65, 0xb7, 0xba, 0x2c, 0x23, 0x25, 0x71, 0xf4, 0xe0, 0x83, 0xef,
+	0xfb, 0xe6, 0x5f, 0xaf, 0xfe, 0x0d, 0x00, 0x91, 0x2a, 0x6c, 0x0e, 0xfc, 0x02, 0x00, 0x00,
 }
 
 func (m *ShardTask) Marshal() (dAtA []byte, err error) {
@@ -683,6 +702,13 @@ func (m *CompactTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.EncryptionKeyId) > 0 {
+		i -= len(m.EncryptionKeyId)
+		copy(dAtA[i:], m.EncryptionKeyId)
+		i = encodeVarintPfsserver(dAtA, i, uint64(len(m.EncryptionKeyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PathRange != nil {
 		{
 			size, err := m.PathRange.MarshalToSizedBuffer(dAtA[:i])
@@ -765,6 +791,13 @@ func (m *ConcatTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.EncryptionKeyId) > 0 {
+		i -= len(m.EncryptionKeyId)
+		copy(dAtA[i:], m.EncryptionKeyId)
+		i = encodeVarintPfsserver(dAtA, i, uint64(len(m.EncryptionKeyId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Inputs) > 0 {
 		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Inputs[iNdEx])
@@ -975,6 +1008,10 @@ func (m *CompactTask) Size() (n int) {
 		l = m.PathRange.Size()
 		n += 1 + l + sovPfsserver(uint64(l))
 	}
+	l = len(m.EncryptionKeyId)
+	if l > 0 {
+		n += 1 + l + sovPfsserver(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1009,6 +1046,10 @@ func (m *ConcatTask) Size() (n int) {
 			n += 1 + l + sovPfsserver(uint64(l))
 		}
 	}
+	l = len(m.EncryptionKeyId)
+	if l > 0 {
+		n += 1 + l + sovPfsserver(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1516,6 +1557,38 @@ func (m *CompactTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
@@ -1682,6 +1755,38 @@ func (m *ConcatTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Inputs = append(m.Inputs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfsserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
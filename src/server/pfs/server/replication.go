@@ -0,0 +1,707 @@
+package server
+
+import (
+	"archive/tar"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// replicationLagInterval is how often the replication lag metric is
+	// updated.
+	replicationLagInterval = 10 * time.Second
+	// findMissingChunksBatchSize is the number of chunks asked about in each
+	// FindMissingChunks request.
+	findMissingChunksBatchSize = 10000
+)
+
+var replicationLagMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "pachyderm",
+	Subsystem: "pfs_replication",
+	Name:      "lag_seconds",
+	Help:      "Seconds since the oldest finished commit of a repo which hasn't been replicated finished",
+}, []string{"repo"})
+
+func (d *driver) createReplication(txnCtx *txncontext.TransactionContext, replication *pfs.Replication, authToken string, update bool) error {
+	// Validate arguments
+	if replication == nil {
+		return errors.New("replication cannot be nil")
+	}
+	if replication.Repo == nil {
+		return errors.New("replication repo cannot be nil")
+	}
+	if replication.Repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot replicate %s, only user repos can be replicated", replication.Repo)
+	}
+	if _, err := grpcutil.ParsePachdAddress(replication.Target); err != nil {
+		return errors.Wrapf(err, "invalid replication target %q", replication.Target)
+	}
+	// The auth token is a credential for the target cluster, so only the
+	// owners of the repo can set it.
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, replication.Repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(replication.Repo, &pfs.RepoInfo{}); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: replication.Repo}
+		}
+		return errors.EnsureStack(err)
+	}
+	// The token is stored with the encryption keys, rather than in the
+	// replication, so it's wrapped with the key encryption keys.
+	var authTokenID string
+	if authToken != "" {
+		authTokenID = "replication-" + uuid.NewWithoutDashes()
+		if err := d.storage.ChunkStorage().CreateSecretTx(txnCtx.SqlTx, authTokenID, []byte(authToken)); err != nil {
+			return err
+		}
+	}
+	replications := d.replications.ReadWrite(txnCtx.SqlTx)
+	if update {
+		replicationInfo := &pfs.ReplicationInfo{}
+		return errors.EnsureStack(replications.Upsert(replication.Repo, replicationInfo, func() error {
+			if replicationInfo.Replication == nil {
+				replicationInfo.Created = txnCtx.Timestamp
+			} else if replicationInfo.Replication.Target != replication.Target {
+				// The progress made replicating to the old target doesn't
+				// apply to the new one.
+				replicationInfo.LastCommit = nil
+				replicationInfo.LastFinished = nil
+			}
+			if replicationInfo.AuthTokenId != "" {
+				if err := chunk.DeleteEncryptionKeyTx(txnCtx.SqlTx, replicationInfo.AuthTokenId); err != nil {
+					return err
+				}
+			}
+			replicationInfo.Replication = replication
+			replicationInfo.AuthTokenId = authTokenID
+			replicationInfo.Error = ""
+			return nil
+		}))
+	}
+	if err := replications.Create(replication.Repo, &pfs.ReplicationInfo{
+		Replication: replication,
+		Created:     txnCtx.Timestamp,
+		AuthTokenId: authTokenID,
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return pfsserver.ErrReplicationExists{Repo: replication.Repo}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (d *driver) inspectReplication(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) (*pfs.ReplicationInfo, error) {
+	// Validate arguments
+	if repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	replicationInfo := &pfs.ReplicationInfo{}
+	if err := d.replications.ReadWrite(txnCtx.SqlTx).Get(repo, replicationInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrReplicationNotFound{Repo: repo}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return replicationInfo, nil
+}
+
+// listReplication calls cb with the replications of the repos that the caller
+// is authorized to read.
+func (d *driver) listReplication(ctx context.Context, cb func(*pfs.ReplicationInfo) error) error {
+	replicationInfo := &pfs.ReplicationInfo{}
+	return errors.EnsureStack(d.replications.ReadOnly(ctx).List(replicationInfo, col.DefaultOptions(), func(string) error {
+		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, replicationInfo.Replication.Repo, auth.Permission_REPO_READ); err != nil {
+			if auth.IsErrNotAuthorized(err) {
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		return cb(replicationInfo)
+	}))
+}
+
+func (d *driver) deleteReplication(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.deleteReplicationInTransaction(txnCtx, repo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrReplicationNotFound{Repo: repo}
+		}
+		return err
+	}
+	return nil
+}
+
+// deleteReplicationInTransaction deletes the replication of repo, and destroys
+// its auth token.
+func (d *driver) deleteReplicationInTransaction(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) error {
+	replications := d.replications.ReadWrite(txnCtx.SqlTx)
+	replicationInfo := &pfs.ReplicationInfo{}
+	if err := replications.Get(repo, replicationInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := replications.Delete(repo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if replicationInfo.AuthTokenId != "" {
+		return chunk.DeleteEncryptionKeyTx(txnCtx.SqlTx, replicationInfo.AuthTokenId)
+	}
+	return nil
+}
+
+// replicateRepos runs a replicator for each replication, restarting it when
+// the replication is updated, until ctx is cancelled.
+func (d *driver) replicateRepos(ctx context.Context) error {
+	type replicator struct {
+		replication *pfs.Replication
+		authTokenID string
+		cancel      context.CancelFunc
+	}
+	replicators := make(map[string]*replicator)
+	defer func() {
+		for _, r := range replicators {
+			r.cancel()
+		}
+	}()
+	err := d.replications.ReadOnly(ctx).WatchF(func(ev *watch.Event) error {
+		if ev.Type == watch.EventError {
+			return ev.Err
+		}
+		key := string(ev.Key)
+		if ev.Type == watch.EventDelete {
+			if r, ok := replicators[key]; ok {
+				r.cancel()
+				replicationLagMetric.DeleteLabelValues(key)
+			}
+			delete(replicators, key)
+			return nil
+		}
+		replicationInfo := &pfs.ReplicationInfo{}
+		if err := ev.Unmarshal(&key, replicationInfo); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if r, ok := replicators[key]; ok {
+			// The replicator updates its own progress, which can be ignored.
+			if proto.Equal(r.replication, replicationInfo.Replication) && r.authTokenID == replicationInfo.AuthTokenId {
+				return nil
+			}
+			r.cancel()
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		replicators[key] = &replicator{
+			replication: replicationInfo.Replication,
+			authTokenID: replicationInfo.AuthTokenId,
+			cancel:      cancel,
+		}
+		repo := replicationInfo.Replication.Repo
+		go func() {
+			backoff.RetryUntilCancel(ctx, func() error {
+				return d.replicateRepo(ctx, repo)
+			}, backoff.NewInfiniteBackOff(), func(err error, dur time.Duration) error {
+				log.Errorf("error replicating repo %v: %v, retrying in %v", repo, err, dur)
+				d.setReplicationError(ctx, repo, err)
+				return nil
+			})
+		}()
+		return nil
+	})
+	return errors.EnsureStack(err)
+}
+
+// replicateRepo replicates the commits of repo to the target of its
+// replication, in the order they were created, waiting for new commits until
+// ctx is cancelled. It resumes after the last commit which was replicated.
+func (d *driver) replicateRepo(ctx context.Context, repo *pfs.Repo) error {
+	replicationInfo := &pfs.ReplicationInfo{}
+	if err := d.replications.ReadOnly(ctx).Get(repo, replicationInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	target, err := client.NewFromURI(replicationInfo.Replication.Target)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+	if replicationInfo.AuthTokenId != "" {
+		authToken, err := d.storage.ChunkStorage().GetEncryptionKey(ctx, replicationInfo.AuthTokenId)
+		if err != nil {
+			return err
+		}
+		target.SetAuthToken(string(authToken))
+	}
+	target = target.WithCtx(ctx)
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var key []byte
+	if repoInfo.EncryptionKeyId != "" {
+		if key, err = d.storage.ChunkStorage().GetEncryptionKey(ctx, repoInfo.EncryptionKeyId); err != nil {
+			return err
+		}
+	}
+	// Create the repo in the target cluster, even if it has no commits yet.
+	if err := sendArchive(target, func(tw *tar.Writer) error {
+		return writeArchiveRepo(tw, repoInfo, key)
+	}); err != nil {
+		return err
+	}
+	// If the last replicated commit has since been squashed, fall back to
+	// checking every commit against the target.
+	last := replicationInfo.LastCommit
+	lag := &replicationLag{pending: make(map[string]*pendingCommit)}
+	if last != nil {
+		lastInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(last, lastInfo); err != nil {
+			if !col.IsErrNotFound(err) {
+				return errors.EnsureStack(err)
+			}
+			last = nil
+		}
+		lag.lastStarted = lastInfo.Started
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		err := d.commits.ReadOnly(ctx).WatchByIndexF(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo), func(ev *watch.Event) error {
+			if ev.Type == watch.EventError {
+				return ev.Err
+			}
+			if ev.Type == watch.EventDelete {
+				lag.remove(string(ev.Key))
+				return nil
+			}
+			var key string
+			commitInfo := &pfs.CommitInfo{}
+			if err := ev.Unmarshal(&key, commitInfo); err != nil {
+				return errors.EnsureStack(err)
+			}
+			lag.add(commitInfo)
+			return nil
+		})
+		return errors.EnsureStack(err)
+	})
+	eg.Go(func() error {
+		ticker := time.NewTicker(replicationLagInterval)
+		defer ticker.Stop()
+		for {
+			replicationLagMetric.WithLabelValues(pfsdb.RepoKey(repo)).Set(lag.seconds())
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	})
+	eg.Go(func() error {
+		return d.subscribeCommit(ctx, repo, "", nil, pfs.CommitState_FINISHED, true, pfs.OriginKind_ORIGIN_KIND_UNKNOWN, func(commitInfo *pfs.CommitInfo) error {
+			if last != nil {
+				if pfsdb.CommitKey(commitInfo.Commit) == pfsdb.CommitKey(last) {
+					last = nil
+				}
+				return nil
+			}
+			if err := d.sendCommit(ctx, target, replicationInfo.Replication, repoInfo, key, commitInfo); err != nil {
+				return errors.Wrapf(err, "error replicating commit %s", commitInfo.Commit)
+			}
+			if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+				replicationInfo := &pfs.ReplicationInfo{}
+				return errors.EnsureStack(d.replications.ReadWrite(txnCtx.SqlTx).Update(repo, replicationInfo, func() error {
+					replicationInfo.LastCommit = commitInfo.Commit
+					replicationInfo.LastFinished = commitInfo.Finished
+					replicationInfo.Error = ""
+					return nil
+				}))
+			}); err != nil {
+				return err
+			}
+			lag.replicated(commitInfo)
+			return nil
+		})
+	})
+	return errors.EnsureStack(eg.Wait())
+}
+
+// replicationLag tracks the finished commits of a repo which haven't been
+// replicated, as they're finished and replicated, so the replication lag is
+// known without scanning the repo's commits. Commits are replicated in the
+// order they were created, so the commits which haven't been replicated are
+// the ones started after the last replicated commit.
+type replicationLag struct {
+	mu          sync.Mutex
+	lastStarted *types.Timestamp
+	// pending is keyed by commit key.
+	pending map[string]*pendingCommit
+}
+
+type pendingCommit struct {
+	started, finished *types.Timestamp
+}
+
+// add records commitInfo if it's finished, and hasn't been replicated.
+func (l *replicationLag) add(commitInfo *pfs.CommitInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if commitInfo.Finished == nil || (l.lastStarted != nil && commitInfo.Started.Compare(l.lastStarted) <= 0) {
+		return
+	}
+	l.pending[pfsdb.CommitKey(commitInfo.Commit)] = &pendingCommit{
+		started:  commitInfo.Started,
+		finished: commitInfo.Finished,
+	}
+}
+
+// remove forgets a deleted commit.
+func (l *replicationLag) remove(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.pending, key)
+}
+
+// replicated forgets commitInfo, and the commits started before it, once it's
+// been replicated.
+func (l *replicationLag) replicated(commitInfo *pfs.CommitInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastStarted = commitInfo.Started
+	for key, c := range l.pending {
+		if c.started.Compare(l.lastStarted) <= 0 {
+			delete(l.pending, key)
+		}
+	}
+}
+
+// seconds returns the time since the oldest finished commit which hasn't been
+// replicated finished, or 0 if every finished commit has been replicated.
+func (l *replicationLag) seconds() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var oldest *types.Timestamp
+	for _, c := range l.pending {
+		if oldest == nil || c.finished.Compare(oldest) < 0 {
+			oldest = c.finished
+		}
+	}
+	finished, err := types.TimestampFromProto(oldest)
+	if err != nil {
+		return 0
+	}
+	return time.Since(finished).Seconds()
+}
+
+// sendCommit replicates a finished commit to the target cluster, with the
+// same ID, branch, parent, description, error and provenance. The commit's
+// diff file set is sent, along with the chunks it points to which the target
+// cluster doesn't have. A commit which was already replicated is skipped.
+func (d *driver) sendCommit(ctx context.Context, target *client.APIClient, replication *pfs.Replication, repoInfo *pfs.RepoInfo, key []byte, commitInfo *pfs.CommitInfo) error {
+	if _, err := target.PfsAPIClient.InspectCommit(target.Ctx(), &pfs.InspectCommitRequest{
+		Commit: commitInfo.Commit,
+	}); err == nil {
+		return nil
+	} else if err := grpcutil.ScrubGRPC(err); !pfsserver.IsCommitNotFoundErr(err) {
+		return err
+	}
+	id, err := d.commitStore.GetDiffFileSet(ctx, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	var chunkIDs []chunk.ID
+	if err := d.storage.Export(ctx, *id, func(_ fileset.ID, md *fileset.Metadata) error {
+		if prim := md.GetPrimitive(); prim != nil {
+			chunkIDs = append(chunkIDs, prim.PointsTo()...)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	chunkIDs, err = d.storage.ChunkStorage().Closure(ctx, chunkIDs)
+	if err != nil {
+		return err
+	}
+	missing, err := findMissingChunks(target, chunkIDs)
+	if err != nil {
+		return err
+	}
+	chunks := d.storage.ChunkStorage().NewExporter(false)
+	for _, chunkID := range chunkIDs {
+		if _, ok := missing[string(chunkID)]; !ok {
+			chunks.Skip(chunkID)
+		}
+	}
+	commitInfo, err = d.replicatedCommitInfo(ctx, replication, commitInfo)
+	if err != nil {
+		return err
+	}
+	return sendArchive(target, func(tw *tar.Writer) error {
+		if err := writeArchiveRepo(tw, repoInfo, key); err != nil {
+			return err
+		}
+		if err := d.exportFileSet(ctx, tw, chunks, make(map[string]struct{}), *id); err != nil {
+			return err
+		}
+		name := path.Join(archiveCommits, commitInfo.Commit.Branch.Name, commitInfo.Commit.ID)
+		if err := writeArchiveFile(tw, path.Join(name, archiveDiff), []byte(id.HexString())); err != nil {
+			return err
+		}
+		return writeArchiveProto(tw, path.Join(name, archiveInfo), commitInfo)
+	})
+}
+
+// replicatedCommitInfo returns the CommitInfo sent to the target cluster. Its
+// provenance is limited to the repos which are replicated to the same target,
+// as the others never exist there.
+func (d *driver) replicatedCommitInfo(ctx context.Context, replication *pfs.Replication, commitInfo *pfs.CommitInfo) (*pfs.CommitInfo, error) {
+	if len(commitInfo.DirectProvenance) == 0 {
+		return commitInfo, nil
+	}
+	replicated := make(map[string]bool)
+	replicationInfo := &pfs.ReplicationInfo{}
+	if err := d.replications.ReadOnly(ctx).List(replicationInfo, col.DefaultOptions(), func(string) error {
+		if replicationInfo.Replication.Target == replication.Target {
+			replicated[pfsdb.RepoKey(replicationInfo.Replication.Repo)] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	commitInfo = proto.Clone(commitInfo).(*pfs.CommitInfo)
+	var provenance []*pfs.Branch
+	for _, branch := range commitInfo.DirectProvenance {
+		if replicated[pfsdb.RepoKey(branch.Repo)] {
+			provenance = append(provenance, branch)
+		}
+	}
+	commitInfo.DirectProvenance = provenance
+	return commitInfo, nil
+}
+
+// findMissingChunks returns the set of the chunks in ids which the target
+// cluster doesn't have.
+func findMissingChunks(target *client.APIClient, ids []chunk.ID) (map[string]struct{}, error) {
+	missing := make(map[string]struct{})
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > findMissingChunksBatchSize {
+			batch = batch[:findMissingChunksBatchSize]
+		}
+		ids = ids[len(batch):]
+		request := &pfs.FindMissingChunksRequest{}
+		for _, id := range batch {
+			request.ChunkIds = append(request.ChunkIds, id)
+		}
+		response, err := target.PfsAPIClient.FindMissingChunks(target.Ctx(), request)
+		if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		for _, id := range response.ChunkIds {
+			missing[string(id)] = struct{}{}
+		}
+	}
+	return missing, nil
+}
+
+// sendArchive sends the archive written by cb to the target cluster with
+// ReplicateCommit.
+func sendArchive(target *client.APIClient, cb func(*tar.Writer) error) error {
+	ctx, cancel := context.WithCancel(target.Ctx())
+	defer cancel()
+	rc, err := target.PfsAPIClient.ReplicateCommit(ctx)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err := miscutil.WithPipe(func(w io.Writer) error {
+		return withArchiveWriter(w, cb)
+	}, func(r io.Reader) error {
+		_, err := grpcutil.ChunkReader(r, func(data []byte) error {
+			return errors.EnsureStack(rc.Send(&pfs.ReplicateCommitRequest{Data: data}))
+		})
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	_, err = rc.CloseAndRecv()
+	return grpcutil.ScrubGRPC(err)
+}
+
+func writeArchiveRepo(tw *tar.Writer, repoInfo *pfs.RepoInfo, key []byte) error {
+	if err := writeArchiveProto(tw, archiveRepo, repoInfo); err != nil {
+		return err
+	}
+	if key != nil {
+		return writeArchiveFile(tw, archiveKey, key)
+	}
+	return nil
+}
+
+// findMissingChunks returns the IDs of the chunks in ids which this cluster
+// doesn't have.
+func (d *driver) findMissingChunks(ctx context.Context, ids [][]byte) ([][]byte, error) {
+	var chunkIDs []chunk.ID
+	for _, id := range ids {
+		chunkIDs = append(chunkIDs, id)
+	}
+	missing, err := d.storage.ChunkStorage().Missing(ctx, chunkIDs)
+	if err != nil {
+		return nil, err
+	}
+	var result [][]byte
+	for _, id := range missing {
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// replicateCommit creates the repo of an archive sent by a replicating
+// cluster if it doesn't exist, and recreates the commit in the archive, if
+// there is one.
+func (d *driver) replicateCommit(ctx context.Context, r io.Reader) error {
+	chunks := d.storage.ChunkStorage().NewImporter(ctx, "replicate-"+uuid.NewWithoutDashes(), false)
+	defer chunks.Close()
+	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		archive, err := d.readRepoArchive(ctx, r, chunks, renewer)
+		if err != nil {
+			return err
+		}
+		if len(archive.branchInfos) > 0 {
+			return errors.New("replicated archives cannot have branches")
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			return d.replicateCommitInTransaction(txnCtx, archive)
+		})
+	})
+}
+
+func (d *driver) replicateCommitInTransaction(txnCtx *txncontext.TransactionContext, archive *repoArchive) error {
+	archived := archive.repoInfo
+	repo := archived.Repo
+	if repo.Type != pfs.UserRepoType {
+		return errors.Errorf("cannot replicate %s, only user repos can be replicated", repo)
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(repo, repoInfo); err != nil {
+		if !col.IsErrNotFound(err) {
+			return errors.EnsureStack(err)
+		}
+		if err := d.createRepo(txnCtx, repo, archived.Description, false); err != nil {
+			return err
+		}
+		if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(repo, repoInfo, func() error {
+			return d.importEncryptionKey(txnCtx, repoInfo, archive)
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	} else if archived.EncryptionKeyId != "" && repoInfo.EncryptionKeyId != archived.EncryptionKeyId {
+		// The replicated chunks refer to the key by its ID.
+		return errors.Errorf("repo %v has a different encryption key than the replicated repo, it must be deleted so it's recreated by replication", repo)
+	}
+	for _, c := range archive.commits {
+		if err := d.replicateArchivedCommit(txnCtx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replicateArchivedCommit recreates a replicated commit with its ID, and
+// finishes it, so its total file set is computed from its parent's and its
+// diff file set. It's skipped if it already exists.
+func (d *driver) replicateArchivedCommit(txnCtx *txncontext.TransactionContext, c *importedCommit) error {
+	commitInfo := c.info
+	commit := commitInfo.Commit
+	if c.total != nil {
+		return errors.Errorf("replicated commit %v cannot have a total file set", commit)
+	}
+	if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(commit, &pfs.CommitInfo{}); err == nil {
+		return nil
+	} else if !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	// The commit's ID can only be shared with the commits it's provenant on,
+	// which were replicated from the same commit set.
+	provenance := make(map[string]bool)
+	for _, branch := range commitInfo.DirectProvenance {
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(branch, &pfs.BranchInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("provenance %v of commit %v has not been replicated yet", branch, commit)
+			}
+			return errors.EnsureStack(err)
+		}
+		provenance[pfsdb.BranchKey(branch)] = true
+	}
+	existing := &pfs.CommitInfo{}
+	if err := d.commits.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.CommitsCommitSetIndex, commit.ID, existing, col.DefaultOptions(), func(string) error {
+		if !provenance[pfsdb.BranchKey(existing.Commit.Branch)] {
+			return errors.Errorf("cannot replicate commit %v, its ID is already used by commit %v", commit, existing.Commit)
+		}
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	txnCtx.CommitSetID = commit.ID
+	newCommit, err := d.startCommit(txnCtx, commitInfo.ParentCommit, commit.Branch, commitInfo.Description)
+	if err != nil {
+		return err
+	}
+	if len(commitInfo.DirectProvenance) > 0 {
+		newCommitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(newCommit, newCommitInfo, func() error {
+			newCommitInfo.DirectProvenance = commitInfo.DirectProvenance
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if c.diff != nil {
+		if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, newCommit, *c.diff); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return d.finishCommit(txnCtx, newCommit, "", commitInfo.Error, false)
+}
+
+// setReplicationError records the error of the last attempt to replicate repo,
+// so it's shown by InspectReplication.
+func (d *driver) setReplicationError(ctx context.Context, repo *pfs.Repo, replicationErr error) {
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		replicationInfo := &pfs.ReplicationInfo{}
+		return errors.EnsureStack(d.replications.ReadWrite(txnCtx.SqlTx).Update(repo, replicationInfo, func() error {
+			replicationInfo.Error = replicationErr.Error()
+			return nil
+		}))
+	}); err != nil && ctx.Err() == nil {
+		log.Errorf("error recording replication error of repo %v: %v", repo, err)
+	}
+}
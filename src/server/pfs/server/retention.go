@@ -0,0 +1,310 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+func validateRetentionPolicy(policy *pfs.RetentionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.KeepLast < 0 {
+		return errors.Errorf("retention policy cannot keep a negative number of commits (%d)", policy.KeepLast)
+	}
+	var set bool
+	for _, d := range []*types.Duration{policy.KeepWithin, policy.KeepDailyWithin} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if duration < 0 {
+			return errors.Errorf("retention policy durations cannot be negative (%v)", duration)
+		}
+		set = set || duration > 0
+	}
+	if policy.KeepLast == 0 && !set {
+		return errors.New("retention policy must keep some commits, use keep_last 1 to only keep the head of each branch")
+	}
+	return nil
+}
+
+// setRetentionPolicy sets the retention policy of repo, or of branch if repo
+// is nil. A nil policy removes the existing one.
+func (d *driver) setRetentionPolicy(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, branch *pfs.Branch, policy *pfs.RetentionPolicy) error {
+	// Validate arguments
+	if (repo == nil) == (branch == nil) {
+		return errors.New("exactly one of repo and branch must be set")
+	}
+	if branch != nil && branch.Repo == nil {
+		return errors.New("branch repo cannot be nil")
+	}
+	if err := validateRetentionPolicy(policy); err != nil {
+		return err
+	}
+	if repo == nil {
+		if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+			return errors.EnsureStack(err)
+		}
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Update(branch, branchInfo, func() error {
+			branchInfo.RetentionPolicy = policy
+			return nil
+		}); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrBranchNotFound{Branch: branch}
+			}
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+		return errors.EnsureStack(err)
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Update(repo, repoInfo, func() error {
+		repoInfo.RetentionPolicy = policy
+		return nil
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// enforceRetention squashes the commit sets which aren't kept by any
+// retention policy, and returns them. Only commit sets with a commit in repo
+// are considered, or with a commit in any repo that the caller is authorized
+// on if repo is nil.
+//
+// A commit set is squashed only if every commit in it can be squashed: the
+// commit must be finished, have children, and not be tagged. Commits on
+// branches with a retention policy must not be kept by it, and commits on
+// branches without one must not have been made by a user, so that a policy
+// never squashes data that nobody asked to expire.
+func (d *driver) enforceRetention(ctx context.Context, repo *pfs.Repo, dryRun bool) ([]*pfs.CommitSet, error) {
+	permission := auth.Permission_REPO_DELETE_COMMIT
+	if dryRun {
+		permission = auth.Permission_REPO_LIST_COMMIT
+	}
+	if repo != nil {
+		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, permission); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	policies := make(map[string]*pfs.RetentionPolicy)
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+		if repoInfo.RetentionPolicy != nil {
+			policies[pfsdb.RepoKey(repoInfo.Repo)] = repoInfo.RetentionPolicy
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	// governed holds the branches with a retention policy, and candidates the
+	// commits which their policies don't keep.
+	governed := make(map[string]bool)
+	candidates := make(map[string]bool)
+	authorized := make(map[string]bool)
+	var seeds []*pfs.Commit
+	now := time.Now()
+	var branchInfos []*pfs.BranchInfo
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadOnly(ctx).List(branchInfo, col.DefaultOptions(), func(string) error {
+		branchInfos = append(branchInfos, proto.Clone(branchInfo).(*pfs.BranchInfo))
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, branchInfo := range branchInfos {
+		policy := branchInfo.RetentionPolicy
+		if policy == nil {
+			policy = policies[pfsdb.RepoKey(branchInfo.Branch.Repo)]
+		}
+		if policy == nil || branchInfo.Head == nil {
+			continue
+		}
+		governed[pfsdb.BranchKey(branchInfo.Branch)] = true
+		commits, err := d.unretainedCommits(ctx, branchInfo, policy, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, commit := range commits {
+			candidates[pfsdb.CommitKey(commit)] = true
+		}
+		if repo != nil {
+			if pfsdb.RepoKey(branchInfo.Branch.Repo) == pfsdb.RepoKey(repo) {
+				seeds = append(seeds, commits...)
+			}
+			continue
+		}
+		key := pfsdb.RepoKey(branchInfo.Branch.Repo)
+		ok, checked := authorized[key]
+		if !checked {
+			if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, branchInfo.Branch.Repo, permission); err != nil {
+				if !auth.IsErrNotAuthorized(err) {
+					return nil, errors.EnsureStack(err)
+				}
+			} else {
+				ok = true
+			}
+			authorized[key] = ok
+		}
+		if ok {
+			seeds = append(seeds, commits...)
+		}
+	}
+	var commitSets []*pfs.CommitSet
+	seen := make(map[string]bool)
+	for _, commit := range seeds {
+		if seen[commit.ID] {
+			continue
+		}
+		seen[commit.ID] = true
+		commitSet := &pfs.CommitSet{ID: commit.ID}
+		ok, err := d.canRetire(ctx, commitSet, governed, candidates)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if !dryRun {
+			if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+				return d.squashCommitSet(txnCtx, commitSet, false)
+			}); err != nil {
+				// The commit set changed since it was checked.
+				if pfsserver.IsTaggedCommitErr(err) || pfsserver.IsSquashWithoutChildrenErr(err) {
+					continue
+				}
+				return nil, err
+			}
+		}
+		commitSets = append(commitSets, commitSet)
+	}
+	return commitSets, nil
+}
+
+// unretainedCommits returns the finished commits on the branch of branchInfo
+// which policy doesn't keep, newest first.
+func (d *driver) unretainedCommits(ctx context.Context, branchInfo *pfs.BranchInfo, policy *pfs.RetentionPolicy, now time.Time) ([]*pfs.Commit, error) {
+	var keepWithin, keepDailyWithin time.Duration
+	if policy.KeepWithin != nil {
+		var err error
+		if keepWithin, err = types.DurationFromProto(policy.KeepWithin); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	if policy.KeepDailyWithin != nil {
+		var err error
+		if keepDailyWithin, err = types.DurationFromProto(policy.KeepDailyWithin); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	var result []*pfs.Commit
+	days := make(map[string]bool)
+	commit := branchInfo.Head
+	for i := int64(0); commit != nil && commit.Branch.Name == branchInfo.Branch.Name; i++ {
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).Get(commit, commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				break
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		commit = commitInfo.ParentCommit
+		started, err := types.TimestampFromProto(commitInfo.Started)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		age := now.Sub(started)
+		keep := i == 0 || i < policy.KeepLast || (keepWithin > 0 && age <= keepWithin)
+		if keepDailyWithin > 0 && age <= keepDailyWithin {
+			// Commits are visited newest first, so the first commit of each day
+			// is its newest.
+			day := started.UTC().Format("2006-01-02")
+			if !days[day] {
+				days[day] = true
+				keep = true
+			}
+		}
+		if !keep && commitInfo.Finished != nil {
+			result = append(result, commitInfo.Commit)
+		}
+	}
+	return result, nil
+}
+
+// canRetire returns whether every commit in commitSet can be squashed by a
+// retention policy, see enforceRetention.
+func (d *driver) canRetire(ctx context.Context, commitSet *pfs.CommitSet, governed, candidates map[string]bool) (bool, error) {
+	var commitInfos []*pfs.CommitInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		commitInfos, err = d.inspectCommitSetImmediate(txnCtx, commitSet)
+		return err
+	}); err != nil {
+		return false, err
+	}
+	tagInfo := &pfs.TagInfo{}
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished == nil || len(commitInfo.ChildCommits) == 0 {
+			return false, nil
+		}
+		if !candidates[pfsdb.CommitKey(commitInfo.Commit)] {
+			if governed[pfsdb.BranchKey(commitInfo.Commit.Branch)] || commitInfo.Origin.Kind == pfs.OriginKind_USER {
+				return false, nil
+			}
+		}
+		var tagged bool
+		if err := d.tags.ReadOnly(ctx).GetByIndex(pfsdb.TagsCommitIndex, pfsdb.CommitKey(commitInfo.Commit), tagInfo, col.DefaultOptions(), func(string) error {
+			tagged = true
+			return nil
+		}); err != nil && !col.IsErrNotFound(err) {
+			return false, errors.EnsureStack(err)
+		}
+		if tagged {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// enforceRetentionForever enforces the retention policies of all repos every
+// period, until ctx is canceled.
+func (d *driver) enforceRetentionForever(ctx context.Context, period time.Duration) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		case <-ticker.C:
+		}
+		commitSets, err := d.enforceRetention(ctx, nil, false)
+		if err != nil {
+			log.Errorf("error enforcing retention policies: %v", err)
+			continue
+		}
+		if len(commitSets) > 0 {
+			log.Infof("squashed %d commit sets to enforce retention policies", len(commitSets))
+		}
+	}
+}
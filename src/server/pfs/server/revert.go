@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+// revertCommit creates a new commit on branch, or on the branch of commit if
+// branch is nil. If restore is set, the content of the new commit equals the
+// content of commit. Otherwise, the changes that commit made to its parent are
+// reversed on top of the head of branch, which fails if later commits changed
+// the same paths. History is kept: the new commit's parent is the head of
+// branch, and it propagates downstream like any other commit.
+func (d *driver) revertCommit(ctx context.Context, commit *pfs.Commit, branch *pfs.Branch, restore bool, description string) (*pfs.Commit, error) {
+	if commit == nil {
+		return nil, errors.New("commit cannot be nil")
+	}
+	if commit.Branch == nil || commit.Branch.Repo == nil {
+		return nil, errors.New("commit repo cannot be nil")
+	}
+	commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finishing == nil {
+		return nil, pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+	}
+	if branch == nil {
+		branch = commitInfo.Commit.Branch
+	}
+	if !proto.Equal(branch.Repo, commitInfo.Commit.Branch.Repo) {
+		return nil, errors.Errorf("cannot revert commit %s on branch %s, the commit and the branch must be in the same repo", commitInfo.Commit, branch)
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	ours, err := d.finishedHead(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	if restore {
+		changes, err := d.changesSince(ctx, ours, commitInfo)
+		if err != nil {
+			return nil, err
+		}
+		if description == "" {
+			description = fmt.Sprintf("Restore commit %s", commitInfo.Commit.ID)
+		}
+		return d.commitChanges(ctx, branch, ours, commitInfo, changes, description, nil)
+	}
+	var parentInfo *pfs.CommitInfo
+	if commitInfo.ParentCommit != nil {
+		if parentInfo, err = d.inspectCommit(ctx, commitInfo.ParentCommit, pfs.CommitState_STARTED); err != nil {
+			return nil, err
+		}
+	}
+	// The changes from commit to its parent reverse the changes commit made.
+	var changes map[string]*pfs.FileInfo
+	if parentInfo != nil {
+		changes, err = d.changesSince(ctx, commitInfo, parentInfo)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Without a parent, commit added all of its files.
+		changes, err = d.changesSince(ctx, nil, commitInfo)
+		if err != nil {
+			return nil, err
+		}
+		for p := range changes {
+			changes[p] = nil
+		}
+	}
+	if !proto.Equal(ours.Commit, commitInfo.Commit) {
+		later, err := d.changesSince(ctx, commitInfo, ours)
+		if err != nil {
+			return nil, err
+		}
+		var conflicts []string
+		for p := range changes {
+			if _, ok := later[p]; ok {
+				conflicts = append(conflicts, p)
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			return nil, pfsserver.ErrRevertConflict{Commit: commitInfo.Commit, Branch: branch, Paths: conflicts}
+		}
+	}
+	if description == "" {
+		description = fmt.Sprintf("Revert commit %s", commitInfo.Commit.ID)
+	}
+	return d.commitChanges(ctx, branch, ours, parentInfo, changes, description, nil)
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"golang.org/x/net/context"
+)
+
+func (d *driver) createTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag, commit *pfs.Commit, description string) error {
+	// Validate arguments
+	if tag == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if commit.Branch == nil || !proto.Equal(commit.Branch.Repo, tag.Repo) {
+		return errors.Errorf("commit %s must be in the repo of tag %s", commit, tag)
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	if uuid.IsUUIDWithoutDashes(tag.Name) {
+		return errors.Errorf("tag name cannot be a commit ID: %s", tag.Name)
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_CREATE_TAG); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Tags and branches are both resolved from <repo>@<name>, so they can't
+	// share a name.
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(tag.Repo.NewBranch(tag.Name), &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("cannot create tag %s, a branch with the same name exists", tag)
+	} else if !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Create(tag, &pfs.TagInfo{
+		Tag:         tag,
+		Commit:      commitInfo.Commit,
+		Created:     txnCtx.Timestamp,
+		Description: description,
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return pfsserver.ErrTagExists{Tag: tag}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+func (d *driver) inspectTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	// Validate arguments
+	if tag == nil {
+		return nil, errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return nil, errors.New("tag repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_LIST_TAG); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(tag, tagInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, pfsserver.ErrTagNotFound{Tag: tag}
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return tagInfo, nil
+}
+
+// listTag calls cb with the tags of repo, or with the tags of all repos that
+// the caller is authorized to list the tags of if repo is nil.
+func (d *driver) listTag(ctx context.Context, repo *pfs.Repo, cb func(*pfs.TagInfo) error) error {
+	tagInfo := &pfs.TagInfo{}
+	opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortDescend}
+	if repo != nil {
+		if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_LIST_TAG); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return errors.EnsureStack(d.tags.ReadOnly(ctx).GetByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo), tagInfo, opts, func(string) error {
+			return cb(tagInfo)
+		}))
+	}
+	authorized := make(map[string]bool)
+	return errors.EnsureStack(d.tags.ReadOnly(ctx).List(tagInfo, opts, func(string) error {
+		key := pfsdb.RepoKey(tagInfo.Tag.Repo)
+		ok, checked := authorized[key]
+		if !checked {
+			if err := d.env.AuthServer.CheckRepoIsAuthorized(ctx, tagInfo.Tag.Repo, auth.Permission_REPO_LIST_TAG); err != nil {
+				if !auth.IsErrNotAuthorized(err) {
+					return errors.EnsureStack(err)
+				}
+			} else {
+				ok = true
+			}
+			authorized[key] = ok
+		}
+		if !ok {
+			return nil
+		}
+		return cb(tagInfo)
+	}))
+}
+
+func (d *driver) deleteTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag) error {
+	// Validate arguments
+	if tag == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_DELETE_TAG); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Delete(tag); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrTagNotFound{Tag: tag}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// checkNotTag returns an error if there is a tag with the same name as branch.
+func (d *driver) checkNotTag(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) error {
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(branch.Repo.NewTag(branch.Name), &pfs.TagInfo{}); err == nil {
+		return errors.Errorf("cannot create branch %s, a tag with the same name exists", branch)
+	} else if !col.IsErrNotFound(err) {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// checkTaggedCommits returns an error if any of commitInfos is tagged, unless
+// force is set, in which case the tags are deleted.
+func (d *driver) checkTaggedCommits(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo, force bool) error {
+	tags := d.tags.ReadWrite(txnCtx.SqlTx)
+	tagInfo := &pfs.TagInfo{}
+	for _, commitInfo := range commitInfos {
+		var names []string
+		if err := tags.GetByIndex(pfsdb.TagsCommitIndex, pfsdb.CommitKey(commitInfo.Commit), tagInfo, col.DefaultOptions(), func(string) error {
+			names = append(names, tagInfo.Tag.Name)
+			return nil
+		}); err != nil && !col.IsErrNotFound(err) {
+			return errors.EnsureStack(err)
+		}
+		if len(names) == 0 {
+			continue
+		}
+		if !force {
+			return pfsserver.ErrTaggedCommit{Commit: commitInfo.Commit, Tags: names}
+		}
+		if err := d.env.AuthServer.CheckRepoIsAuthorizedInTransaction(txnCtx, commitInfo.Commit.Branch.Repo, auth.Permission_REPO_DELETE_TAG); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if err := tags.DeleteByIndex(pfsdb.TagsCommitIndex, pfsdb.CommitKey(commitInfo.Commit)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -32,6 +33,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
@@ -217,6 +219,499 @@ func TestPFS(suite *testing.T) {
 		require.NotNil(t, commitInfo.ParentCommit)
 	})
 
+	suite.Run("ExpectedHead", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommitIfHead(repo, "master", "", commit1.ID))
+
+		// the branch hasn't moved, so a new commit can be started on it
+		commit2, err := env.PachClient.StartCommitIfHead(repo, "master", commit1.ID)
+		require.NoError(t, err)
+		// the branch has moved to commit2
+		err = env.PachClient.FinishCommitIfHead(repo, "master", commit2.ID, commit1.ID)
+		require.YesError(t, err)
+		require.True(t, pacherr.IsConflict(err))
+		require.NoError(t, env.PachClient.FinishCommitIfHead(repo, "master", commit2.ID, commit2.ID))
+		_, err = env.PachClient.StartCommitIfHead(repo, "master", commit1.ID)
+		require.YesError(t, err)
+		require.True(t, pacherr.IsConflict(err))
+
+		// move the branch back, only if it's still at commit2
+		err = env.PachClient.CreateBranchIfHead(repo, "master", "", commit1.ID, nil, commit1.ID)
+		require.YesError(t, err)
+		require.True(t, pacherr.IsConflict(err))
+		require.NoError(t, env.PachClient.CreateBranchIfHead(repo, "master", "", commit1.ID, nil, commit2.ID))
+		branchInfo, err := env.PachClient.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, commit1.ID, branchInfo.Head.ID)
+
+		// a branch which doesn't exist has no head to match
+		err = env.PachClient.CreateBranchIfHead(repo, "new", "", commit1.ID, nil, commit1.ID)
+		require.YesError(t, err)
+		require.True(t, pacherr.IsConflict(err))
+	})
+
+	suite.Run("MergeBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		feature := client.NewCommit(repo, "feature", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		for _, name := range []string{"a", "b", "c"} {
+			require.NoError(t, env.PachClient.PutFile(master, name, strings.NewReader(name)))
+		}
+		require.NoError(t, env.PachClient.CreateBranch(repo, "feature", "master", "", nil))
+		require.NoError(t, env.PachClient.PutFile(feature, "a", strings.NewReader("feature")))
+		require.NoError(t, env.PachClient.DeleteFile(feature, "b"))
+		require.NoError(t, env.PachClient.PutFile(feature, "d", strings.NewReader("d")))
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("master")))
+		require.NoError(t, env.PachClient.PutFile(master, "e", strings.NewReader("e")))
+		masterInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		featureInfo, err := env.PachClient.InspectCommit(repo, "feature", "")
+		require.NoError(t, err)
+
+		resp, err := env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(resp.Conflicts))
+		mergeInfo, err := env.PachClient.WaitCommit(repo, "master", resp.Commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, masterInfo.Commit.ID, mergeInfo.ParentCommit.ID)
+		require.Equal(t, featureInfo.Commit.ID, mergeInfo.MergeParent.ID)
+		checkFiles := func(commit *pfs.Commit, expected map[string]string) {
+			var names []string
+			require.NoError(t, env.PachClient.WalkFile(commit, "/", func(fi *pfs.FileInfo) error {
+				if fi.FileType == pfs.FileType_FILE {
+					names = append(names, strings.TrimPrefix(fi.File.Path, "/"))
+				}
+				return nil
+			}))
+			require.Equal(t, len(expected), len(names))
+			for _, name := range names {
+				buf := &bytes.Buffer{}
+				require.NoError(t, env.PachClient.GetFile(commit, name, buf))
+				require.Equal(t, expected[name], buf.String())
+			}
+		}
+		checkFiles(master, map[string]string{"a": "feature", "c": "master", "d": "d", "e": "e"})
+
+		// master already contains all of feature's changes
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, mergeInfo.Commit.ID, resp.Commit.ID)
+
+		// changing the same path on both branches conflicts
+		require.NoError(t, env.PachClient.PutFile(feature, "c", strings.NewReader("feature")))
+		require.NoError(t, env.PachClient.PutFile(feature, "f", strings.NewReader("f")))
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("master2")))
+		_, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_FAIL)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsMergeConflictErr(err))
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_OURS)
+		require.NoError(t, err)
+		require.Equal(t, []string{"/c"}, resp.Conflicts)
+		checkFiles(master, map[string]string{"a": "feature", "c": "master2", "d": "d", "e": "e", "f": "f"})
+
+		require.NoError(t, env.PachClient.PutFile(feature, "c", strings.NewReader("feature2")))
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("master3")))
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergeStrategy_THEIRS)
+		require.NoError(t, err)
+		require.Equal(t, []string{"/c"}, resp.Conflicts)
+		checkFiles(master, map[string]string{"a": "feature", "c": "feature2", "d": "d", "e": "e", "f": "f"})
+	})
+
+	suite.Run("RevertCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		commit1, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		// commit2 changes "a" and adds "b"
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "a", strings.NewReader("a2")))
+		require.NoError(t, env.PachClient.PutFile(commit, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, "master", commit.ID))
+		commit2, err := env.PachClient.InspectCommit(repo, "master", commit.ID)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(master, "c", strings.NewReader("c")))
+		checkFiles := func(commit *pfs.Commit, expected map[string]string) {
+			var names []string
+			require.NoError(t, env.PachClient.WalkFile(commit, "/", func(fi *pfs.FileInfo) error {
+				if fi.FileType == pfs.FileType_FILE {
+					names = append(names, strings.TrimPrefix(fi.File.Path, "/"))
+				}
+				return nil
+			}))
+			require.Equal(t, len(expected), len(names))
+			for _, name := range names {
+				buf := &bytes.Buffer{}
+				require.NoError(t, env.PachClient.GetFile(commit, name, buf))
+				require.Equal(t, expected[name], buf.String())
+			}
+		}
+
+		// reverse the changes of commit2 on top of the later commit
+		headInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		revert, err := env.PachClient.RevertCommit(repo, "master", commit2.Commit.ID, false)
+		require.NoError(t, err)
+		revertInfo, err := env.PachClient.WaitCommit(repo, "master", revert.ID)
+		require.NoError(t, err)
+		require.Equal(t, headInfo.Commit.ID, revertInfo.ParentCommit.ID)
+		checkFiles(master, map[string]string{"a": "a", "c": "c"})
+
+		// restore the content of commit2
+		_, err = env.PachClient.RevertCommit(repo, "master", commit2.Commit.ID, true)
+		require.NoError(t, err)
+		checkFiles(master, map[string]string{"a": "a2", "b": "b"})
+
+		// reversing the first commit conflicts with later changes to "a"
+		_, err = env.PachClient.RevertCommit(repo, "master", commit1.Commit.ID, false)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsRevertConflictErr(err))
+		checkFiles(master, map[string]string{"a": "a2", "b": "b"})
+	})
+
+	suite.Run("ShredRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, func(config *serviceenv.Configuration) {
+			config.StorageRepoEncryptionKeys = true
+		}, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.NotEqual(t, "", repoInfo.EncryptionKeyId)
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(master, "a", buf))
+		require.Equal(t, "a", buf.String())
+
+		require.NoError(t, env.PachClient.ShredRepo(repo, false))
+		_, err = env.PachClient.InspectRepo(repo)
+		require.YesError(t, err)
+		var n int
+		require.NoError(t, env.ServiceEnv.GetDBClient().Get(&n, `SELECT COUNT(*) FROM storage.keys WHERE name = $1`, repoInfo.EncryptionKeyId))
+		require.Equal(t, 0, n)
+
+		// A plain delete also destroys the key.
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		repoInfo, err = env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteRepo(repo, false))
+		require.NoError(t, env.ServiceEnv.GetDBClient().Get(&n, `SELECT COUNT(*) FROM storage.keys WHERE name = $1`, repoInfo.EncryptionKeyId))
+		require.Equal(t, 0, n)
+	})
+
+	suite.Run("Replication", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		targetEnv := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		target := fmt.Sprintf("grpc://localhost:%d", targetEnv.MockPachd.Addr.(*net.TCPAddr).Port)
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.CreateReplication(repo, target, "", false))
+		err := env.PachClient.CreateReplication(repo, target, "", false)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsReplicationExistsErr(err))
+		// commits made after the replication was created are replicated too
+		require.NoError(t, env.PachClient.DeleteFile(master, "a"))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("c")))
+		head, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+
+		require.NoErrorWithinTRetry(t, time.Minute, func() error {
+			replicationInfo, err := env.PachClient.InspectReplication(repo)
+			if err != nil {
+				return err
+			}
+			if replicationInfo.Error != "" {
+				return errors.New(replicationInfo.Error)
+			}
+			if replicationInfo.LastCommit == nil || replicationInfo.LastCommit.ID != head.Commit.ID {
+				return errors.Errorf("last replicated commit is %v, not %v", replicationInfo.LastCommit, head.Commit)
+			}
+			return nil
+		})
+		commitInfos, err := env.PachClient.ListCommitByRepo(client.NewRepo(repo))
+		require.NoError(t, err)
+		targetCommitInfos, err := targetEnv.PachClient.ListCommitByRepo(client.NewRepo(repo))
+		require.NoError(t, err)
+		require.Equal(t, len(commitInfos), len(targetCommitInfos))
+		for i, commitInfo := range commitInfos {
+			require.Equal(t, commitInfo.Commit.ID, targetCommitInfos[i].Commit.ID)
+			require.Equal(t, commitInfo.ParentCommit, targetCommitInfos[i].ParentCommit)
+		}
+		var names []string
+		require.NoError(t, targetEnv.PachClient.ListFile(master, "/", func(fi *pfs.FileInfo) error {
+			names = append(names, fi.File.Path)
+			return nil
+		}))
+		require.ElementsEqual(t, []string{"/b"}, names)
+		buf := &bytes.Buffer{}
+		require.NoError(t, targetEnv.PachClient.GetFile(master, "b", buf))
+		require.Equal(t, "c", buf.String())
+
+		replicationInfos, err := env.PachClient.ListReplication()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(replicationInfos))
+		require.Equal(t, target, replicationInfos[0].Replication.Target)
+		require.NoError(t, env.PachClient.DeleteReplication(repo))
+		_, err = env.PachClient.InspectReplication(repo)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsReplicationNotFoundErr(err))
+		// the replicated commits are kept
+		_, err = targetEnv.PachClient.InspectCommit(repo, "", head.Commit.ID)
+		require.NoError(t, err)
+	})
+
+	suite.Run("ReplicationProvenance", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		targetEnv := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		target := fmt.Sprintf("grpc://localhost:%d", targetEnv.MockPachd.Addr.(*net.TCPAddr).Port)
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		inBranch := client.NewBranch("in", "master")
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", "", []*pfs.Branch{inBranch}))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("in", "master", ""), "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.FinishCommit("out", "master", ""))
+		out, err := env.PachClient.InspectCommit("out", "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateReplication("out", target, "", false))
+		require.NoError(t, env.PachClient.CreateReplication("in", target, "", false))
+
+		require.NoErrorWithinTRetry(t, time.Minute, func() error {
+			replicationInfo, err := env.PachClient.InspectReplication("out")
+			if err != nil {
+				return err
+			}
+			if replicationInfo.LastCommit == nil || replicationInfo.LastCommit.ID != out.Commit.ID {
+				return errors.Errorf("last replicated commit is %v, not %v", replicationInfo.LastCommit, out.Commit)
+			}
+			return nil
+		})
+		// the commits keep their commit set and provenance
+		targetOut, err := targetEnv.PachClient.InspectCommit("out", "", out.Commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(targetOut.DirectProvenance))
+		require.Equal(t, pfsdb.BranchKey(inBranch), pfsdb.BranchKey(targetOut.DirectProvenance[0]))
+		commitInfos, err := targetEnv.PachClient.InspectCommitSet(out.Commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+
+		// the target only reports the chunks it doesn't have as missing
+		missing := []byte("missing")
+		resp, err := targetEnv.PachClient.PfsAPIClient.FindMissingChunks(targetEnv.PachClient.Ctx(), &pfs.FindMissingChunksRequest{
+			ChunkIds: [][]byte{missing},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.ChunkIds))
+		require.Equal(t, missing, resp.ChunkIds[0])
+	})
+
+	suite.Run("ExportImportRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		targetEnv := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("b")))
+		require.NoError(t, env.PachClient.DeleteFile(master, "a"))
+		require.NoError(t, env.PachClient.PutFile(master, "b", strings.NewReader("c")))
+		head, err := env.PachClient.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+		commitInfos, err := env.PachClient.ListCommitByRepo(client.NewRepo(repo))
+		require.NoError(t, err)
+		fileInfo, err := env.PachClient.InspectFile(master, "b")
+		require.NoError(t, err)
+		// open commits are not exported
+		_, err = env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+
+		archive := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.ExportRepo(repo, false, archive))
+		require.NoError(t, targetEnv.PachClient.ImportRepo(repo, bytes.NewReader(archive.Bytes())))
+		err = targetEnv.PachClient.ImportRepo(repo, bytes.NewReader(archive.Bytes()))
+		require.YesError(t, err)
+		require.True(t, errutil.IsAlreadyExistError(err))
+		targetCommitInfos, err := targetEnv.PachClient.ListCommitByRepo(client.NewRepo(repo))
+		require.NoError(t, err)
+		require.Equal(t, len(commitInfos), len(targetCommitInfos))
+		for i, commitInfo := range commitInfos {
+			require.Equal(t, commitInfo.Commit.ID, targetCommitInfos[i].Commit.ID)
+			require.Equal(t, commitInfo.ParentCommit, targetCommitInfos[i].ParentCommit)
+		}
+		branchInfo, err := targetEnv.PachClient.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, head.Commit.ID, branchInfo.Head.ID)
+		var names []string
+		require.NoError(t, targetEnv.PachClient.ListFile(master, "/", func(fi *pfs.FileInfo) error {
+			names = append(names, fi.File.Path)
+			return nil
+		}))
+		require.ElementsEqual(t, []string{"/b"}, names)
+		targetFileInfo, err := targetEnv.PachClient.InspectFile(master, "b")
+		require.NoError(t, err)
+		require.Equal(t, fileInfo.Hash, targetFileInfo.Hash)
+		buf := &bytes.Buffer{}
+		require.NoError(t, targetEnv.PachClient.GetFile(master, "b", buf))
+		require.Equal(t, "c", buf.String())
+
+		// an archive of references can only be imported into a cluster which
+		// shares the bucket
+		archive.Reset()
+		require.NoError(t, env.PachClient.ExportRepo(repo, true, archive))
+		require.YesError(t, targetEnv.PachClient.ImportRepo("references", bytes.NewReader(archive.Bytes())))
+		require.NoError(t, env.PachClient.ImportRepo("references", bytes.NewReader(archive.Bytes())))
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("references", "master", ""), "b", buf))
+		require.Equal(t, "c", buf.String())
+		// the imported commits get new IDs, rather than joining the commit
+		// sets of the commits they were exported from
+		referencesHead, err := env.PachClient.InspectCommit("references", "master", "")
+		require.NoError(t, err)
+		require.NotEqual(t, head.Commit.ID, referencesHead.Commit.ID)
+		commitSet, err := env.PachClient.InspectCommitSet(head.Commit.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitSet))
+	})
+
+	suite.Run("Tags", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFile(master, "file", strings.NewReader("1")))
+		commit1, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateTag(repo, "v1", "master", ""))
+		require.NoError(t, env.PachClient.PutFile(master, "file", strings.NewReader("2")))
+
+		// the tag still points to the commit it was created on
+		tagInfo, err := env.PachClient.InspectTag(repo, "v1")
+		require.NoError(t, err)
+		require.Equal(t, commit1.Commit.ID, tagInfo.Commit.ID)
+		commitInfo, err := env.PachClient.InspectCommit(repo, "v1", "")
+		require.NoError(t, err)
+		require.Equal(t, commit1.Commit.ID, commitInfo.Commit.ID)
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit(repo, "v1", ""), "file", buf))
+		require.Equal(t, "1", buf.String())
+
+		// tags and branches can't share names
+		err = env.PachClient.CreateTag(repo, "v1", "", commit1.Commit.ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTagExistsErr(err))
+		require.YesError(t, env.PachClient.CreateTag(repo, "master", "master", ""))
+		require.YesError(t, env.PachClient.CreateBranch(repo, "v1", "master", "", nil))
+		tagInfos, err := env.PachClient.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tagInfos))
+
+		// tagged commits are only squashed with force, which deletes the tags
+		err = env.PachClient.SquashCommitSet(commit1.Commit.ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTaggedCommitErr(err))
+		_, err = env.PachClient.PfsAPIClient.SquashCommitSet(env.PachClient.Ctx(), &pfs.SquashCommitSetRequest{
+			CommitSet: client.NewCommitSet(commit1.Commit.ID),
+			Force:     true,
+		})
+		require.NoError(t, err)
+		_, err = env.PachClient.InspectTag(repo, "v1")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTagNotFoundErr(err))
+
+		require.NoError(t, env.PachClient.CreateTag(repo, "v2", "master", ""))
+		require.NoError(t, env.PachClient.DeleteTag(repo, "v2"))
+		tagInfos, err = env.PachClient.ListTag("")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(tagInfos))
+		_, err = env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+	})
+
+	suite.Run("RetentionPolicy", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var ids []string
+		for i := 0; i < 5; i++ {
+			require.NoError(t, env.PachClient.PutFile(master, "file", strings.NewReader(fmt.Sprint(i))))
+			commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+			require.NoError(t, err)
+			ids = append(ids, commitInfo.Commit.ID)
+		}
+
+		require.YesError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{}))
+		require.YesError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{KeepLast: -1}))
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{KeepLast: 2}))
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), repoInfo.RetentionPolicy.KeepLast)
+
+		commitSetIDs := func(commitSets []*pfs.CommitSet) []string {
+			var result []string
+			for _, commitSet := range commitSets {
+				result = append(result, commitSet.ID)
+			}
+			return result
+		}
+		commitSets, err := env.PachClient.EnforceRetention(repo, true)
+		require.NoError(t, err)
+		require.ElementsEqual(t, ids[:3], commitSetIDs(commitSets))
+
+		// tagged commits are kept
+		require.NoError(t, env.PachClient.CreateTag(repo, "v0", "", ids[0]))
+		commitSets, err = env.PachClient.EnforceRetention(repo, false)
+		require.NoError(t, err)
+		require.ElementsEqual(t, ids[1:3], commitSetIDs(commitSets))
+		commitInfos, err := env.PachClient.ListCommit(client.NewRepo(repo), master, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(commitInfos))
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(master, "file", buf))
+		require.Equal(t, "4", buf.String())
+
+		// the policy of a branch overrides the policy of its repo
+		require.NoError(t, env.PachClient.PutFile(master, "file", strings.NewReader("5")))
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "master", &pfs.RetentionPolicy{KeepLast: 10}))
+		commitSets, err = env.PachClient.EnforceRetention(repo, true)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitSets))
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "master", nil))
+		commitSets, err = env.PachClient.EnforceRetention("", true)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitSets))
+	})
+
 	suite.Run("ToggleBranchProvenance", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -2564,6 +3059,54 @@ func TestPFS(suite *testing.T) {
 				}
 			}
 		})
+		t.Run("WithSizeBytes", func(t *testing.T) {
+			repo := "sizerepo"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			commit := client.NewCommit(repo, "master", "")
+			data := "data"
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(data)))
+
+			for i := 0; i < len(data); i++ {
+				for size := 1; size <= len(data)+1; size++ {
+					var b bytes.Buffer
+					require.NoError(t, env.PachClient.GetFile(commit, "file", &b, client.WithOffset(int64(i)), client.WithSizeBytes(int64(size))))
+					end := i + size
+					if end > len(data) {
+						end = len(data)
+					}
+					require.Equal(t, data[i:end], b.String())
+				}
+			}
+			var b bytes.Buffer
+			require.YesError(t, env.PachClient.GetFile(commit, "file", &b, client.WithSizeBytes(-1)))
+		})
+		t.Run("ReadSeeker", func(t *testing.T) {
+			repo := "readseekerrepo"
+			require.NoError(t, env.PachClient.CreateRepo(repo))
+			commit := client.NewCommit(repo, "master", "")
+			data := strings.Repeat("0123456789", 100)
+			require.NoError(t, env.PachClient.PutFile(commit, "file", strings.NewReader(data)))
+
+			r, err := env.PachClient.GetFileReadSeeker(commit, "file")
+			require.NoError(t, err)
+			// small sequential reads return the whole file
+			var b bytes.Buffer
+			_, err = io.CopyBuffer(&b, struct{ io.Reader }{r}, make([]byte, 7))
+			require.NoError(t, err)
+			require.Equal(t, data, b.String())
+			// a read after a seek returns the requested length
+			_, err = r.Seek(95, io.SeekStart)
+			require.NoError(t, err)
+			p := make([]byte, 10)
+			_, err = io.ReadFull(r, p)
+			require.NoError(t, err)
+			require.Equal(t, data[95:105], string(p))
+			_, err = r.Seek(-3, io.SeekEnd)
+			require.NoError(t, err)
+			rest, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, data[len(data)-3:], string(rest))
+		})
 	})
 
 	suite.Run("ManyPutsSingleFileSingleCommit", func(t *testing.T) {
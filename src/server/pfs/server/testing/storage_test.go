@@ -1,10 +1,16 @@
 package testing
 
 import (
+	"bytes"
 	"context"
+	"math/rand"
 	"testing"
 
+	units "github.com/docker/go-units"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -23,6 +29,41 @@ func TestCheckStorage(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+// TestInspectStorage checks that chunks shared between repos are deduplicated
+// and aren't counted as freeable for either repo.
+func TestInspectStorage(t *testing.T) {
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+	data := randutil.Bytes(rand.New(rand.NewSource(0)), units.MB)
+	for _, repo := range []string{"a", "b"} {
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(client.NewCommit(repo, "master", ""), "file", bytes.NewReader(data)))
+	}
+	require.NoError(t, c.PutFile(client.NewCommit("b", "master", ""), "other", bytes.NewReader(randutil.Bytes(rand.New(rand.NewSource(1)), units.MB))))
+	for _, repo := range []string{"a", "b"} {
+		_, err := c.WaitCommit(repo, "master", "")
+		require.NoError(t, err)
+	}
+	storageInfo, err := c.InspectStorage(true)
+	require.NoError(t, err)
+	require.True(t, storageInfo.SharedChunks > 0)
+	require.True(t, storageInfo.Total.PhysicalBytes < storageInfo.Total.LogicalBytes)
+	require.Equal(t, 2, len(storageInfo.Repos))
+	a, b := storageInfo.Repos[0], storageInfo.Repos[1]
+	require.Equal(t, "a", a.Repo.Name)
+	require.Equal(t, int64(0), a.Stats.FreeableBytes)
+	require.True(t, b.Stats.FreeableBytes > 0)
+	require.True(t, b.Stats.FreeableBytes < b.Stats.PhysicalBytes)
+	require.Equal(t, 1, len(a.Branches))
+	require.Equal(t, 2, len(b.Commits))
+	storageInfo, err = c.InspectStorage(false, "b")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(storageInfo.Repos))
+	require.Equal(t, 0, len(storageInfo.Repos[0].Commits))
+	require.Equal(t, 0, len(storageInfo.CommitSets))
+}
+
 func newClient(t testing.TB) pfs.APIClient {
 	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
 	return env.PachClient.PfsAPIClient
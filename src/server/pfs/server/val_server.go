@@ -65,6 +65,20 @@ func (a *validatedAPIServer) InspectFile(ctx context.Context, request *pfs.Inspe
 	return a.apiServer.InspectFile(ctx, request)
 }
 
+// PresignFile implements the protobuf pfs.PresignFile RPC
+func (a *validatedAPIServer) PresignFile(ctx context.Context, request *pfs.PresignFileRequest) (response *pfs.PresignFileResponse, retErr error) {
+	if err := validateFile(request.File); err != nil {
+		return nil, err
+	}
+	if request.Expires == nil {
+		return nil, errors.New("expires cannot be nil")
+	}
+	if err := a.auth.CheckRepoIsAuthorized(ctx, request.File.Commit.Branch.Repo, auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return a.apiServer.PresignFile(ctx, request)
+}
+
 // ListFile implements the protobuf pfs.ListFile RPC
 func (a *validatedAPIServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFileServer) (retErr error) {
 	if err := validateFile(request.File); err != nil {
@@ -161,6 +175,12 @@ func (a *validatedAPIServer) GetFile(request *pfs.GetFileRequest, server pfs.API
 	if request.File.Commit.Branch.Repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	if request.Offset < 0 {
+		return errors.New("offset cannot be negative")
+	}
+	if request.SizeBytes < 0 {
+		return errors.New("size cannot be negative")
+	}
 	return a.apiServer.GetFile(request, server)
 }
 
@@ -690,11 +690,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	var pipelinePath string
 	var jsonnetPath string
 	var jsonnetArgs []string
+	var dryRun bool
 	createPipeline := &cobra.Command{
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see https://docs.pachyderm.com/latest/reference/pipeline_spec/.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, pushImages, registry, username, pipelinePath, jsonnetPath, jsonnetArgs, false)
+			return pipelineHelper(false, pushImages, registry, username, pipelinePath, jsonnetPath, jsonnetArgs, false, dryRun)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "", "A JSON file (url or filepath) containing one or more pipelines. \"-\" reads from stdin (the default behavior). Exactly one of --file and --jsonnet must be set.")
@@ -703,6 +704,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
+	createPipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, preview the datums that the pipeline would process at its current input commits, without creating it.")
 	commands = append(commands, cmdutil.CreateAliases(createPipeline, "create pipeline", pipelines))
 
 	var reprocess bool
@@ -710,7 +712,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see https://docs.pachyderm.com/latest/reference/pipeline-spec/.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, pushImages, registry, username, pipelinePath, jsonnetPath, jsonnetArgs, true)
+			return pipelineHelper(reprocess, pushImages, registry, username, pipelinePath, jsonnetPath, jsonnetArgs, true, dryRun)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "", "A JSON file (url or filepath) containing one or more pipelines. \"-\" reads from stdin (the default behavior). Exactly one of --file and --jsonnet must be set.")
@@ -720,12 +722,13 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, preview the datums that the updated pipeline would process and skip at its current input commits, without updating it.")
 	commands = append(commands, cmdutil.CreateAliases(updatePipeline, "update pipeline", pipelines))
 
 	runCron := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Run an existing Pachyderm cron pipeline now",
-		Long:  "Run an existing Pachyderm cron pipeline now",
+		Long:  "Run an existing Pachyderm cron pipeline now. This also reruns the queries of the pipeline's SQL inputs.",
 		Example: `
 		# Run a cron pipeline "clock" now
 		$ {{alias}} clock`,
@@ -963,10 +966,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if len(args) > 0 {
 				req.Pipeline = pachdclient.NewPipeline(args[0])
 			}
-			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.DeletePipeline(txClient.Ctx(), req)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
@@ -984,10 +987,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StartPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				return errors.Wrap(txClient.StartPipeline(args[0]), "error from StartPipeline")
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAliases(startPipeline, "start pipeline", pipelines))
@@ -1002,10 +1004,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StopPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				return errors.Wrap(txClient.StopPipeline(args[0]), "error from StopPipeline")
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAliases(stopPipeline, "stop pipeline", pipelines))
@@ -1025,16 +1026,14 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return errors.EnsureStack(err)
 			}
 
-			_, err = client.PpsAPIClient.CreateSecret(
-				client.Ctx(),
-				&ppsclient.CreateSecretRequest{
-					File: fileBytes,
-				})
-
-			if err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.CreateSecret(
+					txClient.Ctx(),
+					&ppsclient.CreateSecretRequest{
+						File: fileBytes,
+					})
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	createSecret.Flags().StringVarP(&file, "file", "f", "", "File containing Kubernetes secret.")
@@ -1240,7 +1239,7 @@ func evaluateJsonnetTemplate(client *client.APIClient, jsonnetPath string, jsonn
 	return []byte(res.Json), nil
 }
 
-func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelinePath, jsonnetPath string, jsonnetArgs []string, update bool) error {
+func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelinePath, jsonnetPath string, jsonnetArgs []string, update bool, dryRun bool) error {
 	// validate arguments
 	if pipelinePath != "" && jsonnetPath != "" {
 		return errors.New("cannot set both --file and --jsonnet; exactly one must be set")
@@ -1294,6 +1293,22 @@ func pipelineHelper(reprocess bool, pushImages bool, registry, username, pipelin
 			request.Reprocess = reprocess
 		}
 
+		if dryRun {
+			resp, err := pc.DryRunPipeline(request, 0)
+			if err != nil {
+				return err
+			}
+			pretty.PrintDryRunPipelineResponse(os.Stdout, request.Pipeline.Name, resp)
+			writer := tabwriter.NewWriter(os.Stdout, pretty.DatumPreviewHeader)
+			for _, preview := range resp.Sample {
+				pretty.PrintDatumPreview(writer, preview)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if pushImages {
 			if request.Transform == nil {
 				return errors.New("must specify a pipeline `transform`")
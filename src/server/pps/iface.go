@@ -1,6 +1,8 @@
 package pps
 
 import (
+	"context"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	pps_client "github.com/pachyderm/pachyderm/v2/src/pps"
 )
@@ -14,9 +16,15 @@ type APIServer interface {
 	NewPropagater(*txncontext.TransactionContext) txncontext.PpsPropagater
 	NewJobStopper(*txncontext.TransactionContext) txncontext.PpsJobStopper
 	NewJobFinisher(*txncontext.TransactionContext) txncontext.PpsJobFinisher
+	NewSecretCreator(context.Context, *txncontext.TransactionContext) txncontext.PpsSecretCreator
+	NewJobCacheClearer(context.Context, *txncontext.TransactionContext) txncontext.PpsJobCacheClearer
 
 	StopJobInTransaction(*txncontext.TransactionContext, *pps_client.StopJobRequest) error
 	UpdateJobStateInTransaction(*txncontext.TransactionContext, *pps_client.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*txncontext.TransactionContext, *pps_client.CreatePipelineRequest) error
+	DeletePipelineInTransaction(*txncontext.TransactionContext, *pps_client.DeletePipelineRequest) error
+	StartPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StartPipelineRequest) error
+	StopPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StopPipelineRequest) error
+	CreateSecretInTransaction(*txncontext.TransactionContext, *pps_client.CreateSecretRequest) error
 	InspectPipelineInTransaction(*txncontext.TransactionContext, string) (*pps_client.PipelineInfo, error)
 }
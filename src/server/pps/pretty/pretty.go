@@ -30,6 +30,8 @@ const (
 	JobSetHeader = "ID\tSUBJOBS\tPROGRESS\tCREATED\tMODIFIED\n"
 	// DatumHeader is the header for datums
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
+	// DatumPreviewHeader is the header for datum previews.
+	DatumPreviewHeader = "FILES\tSIZE\tSTATUS\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
 	// jobReasonLen is the amount of the job reason that we print
@@ -300,13 +302,32 @@ func PrintDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	if datumInfo.Datum.ID == "" {
 		datumInfo.Datum.ID = "-"
 	}
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t", datumInfo.Datum.ID, datumFiles(datumInfo), datumState(datumInfo.State), totalTime)
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t", datumInfo.Datum.ID, datumFiles(datumInfo.Data), datumState(datumInfo.State), totalTime)
 	fmt.Fprintln(w)
 }
 
-func datumFiles(datumInfo *ppsclient.DatumInfo) string {
+// PrintDryRunPipelineResponse pretty-prints the summary of a pipeline dry run.
+func PrintDryRunPipelineResponse(w io.Writer, pipelineName string, resp *ppsclient.DryRunPipelineResponse) {
+	fmt.Fprintf(w, "Pipeline: %s\n", pipelineName)
+	fmt.Fprintf(w, "Datums: %d\n", resp.Datums)
+	fmt.Fprintf(w, "Skipped: %d\n", resp.Skipped)
+	fmt.Fprintf(w, "Input Size: %s\n", pretty.Size(resp.TotalBytes))
+	fmt.Fprintf(w, "Largest Datum: %s\n", pretty.Size(resp.MaxDatumBytes))
+}
+
+// PrintDatumPreview pretty-prints a datum from a pipeline dry run.
+func PrintDatumPreview(w io.Writer, preview *ppsclient.DatumPreview) {
+	state := ppsclient.DatumState_UNKNOWN
+	if preview.Skipped {
+		state = ppsclient.DatumState_SKIPPED
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t", datumFiles(preview.Data), pretty.Size(preview.SizeBytes), datumState(state))
+	fmt.Fprintln(w)
+}
+
+func datumFiles(data []*pfsclient.FileInfo) string {
 	builder := &strings.Builder{}
-	for i, fi := range datumInfo.Data {
+	for i, fi := range data {
 		if i != 0 {
 			builder.WriteString(", ")
 		}
@@ -526,6 +547,8 @@ func ShorthandInput(input *ppsclient.Input) string {
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.SQL != nil:
+		return fmt.Sprintf("%s:%s", input.SQL.Name, input.SQL.Spec)
 	}
 	return ""
 }
@@ -36,6 +36,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachtmpl"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsfile"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
@@ -126,6 +127,11 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Cron.Name)
 		}
 		names[input.Cron.Name] = true
+	case input.SQL != nil:
+		if names[input.SQL.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.SQL.Name)
+		}
+		names[input.SQL.Name] = true
 	case input.Union != nil:
 		for _, input := range input.Union {
 			namesCopy := make(map[string]bool)
@@ -251,6 +257,32 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Wrapf(err, "error parsing cron-spec")
 			}
 		}
+		if input.SQL != nil {
+			if set {
+				return errors.Errorf("multiple input types set")
+			}
+			set = true
+			if len(input.SQL.Name) == 0 {
+				return errors.Errorf("input must specify a name")
+			}
+			if _, err := pachsql.ParseURL(input.SQL.URL); err != nil {
+				return errors.Wrapf(err, "error parsing sql url")
+			}
+			if input.SQL.Query == "" {
+				return errors.Errorf("sql input must specify a query")
+			}
+			if input.SQL.Spec != "" {
+				if _, err := cron.ParseStandard(input.SQL.Spec); err != nil {
+					return errors.Wrapf(err, "error parsing cron-spec")
+				}
+			}
+			if input.SQL.KeyColumn != "" && input.SQL.Shards < 1 {
+				return errors.Errorf("sql input with a key column must specify the number of shards")
+			}
+			if input.SQL.KeyColumn == "" && input.SQL.Shards != 0 {
+				return errors.Errorf("sql input must specify a key column to be sharded")
+			}
+		}
 		if !set {
 			return errors.Errorf("no input set")
 		}
@@ -1049,6 +1081,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 		if input.Cron != nil {
 			return errors.Errorf("can't list datums with a cron input, there will be no datums until the pipeline is created")
 		}
+		if input.SQL != nil {
+			return errors.Errorf("can't list datums with a sql input, there will be no datums until the pipeline is created")
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -1544,6 +1579,15 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.Spout != nil && request.Autoscaling {
 		return errors.Errorf("autoscaling can't be used with spouts (spouts aren't triggered externally)")
 	}
+	if request.DatumCache && (request.S3Out || request.Service != nil || request.Spout != nil) {
+		return errors.New("the datum cache is not supported with s3 output, spouts or services")
+	}
+	if request.DatumCheckpointInterval != nil && (request.Service != nil || request.Spout != nil) {
+		return errors.New("datum checkpoints are not supported in spouts or services")
+	}
+	if request.TaskSchedulingSpec != nil && request.TaskSchedulingSpec.Weight < 0 {
+		return errors.Errorf("task scheduling weight must not be negative (0 means the default of 1), but it's %v", request.TaskSchedulingSpec.Weight)
+	}
 	return nil
 }
 
@@ -1591,7 +1635,11 @@ func (a *apiServer) validateSecret(ctx context.Context, req *pps.CreatePipelineR
 		if s.EnvVar != "" && s.Key == "" {
 			return errors.Errorf("secret %s has env_var set but is missing key", s.Name)
 		}
-		ss, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, s.Name, metav1.GetOptions{})
+		kubeClient, err := a.env.kubeClient()
+		if err != nil {
+			return errors.Wrapf(err, "could not get Kubernetes secret %s", s.Name)
+		}
+		ss, err := kubeClient.CoreV1().Secrets(a.namespace).Get(ctx, s.Name, metav1.GetOptions{})
 		if err != nil {
 			if k8serrors.IsNotFound(err) {
 				return errors.Errorf("missing Kubernetes secret %s", s.Name)
@@ -1663,6 +1711,24 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return errors.EnsureStack(err)
 		}
 	}
+	if pipelineInfo.Details.DatumCheckpointInterval != nil {
+		interval, err := types.DurationFromProto(pipelineInfo.Details.DatumCheckpointInterval)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if interval <= 0 {
+			return errors.Errorf("datum checkpoint interval must be positive, but it's %v", interval)
+		}
+		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+			if input.Pfs != nil && input.Pfs.Name == client.PPSCheckpointDir {
+				return errors.Errorf("input cannot be named %q, as pachyderm "+
+					"creates /pfs/%s for datum checkpoints", client.PPSCheckpointDir, client.PPSCheckpointDir)
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
 	if pipelineInfo.Details.PodSpec != "" && !json.Valid([]byte(pipelineInfo.Details.PodSpec)) {
 		return errors.Errorf("malformed PodSpec")
 	}
@@ -1697,6 +1763,9 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 		if input.Cron != nil {
 			result = append(result, client.NewBranch(input.Cron.Repo, "master"))
 		}
+		if input.SQL != nil {
+			result = append(result, pps.SQLInputBranch(input.SQL))
+		}
 		return nil
 	})
 	return result
@@ -1877,6 +1946,132 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	return &types.Empty{}, nil
 }
 
+// DryRunPipeline implements the protobuf pps.DryRunPipeline RPC. It computes
+// the datums that the pipeline would process at its current input commits,
+// without creating the pipeline or scheduling any workers.
+func (a *apiServer) DryRunPipeline(ctx context.Context, request *pps.DryRunPipelineRequest) (response *pps.DryRunPipelineResponse, retErr error) {
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DryRunPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+
+	if request.CreatePipelineRequest == nil || request.CreatePipelineRequest.Pipeline == nil {
+		return nil, errors.New("request.CreatePipelineRequest.Pipeline cannot be nil")
+	}
+	// The datums of cron and SQL inputs are the commits that the pipeline
+	// creates on their schedules, so there are none to preview.
+	if err := pps.VisitInput(request.CreatePipelineRequest.Input, func(input *pps.Input) error {
+		if input.Cron != nil || input.SQL != nil {
+			return errors.New("dry runs are not supported for pipelines with cron or SQL inputs")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// initializePipelineInfo modifies the request, which the caller owns
+	createRequest := proto.Clone(request.CreatePipelineRequest).(*pps.CreatePipelineRequest)
+	var oldPipelineInfo *pps.PipelineInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		oldPipelineInfo, err = a.InspectPipelineInTransaction(txnCtx, createRequest.Pipeline.Name)
+		if err != nil && errutil.IsNotFoundError(err) {
+			// silently ignore pipeline not found, old info will be nil
+			return nil
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if oldPipelineInfo != nil && !createRequest.Update {
+		return nil, ppsServer.ErrPipelineAlreadyExists{
+			Pipeline: createRequest.Pipeline,
+		}
+	}
+	pipelineInfo, err := a.initializePipelineInfo(createRequest, oldPipelineInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Datums which were processed by the old pipeline are skipped if their
+	// hash, which includes the pipeline's salt, is unchanged.
+	var processed map[string]bool
+	if oldPipelineInfo != nil && !createRequest.Reprocess &&
+		pipelineInfo.Details.ReprocessSpec != client.ReprocessSpecEveryJob && !pipelineInfo.Details.S3Out {
+		processed, err = a.processedDatums(ctx, oldPipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sample := request.Sample
+	if sample == 0 {
+		sample = 10
+	}
+	response = &pps.DryRunPipelineResponse{}
+	if err := a.listDatumInput(ctx, pipelineInfo.Details.Input, func(meta *datum.Meta) error {
+		preview := &pps.DatumPreview{
+			Skipped: processed[common.HashDatum(pipelineInfo.Details.Salt, meta.Inputs)],
+		}
+		for _, input := range meta.Inputs {
+			preview.Data = append(preview.Data, input.FileInfo)
+			preview.SizeBytes += input.FileInfo.SizeBytes
+		}
+		response.Datums++
+		if preview.Skipped {
+			response.Skipped++
+		}
+		response.TotalBytes += preview.SizeBytes
+		if preview.SizeBytes > response.MaxDatumBytes {
+			response.MaxDatumBytes = preview.SizeBytes
+		}
+		if int64(len(response.Sample)) < sample {
+			response.Sample = append(response.Sample, preview)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// processedDatums returns the hashes of the datums which were processed
+// successfully by the most recent successful job of a pipeline.
+func (a *apiServer) processedDatums(ctx context.Context, pipelineInfo *pps.PipelineInfo) (map[string]bool, error) {
+	pachClient := a.env.GetPachClient(ctx)
+	outputCommit := client.NewCommit(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch, "")
+	metaCommit := ppsutil.MetaCommit(outputCommit)
+	processed := make(map[string]bool)
+	for metaCommit != nil {
+		metaCI, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{Commit: metaCommit})
+		if err != nil {
+			if errutil.IsNotFoundError(err) {
+				return processed, nil
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		outputCI, err := pachClient.InspectCommit(metaCI.Commit.Branch.Repo.Name, metaCI.Commit.Branch.Name, metaCI.Commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		// both commits must have succeeded, as for the base commit of a job
+		if metaCI.Finished != nil && metaCI.Error == "" && outputCI.Finished != nil && outputCI.Error == "" {
+			metaCommit = metaCI.Commit
+			break
+		}
+		metaCommit = metaCI.ParentCommit
+	}
+	if metaCommit == nil {
+		return processed, nil
+	}
+	if err := datum.NewCommitIterator(pachClient, metaCommit).Iterate(func(meta *datum.Meta) error {
+		if meta.State == datum.State_PROCESSED {
+			processed[meta.Hash] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return processed, nil
+}
+
 func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, oldPipelineInfo *pps.PipelineInfo) (*pps.PipelineInfo, error) {
 	if err := a.validatePipelineRequest(request); err != nil {
 		return nil, err
@@ -1890,31 +2085,34 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 		Pipeline: request.Pipeline,
 		Version:  1,
 		Details: &pps.PipelineInfo_Details{
-			Transform:             request.Transform,
-			TFJob:                 request.TFJob,
-			ParallelismSpec:       request.ParallelismSpec,
-			Input:                 request.Input,
-			OutputBranch:          request.OutputBranch,
-			Egress:                request.Egress,
-			CreatedAt:             now(),
-			ResourceRequests:      request.ResourceRequests,
-			ResourceLimits:        request.ResourceLimits,
-			SidecarResourceLimits: request.SidecarResourceLimits,
-			Description:           request.Description,
-			Salt:                  request.Salt,
-			Service:               request.Service,
-			Spout:                 request.Spout,
-			DatumSetSpec:          request.DatumSetSpec,
-			DatumTimeout:          request.DatumTimeout,
-			JobTimeout:            request.JobTimeout,
-			DatumTries:            request.DatumTries,
-			SchedulingSpec:        request.SchedulingSpec,
-			PodSpec:               request.PodSpec,
-			PodPatch:              request.PodPatch,
-			S3Out:                 request.S3Out,
-			Metadata:              request.Metadata,
-			ReprocessSpec:         request.ReprocessSpec,
-			Autoscaling:           request.Autoscaling,
+			Transform:               request.Transform,
+			TFJob:                   request.TFJob,
+			ParallelismSpec:         request.ParallelismSpec,
+			Input:                   request.Input,
+			OutputBranch:            request.OutputBranch,
+			Egress:                  request.Egress,
+			CreatedAt:               now(),
+			ResourceRequests:        request.ResourceRequests,
+			ResourceLimits:          request.ResourceLimits,
+			SidecarResourceLimits:   request.SidecarResourceLimits,
+			Description:             request.Description,
+			Salt:                    request.Salt,
+			Service:                 request.Service,
+			Spout:                   request.Spout,
+			DatumSetSpec:            request.DatumSetSpec,
+			DatumTimeout:            request.DatumTimeout,
+			JobTimeout:              request.JobTimeout,
+			DatumTries:              request.DatumTries,
+			SchedulingSpec:          request.SchedulingSpec,
+			PodSpec:                 request.PodSpec,
+			PodPatch:                request.PodPatch,
+			S3Out:                   request.S3Out,
+			Metadata:                request.Metadata,
+			ReprocessSpec:           request.ReprocessSpec,
+			Autoscaling:             request.Autoscaling,
+			DatumCache:              request.DatumCache,
+			TaskSchedulingSpec:      request.TaskSchedulingSpec,
+			DatumCheckpointInterval: request.DatumCheckpointInterval,
 		},
 	}
 
@@ -2038,6 +2236,19 @@ func (a *apiServer) CreatePipelineInTransaction(
 			return errors.Wrapf(err, "error creating spec repo for %s", pipelineName)
 		}
 	}
+	// The results of SQL queries are kept in a system repo, so that they are
+	// hidden like the pipeline's other metadata. An update may add SQL inputs,
+	// so the repo is created in that case too.
+	if containsSQLInputs(newPipelineInfo.Details.Input) {
+		if err := a.env.PFSServer.CreateRepoInTransaction(txnCtx,
+			&pfs.CreateRepoRequest{
+				Repo:        client.NewSystemRepo(pipelineName, pfs.SQLRepoType),
+				Description: fmt.Sprintf("SQL query result repo for pipeline %s.", request.Pipeline.Name),
+				Update:      true,
+			}); err != nil && !errutil.IsAlreadyExistError(err) {
+			return errors.Wrapf(err, "error creating sql repo for %s", pipelineName)
+		}
+	}
 
 	if request.SpecCommit != nil {
 		if update {
@@ -2246,6 +2457,14 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
+		if input.SQL != nil {
+			if input.SQL.Start == nil {
+				start, _ := types.TimestampProto(now)
+				input.SQL.Start = start
+			}
+			// the results are always kept in the pipeline's sql repo
+			input.SQL.Repo = pipelineName
+		}
 		return nil
 	})
 }
@@ -2302,8 +2521,8 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 	if !details {
 		info.Details = nil // preserve old behavior
 	} else {
-		kubeClient := a.env.KubeClient
-		if info.Details.Service != nil {
+		// There are no services to look up when pipelines run locally.
+		if kubeClient, err := a.env.kubeClient(); err == nil && info.Details.Service != nil {
 			rcName := ppsutil.PipelineRcName(info.Pipeline.Name, info.Version)
 			service, err := kubeClient.CoreV1().Services(a.namespace).Get(ctx, fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
 			if err != nil {
@@ -2449,56 +2668,105 @@ func (a *apiServer) listPipeline(ctx context.Context, request *pps.ListPipelineR
 
 // DeletePipeline implements the protobuf pps.DeletePipeline RPC
 func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) (response *types.Empty, retErr error) {
+	var requests []*pps.DeletePipelineRequest
 	if request.All {
-		request.Pipeline = &pps.Pipeline{}
-		pipelineInfo := &pps.PipelineInfo{}
 		deleted := make(map[string]struct{})
+		pipelineInfo := &pps.PipelineInfo{}
 		if err := a.pipelines.ReadOnly(ctx).List(pipelineInfo, col.DefaultOptions(), func(string) error {
 			if _, ok := deleted[pipelineInfo.Pipeline.Name]; ok {
 				// while the delete pipeline call will delete historical versions,
 				// they could still show up in the list. Ignore them
 				return nil
 			}
-			request.Pipeline.Name = pipelineInfo.Pipeline.Name
-			err := a.deletePipeline(ctx, request)
-			if err == nil {
-				deleted[pipelineInfo.Pipeline.Name] = struct{}{}
-			}
-			return err
+			deleted[pipelineInfo.Pipeline.Name] = struct{}{}
+			requests = append(requests, &pps.DeletePipelineRequest{
+				Pipeline: client.NewPipeline(pipelineInfo.Pipeline.Name),
+				KeepRepo: request.KeepRepo,
+				Force:    request.Force,
+			})
+			return nil
 		}); err != nil {
 			return nil, errors.EnsureStack(err)
 		}
-	} else if err := a.deletePipeline(ctx, request); err != nil {
+	} else {
+		requests = append(requests, request)
+	}
+	activeTxn, err := client.GetTransaction(ctx)
+	if err != nil {
 		return nil, err
 	}
-
+	if activeTxn != nil {
+		if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+			for _, request := range requests {
+				if err := txn.DeletePipeline(request); err != nil {
+					return errors.EnsureStack(err)
+				}
+			}
+			return nil
+		}, nil); err != nil {
+			return nil, err
+		}
+		return &types.Empty{}, nil
+	}
+	// outside of a transaction, the deletion is committed even if it was
+	// merely incomplete, but the caller is warned
+	var deleteErr error
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		deleteErr = nil
+		for _, request := range requests {
+			if err := a.deletePipeline(txnCtx, request); errors.Is(err, errIncompleteDeletion) {
+				deleteErr = err
+			} else if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if deleteErr != nil {
+		return nil, deleteErr
+	}
 	return &types.Empty{}, nil
 }
 
-func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) error {
+// DeletePipelineInTransaction is identical to DeletePipeline except that it
+// can run inside an existing postgres transaction.  This is not an RPC.  The
+// pipeline is stopped and deleted in the same transaction.
+func (a *apiServer) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if request.All {
+		return errors.New("all pipelines cannot be deleted in a transaction, delete them by name instead")
+	}
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
+	// a transaction can't return the warning without failing, so just log it
+	if err := a.deletePipeline(txnCtx, request); errors.Is(err, errIncompleteDeletion) {
+		logrus.Warnf("pipeline %s: %v", request.Pipeline.Name, err)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *apiServer) deletePipeline(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
 	pipelineName := request.Pipeline.Name
 
 	// stop the pipeline to avoid interference from new jobs
-	if _, err := a.StopPipeline(ctx,
+	if err := a.StopPipelineInTransaction(txnCtx,
 		&pps.StopPipelineRequest{Pipeline: request.Pipeline}); err != nil && errutil.IsNotFoundError(err) {
 		logrus.Errorf("failed to stop pipeline, continuing with delete: %v", err)
 	} else if err != nil {
 		return errors.Wrapf(err, "error stopping pipeline %s", pipelineName)
 	}
-	// perform the rest of the deletion in a transaction
-	var deleteErr error
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		deleteErr = a.deletePipelineInTransaction(txnCtx, request)
-		// we still want deletion to succeed if it was merely incomplete, but warn the caller
-		if errors.Is(deleteErr, errIncompleteDeletion) {
-			return nil
-		}
-		return deleteErr
-	}); err != nil {
+	// we still want deletion to succeed if it was merely incomplete, so the
+	// job cache is cleared either way
+	err := a.deletePipelineInTransaction(txnCtx, request)
+	if err != nil && !errors.Is(err, errIncompleteDeletion) {
 		return err
 	}
-	clearJobCache(a.env.GetPachClient(ctx), pipelineName)
-	return deleteErr
+	txnCtx.ClearJobCache(pipelineName)
+	return err
 }
 
 func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
@@ -2616,10 +2884,16 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 			}); err != nil && !col.IsErrNotFound(err) && !auth.IsErrNoRoleBinding(err) {
 				return errors.EnsureStack(err)
 			}
+			if err := a.env.PFSServer.DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+				Repo:  client.NewSystemRepo(pipelineName, pfs.SQLRepoType),
+				Force: request.Force,
+			}); err != nil && !col.IsErrNotFound(err) && !auth.IsErrNoRoleBinding(err) {
+				return errors.EnsureStack(err)
+			}
 		}
 	}
-	// delete cron after main repo is deleted or has provenance removed
-	// cron repos are only used to trigger jobs, so don't keep them even with KeepRepo
+	// delete cron repos after main repo is deleted or has provenance removed
+	// they are only used as inputs to this pipeline, so don't keep them even with KeepRepo
 	if pipelineInfo.Details != nil {
 		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
 			if input.Cron != nil {
@@ -2645,100 +2919,110 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 
 // StartPipeline implements the protobuf pps.StartPipeline RPC
 func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (response *types.Empty, retErr error) {
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.StartPipeline(request))
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// StartPipelineInTransaction is identical to StartPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StartPipelineRequest) error {
 	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+		return errors.New("request.Pipeline cannot be nil")
+	}
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err != nil {
+		return err
 	}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err != nil {
-			return err
-		}
+	// check if the caller is authorized to update this pipeline
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
 
-		// check if the caller is authorized to update this pipeline
-		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return err
-		}
+	// Restore branch provenance, which may create a new output commit/job
+	provenance := append(branchProvenance(pipelineInfo.Details.Input),
+		client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
+	if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	// restore same provenance to meta repo
+	if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
 
-		// Restore branch provenance, which may create a new output commit/job
-		provenance := append(branchProvenance(pipelineInfo.Details.Input),
-			client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
-		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return errors.EnsureStack(err)
-		}
-		// restore same provenance to meta repo
-		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return errors.EnsureStack(err)
-		}
+	newPipelineInfo := &pps.PipelineInfo{}
+	return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+		newPipelineInfo.Stopped = false
+		return nil
+	})
+}
 
-		newPipelineInfo := &pps.PipelineInfo{}
-		return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-			newPipelineInfo.Stopped = false
-			return nil
-		})
-	}); err != nil {
+// StopPipeline implements the protobuf pps.StopPipeline RPC
+func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.StopPipeline(request))
+	}, nil); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
 }
 
-// StopPipeline implements the protobuf pps.StopPipeline RPC
-func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
+// StopPipelineInTransaction is identical to StopPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StopPipelineRequest) error {
 	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+		return errors.New("request.Pipeline cannot be nil")
 	}
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err == nil {
+		// check if the caller is authorized to update this pipeline
+		// don't pass in the input - stopping the pipeline means they won't be read anymore,
+		// so we don't need to check any permissions
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err == nil {
-			// check if the caller is authorized to update this pipeline
-			// don't pass in the input - stopping the pipeline means they won't be read anymore,
-			// so we don't need to check any permissions
-			if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-				return err
-			}
-
-			// Remove branch provenance to prevent new output and meta commits from being created
+		// Remove branch provenance to prevent new output and meta commits from being created
+		if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if pipelineInfo.Details.Spout == nil && pipelineInfo.Details.Service == nil {
 			if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+				Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
 				Provenance: nil,
 			}); err != nil {
 				return errors.EnsureStack(err)
 			}
-			if pipelineInfo.Details.Spout == nil && pipelineInfo.Details.Service == nil {
-				if err := a.env.PFSServer.CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-					Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-					Provenance: nil,
-				}); err != nil {
-					return errors.EnsureStack(err)
-				}
-			}
+		}
 
-			newPipelineInfo := &pps.PipelineInfo{}
-			if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-				newPipelineInfo.Stopped = true
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else if !errutil.IsNotFoundError(err) {
+		newPipelineInfo := &pps.PipelineInfo{}
+		if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+			newPipelineInfo.Stopped = true
+			return nil
+		}); err != nil {
 			return err
 		}
-
-		// Kill any remaining jobs
-		// if the pipeline output repo doesn't exist, we technically run this without authorization,
-		// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
-		return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
-	}); err != nil {
-		return nil, err
+	} else if !errutil.IsNotFoundError(err) {
+		return err
 	}
-	return &types.Empty{}, nil
+
+	// Kill any remaining jobs
+	// if the pipeline output repo doesn't exist, we technically run this without authorization,
+	// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
+	return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -2752,20 +3036,24 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	}
 
 	if pipelineInfo.Details.Input == nil {
-		return nil, errors.Errorf("pipeline doesn't have a cron input")
+		return nil, errors.Errorf("pipeline doesn't have a cron or sql input")
 	}
 
-	// find any cron inputs
+	// find any cron and sql inputs
 	var crons []*pps.CronInput
+	var sqls []*pps.SQLInput
 	pps.VisitInput(pipelineInfo.Details.Input, func(in *pps.Input) error {
 		if in.Cron != nil {
 			crons = append(crons, in.Cron)
 		}
+		if in.SQL != nil {
+			sqls = append(sqls, in.SQL)
+		}
 		return nil
 	})
 
-	if len(crons) < 1 {
-		return nil, errors.Errorf("pipeline doesn't have a cron input")
+	if len(crons) < 1 && len(sqls) < 1 {
+		return nil, errors.Errorf("pipeline doesn't have a cron or sql input")
 	}
 
 	// put the same time for all ticks
@@ -2777,6 +3065,12 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 			return nil, err
 		}
 	}
+	// and start commits for all the queries, which the pipeline's workers run
+	for _, in := range sqls {
+		if err := sqlTick(a.env.GetPachClient(ctx), in); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.Empty{}, nil
 }
@@ -2842,11 +3136,36 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return errors.EnsureStack(txn.CreateSecret(request))
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// CreateSecretInTransaction is identical to CreateSecret except that it can
+// run inside an existing postgres transaction.  This is not an RPC.  The
+// secret is validated immediately, but only created in Kubernetes at the end
+// of the transaction.
+func (a *apiServer) CreateSecretInTransaction(txnCtx *txncontext.TransactionContext, request *pps.CreateSecretRequest) error {
+	if _, err := a.env.kubeClient(); err != nil {
+		return errors.Wrapf(err, "failed to create secret")
+	}
+	if _, err := parseSecret(request.GetFile()); err != nil {
+		return err
+	}
+	txnCtx.CreateSecret(request)
+	return nil
+}
+
+// parseSecret unmarshals a user's secret and labels it as created through
+// pachyderm.
+func parseSecret(file []byte) (*v1.Secret, error) {
 	var s v1.Secret
-	if err := json.Unmarshal(request.GetFile(), &s); err != nil {
+	if err := json.Unmarshal(file, &s); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal secret")
 	}
-
 	labels := s.GetLabels()
 	if labels["suite"] != "" && labels["suite"] != "pachyderm" {
 		return nil, errors.Errorf("invalid suite label set on secret: suite=%s", labels["suite"])
@@ -2857,11 +3176,7 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	labels["suite"] = "pachyderm"
 	labels["secret-source"] = "pachyderm-user"
 	s.SetLabels(labels)
-
-	if _, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Create(ctx, &s, metav1.CreateOptions{}); err != nil {
-		return nil, errors.Wrapf(err, "failed to create secret")
-	}
-	return &types.Empty{}, nil
+	return &s, nil
 }
 
 // DeleteSecret implements the protobuf pps.DeleteSecret RPC
@@ -2869,7 +3184,11 @@ func (a *apiServer) DeleteSecret(ctx context.Context, request *pps.DeleteSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Delete(ctx, request.Secret.Name, metav1.DeleteOptions{}); err != nil {
+	kubeClient, err := a.env.kubeClient()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to delete secret")
+	}
+	if err := kubeClient.CoreV1().Secrets(a.namespace).Delete(ctx, request.Secret.Name, metav1.DeleteOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to delete secret")
 	}
 	return &types.Empty{}, nil
@@ -2880,7 +3199,11 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secret, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).Get(ctx, request.Secret.Name, metav1.GetOptions{})
+	kubeClient, err := a.env.kubeClient()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret")
+	}
+	secret, err := kubeClient.CoreV1().Secrets(a.namespace).Get(ctx, request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
 	}
@@ -2902,7 +3225,11 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
-	secrets, err := a.env.KubeClient.CoreV1().Secrets(a.namespace).List(ctx, metav1.ListOptions{
+	kubeClient, err := a.env.kubeClient()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list secrets")
+	}
+	secrets, err := kubeClient.CoreV1().Secrets(a.namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	})
 	if err != nil {
@@ -2935,7 +3262,12 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 		return nil, err
 	}
 
-	if err := a.env.KubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+	kubeClient, err := a.env.kubeClient()
+	if err != nil {
+		// There can't be any secrets to delete when pipelines run locally.
+		return &types.Empty{}, nil
+	}
+	if err := kubeClient.CoreV1().Secrets(a.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	}); err != nil {
 		return nil, errors.EnsureStack(err)
@@ -3109,7 +3441,11 @@ func RepoNameToEnvString(repoName string) string {
 }
 
 func (a *apiServer) rcPods(ctx context.Context, rcName string) ([]v1.Pod, error) {
-	podList, err := a.env.KubeClient.CoreV1().Pods(a.namespace).List(ctx, metav1.ListOptions{
+	kubeClient, err := a.env.kubeClient()
+	if err != nil {
+		return nil, err
+	}
+	podList, err := kubeClient.CoreV1().Pods(a.namespace).List(ctx, metav1.ListOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ListOptions",
 			APIVersion: "v1",
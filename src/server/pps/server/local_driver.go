@@ -0,0 +1,609 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/version"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// localRunnerProcess runs local workers as processes on the host.
+	localRunnerProcess = "process"
+	// localRunnerDocker runs local workers as docker containers of the pipeline's image.
+	localRunnerDocker = "docker"
+
+	// localWorkerRanFor is how long a local worker has to run for its restart
+	// backoff to be reset, as kubernetes does for crash looping containers.
+	localWorkerRanFor = 10 * time.Second
+)
+
+// localWorkerSpec describes a worker that a workerRunner should start.
+type localWorkerSpec struct {
+	name  string   // the name of the worker, which is unique among running workers
+	image string   // the pipeline's image
+	env   []string // the worker's environment, in the form "key=value"
+}
+
+// workerRunner runs the workers of the local InfraDriver.
+type workerRunner interface {
+	// start starts a worker, which runs until it exits or ctx is canceled. It
+	// returns a function which waits for the worker to exit.
+	start(ctx context.Context, spec *localWorkerSpec) (wait func() error, _ error)
+}
+
+// localDriver is an InfraDriver which runs pipeline workers on the same host
+// as pachd, so pipelines can run without kubernetes. It keeps the replication
+// controllers of pipelines in memory and runs a worker for each of their
+// replicas, restarting workers which exit. The workers are reported as pods to
+// WatchPipelinePods.
+//
+// There are no kubernetes secrets or services, so pipelines which use
+// secrets, services or the s3 gateway are failed.
+type localDriver struct {
+	ctx        context.Context
+	config     serviceenv.Configuration
+	etcdPrefix string
+	runner     workerRunner
+
+	mu       sync.Mutex
+	rcs      map[string]*localRC // indexed by RC name
+	watchers map[*localWatcher]struct{}
+}
+
+type localRC struct {
+	rc      *v1.ReplicationController
+	pi      *pps.PipelineInfo
+	workers map[int32]*localWorker // indexed by replica
+}
+
+type localWorker struct {
+	cancel context.CancelFunc
+	ready  bool
+}
+
+type localWatcher struct {
+	events chan watch.Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newLocalDriver(ctx context.Context, config serviceenv.Configuration, etcdPrefix string) (InfraDriver, error) {
+	binary, err := exec.LookPath(config.PPSLocalWorkerBinary)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find the worker binary %q", config.PPSLocalWorkerBinary)
+	}
+	if binary, err = filepath.Abs(binary); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var runner workerRunner
+	switch config.PPSLocalWorkerRunner {
+	case localRunnerProcess:
+		runner = &processRunner{binary: binary, dir: config.PPSLocalWorkerDir}
+	case localRunnerDocker:
+		runner = &dockerRunner{binary: binary}
+	default:
+		return nil, errors.Errorf("unknown local worker runner %q, it must be one of %q or %q",
+			config.PPSLocalWorkerRunner, localRunnerProcess, localRunnerDocker)
+	}
+	return newLocalDriverWithRunner(ctx, config, etcdPrefix, runner), nil
+}
+
+func newLocalDriverWithRunner(ctx context.Context, config serviceenv.Configuration, etcdPrefix string, runner workerRunner) *localDriver {
+	return &localDriver{
+		ctx:        ctx,
+		config:     config,
+		etcdPrefix: etcdPrefix,
+		runner:     runner,
+		rcs:        make(map[string]*localRC),
+		watchers:   make(map[*localWatcher]struct{}),
+	}
+}
+
+// Creates a pipeline's replication controller, which starts with no workers.
+func (ld *localDriver) CreatePipelineResources(ctx context.Context, pi *pps.PipelineInfo) error {
+	log.Infof("PPS master: creating local resources for pipeline %q", pi.Pipeline.Name)
+	if err := validateLocalPipeline(pi); err != nil {
+		// these errors indicate a pipeline which can't run locally, don't retry
+		return stepError{
+			error:        errors.Wrap(err, "could not run pipeline locally"),
+			failPipeline: true,
+		}
+	}
+	rcName := ppsutil.PipelineRcName(pi.Pipeline.Name, pi.Version)
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if _, ok := ld.rcs[rcName]; ok {
+		return nil
+	}
+	labels := labels(rcName)
+	labels[pipelineNameLabel] = pi.Pipeline.Name
+	annotations := map[string]string{
+		pipelineNameLabel:            pi.Pipeline.Name,
+		pachVersionAnnotation:        version.PrettyVersion(),
+		pipelineVersionAnnotation:    strconv.FormatUint(pi.Version, 10),
+		pipelineSpecCommitAnnotation: pi.SpecCommit.ID,
+		hashedAuthTokenAnnotation:    hashAuthToken(pi.AuthToken),
+	}
+	var replicas int32 // pipelines start w/ 0 workers & are scaled up
+	ld.rcs[rcName] = &localRC{
+		rc: &v1.ReplicationController{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ReplicationController",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        rcName,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Spec: v1.ReplicationControllerSpec{
+				Selector: labels,
+				Replicas: &replicas,
+			},
+		},
+		pi:      pi,
+		workers: make(map[int32]*localWorker),
+	}
+	return nil
+}
+
+func validateLocalPipeline(pi *pps.PipelineInfo) error {
+	details := pi.Details
+	switch {
+	case len(details.Transform.Secrets) > 0:
+		return errors.New("secrets are not supported by local workers")
+	case details.Service != nil || (details.Spout != nil && details.Spout.Service != nil):
+		return errors.New("services are not supported by local workers")
+	case ppsutil.ContainsS3Inputs(details.Input) || details.S3Out:
+		return errors.New("the s3 gateway is not supported by local workers")
+	case details.Egress != nil && details.Egress.GetSqlDatabase().GetSecret() != nil:
+		return errors.New("egress secrets are not supported by local workers")
+	case containsSQLInputSecrets(details.Input):
+		return errors.New("SQL input secrets are not supported by local workers")
+	}
+	return nil
+}
+
+func containsSQLInputSecrets(in *pps.Input) bool {
+	var found bool
+	pps.VisitInput(in, func(in *pps.Input) error {
+		if in.SQL.GetSecret().GetName() != "" {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	})
+	return found
+}
+
+// Stops a pipeline's workers and deletes its replication controllers.
+func (ld *localDriver) DeletePipelineResources(ctx context.Context, pipeline string) error {
+	log.Infof("PPS master: deleting local resources for pipeline %q", pipeline)
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	for name, lrc := range ld.rcs {
+		if lrc.rc.Labels[pipelineNameLabel] != pipeline {
+			continue
+		}
+		for _, w := range lrc.workers {
+			w.cancel()
+		}
+		delete(ld.rcs, name)
+	}
+	return nil
+}
+
+func (ld *localDriver) ReadReplicationController(ctx context.Context, pi *pps.PipelineInfo) (*v1.ReplicationControllerList, error) {
+	return ld.listRCs(pi.Pipeline.Name), nil
+}
+
+// UpdateReplicationController applies update to the replication controller,
+// and then starts or stops workers to match its replicas.
+func (ld *localDriver) UpdateReplicationController(ctx context.Context, old *v1.ReplicationController, update func(rc *v1.ReplicationController) bool) error {
+	rc := old.DeepCopy()
+	if !update(rc) {
+		return nil
+	}
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	lrc, ok := ld.rcs[rc.Name]
+	if !ok {
+		return newRetriableError(errors.Errorf("RC %q not found", rc.Name), "error updating RC")
+	}
+	lrc.rc.Spec = rc.Spec
+	ld.reconcile(lrc)
+	return nil
+}
+
+func (ld *localDriver) ListReplicationControllers(ctx context.Context) (*v1.ReplicationControllerList, error) {
+	return ld.listRCs(""), nil
+}
+
+// listRCs returns copies of the replication controllers of a pipeline, or of
+// every pipeline if pipeline is empty.
+func (ld *localDriver) listRCs(pipeline string) *v1.ReplicationControllerList {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	result := &v1.ReplicationControllerList{Items: []v1.ReplicationController{}}
+	for _, lrc := range ld.rcs {
+		if pipeline != "" && lrc.rc.Labels[pipelineNameLabel] != pipeline {
+			continue
+		}
+		result.Items = append(result.Items, *lrc.rc.DeepCopy())
+	}
+	return result
+}
+
+// WatchPipelinePods returns the lifecycle events of the local workers, as
+// events about the pods that they would run in under kubernetes.
+func (ld *localDriver) WatchPipelinePods(ctx context.Context) (<-chan watch.Event, func(), error) {
+	w := &localWatcher{
+		events: make(chan watch.Event),
+		done:   make(chan struct{}),
+	}
+	ld.mu.Lock()
+	ld.watchers[w] = struct{}{}
+	ld.mu.Unlock()
+	return w.events, func() {
+		w.once.Do(func() {
+			ld.mu.Lock()
+			delete(ld.watchers, w)
+			ld.mu.Unlock()
+			close(w.done)
+		})
+	}, nil
+}
+
+// reconcile starts or stops workers so the number of workers matches the
+// replicas of a replication controller. ld.mu must be held.
+func (ld *localDriver) reconcile(lrc *localRC) {
+	var replicas int32
+	if lrc.rc.Spec.Replicas != nil {
+		replicas = *lrc.rc.Spec.Replicas
+	}
+	for i := int32(0); i < replicas; i++ {
+		if _, ok := lrc.workers[i]; ok {
+			continue
+		}
+		ctx, cancel := context.WithCancel(ld.ctx)
+		w := &localWorker{cancel: cancel}
+		lrc.workers[i] = w
+		go ld.supervise(ctx, lrc, w, fmt.Sprintf("%s-%d", lrc.rc.Name, i))
+	}
+	for i, w := range lrc.workers {
+		if i >= replicas {
+			w.cancel()
+			delete(lrc.workers, i)
+		}
+	}
+	lrc.rc.Status.Replicas = int32(len(lrc.workers))
+	ld.updateReady(lrc)
+}
+
+// supervise runs a worker until ctx is canceled, restarting it with a backoff
+// when it fails to start or exits.
+func (ld *localDriver) supervise(ctx context.Context, lrc *localRC, w *localWorker, name string) {
+	ld.emit(watch.Added, lrc, name, v1.ContainerState{
+		Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"},
+	})
+	defer ld.emit(watch.Deleted, lrc, name, v1.ContainerState{
+		Terminated: &v1.ContainerStateTerminated{Reason: "Completed"},
+	})
+	b := backoff.NewInfiniteBackOff()
+	for {
+		var wait func() error
+		spec, err := ld.workerSpec(lrc.pi, name)
+		if err == nil {
+			wait, err = ld.runner.start(ctx, spec)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf("PPS master: could not start local worker %q: %v", name, err)
+			// This is reported as a failure to create the container, which
+			// moves the pipeline to CRASHING.
+			ld.emit(watch.Modified, lrc, name, v1.ContainerState{
+				Waiting: &v1.ContainerStateWaiting{Reason: "CreateContainerError", Message: err.Error()},
+			})
+		} else {
+			started := time.Now()
+			ld.setReady(lrc, w, true)
+			ld.emit(watch.Modified, lrc, name, v1.ContainerState{
+				Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(started)},
+			})
+			err := wait()
+			ld.setReady(lrc, w, false)
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf("PPS master: local worker %q exited: %v", name, err)
+			state := &v1.ContainerStateTerminated{
+				Reason:     "Error",
+				StartedAt:  metav1.NewTime(started),
+				FinishedAt: metav1.Now(),
+			}
+			if err != nil {
+				state.Message = err.Error()
+			}
+			if exitErr := (&exec.ExitError{}); errors.As(err, &exitErr) {
+				state.ExitCode = int32(exitErr.ExitCode())
+			}
+			ld.emit(watch.Modified, lrc, name, v1.ContainerState{Terminated: state})
+			if time.Since(started) > localWorkerRanFor {
+				b.Reset()
+			}
+		}
+		select {
+		case <-time.After(b.NextBackOff()):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ld *localDriver) setReady(lrc *localRC, w *localWorker, ready bool) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	w.ready = ready
+	ld.updateReady(lrc)
+}
+
+// updateReady updates the ready replicas of a replication controller. ld.mu must be held.
+func (ld *localDriver) updateReady(lrc *localRC) {
+	var ready int32
+	for _, w := range lrc.workers {
+		if w.ready {
+			ready++
+		}
+	}
+	lrc.rc.Status.ReadyReplicas = ready
+}
+
+// emit sends an event about a worker's pod to the watchers.
+func (ld *localDriver) emit(eventType watch.EventType, lrc *localRC, name string, state v1.ContainerState) {
+	ld.mu.Lock()
+	pod := &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      lrc.rc.Labels,
+			Annotations: lrc.rc.Annotations,
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  client.PPSWorkerUserContainerName,
+				State: state,
+				Ready: state.Running != nil,
+			}},
+		},
+	}
+	switch {
+	case state.Running != nil:
+		pod.Status.Phase = v1.PodRunning
+	case state.Terminated != nil && state.Terminated.Reason == "Completed":
+		pod.Status.Phase = v1.PodSucceeded
+	case state.Terminated != nil:
+		pod.Status.Phase = v1.PodFailed
+		pod.Status.Message = state.Terminated.Message
+	}
+	var watchers []*localWatcher
+	for w := range ld.watchers {
+		watchers = append(watchers, w)
+	}
+	ld.mu.Unlock()
+	for _, w := range watchers {
+		select {
+		case w.events <- watch.Event{Type: eventType, Object: pod.DeepCopy()}:
+		case <-w.done:
+		case <-ld.ctx.Done():
+			return
+		}
+	}
+}
+
+// workerSpec returns the spec of a pipeline's worker, which connects directly
+// to this pachd, since there's no sidecar.
+func (ld *localDriver) workerSpec(pi *pps.PipelineInfo, name string) (*localWorkerSpec, error) {
+	// The workers share the host's network, so each of them needs its own port.
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	env := map[string]string{
+		"PACH_ROOT":                       ld.config.StorageRoot,
+		"PACH_NAMESPACE":                  ld.config.Namespace,
+		"PACH_IN_WORKER":                  "true",
+		"STORAGE_BACKEND":                 ld.config.StorageBackend,
+		"STORAGE_COMPRESSION_ALGO":        ld.config.StorageCompressionAlgo,
+		"STORAGE_COMPRESSION_LEVEL":       strconv.Itoa(ld.config.StorageCompressionLevel),
+		"STORAGE_COMPRESSION_SAMPLE_SIZE": strconv.Itoa(ld.config.StorageCompressionSampleSize),
+		"STORAGE_ENCRYPTION_ALGO":         ld.config.StorageEncryptionAlgo,
+		"STORAGE_ENCRYPTION_KEYS":         ld.config.StorageEncryptionKeys,
+		"STORAGE_COLD_TIER_URL":           ld.config.StorageColdTierURL,
+		"STORAGE_COLD_TIER_AFTER_DAYS":    strconv.FormatInt(ld.config.StorageColdTierAfterDays, 10),
+		"TASK_SERVICE_BACKEND":            ld.config.TaskServiceBackend,
+		"ETCD_SERVICE_HOST":               ld.config.EtcdHost,
+		"ETCD_SERVICE_PORT":               ld.config.EtcdPort,
+		"POSTGRES_USER":                   ld.config.PostgresUser,
+		"POSTGRES_PASSWORD":               ld.config.PostgresPassword,
+		"POSTGRES_DATABASE":               ld.config.PostgresDBName,
+		"POSTGRES_SSL":                    ld.config.PostgresSSL,
+		"PG_BOUNCER_HOST":                 ld.config.PGBouncerHost,
+		"PG_BOUNCER_PORT":                 strconv.Itoa(ld.config.PGBouncerPort),
+		"LOKI_SERVICE_HOST_VAR":           ld.config.LokiHostVar,
+		"LOKI_SERVICE_PORT_VAR":           ld.config.LokiPortVar,
+		"LOG_FORMAT":                      ld.config.LogFormat,
+		"PPS_INFRA_DRIVER":                serviceenv.InfraDriverLocal,
+		UploadConcurrencyLimitEnvVar:      strconv.Itoa(ld.config.StorageUploadConcurrencyLimit),
+		client.PeerPortEnv:                strconv.FormatUint(uint64(ld.config.PeerPort), 10),
+		client.PPSSpecCommitEnv:           pi.SpecCommit.ID,
+		client.PPSPipelineNameEnv:         pi.Pipeline.Name,
+		client.PPSWorkerIPEnv:             "127.0.0.1",
+		client.PPSWorkerPortEnv:           strconv.Itoa(port),
+		client.PPSEtcdPrefixEnv:           ld.etcdPrefix,
+		client.PPSPodNameEnv:              name,
+		"DISABLE_COMMIT_PROGRESS_COUNTER": strconv.FormatBool(ld.config.DisableCommitProgressCounter),
+		"LOKI_LOGGING":                    strconv.FormatBool(ld.config.LokiLogging),
+	}
+	for k, v := range pi.Details.Transform.Env {
+		if _, ok := env[k]; !ok {
+			env[k] = v
+		}
+	}
+	spec := &localWorkerSpec{
+		name:  name,
+		image: pi.Details.Transform.Image,
+	}
+	if spec.image == "" {
+		spec.image = DefaultUserImage
+	}
+	for k, v := range env {
+		spec.env = append(spec.env, k+"="+v)
+	}
+	return spec, nil
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// processRunner runs workers as processes on the host. Each worker gets a
+// scratch directory under dir, which is removed when it exits, and its output
+// is appended to a log file in dir.
+type processRunner struct {
+	binary string
+	dir    string
+}
+
+func (pr *processRunner) start(ctx context.Context, spec *localWorkerSpec) (func() error, error) {
+	root := filepath.Join(pr.dir, spec.name)
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	logFile, err := os.OpenFile(filepath.Join(pr.dir, spec.name+".log"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	cmd := exec.CommandContext(ctx, pr.binary)
+	cmd.Dir = root
+	// The worker inherits pachd's environment, so user code can find the
+	// tools installed on the host.
+	cmd.Env = append(os.Environ(), spec.env...)
+	cmd.Env = append(cmd.Env, "PPS_WORKER_ROOT="+root)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		logFile.Close()
+		return nil, errors.EnsureStack(err)
+	}
+	return func() error {
+		defer func() {
+			logFile.Close()
+			if err := os.RemoveAll(root); err != nil {
+				log.Errorf("could not remove local worker directory %q: %v", root, err)
+			}
+		}()
+		return errors.EnsureStack(cmd.Wait())
+	}, nil
+}
+
+// dockerRunner runs workers as docker containers of the pipeline's image, in
+// the host's network so that they can reach pachd. The worker binary is
+// mounted into the container, so it must be built for the container's
+// platform.
+type dockerRunner struct {
+	binary string
+}
+
+func (dr *dockerRunner) start(ctx context.Context, spec *localWorkerSpec) (func() error, error) {
+	// The container runs the image by its ID, which is passed to the worker
+	// since, unlike the image's name, it identifies the image's contents.
+	imageID, err := dockerImageID(ctx, spec.image)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{
+		"run", "--rm",
+		"--name", spec.name,
+		"--network", "host",
+		"--volume", dr.binary + ":/pach-bin/worker:ro",
+		"--entrypoint", "/pach-bin/worker",
+	}
+	// The worker's environment includes secrets, so only the variables' names
+	// are passed as arguments, which any user on the host can see, and docker
+	// reads their values from its own environment.
+	for _, e := range spec.env {
+		args = append(args, "--env", strings.SplitN(e, "=", 2)[0])
+	}
+	args = append(args, "--env", client.PPSUserImageIDEnv+"="+imageID, imageID)
+	// The container isn't stopped by killing the docker client, so it's
+	// removed when ctx is canceled instead.
+	cmd := exec.Command("docker", args...)
+	cmd.Env = append(os.Environ(), spec.env...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	exited := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			if out, err := exec.Command("docker", "rm", "--force", spec.name).CombinedOutput(); err != nil {
+				log.Errorf("could not remove local worker container %q: %v: %s", spec.name, err, out)
+			}
+		case <-exited:
+		}
+	}()
+	return func() error {
+		defer close(exited)
+		return errors.EnsureStack(cmd.Wait())
+	}, nil
+}
+
+// dockerImageID returns the ID of image, pulling it if it isn't present.
+func dockerImageID(ctx context.Context, image string) (string, error) {
+	inspect := func() ([]byte, error) {
+		return exec.CommandContext(ctx, "docker", "image", "inspect", "--format", "{{.Id}}", image).Output()
+	}
+	out, err := inspect()
+	if err != nil {
+		if out, err := exec.CommandContext(ctx, "docker", "pull", image).CombinedOutput(); err != nil {
+			return "", errors.Wrapf(err, "could not pull image %q: %s", image, out)
+		}
+		if out, err = inspect(); err != nil {
+			return "", errors.Wrapf(err, "could not inspect image %q", image)
+		}
+	}
+	return strings.TrimSpace(string(out)), nil
+}
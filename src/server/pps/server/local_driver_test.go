@@ -0,0 +1,245 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	v1 "k8s.io/api/core/v1"
+)
+
+// fakeRunner runs workers which do nothing until they are stopped or exited.
+type fakeRunner struct {
+	mu       sync.Mutex
+	startErr error
+	running  map[string]chan error
+}
+
+func newFakeRunner() *fakeRunner {
+	return &fakeRunner{running: make(map[string]chan error)}
+}
+
+func (fr *fakeRunner) start(ctx context.Context, spec *localWorkerSpec) (func() error, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	if fr.startErr != nil {
+		return nil, fr.startErr
+	}
+	exit := make(chan error, 1)
+	fr.running[spec.name] = exit
+	return func() error {
+		defer func() {
+			fr.mu.Lock()
+			defer fr.mu.Unlock()
+			delete(fr.running, spec.name)
+		}()
+		select {
+		case err := <-exit:
+			return err
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+	}, nil
+}
+
+func (fr *fakeRunner) numRunning() int {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return len(fr.running)
+}
+
+func localTestPipeline(name string) *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline(name),
+		Version:    1,
+		SpecCommit: &pfs.Commit{ID: "spec"},
+		Details: &pps.PipelineInfo_Details{
+			Transform: &pps.Transform{Image: "ubuntu:20.04"},
+		},
+	}
+}
+
+func newTestLocalDriver(t *testing.T) (*localDriver, *fakeRunner) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	fr := newFakeRunner()
+	return newLocalDriverWithRunner(ctx, newConfig(t), "pachyderm_pps", fr), fr
+}
+
+func scaleLocalRC(t *testing.T, ld *localDriver, pi *pps.PipelineInfo, replicas int32) {
+	rcs, err := ld.ReadReplicationController(context.Background(), pi)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	require.NoError(t, ld.UpdateReplicationController(context.Background(), &rcs.Items[0], func(rc *v1.ReplicationController) bool {
+		rc.Spec.Replicas = &replicas
+		return true
+	}))
+}
+
+func requireReadyReplicas(t *testing.T, ld *localDriver, pi *pps.PipelineInfo, replicas int32) {
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		rcs, err := ld.ReadReplicationController(context.Background(), pi)
+		if err != nil {
+			return err
+		}
+		if got := rcs.Items[0].Status.ReadyReplicas; got != replicas {
+			return errors.Errorf("expected %d ready replicas, but got %d", replicas, got)
+		}
+		return nil
+	})
+}
+
+func TestLocalDriverScaling(t *testing.T) {
+	ld, fr := newTestLocalDriver(t)
+	pi := localTestPipeline("scaling")
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	// creating the resources again is a no-op
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+
+	rcs, err := ld.ListReplicationControllers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rcs.Items))
+	rc := &rcs.Items[0]
+	require.Equal(t, ppsutil.PipelineRcName("scaling", 1), rc.Name)
+	require.Equal(t, "scaling", rc.Labels[pipelineNameLabel])
+	require.Equal(t, int32(0), *rc.Spec.Replicas)
+	require.True(t, rcIsFresh(pi, rc))
+
+	scaleLocalRC(t, ld, pi, 3)
+	requireReadyReplicas(t, ld, pi, 3)
+	require.Equal(t, 3, fr.numRunning())
+
+	scaleLocalRC(t, ld, pi, 1)
+	requireReadyReplicas(t, ld, pi, 1)
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if n := fr.numRunning(); n != 1 {
+			return errors.Errorf("expected 1 running worker, but got %d", n)
+		}
+		return nil
+	})
+
+	require.NoError(t, ld.DeletePipelineResources(context.Background(), "scaling"))
+	rcs, err = ld.ListReplicationControllers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rcs.Items))
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if n := fr.numRunning(); n != 0 {
+			return errors.Errorf("expected no running workers, but got %d", n)
+		}
+		return nil
+	})
+}
+
+func TestLocalDriverRestart(t *testing.T) {
+	ld, fr := newTestLocalDriver(t)
+	pi := localTestPipeline("restart")
+	events, cancel, err := ld.WatchPipelinePods(context.Background())
+	require.NoError(t, err)
+	defer cancel()
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	scaleLocalRC(t, ld, pi, 1)
+
+	// wait for the worker to be reported as running, then make it exit
+	nextPod := func() *v1.Pod {
+		select {
+		case e := <-events:
+			require.Equal(t, "restart", e.Object.(*v1.Pod).Annotations[pipelineNameLabel])
+			return e.Object.(*v1.Pod)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a pod event")
+		}
+		return nil
+	}
+	for pod := nextPod(); pod.Status.Phase != v1.PodRunning; pod = nextPod() {
+	}
+	fr.mu.Lock()
+	for _, exit := range fr.running {
+		exit <- errors.New("exited")
+	}
+	fr.mu.Unlock()
+	pod := nextPod()
+	require.Equal(t, v1.PodFailed, pod.Status.Phase)
+	require.Equal(t, client.PPSWorkerUserContainerName, pod.Status.ContainerStatuses[0].Name)
+	require.NotNil(t, pod.Status.ContainerStatuses[0].State.Terminated)
+
+	// the worker is restarted
+	require.Equal(t, v1.PodRunning, nextPod().Status.Phase)
+	requireReadyReplicas(t, ld, pi, 1)
+}
+
+func TestLocalDriverStartFailure(t *testing.T) {
+	ld, fr := newTestLocalDriver(t)
+	fr.startErr = errors.New("no such image")
+	pi := localTestPipeline("failure")
+	events, cancel, err := ld.WatchPipelinePods(context.Background())
+	require.NoError(t, err)
+	defer cancel()
+	require.NoError(t, ld.CreatePipelineResources(context.Background(), pi))
+	scaleLocalRC(t, ld, pi, 1)
+	// The failure is reported with a reason that makes the master crash the pipeline.
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case e := <-events:
+			state := e.Object.(*v1.Pod).Status.ContainerStatuses[0].State
+			if state.Waiting != nil && failures[state.Waiting.Reason] {
+				require.Equal(t, "1", e.Object.(*v1.Pod).Annotations[pipelineVersionAnnotation])
+				return
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for the worker to fail")
+		}
+	}
+}
+
+func TestLocalDriverUnsupported(t *testing.T) {
+	ld, _ := newTestLocalDriver(t)
+	pi := localTestPipeline("secrets")
+	pi.Details.Transform.Secrets = []*pps.SecretMount{{Name: "secret", EnvVar: "SECRET"}}
+	err := ld.CreatePipelineResources(context.Background(), pi)
+	require.YesError(t, err)
+	var se stepError
+	require.True(t, errors.As(err, &se))
+	require.True(t, se.failPipeline)
+
+	pi = localTestPipeline("sql")
+	pi.Details.Input = &pps.Input{SQL: &pps.SQLInput{
+		Name:   "db",
+		Secret: &pfs.SQLDatabaseEgress_Secret{Name: "secret", Key: "PACHYDERM_SQL_PASSWORD"},
+	}}
+	err = ld.CreatePipelineResources(context.Background(), pi)
+	require.YesError(t, err)
+	require.True(t, errors.As(err, &se))
+	require.True(t, se.failPipeline)
+
+	rcs, err := ld.ListReplicationControllers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rcs.Items))
+}
+
+func TestLocalDriverNoKubernetes(t *testing.T) {
+	ctx := context.Background()
+	config := newConfig(t)
+	config.PPSInfraDriver = serviceenv.InfraDriverLocal
+	a := &apiServer{env: Env{Config: config}}
+	secret := &pps.Secret{Name: "secret"}
+	_, err := a.InspectSecret(ctx, &pps.InspectSecretRequest{Secret: secret})
+	require.True(t, errors.Is(err, serviceenv.ErrNoKubernetes))
+	_, err = a.ListSecret(ctx, &types.Empty{})
+	require.True(t, errors.Is(err, serviceenv.ErrNoKubernetes))
+	_, err = a.DeleteSecret(ctx, &pps.DeleteSecretRequest{Secret: secret})
+	require.True(t, errors.Is(err, serviceenv.ErrNoKubernetes))
+	err = a.CreateSecretInTransaction(nil, &pps.CreateSecretRequest{File: []byte(`{"metadata": {"name": "secret"}}`)})
+	require.True(t, errors.Is(err, serviceenv.ErrNoKubernetes))
+	_, err = a.rcPods(ctx, "pachd")
+	require.True(t, errors.Is(err, serviceenv.ErrNoKubernetes))
+}
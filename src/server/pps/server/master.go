@@ -16,6 +16,7 @@ import (
 	middleware_auth "github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -114,7 +115,10 @@ func (a *apiServer) master() {
 		}
 		defer masterLock.Unlock(ctx)
 		log.Infof("PPS master: launching master process")
-		kd := newKubeDriver(a.env.KubeClient, a.env.Config, a.env.Logger)
+		kd, err := a.newInfraDriver(ctx)
+		if err != nil {
+			return err
+		}
 		sd := newPipelineStateDriver(a.env.DB, a.pipelines, a.txnEnv, a.env.PFSServer)
 		m := newMaster(ctx, a.env, a.etcdPrefix, kd, sd)
 		m.run()
@@ -126,6 +130,19 @@ func (a *apiServer) master() {
 	panic("internal error: PPS master has somehow exited. Restarting pod...")
 }
 
+// newInfraDriver returns the InfraDriver that the PPS master uses to run
+// pipeline workers, as configured by PPS_INFRA_DRIVER.
+func (a *apiServer) newInfraDriver(ctx context.Context) (InfraDriver, error) {
+	switch a.env.Config.PPSInfraDriver {
+	case serviceenv.InfraDriverKubernetes:
+		return newKubeDriver(a.env.KubeClient, a.env.Config, a.env.Logger), nil
+	case serviceenv.InfraDriverLocal:
+		return newLocalDriver(ctx, a.env.Config, a.etcdPrefix)
+	default:
+		return nil, errors.Errorf("unknown infra driver %q", a.env.Config.PPSInfraDriver)
+	}
+}
+
 func (m *ppsMaster) setPipelineCrashing(ctx context.Context, specCommit *pfs.Commit, reason string) error {
 	if err := m.sd.SetState(ctx, specCommit, pps.PipelineState_PIPELINE_CRASHING, reason); err != nil {
 		return errors.Wrapf(err, "failed to set pipeline to crashing state")
@@ -27,8 +27,8 @@ import (
 // startMonitor starts a new goroutine running monitorPipeline for
 // 'pipelineInfo.Pipeline'.
 //
-// Every running pipeline with standby == true or a cron input has a
-// corresponding goroutine running monitorPipeline() that puts the pipeline in
+// Every running pipeline with standby == true, a cron input or a SQL input has
+// a corresponding goroutine running monitorPipeline() that puts the pipeline in
 // and out of standby in response to new output commits appearing in that
 // pipeline's output repo.
 // returns a cancel()
@@ -97,6 +97,14 @@ func (pc *pipelineController) monitorPipeline(ctx context.Context, pipelineInfo
 					backoff.NotifyCtx(ctx, "cron for "+in.Cron.Name))
 			})
 		}
+		if in.SQL != nil && in.SQL.Spec != "" {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return makeSQLCommits(ctx, pc.env, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(ctx, "sql for "+in.SQL.Name))
+			})
+		}
 		return nil
 	})
 	if pipelineInfo.Details.Autoscaling {
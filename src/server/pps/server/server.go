@@ -46,13 +46,27 @@ type Env struct {
 	Config            serviceenv.Configuration
 }
 
+// kubeClient returns the kubernetes client, or serviceenv.ErrNoKubernetes if
+// pipelines run locally, without kubernetes.
+func (env Env) kubeClient() (*kubernetes.Clientset, error) {
+	if env.Config.PPSInfraDriver == serviceenv.InfraDriverLocal {
+		return nil, serviceenv.ErrNoKubernetes
+	}
+	return env.KubeClient, nil
+}
+
 func EnvFromServiceEnv(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, reporter *metrics.Reporter) Env {
 	etcdPrefix := path.Join(senv.Config().EtcdPrefix, senv.Config().PPSEtcdPrefix)
+	// There's no kubernetes client when pipelines run locally.
+	var kubeClient *kubernetes.Clientset
+	if senv.Config().PPSInfraDriver != serviceenv.InfraDriverLocal {
+		kubeClient = senv.GetKubeClient()
+	}
 	return Env{
 		DB:            senv.GetDBClient(),
 		TxnEnv:        txnEnv,
 		Listener:      senv.GetPostgresListener(),
-		KubeClient:    senv.GetKubeClient(),
+		KubeClient:    kubeClient,
 		EtcdClient:    senv.GetEtcdClient(),
 		EtcdPrefix:    etcdPrefix,
 		TaskService:   senv.GetTaskService(etcdPrefix),
@@ -76,7 +90,9 @@ func NewAPIServer(env Env) (ppsiface.APIServer, error) {
 		return nil, err
 	}
 	apiServer := (srv).(*apiServer)
-	apiServer.validateKube(apiServer.env.BackgroundContext)
+	if env.Config.PPSInfraDriver != serviceenv.InfraDriverLocal {
+		apiServer.validateKube(apiServer.env.BackgroundContext)
+	}
 	go apiServer.master()
 	return apiServer, nil
 }
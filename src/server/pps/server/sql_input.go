@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/robfig/cron"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// defaultSQLPasswordKey is the key of the database password in a SQL input's
+// secret, if the input doesn't specify one.
+const defaultSQLPasswordKey = "PACHYDERM_SQL_PASSWORD"
+
+// sqlTick starts a commit in the SQL input's branch. The commit triggers a
+// job, and the pipeline's worker runs the query and writes its result to the
+// commit before processing the job. If the previous commit is still open, its
+// query hasn't run yet, so it will include the rows of this tick anyway.
+func sqlTick(pachClient *client.APIClient, in *pps.SQLInput) error {
+	branch := pps.SQLInputBranch(in)
+	ci, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{
+		Commit: branch.NewCommit(""),
+	})
+	if err != nil && !pfsServer.IsBranchNotFoundErr(err) {
+		return errors.EnsureStack(err)
+	}
+	if err == nil && ci.Finished == nil {
+		log.Infof("SQL input %q: skipping tick, the query of the previous tick hasn't run yet", in.Name)
+		return nil
+	}
+	_, err = pachClient.PfsAPIClient.StartCommit(pachClient.Ctx(), &pfs.StartCommitRequest{
+		Branch: branch,
+	})
+	return errors.EnsureStack(err)
+}
+
+// makeSQLCommits starts the commits of a single SQL input on its schedule.
+// It's a helper function called by monitorPipeline.
+func makeSQLCommits(ctx context.Context, env Env, in *pps.Input) error {
+	schedule, err := cron.ParseStandard(in.SQL.Spec)
+	if err != nil {
+		return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	pachClient := env.GetPachClient(ctx)
+	latestTime, err := getLatestSQLTime(pachClient, in)
+	if err != nil {
+		return err
+	}
+	for {
+		next := schedule.Next(latestTime)
+		if next.IsZero() {
+			return nil // zero time indicates there will never be another tick
+		}
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return errors.EnsureStack(ctx.Err())
+		}
+		if err := sqlTick(pachClient, in.SQL); err != nil {
+			return err
+		}
+		latestTime = next
+	}
+}
+
+// getLatestSQLTime returns the time the latest commit of a SQL input was
+// started, or its start time if there are no commits yet.
+func getLatestSQLTime(pachClient *client.APIClient, in *pps.Input) (time.Time, error) {
+	ci, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{
+		Commit: pps.SQLInputBranch(in.SQL).NewCommit(""),
+	})
+	if err != nil && !pfsServer.IsBranchNotFoundErr(err) {
+		return time.Time{}, errors.EnsureStack(err)
+	}
+	if err == nil && ci.ParentCommit != nil {
+		latestTime, err := types.TimestampFromProto(ci.Started)
+		return latestTime, errors.EnsureStack(err)
+	}
+	latestTime, err := types.TimestampFromProto(in.SQL.Start)
+	return latestTime, errors.EnsureStack(err)
+}
+
+func containsSQLInputs(in *pps.Input) bool {
+	var found bool
+	pps.VisitInput(in, func(in *pps.Input) error {
+		if in.SQL != nil {
+			found = true
+			return errutil.ErrBreak
+		}
+		return nil
+	})
+	return found
+}
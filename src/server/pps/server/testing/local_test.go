@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	ppsapi "github.com/pachyderm/pachyderm/v2/src/server/pps"
+	ppsserver "github.com/pachyderm/pachyderm/v2/src/server/pps/server"
+)
+
+func newPPSServer(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (ppsapi.APIServer, error) {
+	return ppsserver.NewAPIServer(ppsserver.EnvFromServiceEnv(senv, txnEnv, nil))
+}
+
+// buildWorker builds the worker binary run by the local infra driver.
+func buildWorker(t testing.TB) string {
+	bin := filepath.Join(t.TempDir(), "worker")
+	cmd := exec.Command("go", "build", "-o", bin, "github.com/pachyderm/pachyderm/v2/src/server/cmd/worker")
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=0")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return bin
+}
+
+// TestLocalDriverPipeline runs a pipeline end to end with the local infra
+// driver, whose workers are processes of the worker binary.
+func TestLocalDriverPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	env := testpachd.NewRealEnvWithPPS(t, newPPSServer, buildWorker(t), dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+
+	require.NoError(t, c.CreateRepo("in"))
+	require.NoError(t, c.PutFile(client.NewCommit("in", "master", ""), "a", bytes.NewBufferString("foo")))
+	require.NoError(t, c.PutFile(client.NewCommit("in", "master", ""), "b", bytes.NewBufferString("bar")))
+	require.NoError(t, c.CreatePipeline(
+		"copy",
+		"",
+		[]string{"sh"},
+		[]string{"cp \"$in\" pfs/out/"},
+		nil,
+		client.NewPFSInput("in", "/*"),
+		"",
+		false,
+	))
+
+	checkOutput := func(expected map[string]string) {
+		_, err := c.WaitCommit("copy", "master", "")
+		require.NoError(t, err)
+		for name, content := range expected {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(client.NewCommit("copy", "master", ""), name, &buf))
+			require.Equal(t, content, buf.String())
+		}
+	}
+	checkOutput(map[string]string{"a": "foo", "b": "bar"})
+
+	require.NoError(t, c.PutFile(client.NewCommit("in", "master", ""), "c", bytes.NewBufferString("baz")))
+	checkOutput(map[string]string{"a": "foo", "b": "bar", "c": "baz"})
+
+	jobInfos, err := c.ListJob("copy", nil, -1, false)
+	require.NoError(t, err)
+	require.True(t, len(jobInfos) > 0)
+	for _, ji := range jobInfos {
+		require.Equal(t, pps.JobState_JOB_SUCCESS, ji.State)
+	}
+}
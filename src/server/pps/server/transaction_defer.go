@@ -1,6 +1,8 @@
 package server
 
 import (
+	"context"
+
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -9,6 +11,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Propagater is an object that is used to create jobs in response to a new
@@ -131,3 +136,103 @@ func (jf *JobFinisher) Run() error {
 	}
 	return nil
 }
+
+// SecretCreator is an object that is used to create Kubernetes secrets at the
+// end of a transaction, after everything else in it has succeeded.  The
+// transactionenv package provides the interface for this and will call the
+// Run function at the end of a transaction, and the Rollback function if the
+// transaction could not be committed afterwards.
+type SecretCreator struct {
+	a        *apiServer
+	ctx      context.Context
+	requests []*pps.CreateSecretRequest
+	created  []string
+}
+
+func (a *apiServer) NewSecretCreator(ctx context.Context, txnCtx *txncontext.TransactionContext) txncontext.PpsSecretCreator {
+	return &SecretCreator{
+		a:   a,
+		ctx: ctx,
+	}
+}
+
+// CreateSecret notifies PPS that a secret should be created at the end of the
+// transaction.
+func (sc *SecretCreator) CreateSecret(request *pps.CreateSecretRequest) {
+	sc.requests = append(sc.requests, request)
+}
+
+// Run creates the secrets.  If any of them can't be created, the ones created
+// before it are removed again.
+func (sc *SecretCreator) Run() (retErr error) {
+	defer func() {
+		if retErr != nil {
+			sc.Rollback()
+		}
+	}()
+	if len(sc.requests) == 0 {
+		return nil
+	}
+	kubeClient, err := sc.a.env.kubeClient()
+	if err != nil {
+		return errors.Wrapf(err, "failed to create secret")
+	}
+	secrets := kubeClient.CoreV1().Secrets(sc.a.namespace)
+	for _, request := range sc.requests {
+		s, err := parseSecret(request.GetFile())
+		if err != nil {
+			return err
+		}
+		created, err := secrets.Create(sc.ctx, s, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create secret")
+		}
+		sc.created = append(sc.created, created.Name)
+	}
+	return nil
+}
+
+// Rollback deletes the secrets created by Run.
+func (sc *SecretCreator) Rollback() {
+	if len(sc.created) == 0 {
+		return
+	}
+	secrets := sc.a.env.KubeClient.CoreV1().Secrets(sc.a.namespace)
+	for _, name := range sc.created {
+		if err := secrets.Delete(sc.ctx, name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			logrus.Errorf("could not delete secret %s of a failed transaction: %v", name, err)
+		}
+	}
+	sc.created = nil
+}
+
+// JobCacheClearer is an object that is used to clear the cached results of
+// jobs once a transaction is committed, so that they aren't lost if it isn't.
+// The transactionenv package provides the interface for this and will call
+// the Run function once the transaction is committed.
+type JobCacheClearer struct {
+	a           *apiServer
+	ctx         context.Context
+	tagPrefixes []string
+}
+
+func (a *apiServer) NewJobCacheClearer(ctx context.Context, txnCtx *txncontext.TransactionContext) txncontext.PpsJobCacheClearer {
+	return &JobCacheClearer{
+		a:   a,
+		ctx: ctx,
+	}
+}
+
+// ClearJobCache notifies PPS that the cached results with the given tag prefix
+// should be cleared once the transaction is committed.
+func (jc *JobCacheClearer) ClearJobCache(tagPrefix string) {
+	jc.tagPrefixes = append(jc.tagPrefixes, tagPrefix)
+}
+
+// Run clears the cached results.  Failures are only logged, since the cache
+// is not needed for correctness.
+func (jc *JobCacheClearer) Run() {
+	for _, tagPrefix := range jc.tagPrefixes {
+		clearJobCache(jc.a.env.GetPachClient(jc.ctx), tagPrefix)
+	}
+}
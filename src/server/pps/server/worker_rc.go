@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -62,6 +63,7 @@ type workerOptions struct {
 	volumes               []v1.Volume           // Volumes that we expose to the user container
 	volumeMounts          []v1.VolumeMount      // Paths where we mount each volume in 'volumes'
 	postgresSecret        *v1.SecretKeySelector // the reference to the postgres password
+	encryptionKeysSecret  *v1.SecretKeySelector // the reference to the storage encryption keys, if any
 	schedulingSpec        *pps.SchedulingSpec   // the SchedulingSpec for the pipeline
 	podSpec               string
 	podPatch              string
@@ -120,6 +122,9 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 	}, {
 		Name:  "STORAGE_BACKEND",
 		Value: kd.config.StorageBackend,
+	}, {
+		Name:  "TASK_SERVICE_BACKEND",
+		Value: kd.config.TaskServiceBackend,
 	}, {
 		Name:  "POSTGRES_USER",
 		Value: kd.config.PostgresUser,
@@ -180,7 +185,7 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 		Value: strconv.FormatInt(int64(kd.config.GCPercent), 10),
 	}}
 
-	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(pipelineInfo)...)
+	sidecarEnv = append(sidecarEnv, kd.getStorageEnvVars(options, pipelineInfo)...)
 	sidecarEnv = append(sidecarEnv, commonEnv...)
 	sidecarEnv = append(sidecarEnv, kd.getEgressSecretEnvVars(pipelineInfo)...)
 
@@ -223,6 +228,8 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 		},
 	}...)
 	workerEnv = append(workerEnv, commonEnv...)
+	// The worker runs the queries of SQL inputs for the pipeline's jobs
+	workerEnv = append(workerEnv, kd.getSQLInputSecretEnvVars(pipelineInfo)...)
 
 	// Set S3GatewayPort in the worker (for user code) and sidecar (for serving)
 	if options.s3GatewayPort != 0 {
@@ -486,11 +493,44 @@ func (kd *kubeDriver) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pi
 	return podSpec, nil
 }
 
-func (kd *kubeDriver) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
+func (kd *kubeDriver) getStorageEnvVars(options *workerOptions, pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
 	vars := []v1.EnvVar{
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(kd.config.StorageUploadConcurrencyLimit)},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
+	// The sidecar must encrypt chunks like pachd, and unwrap the keys of the
+	// chunks written by pachd.
+	if kd.config.StorageEncryptionAlgo != "" {
+		vars = append(vars, v1.EnvVar{Name: "STORAGE_ENCRYPTION_ALGO", Value: kd.config.StorageEncryptionAlgo})
+	}
+	if options.encryptionKeysSecret != nil {
+		vars = append(vars, v1.EnvVar{
+			Name: "STORAGE_ENCRYPTION_KEYS",
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: options.encryptionKeysSecret,
+			},
+		})
+	}
+	// The sidecar must compress chunks like pachd, so that the chunks it
+	// writes dedupe with those written by pachd.
+	if kd.config.StorageCompressionAlgo != "" {
+		vars = append(vars, v1.EnvVar{Name: "STORAGE_COMPRESSION_ALGO", Value: kd.config.StorageCompressionAlgo})
+	}
+	if kd.config.StorageCompressionLevel > 0 {
+		vars = append(vars, v1.EnvVar{Name: "STORAGE_COMPRESSION_LEVEL", Value: strconv.Itoa(kd.config.StorageCompressionLevel)})
+	}
+	if kd.config.StorageCompressionSampleSize > 0 {
+		vars = append(vars, v1.EnvVar{Name: "STORAGE_COMPRESSION_SAMPLE_SIZE", Value: strconv.Itoa(kd.config.StorageCompressionSampleSize)})
+	}
+	// The sidecar must also read chunks that were moved to the cold tier. The
+	// cold tier uses the same credentials as the main object store, which the
+	// sidecar gets from the storage secret.
+	if kd.config.StorageColdTierURL != "" {
+		vars = append(vars,
+			v1.EnvVar{Name: "STORAGE_COLD_TIER_URL", Value: kd.config.StorageColdTierURL},
+			v1.EnvVar{Name: "STORAGE_COLD_TIER_AFTER_DAYS", Value: strconv.FormatInt(kd.config.StorageColdTierAfterDays, 10)},
+		)
+	}
 	return vars
 }
 
@@ -512,6 +552,31 @@ func (kd *kubeDriver) getEgressSecretEnvVars(pipelineInfo *pps.PipelineInfo) []v
 	return result
 }
 
+func (kd *kubeDriver) getSQLInputSecretEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVar {
+	var result []v1.EnvVar
+	pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
+		secret := input.SQL.GetSecret()
+		if secret.GetName() == "" {
+			return nil
+		}
+		key := secret.Key
+		if key == "" {
+			key = defaultSQLPasswordKey
+		}
+		result = append(result, v1.EnvVar{
+			Name: ppsutil.SQLInputPasswordEnv(input.SQL.Name),
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: secret.Name},
+					Key:                  key,
+				},
+			},
+		})
+		return nil
+	})
+	return result
+}
+
 // We don't want to expose pipeline auth tokens, so we hash it. This will be
 // visible to any user with k8s cluster access
 // Note: This hash shouldn't be used for authentication in any way. We just use
@@ -523,6 +588,26 @@ func hashAuthToken(token string) string {
 	return base64.RawURLEncoding.EncodeToString(h[:])
 }
 
+// getSecretVersions returns the resource versions of secrets, which change
+// whenever the secrets do, so that the datum cache isn't used across changes
+// to them.
+func (kd *kubeDriver) getSecretVersions(ctx context.Context, secrets []*pps.SecretMount) (string, error) {
+	names := make(map[string]struct{})
+	for _, secret := range secrets {
+		names[secret.Name] = struct{}{}
+	}
+	var versions []string
+	for name := range names {
+		secret, err := kd.kubeClient.CoreV1().Secrets(kd.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", errors.Wrapf(err, "could not get secret %q", name)
+		}
+		versions = append(versions, name+"="+secret.ResourceVersion)
+	}
+	sort.Strings(versions)
+	return strings.Join(versions, ","), nil
+}
+
 func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.PipelineInfo) (*workerOptions, error) {
 	pipelineName := pipelineInfo.Pipeline.Name
 	pipelineVersion := pipelineInfo.Version
@@ -606,6 +691,14 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		}
 	}
 
+	if pipelineInfo.Details.DatumCache && len(transform.Secrets) > 0 {
+		secretVersions, err := kd.getSecretVersions(ctx, transform.Secrets)
+		if err != nil {
+			return nil, err
+		}
+		workerEnv = append(workerEnv, v1.EnvVar{Name: client.PPSSecretVersionsEnv, Value: secretVersions})
+	}
+
 	volumes = append(volumes, v1.Volume{
 		Name: "pach-bin",
 		VolumeSource: v1.VolumeSource{
@@ -683,17 +776,26 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 	if err != nil {
 		return nil, errors.EnsureStack(err)
 	}
-	var postgresSecretRef *v1.SecretKeySelector
+	var postgresSecretRef, encryptionKeysSecretRef *v1.SecretKeySelector
 	for _, container := range selfPodInfo.Spec.Containers {
 		for _, envVar := range container.Env {
-			if envVar.Name == "POSTGRES_PASSWORD" && envVar.ValueFrom != nil && envVar.ValueFrom.SecretKeyRef != nil {
+			if envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef == nil {
+				continue
+			}
+			switch envVar.Name {
+			case "POSTGRES_PASSWORD":
 				postgresSecretRef = envVar.ValueFrom.SecretKeyRef
+			case "STORAGE_ENCRYPTION_KEYS":
+				encryptionKeysSecretRef = envVar.ValueFrom.SecretKeyRef
 			}
 		}
 	}
 	if postgresSecretRef == nil {
 		return nil, errors.New("could not load the existing postgres secret reference from kubernetes")
 	}
+	if kd.config.StorageEncryptionKeys != "" && encryptionKeysSecretRef == nil {
+		return nil, errors.New("could not load the existing storage encryption keys secret reference from kubernetes")
+	}
 
 	// Generate options for new RC
 	return &workerOptions{
@@ -711,6 +813,7 @@ func (kd *kubeDriver) getWorkerOptions(ctx context.Context, pipelineInfo *pps.Pi
 		volumes:               volumes,
 		volumeMounts:          volumeMounts,
 		postgresSecret:        postgresSecretRef,
+		encryptionKeysSecret:  encryptionKeysSecretRef,
 		imagePullSecrets:      imagePullSecrets,
 		service:               service,
 		schedulingSpec:        pipelineInfo.Details.SchedulingSpec,
@@ -13,6 +13,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactiondb"
 	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -26,6 +28,9 @@ type driver struct {
 	txnEnv       *txnenv.TransactionEnv
 	db           *pachsql.DB
 	transactions col.PostgresCollection
+	// tracker keeps the file sets added by a transaction from expiring until
+	// it is finished or deleted.
+	tracker track.Tracker
 }
 
 func newDriver(
@@ -37,9 +42,43 @@ func newDriver(
 		txnEnv:       txnEnv,
 		db:           env.GetDBClient(),
 		transactions: transactiondb.Transactions(env.GetDBClient(), env.GetPostgresListener()),
+		tracker:      track.NewPostgresTracker(env.GetDBClient()),
 	}, nil
 }
 
+// fileSetsTrackerPrefix is the prefix of the tracker objects which keep the
+// file sets added by transactions.
+const fileSetsTrackerPrefix = "transaction/"
+
+// pinFileSetsTx keeps the file sets added by the requests of a transaction
+// until it is finished or deleted, since they would otherwise expire while
+// the transaction is open.
+func (d *driver) pinFileSetsTx(sqlTx *pachsql.Tx, txn *transaction.Transaction, requests []*transaction.TransactionRequest) error {
+	if err := d.unpinFileSetsTx(sqlTx, txn); err != nil {
+		return err
+	}
+	var pointsTo []string
+	for _, request := range requests {
+		if request.AddFileSet == nil {
+			continue
+		}
+		id, err := fileset.ParseID(request.AddFileSet.FileSetId)
+		if err != nil {
+			return err
+		}
+		pointsTo = append(pointsTo, id.TrackerID())
+	}
+	if len(pointsTo) == 0 {
+		return nil
+	}
+	return errors.EnsureStack(d.tracker.CreateTx(sqlTx, fileSetsTrackerPrefix+txn.ID, pointsTo, track.NoTTL))
+}
+
+// unpinFileSetsTx lets the file sets added by a transaction expire.
+func (d *driver) unpinFileSetsTx(sqlTx *pachsql.Tx, txn *transaction.Transaction) error {
+	return errors.EnsureStack(d.tracker.DeleteTx(sqlTx, fileSetsTrackerPrefix+txn.ID))
+}
+
 func now() *types.Timestamp {
 	t, err := types.TimestampProto(time.Now())
 	if err != nil {
@@ -101,7 +140,10 @@ func (d *driver) inspectTransaction(ctx context.Context, txn *transaction.Transa
 
 func (d *driver) deleteTransaction(ctx context.Context, txn *transaction.Transaction) error {
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return errors.EnsureStack(d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID))
+		if err := d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return d.unpinFileSetsTx(txnCtx.SqlTx, txn)
 	})
 }
 
@@ -133,6 +175,9 @@ func (d *driver) deleteAll(ctx context.Context, sqlTx *pachsql.Tx, running *tran
 			if err != nil {
 				return errors.EnsureStack(err)
 			}
+			if err := d.unpinFileSetsTx(sqlTx, info.Transaction); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -173,6 +218,16 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			err = directTxn.StopJob(request.StopJob)
 		} else if request.CreatePipeline != nil {
 			err = directTxn.CreatePipeline(request.CreatePipeline)
+		} else if request.AddFileSet != nil {
+			err = directTxn.AddFileSet(request.AddFileSet)
+		} else if request.DeletePipeline != nil {
+			err = directTxn.DeletePipeline(request.DeletePipeline)
+		} else if request.StopPipeline != nil {
+			err = directTxn.StopPipeline(request.StopPipeline)
+		} else if request.StartPipeline != nil {
+			err = directTxn.StartPipeline(request.StartPipeline)
+		} else if request.CreateSecret != nil {
+			err = directTxn.CreateSecret(request.CreateSecret)
 		} else {
 			err = errors.New("unrecognized transaction request type")
 		}
@@ -193,6 +248,10 @@ func (d *driver) finishTransaction(ctx context.Context, txn *transaction.Transac
 		if err := d.transactions.ReadWrite(txnCtx.SqlTx).Delete(txn.ID); err != nil {
 			return info, errors.EnsureStack(err)
 		}
+		// the file sets are referenced by their commits now
+		if err := d.unpinFileSetsTx(txnCtx.SqlTx, txn); err != nil {
+			return info, err
+		}
 		// no need to update the transaction, since it's gone
 		// because the transaction info was read in the same sql transaction as the delete,
 		// we don't have to worry about checking for additional transaction changes
@@ -292,7 +351,10 @@ func (d *driver) updateTransaction(
 					storedInfo.Version += 1
 					return nil
 				})
-				return errors.EnsureStack(err)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				return d.pinFileSetsTx(sqlTx, txn, localInfo.Requests)
 			}); err == nil {
 				// update succeeded, put the incremented version in the returned info
 				localInfo.Version = storedInfo.Version
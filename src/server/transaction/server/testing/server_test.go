@@ -393,6 +393,64 @@ func TestTransactions(suite *testing.T) {
 		require.Equal(t, txn.ID, commitInfos[0].Commit.ID)
 	})
 
+	suite.Run("TestModifyFileTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+
+		require.NoError(t, txnClient.CreateRepo("A"))
+		commit, err := txnClient.StartCommit("A", "master")
+		require.NoError(t, err)
+		require.NoError(t, txnClient.PutFile(commit, "foo", strings.NewReader("foo")))
+		require.NoError(t, txnClient.PutFile(commit, "bar", strings.NewReader("bar")))
+		require.NoError(t, txnClient.DeleteFile(commit, "bar"))
+		require.YesError(t, txnClient.AddFileSet("A", "master", "", "not a file set"))
+
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(info.Requests))
+		_, err = env.PachClient.InspectRepo("A")
+		require.YesError(t, err)
+
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit("A", "master", ""))
+
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "foo", &buf))
+		require.Equal(t, "foo", buf.String())
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+	})
+
+	suite.Run("TestBatchAddFileSet", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		resp, err := env.PachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+			return mf.PutFile("foo", strings.NewReader("foo"))
+		})
+		require.NoError(t, err)
+
+		info, err := env.PachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+			require.NoError(t, builder.CreateRepo("A"))
+			_, err := builder.StartCommit("A", "master")
+			require.NoError(t, err)
+			require.NoError(t, builder.AddFileSet("A", "master", "", resp.FileSetId))
+			return builder.FinishCommit("A", "master", "")
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(info.Requests))
+
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(client.NewCommit("A", "master", ""), "foo", &buf))
+		require.Equal(t, "foo", buf.String())
+	})
+
 	suite.Run("TestBatchTransaction", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -502,3 +560,40 @@ func TestCreatePipelineTransaction(t *testing.T) {
 	require.NoError(t, c.GetFile(commitInfo.Commit, "foo", &buf))
 	require.Equal(t, "bar", buf.String())
 }
+
+func TestDeletePipelineTransaction(t *testing.T) {
+	c, _ := minikubetestenv.AcquireCluster(t)
+	repo := testutil.UniqueString("in")
+	pipeline := testutil.UniqueString("pipeline")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out", repo)},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/"),
+		"master",
+		false,
+	))
+
+	txn, err := c.StartTransaction()
+	require.NoError(t, err)
+	txnClient := c.WithTransaction(txn)
+	require.NoError(t, txnClient.StopPipeline(pipeline))
+	require.NoError(t, txnClient.DeletePipeline(pipeline, false))
+	// deleting a pipeline which doesn't exist is a no-op
+	require.NoError(t, txnClient.DeletePipeline(testutil.UniqueString("missing"), false))
+
+	// nothing happens until the transaction is finished
+	pipelineInfo, err := c.InspectPipeline(pipeline, false)
+	require.NoError(t, err)
+	require.False(t, pipelineInfo.Stopped)
+
+	_, err = c.FinishTransaction(txn)
+	require.NoError(t, err)
+	_, err = c.InspectPipeline(pipeline, false)
+	require.YesError(t, err)
+	_, err = c.InspectRepo(pipeline)
+	require.YesError(t, err)
+}
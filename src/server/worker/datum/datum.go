@@ -177,6 +177,19 @@ func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error
 	return err
 }
 
+// UploadCachedOutput uploads src as the output of a datum, instead of
+// processing it. src holds the output of an identical datum.
+func (s *Set) UploadCachedOutput(meta *Meta, src *pfs.File, opts ...Option) error {
+	d := newDatum(s, meta, opts...)
+	if s.pfsOutputClient != nil {
+		if err := s.pfsOutputClient.CopyFile("/", src, client.WithAppendCopyFile(), client.WithDatumCopyFile(d.ID)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	s.stats.Processed++
+	return d.uploadMetaOutput()
+}
+
 // Datum manages a datum.
 type Datum struct {
 	set              *Set
@@ -187,6 +200,18 @@ type Datum struct {
 	recoveryCallback func(context.Context) error
 	timeout          time.Duration
 	IDPrefix         string
+	checkpointer     Checkpointer
+	checkpointEvery  time.Duration
+}
+
+// Checkpointer saves and restores the checkpoint directory of datums, so that
+// a datum which is retried can resume from the progress of the last attempt.
+type Checkpointer interface {
+	// Save saves the contents of dir as the checkpoint of a datum. Failing to
+	// save a checkpoint doesn't fail the datum, so Save handles its own errors.
+	Save(ctx context.Context, datumID, dir string)
+	// Restore restores the last checkpoint of a datum, if there is one, into dir.
+	Restore(ctx context.Context, datumID, dir string) error
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -210,6 +235,11 @@ func (d *Datum) PFSStorageRoot() string {
 	return path.Join(d.storageRoot, PFSPrefix, d.ID)
 }
 
+// CheckpointStorageRoot returns the checkpoint storage root.
+func (d *Datum) CheckpointStorageRoot() string {
+	return path.Join(d.PFSStorageRoot(), client.PPSCheckpointDir)
+}
+
 // MetaStorageRoot returns the meta storage root.
 func (d *Datum) MetaStorageRoot() string {
 	return path.Join(d.storageRoot, MetaPrefix, d.ID)
@@ -247,6 +277,11 @@ func (d *Datum) withData(cb func() error) (retErr error) {
 	if err := os.MkdirAll(path.Join(d.PFSStorageRoot(), OutputPrefix), 0777); err != nil {
 		return errors.EnsureStack(err)
 	}
+	if d.checkpointer != nil {
+		if err := os.MkdirAll(d.CheckpointStorageRoot(), 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
 	defer func() {
 		if err := os.RemoveAll(d.PFSStorageRoot()); retErr == nil {
 			retErr = errors.EnsureStack(err)
@@ -304,6 +339,15 @@ func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) err
 	defer func() {
 		d.meta.Stats.ProcessTime = types.DurationProto(time.Since(start))
 	}()
+	if d.checkpointer != nil {
+		return d.withCheckpoints(ctx, func() error {
+			return d.runWithTimeout(ctx, cb)
+		})
+	}
+	return d.runWithTimeout(ctx, cb)
+}
+
+func (d *Datum) runWithTimeout(ctx context.Context, cb func(ctx context.Context) error) error {
 	if d.timeout > 0 {
 		timeoutCtx, cancel := context.WithTimeout(ctx, d.timeout)
 		defer cancel()
@@ -312,6 +356,41 @@ func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) err
 	return d.run(ctx, cb)
 }
 
+// withCheckpoints restores the last checkpoint of the datum, and then saves
+// the checkpoint directory periodically while cb runs. The checkpoint is also
+// saved if cb fails, so that a datum which times out resumes from where it
+// stopped. The checkpoints are saved with ctx rather than the datum's timeout.
+func (d *Datum) withCheckpoints(ctx context.Context, cb func() error) (retErr error) {
+	dir := d.CheckpointStorageRoot()
+	if err := d.checkpointer.Restore(ctx, d.ID, dir); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(d.checkpointEvery)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.checkpointer.Save(ctx, d.ID, dir)
+			case <-done:
+				return
+			}
+		}
+	}()
+	defer func() {
+		close(done)
+		wg.Wait()
+		if retErr != nil {
+			d.checkpointer.Save(ctx, d.ID, dir)
+		}
+	}()
+	return cb()
+}
+
 func (d *Datum) run(ctx context.Context, cb func(ctx context.Context) error) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -379,6 +458,11 @@ func (d *Datum) uploadOutput() error {
 	return d.uploadMetaOutput()
 }
 
+// UploadOutput uploads the current output of the datum to mf.
+func (d *Datum) UploadOutput(mf client.ModifyFile) error {
+	return d.upload(mf, path.Join(d.PFSStorageRoot(), OutputPrefix))
+}
+
 func (d *Datum) upload(mf client.ModifyFile, storageRoot string, cb ...func(*tar.Header) error) (retErr error) {
 	if err := miscutil.WithPipe(func(w io.Writer) (retErr error) {
 		bufW := bufio.NewWriterSize(w, grpcutil.MaxMsgPayloadSize)
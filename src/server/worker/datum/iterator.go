@@ -51,11 +51,14 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	if pi.input == nil {
 		return nil
 	}
-	repo := pi.input.Repo
+	repo := client.NewRepo(pi.input.Repo)
+	if pi.input.RepoType != "" {
+		repo = client.NewSystemRepo(pi.input.Repo, pi.input.RepoType)
+	}
 	branch := pi.input.Branch
 	commit := pi.input.Commit
 	pattern := pi.input.Glob
-	return pi.pachClient.GlobFile(client.NewCommit(repo, branch, commit), pattern, func(fi *pfs.FileInfo) error {
+	return pi.pachClient.GlobFile(repo.NewCommit(branch, commit), pattern, func(fi *pfs.FileInfo) error {
 		g := glob.MustCompile(pfsfile.CleanPath(pi.input.Glob), '/')
 		// Remove the trailing slash to support glob replace on directory paths.
 		p := strings.TrimRight(fi.File.Path, "/")
@@ -148,6 +151,18 @@ func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) Iterato
 	})
 }
 
+func newSQLIterator(pachClient *client.APIClient, input *pps.SQLInput) Iterator {
+	branch := pps.SQLInputBranch(input)
+	return newPFSIterator(pachClient, &pps.PFSInput{
+		Name:     input.Name,
+		Repo:     branch.Repo.Name,
+		RepoType: branch.Repo.Type,
+		Branch:   branch.Name,
+		Commit:   input.Commit,
+		Glob:     "/*",
+	})
+}
+
 // Hasher is the standard interface for a datum hasher.
 type Hasher interface {
 	// Hash computes the datum hash based on the inputs.
@@ -546,6 +561,8 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		}
 	case input.Cron != nil:
 		iterator = newCronIterator(pachClient, input.Cron)
+	case input.SQL != nil:
+		iterator = newSQLIterator(pachClient, input.SQL)
 	default:
 		return nil, errors.Errorf("unrecognized input type: %v", input)
 	}
@@ -62,3 +62,12 @@ func WithPrefixIndex() Option {
 		d.IDPrefix = fmt.Sprintf("%016d", d.meta.Index) + "-"
 	}
 }
+
+// WithCheckpointer exposes a checkpoint directory to the datum, which is saved
+// with cp at the given interval and restored when the datum is retried.
+func WithCheckpointer(cp Checkpointer, interval time.Duration) Option {
+	return func(d *Datum) {
+		d.checkpointer = cp
+		d.checkpointEvery = interval
+	}
+}
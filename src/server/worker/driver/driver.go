@@ -278,7 +278,7 @@ func (d *driver) NewTaskSource() task.Source {
 func (d *driver) NewTaskDoer(groupID string, cache task.Cache) task.Doer {
 	etcdPrefix := path.Join(d.env.Config().EtcdPrefix, d.env.Config().PPSEtcdPrefix)
 	taskService := d.env.GetTaskService(etcdPrefix)
-	return taskService.NewDoer(TaskNamespace(d.pipelineInfo), groupID, cache)
+	return taskService.NewDoer(TaskNamespace(d.pipelineInfo), groupID, cache, ppsutil.TaskDoerOptions(d.pipelineInfo)...)
 }
 
 func (d *driver) ExpectedNumWorkers() (int64, error) {
@@ -539,6 +539,11 @@ func (d *driver) UserCodeEnv(
 }
 
 func (d *driver) GetContainerImageID(ctx context.Context, containerName string) (string, error) {
+	// Local workers don't run in a pod, so the image ID is passed by the
+	// local driver. Workers which run on the host don't have one.
+	if d.env.Config().PPSInfraDriver == serviceenv.InfraDriverLocal {
+		return os.Getenv(client.PPSUserImageIDEnv), nil
+	}
 	pod, err := d.env.GetKubeClient().CoreV1().Pods(d.env.Config().Namespace).Get(
 		ctx,
 		d.env.Config().WorkerSpecificConfiguration.PodName,
@@ -141,5 +141,11 @@ func (d *driver) linkData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	if d.PipelineInfo().Details.DatumCheckpointInterval != nil {
+		if err := os.Symlink(filepath.Join(dir, client.PPSCheckpointDir), filepath.Join(d.InputDir(), client.PPSCheckpointDir)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+
 	return nil
 }
@@ -61,6 +61,12 @@ func (d *driver) moveData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	if d.PipelineInfo().Details.DatumCheckpointInterval != nil {
+		if err := os.Rename(filepath.Join(dir, client.PPSCheckpointDir), filepath.Join(d.InputDir(), client.PPSCheckpointDir)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+
 	err := os.Rename(filepath.Join(dir, "out"), filepath.Join(d.InputDir(), "out"))
 	return errors.EnsureStack(err)
 }
@@ -0,0 +1,111 @@
+package transform
+
+import (
+	"context"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+// checkpointer saves the checkpoint directories of a job's datums in the PFS
+// cache. The entries are tagged with the job, so they are cleared with the
+// rest of the job's cache when the job finishes. Cache entries can't be
+// overwritten, so the last checkpoint of a datum is cleared before a new one
+// is put. A worker which fails in between loses the datum's checkpoint. The
+// checkpoints are encrypted with the key of the job's output repo, if it has
+// one.
+type checkpointer struct {
+	pachClient *client.APIClient
+	logger     logs.TaggedLogger
+	job        *pps.Job
+	commit     *pfs.Commit
+}
+
+func newCheckpointer(pachClient *client.APIClient, logger logs.TaggedLogger, job *pps.Job, commit *pfs.Commit) *checkpointer {
+	return &checkpointer{
+		pachClient: pachClient,
+		logger:     logger,
+		job:        job,
+		commit:     commit,
+	}
+}
+
+// key returns the cache key of a datum's checkpoint, which is also its tag.
+func (c *checkpointer) key(datumID string) string {
+	return ppsdb.JobKey(c.job) + "/checkpoint/" + datumID
+}
+
+func (c *checkpointer) Save(ctx context.Context, datumID, dir string) {
+	if err := c.save(ctx, datumID, dir); err != nil {
+		c.logger.Logf("could not save the checkpoint of the datum: %v", err)
+	}
+}
+
+func (c *checkpointer) save(ctx context.Context, datumID, dir string) error {
+	pachClient := c.pachClient.WithCtx(ctx)
+	resp, err := pachClient.WithCreateFileSetClientForCommit(c.commit, func(mf client.ModifyFile) error {
+		return miscutil.WithPipe(func(w io.Writer) error {
+			return tarutil.Export(dir, w)
+		}, func(r io.Reader) error {
+			return errors.EnsureStack(mf.PutFileTAR(r))
+		})
+	})
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(&DatumCheckpoint{FileSetId: resp.FileSetId})
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	key := c.key(datumID)
+	if _, err := pachClient.PfsAPIClient.ClearCache(ctx, &pfs.ClearCacheRequest{TagPrefix: key}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = pachClient.PfsAPIClient.PutCache(ctx, &pfs.PutCacheRequest{
+		Key: key,
+		Value: &types.Any{
+			TypeUrl: "/" + proto.MessageName(&DatumCheckpoint{}),
+			Value:   data,
+		},
+		FileSetIds: []string{resp.FileSetId},
+		Tag:        key,
+	})
+	return errors.EnsureStack(err)
+}
+
+func (c *checkpointer) Restore(ctx context.Context, datumID, dir string) error {
+	pachClient := c.pachClient.WithCtx(ctx)
+	resp, err := pachClient.PfsAPIClient.GetCache(ctx, &pfs.GetCacheRequest{Key: c.key(datumID)})
+	if err != nil {
+		if pfsserver.IsCacheEntryNotFoundErr(err) {
+			// The datum hasn't been checkpointed yet.
+			return nil
+		}
+		return errors.EnsureStack(err)
+	}
+	checkpoint := &DatumCheckpoint{}
+	if err := types.UnmarshalAny(resp.Value, checkpoint); err != nil {
+		return errors.EnsureStack(err)
+	}
+	c.logger.Logf("restoring the checkpoint of the datum")
+	commit := client.NewRepo(client.FileSetsRepoName).NewCommit("", checkpoint.FileSetId)
+	r, err := pachClient.GetFileTAR(commit, "/")
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	if err := tarutil.Import(dir, r); err != nil && !pfsserver.IsFileNotFoundErr(err) {
+		return err
+	}
+	return nil
+}
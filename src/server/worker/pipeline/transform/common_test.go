@@ -58,6 +58,8 @@ type testEnv struct {
 	*testpachd.RealEnv
 	logger *logs.MockLogger
 	driver driver.Driver
+	// stop stops the pipeline's workers.
+	stop context.CancelFunc
 }
 
 // testDriver is identical to a real driver except it overloads egress, which is
@@ -126,12 +128,17 @@ func (td *testDriver) GetContainerImageID(ctx context.Context, containerName str
 // newTestEnv provides a test env with etcd and pachd instances and connected
 // clients, plus a worker driver for performing worker operations.
 func newTestEnv(t *testing.T, dbConfig serviceenv.ConfigOption, pipelineInfo *pps.PipelineInfo) *testEnv {
-	realEnv := testpachd.NewRealEnv(t, dbConfig)
+	return newPipelineTestEnv(t, testpachd.NewRealEnv(t, dbConfig), pipelineInfo)
+}
+
+// newPipelineTestEnv provides a worker driver for a pipeline in an existing
+// test env, so that multiple pipelines can share it.
+func newPipelineTestEnv(t *testing.T, realEnv *testpachd.RealEnv, pipelineInfo *pps.PipelineInfo) *testEnv {
 	logger := logs.NewMockLogger()
 	if debug {
 		logger.Writer = os.Stdout
 	}
-	workerDir := filepath.Join(realEnv.Directory, "worker")
+	workerDir := filepath.Join(realEnv.Directory, "worker", pipelineInfo.Pipeline.Name)
 	driver, err := driver.NewDriver(
 		realEnv.ServiceEnv,
 		realEnv.PachClient,
@@ -148,5 +155,6 @@ func newTestEnv(t *testing.T, dbConfig serviceenv.ConfigOption, pipelineInfo *pp
 		RealEnv: realEnv,
 		logger:  logger,
 		driver:  &testDriver{driver},
+		stop:    cancel,
 	}
 }
@@ -0,0 +1,109 @@
+package transform
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+)
+
+// datumCache reuses the output of datums across pipelines. An entry is keyed
+// on the hash of a datum's inputs and the hash of the transform which
+// processed it, and holds a file set with the datum's output. The entries are
+// shared by all pipelines, so they aren't cleared with a job. They are stored
+// in a separate PFS cache, which evicts the least recently used entries.
+type datumCache struct {
+	pachClient    *client.APIClient
+	renewer       *renew.StringSet
+	transformHash string
+}
+
+func newDatumCache(pachClient *client.APIClient, renewer *renew.StringSet, transform *pps.Transform, imageID, secretVersions string) (*datumCache, error) {
+	// The transform is hashed as JSON, because the JSON encoding of maps
+	// (such as the env) is deterministic.
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(transform)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	hash := pfs.NewHash()
+	hash.Write([]byte(data))
+	// The image ID identifies the contents of the user image, which the
+	// image name in the transform may not. Similarly, the secret versions
+	// identify the contents of the secrets named in the transform.
+	hash.Write([]byte(imageID))
+	hash.Write([]byte{0})
+	hash.Write([]byte(secretVersions))
+	return &datumCache{
+		pachClient:    pachClient,
+		renewer:       renewer,
+		transformHash: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// key returns the cache key of a datum with the given inputs.
+func (dc *datumCache) key(inputs []*common.Input) string {
+	hash := pfs.NewHash()
+	hash.Write([]byte(common.DatumID(inputs)))
+	for _, input := range inputs {
+		hash.Write([]byte(input.FileInfo.Hash))
+		// Empty files change the inputs that the user code sees.
+		if input.EmptyFiles {
+			hash.Write([]byte{1})
+		} else {
+			hash.Write([]byte{0})
+		}
+	}
+	hash.Write([]byte(dc.transformHash))
+	return pfs.DatumCacheTag + "/" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// get returns the output of a cached datum. The output is renewed until the
+// datum set is done, so that it isn't deleted if the entry is evicted.
+func (dc *datumCache) get(ctx context.Context, key string) (*pfs.File, bool) {
+	resp, err := dc.pachClient.PfsAPIClient.GetCache(ctx, &pfs.GetCacheRequest{Key: key})
+	if err != nil {
+		return nil, false
+	}
+	entry := &DatumCacheEntry{}
+	if err := types.UnmarshalAny(resp.Value, entry); err != nil {
+		return nil, false
+	}
+	if err := dc.renewer.Add(ctx, entry.FileSetId); err != nil {
+		return nil, false
+	}
+	return client.NewRepo(client.FileSetsRepoName).NewCommit("", entry.FileSetId).NewFile("/"), true
+}
+
+// put caches the output of a datum, which has been processed successfully.
+func (dc *datumCache) put(ctx context.Context, key string, d *datum.Datum) error {
+	resp, err := dc.pachClient.WithCtx(ctx).WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		return d.UploadOutput(mf)
+	})
+	if err != nil {
+		return err
+	}
+	entry := &DatumCacheEntry{FileSetId: resp.FileSetId}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = dc.pachClient.PfsAPIClient.PutCache(ctx, &pfs.PutCacheRequest{
+		Key: key,
+		Value: &types.Any{
+			TypeUrl: "/" + proto.MessageName(entry),
+			Value:   data,
+		},
+		FileSetIds: []string{resp.FileSetId},
+		Tag:        pfs.DatumCacheTag,
+	})
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,50 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+)
+
+func TestDatumCacheKey(t *testing.T) {
+	newInputs := func(hash string, emptyFiles bool) []*common.Input {
+		return []*common.Input{{
+			Name:       "input",
+			EmptyFiles: emptyFiles,
+			FileInfo: &pfs.FileInfo{
+				File: client.NewCommit("input", "master", "").NewFile("/file"),
+				Hash: []byte(hash),
+			},
+		}}
+	}
+	newKey := func(transform *pps.Transform, imageID, secretVersions string, inputs []*common.Input) string {
+		dc, err := newDatumCache(nil, nil, transform, imageID, secretVersions)
+		require.NoError(t, err)
+		return dc.key(inputs)
+	}
+	transform := &pps.Transform{
+		Image: "image",
+		Cmd:   []string{"bash"},
+		Env:   map[string]string{"a": "1", "b": "2", "c": "3"},
+	}
+	key := newKey(transform, "id", "secret=1", newInputs("hash", false))
+
+	// Identical datums of pipelines with the same transform share a key.
+	for i := 0; i < 10; i++ {
+		transform := &pps.Transform{
+			Image: "image",
+			Cmd:   []string{"bash"},
+			Env:   map[string]string{"c": "3", "b": "2", "a": "1"},
+		}
+		require.Equal(t, key, newKey(transform, "id", "secret=1", newInputs("hash", false)))
+	}
+	require.NotEqual(t, key, newKey(transform, "id", "secret=1", newInputs("other", false)))
+	require.NotEqual(t, key, newKey(transform, "id", "secret=1", newInputs("hash", true)))
+	require.NotEqual(t, key, newKey(transform, "other", "secret=1", newInputs("hash", false)))
+	require.NotEqual(t, key, newKey(transform, "id", "secret=2", newInputs("hash", false)))
+	require.NotEqual(t, key, newKey(&pps.Transform{Image: "image", Cmd: []string{"sh"}}, "id", "secret=1", newInputs("hash", false)))
+}
@@ -260,6 +260,9 @@ func (reg *registry) processJob(pj *pendingJob) error {
 }
 
 func (reg *registry) processJobStarting(pj *pendingJob) error {
+	if err := materializeSQLInputs(pj.driver.PachClient(), pj.logger, pj.ji); err != nil {
+		return err
+	}
 	// block until job inputs are ready
 	failed, err := failedInputs(pj.driver.PachClient(), pj.ji)
 	if err != nil {
@@ -507,9 +510,12 @@ func (reg *registry) processJobEgressing(pj *pendingJob) error {
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	waitCommit := func(name string, commit *pfs.Commit) error {
-		ci, err := pachClient.WaitCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
+		ci, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{
+			Commit: commit,
+			Wait:   pfs.CommitState_FINISHED,
+		})
 		if err != nil {
-			return errors.Wrapf(err, "error blocking on commit %s", commit)
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error blocking on commit %s", commit)
 		}
 		if ci.Error != "" {
 			failed = append(failed, name)
@@ -522,6 +528,11 @@ func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string,
 				return err
 			}
 		}
+		if input.SQL != nil && input.SQL.Commit != "" {
+			if err := waitCommit(input.SQL.Name, pps.SQLInputBranch(input.SQL).NewCommit(input.SQL.Commit)); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 	if visitErr != nil {
@@ -0,0 +1,214 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachsql"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sdata"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+// materializeSQLInputs runs the queries of the job's SQL inputs whose commits
+// are still open, writes their results to the commits and finishes them. pachd
+// only starts a commit on each tick of a SQL input, so that the queries and
+// their results stay out of pachd. If a query fails, its commit is finished
+// with the error, which makes the job unrunnable, and the next tick runs the
+// query again.
+func materializeSQLInputs(pachClient *client.APIClient, logger logs.TaggedLogger, jobInfo *pps.JobInfo) error {
+	return pps.VisitInput(jobInfo.Details.Input, func(input *pps.Input) error {
+		if input.SQL == nil || input.SQL.Commit == "" {
+			return nil
+		}
+		ci, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{
+			Commit: pps.SQLInputBranch(input.SQL).NewCommit(input.SQL.Commit),
+		})
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		// Commits of other jobs' ticks are aliased into the job's commit set,
+		// and are already finished.
+		if ci.Finishing != nil || ci.Origin.Kind != pfs.OriginKind_USER {
+			return nil
+		}
+		return errors.EnsureStack(logger.LogStep(fmt.Sprintf("running the query of SQL input %q", input.SQL.Name), func() error {
+			return materializeSQLInput(pachClient, logger, input.SQL, ci.Commit)
+		}))
+	})
+}
+
+func materializeSQLInput(pachClient *client.APIClient, logger logs.TaggedLogger, in *pps.SQLInput, commit *pfs.Commit) error {
+	dir, err := ioutil.TempDir("", "sql-input-")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer os.RemoveAll(dir)
+	password := os.Getenv(ppsutil.SQLInputPasswordEnv(in.Name))
+	paths, queryErr := runSQLQuery(pachClient.Ctx(), in, password, dir)
+	if queryErr != nil {
+		logger.Logf("could not run the query of SQL input %q: %v", in.Name, queryErr)
+		_, err := pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit: commit,
+			Error:  fmt.Sprintf("could not run the query: %v", queryErr),
+		})
+		return errors.EnsureStack(err)
+	}
+	if err := pachClient.WithModifyFileClient(commit, func(m client.ModifyFile) error {
+		// The commit may hold part of the result of a previous attempt.
+		if err := m.DeleteFile("/"); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, p := range paths {
+			if err := putSQLInputFile(m, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	_, err = pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit,
+	})
+	return errors.EnsureStack(err)
+}
+
+func putSQLInputFile(m client.ModifyFile, p string) (retErr error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	return errors.EnsureStack(m.PutFile(filepath.Base(p), f))
+}
+
+// runSQLQuery runs the query of a SQL input and writes its result to files in
+// dir, one per shard, so that the result can be larger than memory. It
+// returns the paths of the files.
+func runSQLQuery(ctx context.Context, in *pps.SQLInput, password, dir string) (_ []string, retErr error) {
+	u, err := pachsql.ParseURL(in.URL)
+	if err != nil {
+		return nil, err
+	}
+	db, err := pachsql.OpenURL(*u, password)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	rows, err := db.QueryContext(ctx, in.Query)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer rows.Close()
+	cTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	info := sdata.NewTableInfoFromColumnTypes(cTypes)
+	keyIdx := -1
+	shards := 1
+	if in.KeyColumn != "" {
+		for i, ci := range info.Columns {
+			if strings.EqualFold(ci.Name, in.KeyColumn) {
+				keyIdx = i
+			}
+		}
+		if keyIdx < 0 {
+			return nil, errors.Errorf("query result has no column %q", in.KeyColumn)
+		}
+		shards = int(in.Shards)
+	}
+	paths := make([]string, shards)
+	files := make([]*os.File, shards)
+	writers := make([]sdata.TupleWriter, shards)
+	defer func() {
+		for _, f := range files {
+			if f == nil {
+				continue
+			}
+			if err := f.Close(); retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+		}
+	}()
+	for i := range files {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("%04d%s", i, sqlInputExtension(in.FileFormat)))
+		if files[i], err = os.Create(paths[i]); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		if writers[i], err = newSQLInputWriter(files[i], in.FileFormat, info); err != nil {
+			return nil, err
+		}
+	}
+	row, err := sdata.NewTupleFromColumnTypes(cTypes)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		if err := rows.Scan(row...); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		var shard int
+		if keyIdx >= 0 {
+			h := fnv.New64a()
+			fmt.Fprint(h, reflect.Indirect(reflect.ValueOf(row[keyIdx])).Interface())
+			shard = int(h.Sum64() % uint64(shards))
+		}
+		if err := writers[shard].WriteTuple(row); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, w := range writers {
+		if err := w.Flush(); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	return paths, nil
+}
+
+func newSQLInputWriter(w io.Writer, format *pfs.SQLDatabaseEgress_FileFormat, info *pachsql.TableInfo) (sdata.TupleWriter, error) {
+	var fieldNames []string
+	for _, ci := range info.Columns {
+		fieldNames = append(fieldNames, ci.Name)
+	}
+	switch format.GetType() {
+	case pfs.SQLDatabaseEgress_FileFormat_UNKNOWN, pfs.SQLDatabaseEgress_FileFormat_CSV:
+		return sdata.NewCSVWriter(w, fieldNames), nil
+	case pfs.SQLDatabaseEgress_FileFormat_JSON:
+		return sdata.NewJSONWriter(w, fieldNames), nil
+	case pfs.SQLDatabaseEgress_FileFormat_PARQUET:
+		return sdata.NewParquetWriter(w, info)
+	default:
+		return nil, errors.Errorf("unknown file format %v", format.GetType())
+	}
+}
+
+func sqlInputExtension(format *pfs.SQLDatabaseEgress_FileFormat) string {
+	switch format.GetType() {
+	case pfs.SQLDatabaseEgress_FileFormat_JSON:
+		return ".json"
+	case pfs.SQLDatabaseEgress_FileFormat_PARQUET:
+		return ".parquet"
+	default:
+		return ".csv"
+	}
+}
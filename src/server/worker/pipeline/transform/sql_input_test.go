@@ -0,0 +1,105 @@
+package transform
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+func TestSQLInput(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	u := dockertestenv.NewMySQLURL(t)
+	db := testutil.OpenDBURL(t, u, dockertestenv.MySQLPassword)
+	_, err := db.Exec(`CREATE TABLE test_data (
+		id SERIAL PRIMARY KEY,
+		col_a VARCHAR(100)
+	)`)
+	require.NoError(t, err)
+	insert := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := db.Exec(`INSERT INTO test_data (col_a) VALUES (?)`, randutil.UniqueString(""))
+			require.NoError(t, err)
+		}
+	}
+	in := &pps.SQLInput{
+		Name:      "in",
+		Repo:      "pipeline",
+		URL:       fmt.Sprintf("%s://%s@%s:%d/%s", u.Protocol, u.User, u.Host, u.Port, u.Database),
+		Query:     "select * from test_data",
+		KeyColumn: "id",
+		Shards:    4,
+	}
+	t.Setenv(ppsutil.SQLInputPasswordEnv(in.Name), dockertestenv.MySQLPassword)
+	require.NoError(t, env.PachClient.CreateRepo(in.Repo))
+	_, err = env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+		Repo: client.NewSystemRepo(in.Repo, pfs.SQLRepoType),
+	})
+	require.NoError(t, err)
+	branch := pps.SQLInputBranch(in)
+	// tick starts a commit for the input, like pachd does, and runs the query
+	// of the job's input, like the worker does.
+	tick := func() {
+		commit, err := env.PachClient.PfsAPIClient.StartCommit(env.PachClient.Ctx(), &pfs.StartCommitRequest{Branch: branch})
+		require.NoError(t, err)
+		jobInput := proto.Clone(in).(*pps.SQLInput)
+		jobInput.Commit = commit.ID
+		jobInfo := &pps.JobInfo{Details: &pps.JobInfo_Details{Input: &pps.Input{SQL: jobInput}}}
+		require.NoError(t, materializeSQLInputs(env.PachClient, logs.NewMockLogger(), jobInfo))
+		ci, err := env.PachClient.PfsAPIClient.InspectCommit(env.PachClient.Ctx(), &pfs.InspectCommitRequest{Commit: commit})
+		require.NoError(t, err)
+		require.NotNil(t, ci.Finished)
+		require.Equal(t, "", ci.Error)
+	}
+	// countRows checks that the result is in one file per shard, and returns
+	// the number of rows in the files.
+	countRows := func() int {
+		fis, err := env.PachClient.ListFileAll(branch.NewCommit(""), "/")
+		require.NoError(t, err)
+		require.Equal(t, int(in.Shards), len(fis))
+		var rows int
+		for _, fi := range fis {
+			require.Equal(t, pfs.FileType_FILE, fi.FileType)
+			require.True(t, strings.HasSuffix(fi.File.Path, ".csv"))
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PachClient.GetFile(fi.File.Commit, fi.File.Path, buf))
+			// non-empty files start with a header
+			if lines := strings.Count(buf.String(), "\n"); lines > 0 {
+				rows += lines - 1
+			}
+		}
+		return rows
+	}
+
+	insert(100)
+	tick()
+	require.Equal(t, 100, countRows())
+
+	// each tick replaces the previous result
+	insert(10)
+	tick()
+	require.Equal(t, 110, countRows())
+
+	// a failed query finishes the commit with the error
+	in.Query = "select * from missing_table"
+	commit, err := env.PachClient.PfsAPIClient.StartCommit(env.PachClient.Ctx(), &pfs.StartCommitRequest{Branch: branch})
+	require.NoError(t, err)
+	in.Commit = commit.ID
+	require.NoError(t, materializeSQLInputs(env.PachClient, logs.NewMockLogger(), &pps.JobInfo{Details: &pps.JobInfo_Details{Input: &pps.Input{SQL: in}}}))
+	ci, err := env.PachClient.PfsAPIClient.InspectCommit(env.PachClient.Ctx(), &pfs.InspectCommitRequest{Commit: commit})
+	require.NoError(t, err)
+	require.NotEqual(t, "", ci.Error)
+}
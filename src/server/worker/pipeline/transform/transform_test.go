@@ -5,7 +5,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,12 +33,14 @@ import (
 )
 
 func newWorkerSpawnerPair(t *testing.T, dbConfig serviceenv.ConfigOption, pipelineInfo *pps.PipelineInfo) *testEnv {
+	return startWorkerSpawnerPair(t, newTestEnv(t, dbConfig, pipelineInfo), pipelineInfo)
+}
+
+func startWorkerSpawnerPair(t *testing.T, env *testEnv, pipelineInfo *pps.PipelineInfo) *testEnv {
 	// We only support simple pfs input pipelines in this test suite at the moment
 	require.NotNil(t, pipelineInfo.Details.Input)
 	require.NotNil(t, pipelineInfo.Details.Input.Pfs)
 
-	env := newTestEnv(t, dbConfig, pipelineInfo)
-
 	eg, ctx := errgroup.WithContext(env.driver.PachClient().Ctx())
 	t.Cleanup(func() {
 		require.NoError(t, eg.Wait())
@@ -49,10 +54,12 @@ func newWorkerSpawnerPair(t *testing.T, dbConfig serviceenv.ConfigOption, pipeli
 	require.NoError(t, os.Setenv(obj.StorageBackendEnvVar, obj.Local))
 	require.NoError(t, os.MkdirAll(env.ServiceEnv.Config().StorageRoot, 0777))
 
-	// Set up the input repo and branch
+	// Set up the input repo and branch, unless another pipeline shares them
 	input := pipelineInfo.Details.Input.Pfs
-	require.NoError(t, env.PachClient.CreateRepo(input.Repo))
-	require.NoError(t, env.PachClient.CreateBranch(input.Repo, input.Branch, "", "", nil))
+	if _, err := env.PachClient.InspectRepo(input.Repo); err != nil {
+		require.NoError(t, env.PachClient.CreateRepo(input.Repo))
+		require.NoError(t, env.PachClient.CreateBranch(input.Repo, input.Branch, "", "", nil))
+	}
 
 	// Create the output repo
 	pipelineRepo := client.NewRepo(pipelineInfo.Pipeline.Name)
@@ -222,6 +229,12 @@ func triggerJob(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []taruti
 }
 
 func testJobSuccess(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []tarutil.File) {
+	testJobSuccessOutput(t, env, pi, files, files)
+}
+
+// testJobSuccessOutput triggers a job by adding files to the input, and checks
+// that the job succeeds with the output files.
+func testJobSuccessOutput(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files, output []tarutil.File) {
 	ctx, jobInfo := mockBasicJob(t, env, pi)
 	triggerJob(t, env, pi, files)
 	ctx = withTimeout(ctx, 10*time.Second)
@@ -241,10 +254,10 @@ func testJobSuccess(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []ta
 	r, err := env.PachClient.GetFileTAR(jobInfo.OutputCommit, "/*")
 	require.NoError(t, err)
 	require.NoError(t, tarutil.Iterate(r, func(file tarutil.File) error {
-		ok, err := tarutil.Equal(files[0], file)
+		ok, err := tarutil.Equal(output[0], file)
 		require.NoError(t, err)
 		require.True(t, ok)
-		files = files[1:]
+		output = output[1:]
 		return nil
 	}))
 }
@@ -261,6 +274,58 @@ func TestTransformPipeline(suite *testing.T) {
 		})
 	})
 
+	suite.Run("TestJobSuccessDatumCache", func(t *testing.T) {
+		t.Parallel()
+		// The user code records each run, so the test can tell whether the
+		// output of a datum was reused.
+		runs := filepath.Join(t.TempDir(), "runs")
+		countRuns := func() int {
+			data, err := ioutil.ReadFile(runs)
+			require.NoError(t, err)
+			return strings.Count(string(data), "\n")
+		}
+		pi := defaultPipelineInfo()
+		pi.Details.DatumCache = true
+		pi.Details.Transform.Stdin = []string{
+			"cp inputRepo/* out",
+			fmt.Sprintf("echo run >> %s", runs),
+		}
+		env := newWorkerSpawnerPair(t, dockertestenv.NewTestDBConfig(t), pi)
+		files := []tarutil.File{
+			tarutil.NewMemFile("/a", []byte("foobar")),
+			tarutil.NewMemFile("/b", []byte("barfoo")),
+		}
+		testJobSuccess(t, env, pi, files)
+		require.Equal(t, 2, countRuns())
+
+		// A second pipeline with the same transform over the same datums
+		// reuses their output instead of running the user code.
+		env.stop()
+		pi2 := proto.Clone(pi).(*pps.PipelineInfo)
+		pi2.Pipeline = client.NewPipeline("testPipeline2")
+		env2 := startWorkerSpawnerPair(t, newPipelineTestEnv(t, env.RealEnv, pi2), pi2)
+		testJobSuccessOutput(t, env2, pi2, nil, files)
+		require.Equal(t, 2, countRuns())
+	})
+
+	suite.Run("TestJobSuccessDatumCheckpoint", func(t *testing.T) {
+		t.Parallel()
+		pi := defaultPipelineInfo()
+		// The first try of each datum fails after writing a checkpoint, and
+		// the second try only succeeds if the checkpoint was restored.
+		pi.Details.Transform.Stdin = []string{
+			"if [ ! -f checkpoint/progress ]; then echo progress > checkpoint/progress; exit 1; fi",
+			"cp inputRepo/* out",
+		}
+		pi.Details.DatumTries = 2
+		pi.Details.DatumCheckpointInterval = types.DurationProto(time.Hour)
+		env := newWorkerSpawnerPair(t, dockertestenv.NewTestDBConfig(t), pi)
+		testJobSuccess(t, env, pi, []tarutil.File{
+			tarutil.NewMemFile("/a", []byte("foobar")),
+			tarutil.NewMemFile("/b", []byte("barfoo")),
+		})
+	})
+
 	suite.Run("TestJobSuccessEgress", func(t *testing.T) {
 		t.Parallel()
 		objC := dockertestenv.NewTestObjClient(t)
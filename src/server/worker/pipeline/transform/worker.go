@@ -352,9 +352,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	}
 	return pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 		// Setup file operation client for output meta commit.
-		resp, err := pachClient.WithCreateFileSetClient(func(mfMeta client.ModifyFile) error {
+		resp, err := pachClient.WithCreateFileSetClientForCommit(ppsutil.MetaCommit(datumSet.OutputCommit), func(mfMeta client.ModifyFile) error {
 			// Setup file operation client for output PFS commit.
-			resp, err := pachClient.WithCreateFileSetClient(func(mfPFS client.ModifyFile) (retErr error) {
+			resp, err := pachClient.WithCreateFileSetClientForCommit(datumSet.OutputCommit, func(mfPFS client.ModifyFile) (retErr error) {
 				opts := []datum.SetOption{
 					datum.WithMetaOutput(mfMeta),
 					datum.WithPFSOutput(mfPFS),
@@ -362,6 +362,28 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				}
 				pachClient := pachClient.WithCtx(ctx)
 				cacheClient := pfssync.NewCacheClient(pachClient, renewer)
+				var dc *datumCache
+				if driver.PipelineInfo().Details.DatumCache {
+					// The datum cache is shared with other pipelines, so the
+					// output of a repo with its own encryption key isn't cached,
+					// as shredding the repo would break the other pipelines'
+					// outputs.
+					repoInfo, err := pachClient.InspectRepo(datumSet.OutputCommit.Branch.Repo.Name)
+					if err != nil {
+						return err
+					}
+					// Without an image ID, the user code can't be identified.
+					if repoInfo.EncryptionKeyId == "" && userImageID != "" {
+						dc, err = newDatumCache(pachClient, renewer, driver.PipelineInfo().Details.Transform, userImageID, os.Getenv(client.PPSSecretVersionsEnv))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				var cp *checkpointer
+				if driver.PipelineInfo().Details.DatumCheckpointInterval != nil {
+					cp = newCheckpointer(pachClient, logger, client.NewJob(driver.PipelineInfo().Pipeline.Name, datumSet.JobID), ppsutil.MetaCommit(datumSet.OutputCommit))
+				}
 				// Setup datum set for processing.
 				return datum.WithSet(cacheClient, storageRoot, func(s *datum.Set) error {
 					di := datum.NewFileSetIterator(pachClient, datumSet.FileSetId)
@@ -372,6 +394,14 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						meta.ImageId = userImageID
 						inputs := meta.Inputs
 						logger = logger.WithData(inputs)
+						var cacheKey string
+						if dc != nil {
+							cacheKey = dc.key(inputs)
+							if output, ok := dc.get(ctx, cacheKey); ok {
+								logger.Logf("reusing the cached output of an identical datum")
+								return s.UploadCachedOutput(meta, output)
+							}
+						}
 						env := driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs)
 						var opts []datum.Option
 						if driver.PipelineInfo().Details.DatumTimeout != nil {
@@ -384,6 +414,13 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						if driver.PipelineInfo().Details.DatumTries > 0 {
 							opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 						}
+						if cp != nil {
+							interval, err := types.DurationFromProto(driver.PipelineInfo().Details.DatumCheckpointInterval)
+							if err != nil {
+								return errors.EnsureStack(err)
+							}
+							opts = append(opts, datum.WithCheckpointer(cp, interval))
+						}
 						if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 							opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
 								return errors.EnsureStack(driver.RunUserErrorHandlingCode(runCtx, logger, env))
@@ -397,6 +434,12 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 									err := d.Run(cancelCtx, func(runCtx context.Context) error {
 										return errors.EnsureStack(driver.RunUserCode(runCtx, logger, env))
 									})
+									if err == nil && dc != nil {
+										// Failing to cache the output doesn't fail the datum.
+										if err := dc.put(ctx, cacheKey, d); err != nil {
+											logger.Logf("could not cache the output of the datum: %v", err)
+										}
+									}
 									return errors.EnsureStack(err)
 								})
 								return errors.EnsureStack(err)
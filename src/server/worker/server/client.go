@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"strconv"
@@ -118,7 +119,16 @@ func ForEachWorker(ctx context.Context, pipelineRcName string, etcdClient *etcd.
 	}
 	for _, kv := range resp.Kvs {
 		workerIP := path.Base(string(kv.Key))
-		if err := WithClient(ctx, workerIP, workerGrpcPort, cb); err != nil {
+		port := workerGrpcPort
+		// Local workers register their port along with their IP address.
+		if host, p, err := net.SplitHostPort(workerIP); err == nil {
+			parsed, err := strconv.ParseUint(p, 10, 16)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			workerIP, port = host, uint16(parsed)
+		}
+		if err := WithClient(ctx, workerIP, port, cb); err != nil {
 			return err
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -113,15 +114,21 @@ func (m *Group) GetGroup() string {
 }
 
 type TaskInfo struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Group                *Group   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
-	State                State    `protobuf:"varint,3,opt,name=state,proto3,enum=taskapi.State" json:"state,omitempty"`
-	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	InputType            string   `protobuf:"bytes,5,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
-	InputData            string   `protobuf:"bytes,6,opt,name=input_data,json=inputData,proto3" json:"input_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group     *Group `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	State     State  `protobuf:"varint,3,opt,name=state,proto3,enum=taskapi.State" json:"state,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	InputType string `protobuf:"bytes,5,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
+	InputData string `protobuf:"bytes,6,opt,name=input_data,json=inputData,proto3" json:"input_data,omitempty"`
+	// group_queue_depth is the number of tasks in the task's group which are
+	// waiting to be claimed.
+	GroupQueueDepth int64 `protobuf:"varint,7,opt,name=group_queue_depth,json=groupQueueDepth,proto3" json:"group_queue_depth,omitempty"`
+	// group_wait_time is how long the oldest task in the task's group which is
+	// waiting to be claimed has been waiting.
+	GroupWaitTime        *types.Duration `protobuf:"bytes,8,opt,name=group_wait_time,json=groupWaitTime,proto3" json:"group_wait_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
@@ -199,6 +206,20 @@ func (m *TaskInfo) GetInputData() string {
 	return ""
 }
 
+func (m *TaskInfo) GetGroupQueueDepth() int64 {
+	if m != nil {
+		return m.GroupQueueDepth
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetGroupWaitTime() *types.Duration {
+	if m != nil {
+		return m.GroupWaitTime
+	}
+	return nil
+}
+
 type ListTaskRequest struct {
 	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -256,30 +277,35 @@ func init() {
 func init() { proto.RegisterFile("task/task.proto", fileDescriptor_8e8f2b86464a95fe) }
 
 var fileDescriptor_8e8f2b86464a95fe = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcd, 0x8a, 0xdb, 0x30,
-	0x14, 0x85, 0x2b, 0x27, 0x76, 0x1a, 0x05, 0x12, 0x23, 0x42, 0x30, 0xa5, 0x4d, 0x43, 0xe8, 0x22,
-	0x64, 0x61, 0x43, 0xba, 0x68, 0xa1, 0xab, 0xfc, 0x35, 0x35, 0x4d, 0x5d, 0xb0, 0x63, 0x0a, 0xdd,
-	0x0c, 0x8a, 0xad, 0x71, 0x4c, 0x88, 0xa5, 0xb1, 0xe4, 0x81, 0xbc, 0xdf, 0x2c, 0x66, 0x39, 0x4f,
-	0x30, 0x0c, 0x7e, 0x92, 0x41, 0x72, 0x20, 0x5e, 0xcd, 0x46, 0xdc, 0x73, 0xce, 0xbd, 0xd2, 0x27,
-	0x2e, 0xec, 0x09, 0xcc, 0x8f, 0x8e, 0x3c, 0x6c, 0x96, 0x53, 0x41, 0x51, 0x4b, 0xd6, 0x98, 0xa5,
-	0x1f, 0xfa, 0x09, 0x4d, 0xa8, 0xf2, 0x1c, 0x59, 0x55, 0xf1, 0xf8, 0x07, 0xd4, 0x37, 0x39, 0x2d,
-	0x18, 0xfa, 0x08, 0xdb, 0x19, 0x3e, 0x11, 0xce, 0x70, 0x44, 0x2c, 0x30, 0x02, 0x93, 0xb6, 0x7f,
-	0x35, 0x50, 0x1f, 0xea, 0x89, 0x6c, 0xb3, 0x34, 0x95, 0x54, 0x62, 0xfc, 0x00, 0xe0, 0xfb, 0x1d,
-	0xe6, 0x47, 0x37, 0xbb, 0xa5, 0x68, 0x00, 0xb5, 0x34, 0xae, 0x26, 0x17, 0x46, 0xf9, 0xfc, 0x59,
-	0x73, 0x57, 0xbe, 0x96, 0xc6, 0xe8, 0x4b, 0x7d, 0xb4, 0x33, 0xeb, 0xda, 0x17, 0x20, 0x5b, 0xbd,
-	0x7b, 0xb9, 0x4a, 0x76, 0x71, 0x81, 0x05, 0xb1, 0x1a, 0x23, 0x30, 0xe9, 0xd6, 0xba, 0x02, 0xe9,
-	0xfa, 0x55, 0x88, 0x06, 0xd0, 0xc8, 0x09, 0xe6, 0x34, 0xb3, 0x9a, 0x8a, 0xe3, 0xa2, 0xd0, 0x27,
-	0x08, 0xd3, 0x8c, 0x15, 0xe2, 0x46, 0x9c, 0x19, 0xb1, 0xf4, 0x8a, 0x5e, 0x39, 0xbb, 0x33, 0x23,
-	0xd7, 0x38, 0xc6, 0x02, 0x5b, 0x46, 0x2d, 0x5e, 0x61, 0x81, 0xc7, 0xdf, 0x60, 0x6f, 0x9b, 0x72,
-	0x21, 0x7f, 0xe2, 0x93, 0xbb, 0x82, 0x70, 0x71, 0x85, 0x06, 0x6f, 0x40, 0x4f, 0x7f, 0x41, 0x5d,
-	0xe1, 0xa1, 0x0e, 0x6c, 0x85, 0xde, 0x6f, 0xef, 0xef, 0x3f, 0xcf, 0x7c, 0x27, 0x85, 0x1f, 0x7a,
-	0x9e, 0xeb, 0x6d, 0x4c, 0x20, 0x45, 0x10, 0x2e, 0x97, 0xeb, 0x20, 0x30, 0x35, 0x29, 0x7e, 0xce,
-	0xdd, 0x6d, 0xe8, 0xaf, 0xcd, 0x86, 0x14, 0xcb, 0xed, 0xdc, 0xfd, 0xb3, 0x5e, 0x99, 0xcd, 0xc5,
-	0xf7, 0xc7, 0x72, 0x08, 0x9e, 0xca, 0x21, 0x78, 0x29, 0x87, 0xe0, 0xff, 0x34, 0x49, 0xc5, 0xa1,
-	0xd8, 0xdb, 0x11, 0x3d, 0x39, 0x0c, 0x47, 0x87, 0x73, 0x4c, 0xf2, 0x7a, 0x75, 0x3f, 0x73, 0x78,
-	0x1e, 0xa9, 0x2d, 0xef, 0x0d, 0xb5, 0xc7, 0xaf, 0xaf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x67, 0xd5,
-	0xc3, 0x0d, 0xf9, 0x01, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0xc5, 0xe9, 0xda, 0x6e, 0x9e, 0x58, 0x83, 0x35, 0x4d, 0x61, 0x82, 0x52, 0x55, 0x3c, 0x54,
+	0x7d, 0x48, 0xa4, 0xf1, 0x00, 0x12, 0x4f, 0x5d, 0x53, 0x46, 0x44, 0x09, 0x22, 0x6d, 0x35, 0x89,
+	0x97, 0xc8, 0x6d, 0xbc, 0xd4, 0x1a, 0x8d, 0xbd, 0xe4, 0x1a, 0xd4, 0xef, 0xe2, 0x27, 0x78, 0xe4,
+	0x0b, 0x10, 0xea, 0x97, 0x20, 0xdb, 0x45, 0xcd, 0x13, 0x2f, 0xd6, 0x3d, 0xe7, 0xdc, 0x6b, 0x9f,
+	0x7b, 0x64, 0xdc, 0x01, 0x5a, 0xdd, 0x07, 0xfa, 0xf0, 0x65, 0x29, 0x40, 0x90, 0xb6, 0xae, 0xa9,
+	0xe4, 0x97, 0xe7, 0xb9, 0xc8, 0x85, 0xe1, 0x02, 0x5d, 0x59, 0xf9, 0xb2, 0x9b, 0x0b, 0x91, 0x7f,
+	0x65, 0x81, 0x41, 0x4b, 0x75, 0x17, 0x64, 0xaa, 0xa4, 0xc0, 0x45, 0x61, 0xf5, 0xfe, 0x5b, 0xdc,
+	0xbc, 0x29, 0x85, 0x92, 0xe4, 0x19, 0x3e, 0x29, 0xe8, 0x86, 0x55, 0x92, 0xae, 0x98, 0x87, 0x7a,
+	0x68, 0x70, 0x92, 0x1c, 0x08, 0x72, 0x8e, 0x9b, 0xb9, 0x6e, 0xf3, 0x1c, 0xa3, 0x58, 0xd0, 0xff,
+	0xe1, 0xe0, 0xe3, 0x39, 0xad, 0xee, 0xa3, 0xe2, 0x4e, 0x90, 0x0b, 0xec, 0xf0, 0xcc, 0x4e, 0x5e,
+	0xb7, 0x76, 0xbf, 0x5f, 0x38, 0x51, 0x98, 0x38, 0x3c, 0x23, 0x2f, 0xeb, 0xa3, 0xa7, 0x57, 0x67,
+	0xfe, 0xde, 0xb0, 0x6f, 0xde, 0xdd, 0x5f, 0xa5, 0xbb, 0x2a, 0xa0, 0xc0, 0xbc, 0x46, 0x0f, 0x0d,
+	0xce, 0x6a, 0x5d, 0x33, 0xcd, 0x26, 0x56, 0x24, 0x17, 0xb8, 0x55, 0x32, 0x5a, 0x89, 0xc2, 0x3b,
+	0x32, 0x3e, 0xf6, 0x88, 0x3c, 0xc7, 0x98, 0x17, 0x52, 0x41, 0x0a, 0x5b, 0xc9, 0xbc, 0xa6, 0x75,
+	0x6f, 0x98, 0xf9, 0x56, 0xb2, 0x83, 0x9c, 0x51, 0xa0, 0x5e, 0xab, 0x26, 0x87, 0x14, 0x28, 0x19,
+	0xe2, 0x27, 0xc6, 0x44, 0xfa, 0xa0, 0x98, 0x62, 0x69, 0xc6, 0x24, 0xac, 0xbd, 0x76, 0x0f, 0x0d,
+	0x1a, 0x49, 0xc7, 0x08, 0x9f, 0x35, 0x1f, 0x6a, 0x9a, 0x8c, 0xb0, 0xa5, 0xd2, 0xef, 0x94, 0x43,
+	0x0a, 0x7c, 0xc3, 0xbc, 0x63, 0xb3, 0xd7, 0x53, 0xdf, 0x26, 0xed, 0xff, 0x4b, 0xda, 0x0f, 0xf7,
+	0x49, 0x27, 0x8f, 0xcd, 0xc4, 0x2d, 0xe5, 0x30, 0xe7, 0x1b, 0xd6, 0x7f, 0x8d, 0x3b, 0x53, 0x5e,
+	0x81, 0x0e, 0x2e, 0x61, 0x0f, 0x8a, 0x55, 0x70, 0xc8, 0x08, 0xfd, 0x27, 0xa3, 0xe1, 0x7b, 0xdc,
+	0x34, 0x69, 0x90, 0x53, 0xdc, 0x5e, 0xc4, 0x1f, 0xe2, 0x4f, 0xb7, 0xb1, 0xfb, 0x48, 0x83, 0x64,
+	0x11, 0xc7, 0x51, 0x7c, 0xe3, 0x22, 0x0d, 0x66, 0x8b, 0xf1, 0x78, 0x32, 0x9b, 0xb9, 0x8e, 0x06,
+	0xef, 0x46, 0xd1, 0x74, 0x91, 0x4c, 0xdc, 0x86, 0x06, 0xe3, 0xe9, 0x28, 0xfa, 0x38, 0x09, 0xdd,
+	0xa3, 0xeb, 0x37, 0x3f, 0x77, 0x5d, 0xf4, 0x6b, 0xd7, 0x45, 0x7f, 0x76, 0x5d, 0xf4, 0x65, 0x98,
+	0x73, 0x58, 0xab, 0xa5, 0xbf, 0x12, 0x9b, 0x40, 0xd2, 0xd5, 0x7a, 0x9b, 0xb1, 0xb2, 0x5e, 0x7d,
+	0xbb, 0x0a, 0xaa, 0x72, 0x65, 0x3e, 0xdd, 0xb2, 0x65, 0xd6, 0x7b, 0xf5, 0x77, 0x00, 0x8f, 0xa1,
+	0x90, 0x6c, 0x88, 0x02, 0x00, 0x00,
 }
 
 func (m *Group) Marshal() (dAtA []byte, err error) {
@@ -347,6 +373,23 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.GroupWaitTime != nil {
+		{
+			size, err := m.GroupWaitTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GroupQueueDepth != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.GroupQueueDepth))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.InputData) > 0 {
 		i -= len(m.InputData)
 		copy(dAtA[i:], m.InputData)
@@ -494,6 +537,13 @@ func (m *TaskInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.GroupQueueDepth != 0 {
+		n += 1 + sovTask(uint64(m.GroupQueueDepth))
+	}
+	if m.GroupWaitTime != nil {
+		l = m.GroupWaitTime.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -849,6 +899,61 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.InputData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupQueueDepth", wireType)
+			}
+			m.GroupQueueDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupQueueDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupWaitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupWaitTime == nil {
+				m.GroupWaitTime = &types.Duration{}
+			}
+			if err := m.GroupWaitTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -81,6 +81,11 @@ type TransactionRequest struct {
 	UpdateJobState       *pps.UpdateJobStateRequest  `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
 	CreatePipeline       *pps.CreatePipelineRequest  `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
 	StopJob              *pps.StopJobRequest         `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
+	AddFileSet           *pfs.AddFileSetRequest      `protobuf:"bytes,11,opt,name=add_file_set,json=addFileSet,proto3" json:"add_file_set,omitempty"`
+	DeletePipeline       *pps.DeletePipelineRequest  `protobuf:"bytes,12,opt,name=delete_pipeline,json=deletePipeline,proto3" json:"delete_pipeline,omitempty"`
+	StopPipeline         *pps.StopPipelineRequest    `protobuf:"bytes,13,opt,name=stop_pipeline,json=stopPipeline,proto3" json:"stop_pipeline,omitempty"`
+	StartPipeline        *pps.StartPipelineRequest   `protobuf:"bytes,14,opt,name=start_pipeline,json=startPipeline,proto3" json:"start_pipeline,omitempty"`
+	CreateSecret         *pps.CreateSecretRequest    `protobuf:"bytes,15,opt,name=create_secret,json=createSecret,proto3" json:"create_secret,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -189,6 +194,41 @@ func (m *TransactionRequest) GetStopJob() *pps.StopJobRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetAddFileSet() *pfs.AddFileSetRequest {
+	if m != nil {
+		return m.AddFileSet
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeletePipeline() *pps.DeletePipelineRequest {
+	if m != nil {
+		return m.DeletePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStopPipeline() *pps.StopPipelineRequest {
+	if m != nil {
+		return m.StopPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStartPipeline() *pps.StartPipelineRequest {
+	if m != nil {
+		return m.StartPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetCreateSecret() *pps.CreateSecretRequest {
+	if m != nil {
+		return m.CreateSecret
+	}
+	return nil
+}
+
 type TransactionResponse struct {
 	// At most, one of these fields should be set (most responses are empty)
 	Commit               *pfs.Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -694,60 +734,66 @@ func init() {
 func init() { proto.RegisterFile("transaction/transaction.proto", fileDescriptor_284c03442be38d9f) }
 
 var fileDescriptor_284c03442be38d9f = []byte{
-	// 840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xde, 0x64, 0xbb, 0x9b, 0xcd, 0x49, 0xbb, 0xc9, 0x0e, 0x28, 0xf5, 0x7a, 0xd5, 0x6c, 0x64,
-	0x44, 0x59, 0x6e, 0x6c, 0x35, 0x70, 0x85, 0x54, 0x60, 0xd3, 0xd2, 0x2a, 0x11, 0x17, 0x95, 0x53,
-	0x84, 0x76, 0x25, 0x1a, 0xfc, 0x33, 0x4e, 0x8c, 0x12, 0xcf, 0xd4, 0x33, 0x89, 0xd4, 0x37, 0xe0,
-	0x35, 0x10, 0x2f, 0xc3, 0x25, 0x4f, 0x80, 0x50, 0x9e, 0x04, 0x79, 0x66, 0xec, 0xd8, 0xce, 0x4f,
-	0x8b, 0xba, 0x77, 0x9e, 0x73, 0xce, 0xf7, 0xf9, 0x3b, 0x3f, 0x73, 0x34, 0xf0, 0x88, 0xc7, 0x4e,
-	0xc4, 0x1c, 0x8f, 0x87, 0x24, 0xb2, 0x72, 0xdf, 0x26, 0x8d, 0x09, 0x27, 0xe8, 0x34, 0x67, 0x1a,
-	0x2f, 0x7b, 0xfa, 0xc5, 0x84, 0x90, 0xc9, 0x0c, 0x5b, 0xc2, 0xeb, 0x2e, 0x02, 0x0b, 0xcf, 0x29,
-	0x7f, 0x27, 0x83, 0xf5, 0xcb, 0xb2, 0x93, 0x87, 0x73, 0xcc, 0xb8, 0x33, 0xa7, 0x2a, 0xe0, 0xd3,
-	0x09, 0x99, 0x10, 0xf1, 0x69, 0x25, 0x5f, 0xca, 0xfa, 0x80, 0x06, 0xcc, 0xa2, 0x01, 0xcb, 0x8e,
-	0x94, 0x59, 0x94, 0xaa, 0xa3, 0x81, 0xa0, 0xf5, 0x1c, 0xcf, 0x30, 0xc7, 0xd7, 0xb3, 0x99, 0x8d,
-	0xdf, 0x2e, 0x30, 0xe3, 0xc6, 0x1f, 0x47, 0x80, 0x5e, 0xaf, 0x85, 0x29, 0x33, 0xfa, 0x06, 0x1a,
-	0x5e, 0x8c, 0x1d, 0x8e, 0xc7, 0x31, 0xa6, 0x44, 0xab, 0x74, 0x2b, 0x57, 0x8d, 0xde, 0xb9, 0x49,
-	0x03, 0x36, 0x5e, 0xf6, 0xcc, 0x67, 0xc2, 0x65, 0x63, 0x4a, 0x54, 0xbc, 0x0d, 0x5e, 0x66, 0x4a,
-	0xb0, 0xbe, 0xf8, 0x8d, 0xc4, 0x56, 0x8b, 0x58, 0xa9, 0xa0, 0x80, 0xf5, 0x33, 0x13, 0x7a, 0x0a,
-	0xf7, 0x19, 0x77, 0x62, 0x3e, 0xf6, 0xc8, 0x7c, 0x1e, 0x72, 0xed, 0x50, 0x80, 0xf5, 0x14, 0x3c,
-	0x4a, 0x7c, 0xcf, 0x84, 0x2b, 0x45, 0x37, 0xd8, 0xda, 0x86, 0xbe, 0x87, 0x07, 0x41, 0x18, 0x85,
-	0x6c, 0x9a, 0xe2, 0xef, 0x09, 0xfc, 0x45, 0x8a, 0x7f, 0x21, 0x9c, 0x45, 0x82, 0xfb, 0x41, 0xce,
-	0x88, 0x86, 0x70, 0xc6, 0xde, 0x2e, 0x9c, 0x8c, 0x61, 0xcc, 0x30, 0xd7, 0x8e, 0x04, 0x4b, 0x27,
-	0x53, 0x21, 0x02, 0x24, 0x60, 0x84, 0x33, 0xa2, 0x26, 0x2b, 0xda, 0x13, 0x35, 0xaa, 0x88, 0x6e,
-	0xec, 0x44, 0xde, 0x54, 0x3b, 0x2e, 0xaa, 0x91, 0x65, 0xec, 0x0b, 0x5f, 0xa6, 0xc6, 0xcb, 0x19,
-	0x13, 0x06, 0x55, 0x4a, 0xc5, 0x50, 0x2b, 0x32, 0xc8, 0x62, 0x96, 0x18, 0xfc, 0x9c, 0x11, 0xbd,
-	0x84, 0xd6, 0x82, 0xfa, 0x89, 0x86, 0xdf, 0x88, 0x3b, 0x66, 0xdc, 0xe1, 0x58, 0x3b, 0x11, 0x24,
-	0x8f, 0x4c, 0x4a, 0x05, 0xc9, 0x4f, 0xc2, 0x3f, 0x24, 0xee, 0x88, 0x8b, 0x16, 0x4a, 0x9a, 0xd3,
-	0x45, 0xc1, 0x8c, 0x5e, 0x40, 0x53, 0x25, 0x43, 0x43, 0x8a, 0x67, 0x61, 0x84, 0xb5, 0x7a, 0x91,
-	0x47, 0xa6, 0xf3, 0x4a, 0x79, 0x33, 0x1e, 0xaf, 0x60, 0x46, 0x4f, 0xe0, 0x84, 0x71, 0x42, 0x13,
-	0x39, 0x1a, 0x08, 0x82, 0x76, 0x4a, 0x30, 0xe2, 0x84, 0x0e, 0x89, 0x9b, 0x22, 0x6b, 0x4c, 0x9e,
-	0x8d, 0xa7, 0xf0, 0x49, 0x61, 0x44, 0x19, 0x25, 0x11, 0xc3, 0xe8, 0x31, 0x1c, 0xab, 0x2e, 0xcb,
-	0xf1, 0x3c, 0xcd, 0xea, 0x2a, 0xfb, 0xab, 0xbc, 0xc6, 0xe7, 0xd0, 0xc8, 0xc1, 0x51, 0x1b, 0xaa,
-	0xa1, 0x2f, 0x20, 0xf5, 0xfe, 0xf1, 0xea, 0x9f, 0xcb, 0xea, 0xe0, 0xb9, 0x5d, 0x0d, 0x7d, 0xe3,
-	0xcf, 0x2a, 0x34, 0x73, 0x71, 0x83, 0x28, 0x48, 0xc6, 0xb1, 0x91, 0xbb, 0xb5, 0xea, 0x3f, 0x17,
-	0x66, 0xf1, 0x26, 0x9b, 0x79, 0x71, 0xf9, 0x78, 0xf4, 0x2d, 0x9c, 0xc4, 0x32, 0x19, 0xa6, 0x55,
-	0xbb, 0x87, 0x57, 0x8d, 0x9e, 0xb1, 0x0f, 0xab, 0xf2, 0xce, 0x30, 0xe8, 0x1a, 0xea, 0xb1, 0xca,
-	0x96, 0x69, 0x87, 0x82, 0xe0, 0xb3, 0xbd, 0x04, 0x32, 0xd6, 0x5e, 0xa3, 0xd0, 0xd7, 0x50, 0x13,
-	0x17, 0x04, 0xfb, 0xea, 0x2e, 0xe8, 0xa6, 0x5c, 0x2d, 0x66, 0xba, 0x5a, 0xcc, 0xd7, 0xe9, 0x6a,
-	0xb1, 0xd3, 0x50, 0xa4, 0x41, 0x6d, 0x89, 0x63, 0x96, 0xe4, 0x9c, 0xcc, 0xfe, 0x3d, 0x3b, 0x3d,
-	0x1a, 0x6f, 0xa0, 0x55, 0x2a, 0x12, 0x43, 0x43, 0x68, 0xe5, 0x45, 0x85, 0x51, 0x90, 0x6c, 0x8c,
-	0x44, 0xed, 0xe5, 0x1e, 0xb5, 0x09, 0xd6, 0x6e, 0xf2, 0xa2, 0xc1, 0xb8, 0x81, 0x87, 0x7d, 0x87,
-	0x7b, 0xd3, 0x2d, 0x3b, 0x29, 0x5f, 0xcd, 0xca, 0xff, 0xaf, 0xa6, 0x71, 0x0e, 0x0f, 0xc5, 0xfe,
-	0xd8, 0x0c, 0x32, 0x6e, 0xe1, 0x7c, 0x10, 0x31, 0x8a, 0xbd, 0x2d, 0xce, 0x8f, 0x1c, 0x02, 0xe3,
-	0x06, 0x34, 0x79, 0x4d, 0xef, 0x9e, 0x5a, 0x83, 0xf6, 0x8f, 0x21, 0xdb, 0x96, 0xd0, 0x0d, 0x68,
-	0x72, 0xd7, 0xdd, 0xf9, 0x4f, 0x7b, 0xbf, 0x1f, 0xc1, 0xe1, 0xf5, 0xab, 0x01, 0x7a, 0x03, 0xad,
-	0x72, 0xa7, 0xd0, 0x17, 0x65, 0x96, 0x1d, 0xbd, 0xd4, 0xdf, 0x37, 0x18, 0xc6, 0x01, 0xba, 0x85,
-	0x56, 0xb9, 0x5d, 0x9b, 0xfc, 0x3b, 0x1a, 0xaa, 0xef, 0x4b, 0xc7, 0x38, 0x40, 0x2e, 0xa0, 0xcd,
-	0x7e, 0xa3, 0x2f, 0xcb, 0xa0, 0x9d, 0x33, 0xf1, 0x21, 0xfa, 0x7f, 0x86, 0xb3, 0x8d, 0xbe, 0xa3,
-	0xab, 0x32, 0x6e, 0xd7, 0x68, 0xe8, 0xed, 0x8d, 0x7b, 0xfa, 0x43, 0xf2, 0x3e, 0x30, 0x0e, 0xd0,
-	0x2f, 0xd0, 0x2c, 0x75, 0x1d, 0x3d, 0x2e, 0xd3, 0x6e, 0x1f, 0x0b, 0xbd, 0xfb, 0x1e, 0xd9, 0xcc,
-	0x38, 0x40, 0xbf, 0xc2, 0xd9, 0xc6, 0xe8, 0x6c, 0xea, 0xde, 0x35, 0x5d, 0x1f, 0x52, 0x99, 0x97,
-	0x50, 0xcf, 0xde, 0x21, 0xa8, 0xbb, 0xbd, 0x22, 0xeb, 0x27, 0xca, 0xee, 0x4a, 0xf4, 0xbf, 0xfb,
-	0x6b, 0xd5, 0xa9, 0xfc, 0xbd, 0xea, 0x54, 0xfe, 0x5d, 0x75, 0x2a, 0xb7, 0x4f, 0x26, 0x21, 0x9f,
-	0x2e, 0x5c, 0xd3, 0x23, 0x73, 0x8b, 0x3a, 0xde, 0xf4, 0x9d, 0x8f, 0xe3, 0xfc, 0xd7, 0xb2, 0x67,
-	0xb1, 0xd8, 0xcb, 0xbf, 0xcc, 0xdc, 0x63, 0x41, 0xf9, 0xd5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc8, 0x02, 0x52, 0x48, 0xbb, 0x09, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x9d, 0x36, 0x4e, 0x8e, 0x1d, 0xdb, 0x19, 0x50, 0xba, 0x71, 0x68, 0x12, 0x2d, 0xa2,
+	0x84, 0x9b, 0xb5, 0x6a, 0xb8, 0x02, 0x15, 0x48, 0x52, 0x52, 0x25, 0xe2, 0xa2, 0x5a, 0x17, 0xa1,
+	0x44, 0xa2, 0x66, 0xbd, 0x3b, 0x6b, 0x2f, 0xb2, 0x77, 0xa6, 0x3b, 0xe3, 0x48, 0x7d, 0x03, 0xde,
+	0x83, 0x97, 0xe1, 0x92, 0x27, 0x40, 0x28, 0x2f, 0xc0, 0x2b, 0xa0, 0xf9, 0xd9, 0xf5, 0xcc, 0xda,
+	0x4e, 0x8b, 0x9a, 0xbb, 0xdd, 0xef, 0x9c, 0xef, 0xf3, 0x37, 0xe7, 0x9c, 0x39, 0x5e, 0x78, 0xcc,
+	0xb3, 0x20, 0x65, 0x41, 0xc8, 0x13, 0x92, 0x76, 0x8d, 0x67, 0x8f, 0x66, 0x84, 0x13, 0xd4, 0x34,
+	0xa0, 0xc1, 0x4d, 0xaf, 0xb3, 0x3f, 0x22, 0x64, 0x34, 0xc1, 0x5d, 0x19, 0x1d, 0xce, 0xe2, 0x2e,
+	0x9e, 0x52, 0xfe, 0x56, 0x25, 0x77, 0x0e, 0xcb, 0x41, 0x9e, 0x4c, 0x31, 0xe3, 0xc1, 0x94, 0xea,
+	0x84, 0x8f, 0x47, 0x64, 0x44, 0xe4, 0x63, 0x57, 0x3c, 0x69, 0x74, 0x9b, 0xc6, 0xac, 0x4b, 0x63,
+	0x56, 0xbc, 0x52, 0xd6, 0xa5, 0x54, 0xbf, 0xba, 0x08, 0xda, 0xcf, 0xf1, 0x04, 0x73, 0x7c, 0x32,
+	0x99, 0xf8, 0xf8, 0xcd, 0x0c, 0x33, 0xee, 0xfe, 0x5b, 0x03, 0xf4, 0x6a, 0x6e, 0x4c, 0xc3, 0xe8,
+	0x6b, 0xa8, 0x87, 0x19, 0x0e, 0x38, 0x1e, 0x64, 0x98, 0x12, 0xa7, 0x72, 0x54, 0x39, 0xae, 0xf7,
+	0xf6, 0x3c, 0x1a, 0xb3, 0xc1, 0x4d, 0xcf, 0x3b, 0x93, 0x21, 0x1f, 0x53, 0xa2, 0xf3, 0x7d, 0x08,
+	0x0b, 0x48, 0x70, 0x23, 0xf9, 0x33, 0x8a, 0x5b, 0xb5, 0xb9, 0xca, 0x81, 0xc5, 0x8d, 0x0a, 0x08,
+	0x3d, 0x83, 0x06, 0xe3, 0x41, 0xc6, 0x07, 0x21, 0x99, 0x4e, 0x13, 0xee, 0xac, 0x4b, 0x72, 0x27,
+	0x27, 0xf7, 0x45, 0xec, 0x4c, 0x86, 0x72, 0x76, 0x9d, 0xcd, 0x31, 0xf4, 0x3d, 0x6c, 0xc7, 0x49,
+	0x9a, 0xb0, 0x71, 0xce, 0x7f, 0x20, 0xf9, 0xfb, 0x39, 0xff, 0x5c, 0x06, 0x6d, 0x81, 0x46, 0x6c,
+	0x80, 0xe8, 0x12, 0x76, 0xd8, 0x9b, 0x59, 0x50, 0x28, 0x0c, 0x18, 0xe6, 0xce, 0x43, 0xa9, 0x72,
+	0x50, 0xb8, 0x90, 0x09, 0x8a, 0xd0, 0xc7, 0x85, 0x50, 0x8b, 0xd9, 0xb8, 0x70, 0xa3, 0x8b, 0x38,
+	0xcc, 0x82, 0x34, 0x1c, 0x3b, 0x1b, 0xb6, 0x1b, 0x55, 0xc6, 0x53, 0x19, 0x2b, 0xdc, 0x84, 0x06,
+	0x28, 0x14, 0x74, 0x29, 0xb5, 0x42, 0xcd, 0x56, 0x50, 0xc5, 0x2c, 0x29, 0x44, 0x06, 0x88, 0x5e,
+	0x40, 0x7b, 0x46, 0x23, 0xe1, 0xe1, 0x37, 0x32, 0x1c, 0x30, 0x1e, 0x70, 0xec, 0x6c, 0x4a, 0x91,
+	0xc7, 0x1e, 0xa5, 0x52, 0xe4, 0x27, 0x19, 0xbf, 0x24, 0xc3, 0x3e, 0x97, 0x2d, 0x54, 0x32, 0xcd,
+	0x99, 0x05, 0xa3, 0x73, 0x68, 0xe9, 0xc3, 0xd0, 0x84, 0xe2, 0x49, 0x92, 0x62, 0x67, 0xcb, 0xd6,
+	0x51, 0xc7, 0x79, 0xa9, 0xa3, 0x85, 0x4e, 0x68, 0xc1, 0xe8, 0x29, 0x6c, 0x32, 0x4e, 0xa8, 0xb0,
+	0xe3, 0x80, 0x14, 0xd8, 0xcd, 0x05, 0xfa, 0x9c, 0xd0, 0x4b, 0x32, 0xcc, 0x99, 0x35, 0xa6, 0xde,
+	0xd1, 0x37, 0xd0, 0x08, 0xa2, 0x68, 0x10, 0x27, 0x13, 0x2c, 0xdb, 0x51, 0xb7, 0x27, 0xea, 0x24,
+	0x8a, 0xce, 0x93, 0x09, 0x36, 0x3a, 0x01, 0x41, 0x01, 0x09, 0xdf, 0xba, 0x84, 0x85, 0xef, 0x86,
+	0xed, 0x5b, 0x15, 0x71, 0xc1, 0x77, 0x64, 0xc1, 0xa2, 0x15, 0xd2, 0x77, 0xa1, 0xb2, 0x9d, 0xb7,
+	0x62, 0x6e, 0xbe, 0xac, 0xd1, 0x60, 0x06, 0x88, 0xce, 0xa0, 0xa9, 0x66, 0xbb, 0x90, 0x68, 0x4a,
+	0x89, 0x4f, 0xe6, 0x12, 0x41, 0xc6, 0xcb, 0x1a, 0xdb, 0xcc, 0x44, 0x8d, 0x99, 0x62, 0x38, 0xcc,
+	0x30, 0x77, 0x5a, 0xb6, 0x0d, 0xd5, 0x84, 0xbe, 0x8c, 0x95, 0x66, 0x4a, 0x81, 0xee, 0x33, 0xf8,
+	0xc8, 0xba, 0xf0, 0x8c, 0x92, 0x94, 0x61, 0xf4, 0x04, 0x36, 0xf4, 0x9d, 0x51, 0x97, 0xbd, 0x59,
+	0x4c, 0xa9, 0x44, 0x7d, 0x1d, 0x75, 0x3f, 0x83, 0xba, 0x41, 0x47, 0xbb, 0x50, 0x4d, 0x22, 0x49,
+	0xd9, 0x3a, 0xdd, 0xb8, 0xfd, 0xfb, 0xb0, 0x7a, 0xf1, 0xdc, 0xaf, 0x26, 0x91, 0xfb, 0x47, 0x15,
+	0x5a, 0x46, 0xde, 0x45, 0x1a, 0x8b, 0xcb, 0x5d, 0x37, 0x76, 0xa0, 0xfe, 0x9d, 0x7d, 0xcf, 0xde,
+	0x8b, 0x9e, 0x69, 0xce, 0xcc, 0x47, 0xdf, 0xc2, 0x66, 0xa6, 0x4e, 0xc4, 0x9c, 0xea, 0xd1, 0xfa,
+	0x71, 0xbd, 0xe7, 0xde, 0xc5, 0xd5, 0x87, 0x2f, 0x38, 0xe8, 0x04, 0xb6, 0x32, 0x7d, 0x5a, 0xe6,
+	0xac, 0x4b, 0x81, 0x4f, 0xef, 0x14, 0x50, 0xb9, 0xfe, 0x9c, 0x85, 0xbe, 0x82, 0x9a, 0x6c, 0x07,
+	0x8e, 0xf4, 0x66, 0xe9, 0x78, 0x6a, 0x51, 0x7b, 0xf9, 0xa2, 0xf6, 0x5e, 0xe5, 0x8b, 0xda, 0xcf,
+	0x53, 0x91, 0x03, 0xb5, 0x1b, 0x9c, 0x31, 0x71, 0x66, 0xb1, 0x49, 0x1e, 0xf8, 0xf9, 0xab, 0xfb,
+	0x1a, 0xda, 0xa5, 0x22, 0x31, 0x74, 0x09, 0x6d, 0xd3, 0x54, 0x92, 0xc6, 0x62, 0xff, 0x0a, 0xb7,
+	0x87, 0x77, 0xb8, 0x15, 0x5c, 0xbf, 0xc5, 0x6d, 0xc0, 0xbd, 0x82, 0x47, 0xa7, 0x01, 0x0f, 0xc7,
+	0x4b, 0x36, 0xbc, 0x59, 0xcd, 0xca, 0xff, 0xaf, 0xa6, 0xbb, 0x07, 0x8f, 0xe4, 0xbc, 0x2e, 0x26,
+	0xb9, 0xd7, 0xb0, 0x77, 0x91, 0x32, 0x8a, 0xc3, 0x25, 0xc1, 0x0f, 0x1c, 0x02, 0xf7, 0x0a, 0x1c,
+	0x75, 0x5f, 0xef, 0x5f, 0xda, 0x81, 0xdd, 0x1f, 0x13, 0xb6, 0xec, 0x40, 0x57, 0xe0, 0xa8, 0x7f,
+	0x8e, 0x7b, 0xff, 0xd1, 0xde, 0xef, 0x0f, 0x61, 0xfd, 0xe4, 0xe5, 0x05, 0x7a, 0x0d, 0xed, 0x72,
+	0xa7, 0xd0, 0xe7, 0x65, 0x95, 0x15, 0xbd, 0xec, 0xbc, 0x6b, 0x30, 0xdc, 0x35, 0x74, 0x0d, 0xed,
+	0x72, 0xbb, 0x16, 0xf5, 0x57, 0x34, 0xb4, 0x73, 0xd7, 0x71, 0xdc, 0x35, 0x34, 0x04, 0xb4, 0xd8,
+	0x6f, 0xf4, 0x45, 0x99, 0xb4, 0x72, 0x26, 0xde, 0xc7, 0xff, 0xcf, 0xb0, 0xb3, 0xd0, 0x77, 0x74,
+	0x5c, 0xe6, 0xad, 0x1a, 0x8d, 0xce, 0xee, 0xc2, 0x3d, 0xfd, 0x41, 0x7c, 0x6d, 0xb9, 0x6b, 0xe8,
+	0x17, 0x68, 0x95, 0xba, 0x8e, 0x9e, 0x94, 0x65, 0x97, 0x8f, 0x45, 0xe7, 0xe8, 0x1d, 0xb6, 0x99,
+	0xbb, 0x86, 0x7e, 0x85, 0x9d, 0x85, 0xd1, 0x59, 0xf4, 0xbd, 0x6a, 0xba, 0xde, 0xa7, 0x32, 0x2f,
+	0x60, 0xab, 0xf8, 0xaa, 0x43, 0x47, 0xcb, 0x2b, 0x32, 0xff, 0xe0, 0x5b, 0x5d, 0x89, 0xd3, 0xef,
+	0xfe, 0xbc, 0x3d, 0xa8, 0xfc, 0x75, 0x7b, 0x50, 0xf9, 0xe7, 0xf6, 0xa0, 0x72, 0xfd, 0x74, 0x94,
+	0xf0, 0xf1, 0x6c, 0xe8, 0x85, 0x64, 0xda, 0xa5, 0x41, 0x38, 0x7e, 0x1b, 0xe1, 0xcc, 0x7c, 0xba,
+	0xe9, 0x75, 0x59, 0x16, 0x9a, 0xdf, 0xb9, 0xc3, 0x0d, 0x29, 0xf9, 0xe5, 0x7f, 0x03, 0x00, 0x00,
+	0x80, 0x03, 0x1f, 0x09, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1099,6 +1145,66 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CreateSecret != nil {
+		{
+			size, err := m.CreateSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StartPipeline != nil {
+		{
+			size, err := m.StartPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StopPipeline != nil {
+		{
+			size, err := m.StopPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DeletePipeline != nil {
+		{
+			size, err := m.DeletePipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AddFileSet != nil {
+		{
+			size, err := m.AddFileSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.StopJob != nil {
 		{
 			size, err := m.StopJob.MarshalToSizedBuffer(dAtA[:i])
@@ -1701,6 +1807,26 @@ func (m *TransactionRequest) Size() (n int) {
 		l = m.StopJob.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.AddFileSet != nil {
+		l = m.AddFileSet.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeletePipeline != nil {
+		l = m.DeletePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StopPipeline != nil {
+		l = m.StopPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartPipeline != nil {
+		l = m.StartPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.CreateSecret != nil {
+		l = m.CreateSecret.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2328,6 +2454,186 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFileSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddFileSet == nil {
+				m.AddFileSet = &pfs.AddFileSetRequest{}
+			}
+			if err := m.AddFileSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePipeline == nil {
+				m.DeletePipeline = &pps.DeletePipelineRequest{}
+			}
+			if err := m.DeletePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopPipeline == nil {
+				m.StopPipeline = &pps.StopPipelineRequest{}
+			}
+			if err := m.StopPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartPipeline == nil {
+				m.StartPipeline = &pps.StartPipelineRequest{}
+			}
+			if err := m.StartPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateSecret == nil {
+				m.CreateSecret = &pps.CreateSecretRequest{}
+			}
+			if err := m.CreateSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])